@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goofr-group/gaming-store/server/internal/config"
+	"github.com/goofr-group/gaming-store/server/internal/logging"
+	"github.com/goofr-group/gaming-store/server/internal/recommender"
+	idataStore "github.com/goofr-group/gaming-store/server/internal/store/data"
+)
+
+// Commands.
+const (
+	commandTrain    = "train"
+	commandEvaluate = "evaluate"
+)
+
+// Default flag values.
+const (
+	defaultK       = 10
+	defaultHoldout = 0.2
+)
+
+func main() {
+	neighbors := flag.Int("neighbors", recommender.DefaultNeighbors, "amount of similar games kept for each game")
+	k := flag.Int("k", defaultK, "amount of recommendations per user to evaluate")
+	holdout := flag.Float64("holdout", defaultHoldout, "ratio of the most recent purchases of each user held out for evaluation")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] %s|%s\n", os.Args[0], commandTrain, commandEvaluate)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() != 1 || *neighbors < 1 || *k < 1 || *holdout <= 0 || *holdout >= 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	logging.Init(slog.NewJSONHandler(os.Stderr, nil))
+
+	ctx := context.Background()
+
+	// Load service configuration.
+	serviceConfig, err := config.Load()
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, "main: failed to load service configuration", logging.Error(err))
+		os.Exit(1)
+	}
+
+	// Set up data store.
+	dataStore, err := idataStore.New(ctx, serviceConfig.Database)
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, "main: failed to set up data store", logging.Error(err))
+		os.Exit(1)
+	}
+	defer dataStore.Close()
+
+	switch flag.Arg(0) {
+	case commandTrain:
+		err = train(ctx, dataStore, *neighbors)
+	case commandEvaluate:
+		err = evaluate(ctx, dataStore, *neighbors, *k, *holdout)
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, "main: failed to run command", slog.String("command", flag.Arg(0)), logging.Error(err))
+		os.Exit(1)
+	}
+}
+
+// dataStore defines the data store interface required by the commands.
+type dataStore interface {
+	recommender.DataStore
+	recommender.Fallback
+
+	NewTx(ctx context.Context, isoLevel pgx.TxIsoLevel, accessMode pgx.TxAccessMode) (pgx.Tx, error)
+}
+
+// train trains the item-based recommender and stores its items.
+func train(ctx context.Context, dataStore dataStore, neighbors int) error {
+	tx, err := dataStore.NewTx(ctx, pgx.RepeatableRead, pgx.ReadWrite)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	err = recommender.NewItemBased(dataStore, dataStore, neighbors).Train(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// evaluate evaluates the item-based recommender against the held-out purchases and prints the precision at k.
+func evaluate(ctx context.Context, dataStore dataStore, neighbors, k int, holdout float64) error {
+	tx, err := dataStore.NewTx(ctx, pgx.RepeatableRead, pgx.ReadOnly)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	interactions, err := dataStore.ListRecommenderInteractions(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	evaluation := recommender.Evaluate(interactions, neighbors, k, holdout)
+
+	fmt.Printf("users: %d\nprecision@%d: %.4f\n", evaluation.Users, evaluation.K, evaluation.Precision)
+
+	return nil
+}
@@ -12,10 +12,13 @@ import (
 
 	"github.com/goofr-group/gaming-store/server/internal/authn"
 	"github.com/goofr-group/gaming-store/server/internal/authz"
+	ioidc "github.com/goofr-group/gaming-store/server/internal/client/oidc"
 	ismtp "github.com/goofr-group/gaming-store/server/internal/client/smtp"
 	"github.com/goofr-group/gaming-store/server/internal/config"
 	"github.com/goofr-group/gaming-store/server/internal/logging"
+	"github.com/goofr-group/gaming-store/server/internal/recommender"
 	"github.com/goofr-group/gaming-store/server/internal/service"
+	ibreachStore "github.com/goofr-group/gaming-store/server/internal/store/breach"
 	idataStore "github.com/goofr-group/gaming-store/server/internal/store/data"
 	iobjectStore "github.com/goofr-group/gaming-store/server/internal/store/object"
 	ihttp "github.com/goofr-group/gaming-store/server/internal/transport/http"
@@ -23,12 +26,17 @@ import (
 
 // Environment variable keys.
 const (
-	envKeyJWTSigningKey = "JWT_SIGNING_KEY"
+	envKeyJWTSigningKey       = "JWT_SIGNING_KEY"
+	envKeyJWTVerificationKeys = "JWT_VERIFICATION_KEYS"
 )
 
 // Default configuration values.
 const (
-	defaultAddressHTTP = ":8080"
+	defaultAddressHTTP              = ":8080"
+	defaultGamesSimilarInterval     = time.Hour
+	defaultGamesChartsInterval      = 15 * time.Minute
+	defaultRecommenderTrainInterval = 24 * time.Hour
+	defaultScheduledJobsInterval    = time.Minute
 )
 
 // Server metadata.
@@ -92,6 +100,21 @@ func main() {
 		objectStore = iobjectStore.NewNOOP()
 	}
 
+	// Set up breached password store.
+	var breachStore service.BreachStore
+
+	if serviceConfig.BreachedPasswords.Enabled {
+		store, err := ibreachStore.New(serviceConfig.BreachedPasswords)
+		if err != nil {
+			logging.Logger.ErrorContext(ctx, "main: failed to set up breached password store", logging.Error(err))
+			return
+		}
+
+		breachStore = store
+	} else {
+		breachStore = ibreachStore.NewNOOP()
+	}
+
 	// Set up smtp client.
 	var smtp service.SMTP
 
@@ -101,19 +124,82 @@ func main() {
 		smtp = ismtp.NewNOOP()
 	}
 
+	// Set up oidc client.
+	oidc, err := ioidc.New(ctx, serviceConfig.OIDC, serviceConfig.Web.URL)
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, "main: failed to set up oidc client", logging.Error(err))
+		return
+	}
+
 	// Set up authentication service.
-	jwtSigningKey, ok := os.LookupEnv(envKeyJWTSigningKey)
-	if !ok {
-		logging.Logger.WarnContext(ctx, "main: failed to get jwt signing key")
+	keySet, err := authn.NewKeySet([]byte(os.Getenv(envKeyJWTSigningKey)), []byte(os.Getenv(envKeyJWTVerificationKeys)))
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, "main: failed to load jwt keys", logging.Error(err))
+		return
 	}
 
-	authnService := authn.New([]byte(jwtSigningKey))
+	authnService, err := authn.New(keySet, serviceConfig.Web.URL)
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, "main: failed to set up authentication service", logging.Error(err))
+		return
+	}
 
-	// Set up authorization service.
-	authzService := authz.New(ihttp.AuthzRoles, authnService)
+	// Set up recommender.
+	recommender := recommender.NewItemBased(dataStore, dataStore, recommender.DefaultNeighbors)
 
 	// Set up service.
-	service := service.New(authnService, dataStore, objectStore, smtp)
+	service := service.New(authnService, dataStore, objectStore, breachStore, smtp, oidc, recommender, serviceConfig.Web.URL)
+
+	// Set up authorization service.
+	authzPolicy, err := ihttp.NewAuthzPolicy(service)
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, "main: failed to set up authorization policy", logging.Error(err))
+		return
+	}
+
+	authzService := authz.New(authzPolicy, authnService, service)
+
+	// Set up periodic jobs.
+	gamesSimilarInterval := defaultGamesSimilarInterval
+	if len(serviceConfig.Jobs.GamesSimilarInterval) != 0 {
+		gamesSimilarInterval, err = time.ParseDuration(serviceConfig.Jobs.GamesSimilarInterval)
+		if err != nil {
+			logging.Logger.ErrorContext(ctx, "main: failed to parse games similar interval configuration", logging.Error(err))
+			return
+		}
+	}
+
+	gamesChartsInterval := defaultGamesChartsInterval
+	if len(serviceConfig.Jobs.GamesChartsInterval) != 0 {
+		gamesChartsInterval, err = time.ParseDuration(serviceConfig.Jobs.GamesChartsInterval)
+		if err != nil {
+			logging.Logger.ErrorContext(ctx, "main: failed to parse games charts interval configuration", logging.Error(err))
+			return
+		}
+	}
+
+	recommenderTrainInterval := defaultRecommenderTrainInterval
+	if len(serviceConfig.Jobs.RecommenderTrainInterval) != 0 {
+		recommenderTrainInterval, err = time.ParseDuration(serviceConfig.Jobs.RecommenderTrainInterval)
+		if err != nil {
+			logging.Logger.ErrorContext(ctx, "main: failed to parse recommender train interval configuration", logging.Error(err))
+			return
+		}
+	}
+
+	scheduledJobsInterval := defaultScheduledJobsInterval
+	if len(serviceConfig.Jobs.ScheduledJobsInterval) != 0 {
+		scheduledJobsInterval, err = time.ParseDuration(serviceConfig.Jobs.ScheduledJobsInterval)
+		if err != nil {
+			logging.Logger.ErrorContext(ctx, "main: failed to parse scheduled jobs interval configuration", logging.Error(err))
+			return
+		}
+	}
+
+	go runPeriodically(ctx, gamesSimilarInterval, service.RefreshGamesSimilar)
+	go runPeriodically(ctx, gamesChartsInterval, service.RefreshGamesCharts)
+	go runPeriodically(ctx, recommenderTrainInterval, service.TrainRecommender)
+	go runPeriodically(ctx, scheduledJobsInterval, service.RunScheduledJobs)
 
 	// Handle signals.
 	sigs := make(chan os.Signal, 1)
@@ -125,7 +211,7 @@ func main() {
 		addressHTTP = serviceConfig.ServerHTTP.Address
 	}
 
-	handlerHTTP := ihttp.New(authzService, service)
+	handlerHTTP := ihttp.New(authzService, service, serviceConfig.ServerHTTP.TrustedProxies)
 
 	var writeTimeoutHTTP time.Duration
 	if len(serviceConfig.ServerHTTP.WriteTimeout) != 0 {
@@ -166,3 +252,20 @@ func main() {
 
 	logging.Logger.InfoContext(ctx, "main: server terminated")
 }
+
+// runPeriodically executes f immediately and then on every interval, until the context is done.
+// The errors are already logged by the service, so they are ignored.
+func runPeriodically(ctx context.Context, interval time.Duration, f func(ctx context.Context) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		_ = f(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
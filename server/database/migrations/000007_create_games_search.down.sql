@@ -0,0 +1,16 @@
+DROP TRIGGER games_tags_update_games_search_vector ON games_tags;
+DROP TRIGGER games_update_search_vector ON games;
+
+DROP INDEX games_title_trgm_idx;
+DROP INDEX games_search_vector_idx;
+
+ALTER TABLE games DROP COLUMN search_vector;
+
+DROP FUNCTION games_tags_update_games_search_vector();
+DROP FUNCTION games_update_search_vector();
+DROP FUNCTION games_tags_names(uuid);
+DROP FUNCTION games_search_vector(text, text, text, text);
+DROP FUNCTION search_query(text);
+DROP FUNCTION search_document(text, "char");
+
+DROP EXTENSION IF EXISTS pg_trgm;
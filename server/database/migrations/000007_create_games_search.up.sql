@@ -0,0 +1,89 @@
+-- Extensions.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- Functions.
+CREATE FUNCTION search_document(value text, weight "char")
+RETURNS tsvector AS $$
+BEGIN
+    -- The unstemmed configuration preserves exact matches (e.g. names), while the language configurations reduce the
+    -- words to their stems, in the languages supported by the store.
+    RETURN setweight(to_tsvector('simple', coalesce(value, '')), weight) ||
+        setweight(to_tsvector('english', coalesce(value, '')), weight) ||
+        setweight(to_tsvector('portuguese', coalesce(value, '')), weight);
+END;
+$$ LANGUAGE plpgsql IMMUTABLE;
+
+CREATE FUNCTION search_query(value text)
+RETURNS tsquery AS $$
+BEGIN
+    RETURN websearch_to_tsquery('simple', value) ||
+        websearch_to_tsquery('english', value) ||
+        websearch_to_tsquery('portuguese', value);
+END;
+$$ LANGUAGE plpgsql IMMUTABLE;
+
+CREATE FUNCTION games_search_vector(title text, description text, features text, tags text)
+RETURNS tsvector AS $$
+BEGIN
+    RETURN search_document(title, 'A') ||
+        search_document(tags, 'B') ||
+        search_document(features, 'C') ||
+        search_document(description, 'D');
+END;
+$$ LANGUAGE plpgsql IMMUTABLE;
+
+CREATE FUNCTION games_tags_names(game_id uuid)
+RETURNS text AS $$
+BEGIN
+    RETURN (
+        SELECT string_agg(t.name, ' ')
+        FROM games_tags gt
+        INNER JOIN tags t
+            ON t.id = gt.tag_id
+        WHERE gt.game_id = games_tags_names.game_id
+    );
+END;
+$$ LANGUAGE plpgsql STABLE;
+
+CREATE FUNCTION games_update_search_vector()
+RETURNS TRIGGER AS $$
+BEGIN
+    new.search_vector = games_search_vector(new.title, new.description, new.features, games_tags_names(new.id));
+    RETURN new;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE FUNCTION games_tags_update_games_search_vector()
+RETURNS TRIGGER AS $$
+DECLARE
+    target_game_id uuid := coalesce(new.game_id, old.game_id);
+BEGIN
+    UPDATE games g
+    SET search_vector = games_search_vector(g.title, g.description, g.features, games_tags_names(g.id))
+    WHERE g.id = target_game_id;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Columns.
+ALTER TABLE games ADD COLUMN search_vector tsvector;
+
+UPDATE games g
+SET search_vector = games_search_vector(g.title, g.description, g.features, games_tags_names(g.id));
+
+ALTER TABLE games ALTER COLUMN search_vector SET NOT NULL;
+
+-- Indexes.
+CREATE INDEX games_search_vector_idx    ON games USING GIN (search_vector);
+CREATE INDEX games_title_trgm_idx       ON games USING GIN (title gin_trgm_ops);
+
+-- Triggers.
+CREATE TRIGGER games_update_search_vector
+    BEFORE INSERT OR UPDATE OF title, description, features ON games
+    FOR EACH ROW
+    EXECUTE PROCEDURE games_update_search_vector();
+
+CREATE TRIGGER games_tags_update_games_search_vector
+    AFTER INSERT OR DELETE ON games_tags
+    FOR EACH ROW
+    EXECUTE PROCEDURE games_tags_update_games_search_vector();
@@ -0,0 +1,4 @@
+DROP INDEX users_libraries_game_id_idx;
+DROP INDEX publishers_name_prefix_idx;
+DROP INDEX tags_name_prefix_idx;
+DROP INDEX games_title_prefix_idx;
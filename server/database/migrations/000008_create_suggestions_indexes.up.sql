@@ -0,0 +1,5 @@
+-- Indexes.
+CREATE INDEX games_title_prefix_idx         ON games            (LOWER(title) text_pattern_ops);
+CREATE INDEX tags_name_prefix_idx           ON tags             (LOWER(name) text_pattern_ops);
+CREATE INDEX publishers_name_prefix_idx     ON publishers       (LOWER(name) text_pattern_ops);
+CREATE INDEX users_libraries_game_id_idx    ON users_libraries  (game_id);
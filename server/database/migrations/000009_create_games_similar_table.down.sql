@@ -0,0 +1 @@
+DROP TABLE games_similar;
@@ -0,0 +1,13 @@
+-- Tables.
+CREATE TABLE games_similar (
+    game_id         uuid        NOT NULL,
+    similar_game_id uuid        NOT NULL,
+    score           float8      NOT NULL,
+    created_at      timestamp   NOT NULL    DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT games_similar_pkey                   PRIMARY KEY (game_id, similar_game_id),
+    CONSTRAINT games_similar_game_id_fkey           FOREIGN KEY (game_id)           REFERENCES games (id)   ON DELETE CASCADE,
+    CONSTRAINT games_similar_similar_game_id_fkey   FOREIGN KEY (similar_game_id)   REFERENCES games (id)   ON DELETE CASCADE
+);
+
+-- Indexes.
+CREATE INDEX games_similar_game_id_score_idx ON games_similar (game_id, score DESC);
@@ -0,0 +1 @@
+DROP TABLE recommender_items;
@@ -0,0 +1,10 @@
+-- Tables.
+CREATE TABLE recommender_items (
+    game_id         uuid        NOT NULL,
+    similar_game_id uuid        NOT NULL,
+    score           float8      NOT NULL,
+    created_at      timestamp   NOT NULL    DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT recommender_items_pkey                   PRIMARY KEY (game_id, similar_game_id),
+    CONSTRAINT recommender_items_game_id_fkey           FOREIGN KEY (game_id)           REFERENCES games (id)   ON DELETE CASCADE,
+    CONSTRAINT recommender_items_similar_game_id_fkey   FOREIGN KEY (similar_game_id)   REFERENCES games (id)   ON DELETE CASCADE
+);
@@ -0,0 +1,3 @@
+DROP INDEX users_libraries_created_at_idx;
+DROP INDEX invoices_games_created_at_idx;
+DROP TABLE games_charts;
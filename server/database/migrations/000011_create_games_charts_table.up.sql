@@ -0,0 +1,15 @@
+-- Tables.
+CREATE TABLE games_charts (
+    type        varchar(20) NOT NULL,
+    time_window varchar(5)  NOT NULL,
+    game_id     uuid        NOT NULL,
+    score       float8      NOT NULL,
+    created_at  timestamp   NOT NULL    DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT games_charts_pkey            PRIMARY KEY (type, time_window, game_id),
+    CONSTRAINT games_charts_game_id_fkey    FOREIGN KEY (game_id)   REFERENCES games (id)   ON DELETE CASCADE
+);
+
+-- Indexes.
+CREATE INDEX games_charts_type_time_window_score_idx    ON games_charts     (type, time_window, score DESC);
+CREATE INDEX invoices_games_created_at_idx              ON invoices_games   (created_at);
+CREATE INDEX users_libraries_created_at_idx             ON users_libraries  (created_at);
@@ -0,0 +1,2 @@
+DROP INDEX refresh_tokens_family_id_idx;
+DROP TABLE refresh_tokens;
@@ -0,0 +1,17 @@
+-- Tables.
+CREATE TABLE refresh_tokens (
+    id              uuid            NOT NULL    DEFAULT GEN_RANDOM_UUID(),
+    family_id       uuid            NOT NULL,
+    subject_id      uuid            NOT NULL,
+    subject_role    varchar(20)     NOT NULL,
+    hash            bytea           NOT NULL, -- SHA-256 hash of the token.
+    expires_at      timestamp       NOT NULL,
+    rotated_at      timestamp,
+    revoked_at      timestamp,
+    created_at      timestamp       NOT NULL    DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT refresh_tokens_pkey      PRIMARY KEY (id),
+    CONSTRAINT refresh_tokens_hash_key  UNIQUE (hash)
+);
+
+-- Indexes.
+CREATE INDEX refresh_tokens_family_id_idx ON refresh_tokens (family_id);
@@ -0,0 +1,15 @@
+DROP INDEX sessions_subject_id_idx;
+ALTER INDEX refresh_tokens_session_id_idx RENAME TO refresh_tokens_family_id_idx;
+
+ALTER TABLE refresh_tokens DROP CONSTRAINT refresh_tokens_session_id_fkey;
+ALTER TABLE refresh_tokens ADD COLUMN subject_id uuid, ADD COLUMN subject_role varchar(20), ADD COLUMN revoked_at timestamp;
+
+UPDATE refresh_tokens rt
+SET subject_id = s.subject_id, subject_role = s.subject_role, revoked_at = s.revoked_at
+FROM sessions s
+WHERE s.id = rt.session_id;
+
+ALTER TABLE refresh_tokens ALTER COLUMN subject_id SET NOT NULL, ALTER COLUMN subject_role SET NOT NULL;
+ALTER TABLE refresh_tokens RENAME COLUMN session_id TO family_id;
+
+DROP TABLE sessions;
@@ -0,0 +1,27 @@
+-- Tables.
+CREATE TABLE sessions (
+    id              uuid            NOT NULL    DEFAULT GEN_RANDOM_UUID(),
+    subject_id      uuid            NOT NULL,
+    subject_role    varchar(20)     NOT NULL,
+    user_agent      varchar(500)    NOT NULL,
+    ip_address      varchar(45)     NOT NULL,
+    last_seen_at    timestamp       NOT NULL    DEFAULT CURRENT_TIMESTAMP,
+    expires_at      timestamp       NOT NULL,
+    revoked_at      timestamp,
+    created_at      timestamp       NOT NULL    DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT sessions_pkey PRIMARY KEY (id)
+);
+
+-- Existing refresh token families become sessions.
+INSERT INTO sessions (id, subject_id, subject_role, user_agent, ip_address, last_seen_at, expires_at, revoked_at, created_at)
+SELECT family_id, subject_id, subject_role, '', '', max(created_at), max(expires_at), max(revoked_at), min(created_at)
+FROM refresh_tokens
+GROUP BY family_id, subject_id, subject_role;
+
+ALTER TABLE refresh_tokens RENAME COLUMN family_id TO session_id;
+ALTER TABLE refresh_tokens DROP COLUMN subject_id, DROP COLUMN subject_role, DROP COLUMN revoked_at;
+ALTER TABLE refresh_tokens ADD CONSTRAINT refresh_tokens_session_id_fkey FOREIGN KEY (session_id) REFERENCES sessions (id) ON DELETE CASCADE;
+
+-- Indexes.
+ALTER INDEX refresh_tokens_family_id_idx RENAME TO refresh_tokens_session_id_idx;
+CREATE INDEX sessions_subject_id_idx ON sessions (subject_id);
@@ -0,0 +1,12 @@
+-- Tables.
+CREATE TABLE password_reset_tokens (
+    id              uuid            NOT NULL    DEFAULT GEN_RANDOM_UUID(),
+    subject_id      uuid            NOT NULL,
+    subject_role    varchar(20)     NOT NULL,
+    hash            bytea           NOT NULL, -- SHA-256 hash of the token.
+    expires_at      timestamp       NOT NULL,
+    used_at         timestamp,
+    created_at      timestamp       NOT NULL    DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT password_reset_tokens_pkey       PRIMARY KEY (id),
+    CONSTRAINT password_reset_tokens_hash_key   UNIQUE (hash)
+);
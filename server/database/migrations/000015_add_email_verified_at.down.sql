@@ -0,0 +1,10 @@
+-- Triggers.
+DROP TRIGGER publishers_reset_email_verified_at ON publishers;
+DROP TRIGGER users_reset_email_verified_at ON users;
+
+-- Functions.
+DROP FUNCTION reset_email_verified_at();
+
+-- Columns.
+ALTER TABLE publishers DROP COLUMN email_verified_at;
+ALTER TABLE users DROP COLUMN email_verified_at;
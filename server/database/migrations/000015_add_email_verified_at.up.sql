@@ -0,0 +1,30 @@
+-- Columns.
+ALTER TABLE users ADD COLUMN email_verified_at timestamp;
+ALTER TABLE publishers ADD COLUMN email_verified_at timestamp;
+
+-- Existing accounts were created before email verification and are kept verified.
+UPDATE users SET email_verified_at = created_at;
+UPDATE publishers SET email_verified_at = created_at;
+
+-- Functions.
+CREATE FUNCTION reset_email_verified_at()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF new.email IS DISTINCT FROM old.email THEN
+        new.email_verified_at = NULL;
+    END IF;
+    RETURN new;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Triggers.
+-- Named to run after the lower case email triggers, so that only actual email changes are detected.
+CREATE TRIGGER users_reset_email_verified_at
+    BEFORE UPDATE ON users
+    FOR EACH ROW
+    EXECUTE PROCEDURE reset_email_verified_at();
+
+CREATE TRIGGER publishers_reset_email_verified_at
+    BEFORE UPDATE ON publishers
+    FOR EACH ROW
+    EXECUTE PROCEDURE reset_email_verified_at();
@@ -0,0 +1,19 @@
+-- Tables.
+CREATE TABLE totps (
+    subject_id      uuid            NOT NULL,
+    secret          bytea           NOT NULL,
+    last_used_step  bigint, -- Time step of the last accepted code, to prevent replays.
+    enabled_at      timestamp,
+    created_at      timestamp       NOT NULL    DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT totps_pkey   PRIMARY KEY (subject_id)
+);
+
+CREATE TABLE recovery_codes (
+    id          uuid            NOT NULL    DEFAULT GEN_RANDOM_UUID(),
+    subject_id  uuid            NOT NULL,
+    hash        bytea           NOT NULL, -- SHA-256 hash of the code.
+    used_at     timestamp,
+    created_at  timestamp       NOT NULL    DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT recovery_codes_pkey                  PRIMARY KEY (id),
+    CONSTRAINT recovery_codes_subject_id_hash_key   UNIQUE (subject_id, hash)
+);
@@ -0,0 +1,29 @@
+-- Tables.
+CREATE TABLE webauthn_ceremonies (
+    id              uuid            NOT NULL    DEFAULT GEN_RANDOM_UUID(),
+    subject_id      uuid, -- Not set when signing in, since the subject is only known from the credential.
+    subject_role    varchar(20)     NOT NULL,
+    session         jsonb           NOT NULL,
+    expires_at      timestamp       NOT NULL,
+    created_at      timestamp       NOT NULL    DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT webauthn_ceremonies_pkey PRIMARY KEY (id)
+);
+
+CREATE TABLE webauthn_credentials (
+    id                  uuid            NOT NULL    DEFAULT GEN_RANDOM_UUID(),
+    subject_id          uuid            NOT NULL,
+    subject_role        varchar(20)     NOT NULL,
+    credential_id       bytea           NOT NULL,
+    public_key          bytea           NOT NULL, -- COSE encoded public key.
+    attestation_type    varchar(50)     NOT NULL,
+    transports          varchar(50)[]   NOT NULL,
+    aaguid              bytea           NOT NULL,
+    sign_count          bigint          NOT NULL,
+    last_used_at        timestamp,
+    created_at          timestamp       NOT NULL    DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT webauthn_credentials_pkey                PRIMARY KEY (id),
+    CONSTRAINT webauthn_credentials_credential_id_key   UNIQUE (credential_id)
+);
+
+-- Indexes.
+CREATE INDEX webauthn_credentials_subject_id_idx ON webauthn_credentials (subject_id);
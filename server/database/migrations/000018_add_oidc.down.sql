@@ -0,0 +1,2 @@
+DROP TABLE users_identities;
+DROP TABLE oidc_authorizations;
@@ -0,0 +1,23 @@
+-- Tables.
+CREATE TABLE oidc_authorizations (
+    id              uuid            NOT NULL    DEFAULT GEN_RANDOM_UUID(), -- Sent to the provider as the state.
+    provider        varchar(50)     NOT NULL,
+    nonce           varchar(100)    NOT NULL,
+    code_verifier   varchar(128)    NOT NULL,
+    expires_at      timestamp       NOT NULL,
+    created_at      timestamp       NOT NULL    DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT oidc_authorizations_pkey PRIMARY KEY (id)
+);
+
+CREATE TABLE users_identities (
+    id          uuid            NOT NULL    DEFAULT GEN_RANDOM_UUID(),
+    user_id     uuid            NOT NULL,
+    provider    varchar(50)     NOT NULL,
+    subject     varchar(255)    NOT NULL,
+    email       varchar(320),
+    created_at  timestamp       NOT NULL    DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT users_identities_pkey                    PRIMARY KEY (id),
+    CONSTRAINT users_identities_provider_subject_key    UNIQUE (provider, subject),
+    CONSTRAINT users_identities_user_id_provider_key    UNIQUE (user_id, provider),
+    CONSTRAINT users_identities_user_id_fkey            FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
@@ -0,0 +1,8 @@
+-- Tables.
+CREATE TABLE sign_in_throttles (
+    key             varchar(400)    NOT NULL, -- Role followed by the account identifier or the IP address.
+    failed_attempts integer         NOT NULL,
+    last_failed_at  timestamp       NOT NULL,
+    locked_until    timestamp,
+    CONSTRAINT sign_in_throttles_pkey PRIMARY KEY (key)
+);
@@ -0,0 +1,10 @@
+-- Data.
+-- The argon2id hashes do not fit the previous width, and bcrypt cannot verify them anyway, so they are replaced with
+-- the bcrypt hash of a random password which was never revealed. The accounts with those hashes must reset their
+-- password after rolling back.
+UPDATE publishers SET password = '$2a$10$DJm4dpVUHQnsFPHnICjt3epoGXQmotGKCviozKjMPh32EKK7WzjOC' WHERE length(password) > 60;
+UPDATE users SET password = '$2a$10$DJm4dpVUHQnsFPHnICjt3epoGXQmotGKCviozKjMPh32EKK7WzjOC' WHERE length(password) > 60;
+
+-- Columns.
+ALTER TABLE publishers ALTER COLUMN password TYPE varchar(60);
+ALTER TABLE users ALTER COLUMN password TYPE varchar(60);
@@ -0,0 +1,4 @@
+-- Columns.
+-- Wide enough for the encoded argon2id hashes, besides the bcrypt hashes which are upgraded on sign in.
+ALTER TABLE users ALTER COLUMN password TYPE varchar(200);
+ALTER TABLE publishers ALTER COLUMN password TYPE varchar(200);
@@ -0,0 +1,3 @@
+-- Tables.
+DROP TABLE publishers_invitations;
+DROP TABLE publishers_members;
@@ -0,0 +1,33 @@
+-- Tables.
+CREATE TABLE publishers_members (
+    publisher_id    uuid            NOT NULL,
+    user_id         uuid            NOT NULL,
+    role            varchar(20)     NOT NULL, -- One of owner, developer, finance or viewer.
+    created_at      timestamp       NOT NULL    DEFAULT CURRENT_TIMESTAMP,
+    modified_at     timestamp       NOT NULL    DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT publishers_members_pkey              PRIMARY KEY (publisher_id, user_id),
+    CONSTRAINT publishers_members_publisher_id_fkey FOREIGN KEY (publisher_id) REFERENCES publishers (id) ON DELETE CASCADE,
+    CONSTRAINT publishers_members_user_id_fkey      FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE INDEX publishers_members_user_id_idx ON publishers_members (user_id);
+
+CREATE TABLE publishers_invitations (
+    id              uuid            NOT NULL    DEFAULT GEN_RANDOM_UUID(),
+    publisher_id    uuid            NOT NULL,
+    email           varchar(320)    NOT NULL,
+    role            varchar(20)     NOT NULL,
+    hash            bytea           NOT NULL, -- SHA-256 hash of the token.
+    expires_at      timestamp       NOT NULL,
+    accepted_at     timestamp,
+    created_at      timestamp       NOT NULL    DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT publishers_invitations_pkey              PRIMARY KEY (id),
+    CONSTRAINT publishers_invitations_hash_key          UNIQUE (hash),
+    CONSTRAINT publishers_invitations_publisher_id_fkey FOREIGN KEY (publisher_id) REFERENCES publishers (id) ON DELETE CASCADE
+);
+
+-- Triggers.
+CREATE TRIGGER publishers_members_update_modified_at
+    BEFORE UPDATE ON publishers_members
+    FOR EACH ROW
+    EXECUTE PROCEDURE update_modified_at();
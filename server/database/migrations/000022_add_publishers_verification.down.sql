@@ -0,0 +1,10 @@
+-- Tables.
+DROP TABLE publishers_documents;
+
+-- Indexes.
+DROP INDEX publishers_verification_status_idx;
+
+-- Columns.
+ALTER TABLE users DROP COLUMN admin;
+ALTER TABLE publishers DROP COLUMN verification_reason;
+ALTER TABLE publishers DROP COLUMN verification_status;
@@ -0,0 +1,22 @@
+-- Columns.
+ALTER TABLE publishers
+    ADD COLUMN verification_status  varchar(20)     NOT NULL    DEFAULT 'pending', -- One of pending, verified or rejected.
+    ADD COLUMN verification_reason  varchar(500); -- Reason of the rejection, emailed to the publisher.
+
+-- Publishers created before the verification workflow keep selling their games.
+UPDATE publishers SET verification_status = 'verified';
+
+ALTER TABLE users ADD COLUMN admin boolean NOT NULL DEFAULT false;
+
+-- Tables.
+CREATE TABLE publishers_documents (
+    publisher_id    uuid            NOT NULL,
+    multimedia_id   uuid            NOT NULL,
+    created_at      timestamp       NOT NULL    DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT publishers_documents_pkey                PRIMARY KEY (publisher_id, multimedia_id),
+    CONSTRAINT publishers_documents_publisher_id_fkey   FOREIGN KEY (publisher_id) REFERENCES publishers (id) ON DELETE CASCADE,
+    CONSTRAINT publishers_documents_multimedia_id_fkey  FOREIGN KEY (multimedia_id) REFERENCES multimedia (id)
+);
+
+-- Indexes.
+CREATE INDEX publishers_verification_status_idx ON publishers (verification_status);
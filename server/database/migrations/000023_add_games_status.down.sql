@@ -0,0 +1,10 @@
+-- Indexes.
+DROP INDEX games_status_idx;
+
+-- Columns.
+ALTER TABLE games ADD COLUMN is_active boolean NOT NULL DEFAULT FALSE;
+
+UPDATE games SET is_active = true WHERE status = 'published';
+
+ALTER TABLE games DROP COLUMN review_notes;
+ALTER TABLE games DROP COLUMN status;
@@ -0,0 +1,12 @@
+-- Columns.
+ALTER TABLE games
+    ADD COLUMN status       varchar(20)     NOT NULL    DEFAULT 'draft', -- One of draft, in_review, approved, published or delisted.
+    ADD COLUMN review_notes varchar(500); -- Notes of the last review, shown to the publisher.
+
+-- Active games were already live in the store.
+UPDATE games SET status = 'published' WHERE is_active;
+
+ALTER TABLE games DROP COLUMN is_active;
+
+-- Indexes.
+CREATE INDEX games_status_idx ON games (status);
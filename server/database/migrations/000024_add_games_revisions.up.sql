@@ -0,0 +1,38 @@
+-- Tables.
+CREATE TABLE games_revisions (
+    id              uuid            NOT NULL    DEFAULT GEN_RANDOM_UUID(),
+    game_id         uuid            NOT NULL,
+    number          int             NOT NULL, -- Sequential number of the revision in the game, starting at 1.
+    author_id       uuid            NOT NULL, -- Identifier of the publisher or user that made the change.
+    author_role     varchar(20)     NOT NULL, -- One of publisher or user.
+    changed_fields  varchar(30)[]   NOT NULL, -- Fields of the store page changed since the previous revision.
+    snapshot        jsonb           NOT NULL, -- Store page of the game after the change.
+    created_at      timestamp       NOT NULL    DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT games_revisions_pkey                 PRIMARY KEY (id),
+    CONSTRAINT games_revisions_game_id_number_key   UNIQUE (game_id, number),
+    CONSTRAINT games_revisions_game_id_fkey         FOREIGN KEY (game_id) REFERENCES games (id) ON DELETE CASCADE
+);
+
+-- Existing games start their history with the current store page, authored by their publisher.
+INSERT INTO games_revisions (game_id, number, author_id, author_role, changed_fields, snapshot, created_at)
+SELECT g.id, 1, g.publisher_id, 'publisher',
+    ARRAY['title', 'price', 'releaseDate', 'description', 'ageRating', 'features', 'languages', 'requirements', 'previewMultimediaId', 'downloadMultimediaId', 'multimedia'],
+    jsonb_build_object(
+        'title', g.title,
+        'price', g.price,
+        'releaseDate', to_char(g.release_date, 'YYYY-MM-DD"T"00:00:00"Z"'),
+        'description', g.description,
+        'ageRating', g.age_rating,
+        'features', g.features,
+        'languages', to_jsonb(g.languages),
+        'requirements', g.requirements,
+        'previewMultimediaId', g.preview_multimedia_id,
+        'downloadMultimediaId', g.download_multimedia_id,
+        'multimedia', coalesce((
+            SELECT jsonb_agg(jsonb_build_object('multimediaId', gm.multimedia_id, 'position', gm.position) ORDER BY gm.position)
+            FROM games_multimedia gm
+            WHERE gm.game_id = g.id
+        ), '[]'::jsonb)
+    ),
+    g.modified_at
+FROM games g;
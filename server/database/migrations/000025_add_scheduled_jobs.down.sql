@@ -0,0 +1,2 @@
+-- Tables.
+DROP TABLE scheduled_jobs;
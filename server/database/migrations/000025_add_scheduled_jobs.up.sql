@@ -0,0 +1,24 @@
+-- Tables.
+CREATE TABLE scheduled_jobs (
+    id              uuid            NOT NULL    DEFAULT GEN_RANDOM_UUID(),
+    game_id         uuid            NOT NULL,
+    action          varchar(30)     NOT NULL, -- One of publish_game or delist_game.
+    run_at          timestamp       NOT NULL,
+    status          varchar(20)     NOT NULL    DEFAULT 'pending', -- One of pending, succeeded, failed or cancelled.
+    error           varchar(500), -- Reason of the failure, if the job failed.
+    executed_at     timestamp,
+    created_at      timestamp       NOT NULL    DEFAULT CURRENT_TIMESTAMP,
+    modified_at     timestamp       NOT NULL    DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT scheduled_jobs_pkey          PRIMARY KEY (id),
+    CONSTRAINT scheduled_jobs_game_id_fkey  FOREIGN KEY (game_id) REFERENCES games (id) ON DELETE CASCADE
+);
+
+-- Indexes.
+CREATE INDEX scheduled_jobs_game_id_idx ON scheduled_jobs (game_id);
+CREATE INDEX scheduled_jobs_pending_run_at_idx ON scheduled_jobs (run_at) WHERE status = 'pending';
+
+-- Triggers.
+CREATE TRIGGER scheduled_jobs_update_modified_at
+    BEFORE UPDATE ON scheduled_jobs
+    FOR EACH ROW
+    EXECUTE PROCEDURE update_modified_at();
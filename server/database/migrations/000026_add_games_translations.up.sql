@@ -0,0 +1,18 @@
+-- Tables.
+CREATE TABLE games_translations (
+    game_id         uuid            NOT NULL,
+    language        varchar(20)     NOT NULL, -- BCP 47 language tag.
+    title           varchar(150)    NOT NULL,
+    description     varchar(500)    NOT NULL,
+    features        varchar(250)    NOT NULL,
+    created_at      timestamp       NOT NULL    DEFAULT CURRENT_TIMESTAMP,
+    modified_at     timestamp       NOT NULL    DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT games_translations_pkey          PRIMARY KEY (game_id, language),
+    CONSTRAINT games_translations_game_id_fkey  FOREIGN KEY (game_id) REFERENCES games (id) ON DELETE CASCADE
+);
+
+-- Triggers.
+CREATE TRIGGER games_translations_update_modified_at
+    BEFORE UPDATE ON games_translations
+    FOR EACH ROW
+    EXECUTE PROCEDURE update_modified_at();
@@ -0,0 +1,2 @@
+-- Columns.
+ALTER TABLE oidc_authorizations DROP COLUMN binding_hash;
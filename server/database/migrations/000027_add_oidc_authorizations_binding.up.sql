@@ -0,0 +1,5 @@
+-- Pending authorizations were not bound to the browser that started them, so they are discarded.
+DELETE FROM oidc_authorizations;
+
+-- Columns.
+ALTER TABLE oidc_authorizations ADD COLUMN binding_hash bytea NOT NULL; -- SHA-256 hash of the binding kept in a cookie of the browser that started the authorization.
@@ -0,0 +1,11 @@
+-- Tables.
+DROP TABLE publishers_documents;
+
+CREATE TABLE publishers_documents (
+    publisher_id    uuid            NOT NULL,
+    multimedia_id   uuid            NOT NULL,
+    created_at      timestamp       NOT NULL    DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT publishers_documents_pkey                PRIMARY KEY (publisher_id, multimedia_id),
+    CONSTRAINT publishers_documents_publisher_id_fkey   FOREIGN KEY (publisher_id) REFERENCES publishers (id) ON DELETE CASCADE,
+    CONSTRAINT publishers_documents_multimedia_id_fkey  FOREIGN KEY (multimedia_id) REFERENCES multimedia (id)
+);
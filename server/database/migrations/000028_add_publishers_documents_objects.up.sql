@@ -0,0 +1,17 @@
+-- Tables.
+-- The documents submitted so far were public multimedia, so they are dropped and must be submitted again to the
+-- private documents bucket.
+DROP TABLE publishers_documents;
+
+CREATE TABLE publishers_documents (
+    id              uuid            NOT NULL    DEFAULT GEN_RANDOM_UUID(),
+    publisher_id    uuid            NOT NULL,
+    object_name     varchar(100)    NOT NULL, -- Name of the object in the private documents bucket.
+    media_type      varchar(255)    NOT NULL, -- MIME type.
+    created_at      timestamp       NOT NULL    DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT publishers_documents_pkey                PRIMARY KEY (id),
+    CONSTRAINT publishers_documents_publisher_id_fkey   FOREIGN KEY (publisher_id) REFERENCES publishers (id) ON DELETE CASCADE
+);
+
+-- Indexes.
+CREATE INDEX publishers_documents_publisher_id_idx ON publishers_documents (publisher_id);
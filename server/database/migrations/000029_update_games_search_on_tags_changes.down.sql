@@ -0,0 +1,23 @@
+-- Triggers.
+DROP TRIGGER tags_update_games_search_vector ON tags;
+DROP TRIGGER games_tags_update_games_search_vector ON games_tags;
+
+CREATE TRIGGER games_tags_update_games_search_vector
+    AFTER INSERT OR DELETE ON games_tags
+    FOR EACH ROW
+    EXECUTE PROCEDURE games_tags_update_games_search_vector();
+
+-- Functions.
+DROP FUNCTION tags_update_games_search_vector();
+
+CREATE OR REPLACE FUNCTION games_tags_update_games_search_vector()
+RETURNS TRIGGER AS $$
+DECLARE
+    target_game_id uuid := coalesce(new.game_id, old.game_id);
+BEGIN
+    UPDATE games g
+    SET search_vector = games_search_vector(g.title, g.description, g.features, games_tags_names(g.id))
+    WHERE g.id = target_game_id;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
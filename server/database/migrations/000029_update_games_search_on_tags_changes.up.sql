@@ -0,0 +1,39 @@
+-- Functions.
+CREATE OR REPLACE FUNCTION games_tags_update_games_search_vector()
+RETURNS TRIGGER AS $$
+BEGIN
+    -- An update may move the tag to another game, so both the old and the new games are recomputed.
+    UPDATE games g
+    SET search_vector = games_search_vector(g.title, g.description, g.features, games_tags_names(g.id))
+    WHERE g.id IN (old.game_id, new.game_id);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE FUNCTION tags_update_games_search_vector()
+RETURNS TRIGGER AS $$
+BEGIN
+    UPDATE games g
+    SET search_vector = games_search_vector(g.title, g.description, g.features, games_tags_names(g.id))
+    WHERE g.id IN (
+        SELECT gt.game_id
+        FROM games_tags gt
+        WHERE gt.tag_id = new.id
+    );
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Triggers.
+DROP TRIGGER games_tags_update_games_search_vector ON games_tags;
+
+CREATE TRIGGER games_tags_update_games_search_vector
+    AFTER INSERT OR UPDATE OR DELETE ON games_tags
+    FOR EACH ROW
+    EXECUTE PROCEDURE games_tags_update_games_search_vector();
+
+CREATE TRIGGER tags_update_games_search_vector
+    AFTER UPDATE OF name ON tags
+    FOR EACH ROW
+    WHEN (old.name IS DISTINCT FROM new.name)
+    EXECUTE PROCEDURE tags_update_games_search_vector();
@@ -1,13 +1,25 @@
 package authn
 
+import (
+	"github.com/go-webauthn/webauthn/webauthn"
+)
+
 // service defines the authentication service structure.
 type service struct {
-	jwtSigningKey []byte
+	keySet   KeySet
+	webAuthn *webauthn.WebAuthn
 }
 
-// New returns a new authentication service.
-func New(jwtSigningKey []byte) *service {
-	return &service{
-		jwtSigningKey: jwtSigningKey,
+// New returns a new authentication service, which signs and verifies tokens with the key set. The web URL is the
+// origin where passkeys are registered and used.
+func New(keySet KeySet, webURL string) (*service, error) {
+	webAuthn, err := newWebAuthn(webURL)
+	if err != nil {
+		return nil, err
 	}
+
+	return &service{
+		keySet:   keySet,
+		webAuthn: webAuthn,
+	}, nil
 }
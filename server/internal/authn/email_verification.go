@@ -0,0 +1,59 @@
+package authn
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	emailVerificationAudience       = "email-verification"
+	emailVerificationExpirationTime = time.Hour * 24
+
+	descriptionFailedToParseEmailVerificationToken = "authn: failed to parse email verification token"
+)
+
+// EmailVerificationClaims defines the email verification token claims structure.
+type EmailVerificationClaims struct {
+	jwt.RegisteredClaims
+	Role  SubjectRole `json:"role"`
+	Email string      `json:"email"`
+}
+
+// NewEmailVerificationToken returns a new signed token to verify the specified email of the subject, with an
+// expiration time of 24 hours. The token is bound to the email, so that it stops working if the email changes.
+func (s *service) NewEmailVerificationToken(subject string, role SubjectRole, email string) (string, error) {
+	expiresAt := time.Now().Add(emailVerificationExpirationTime).UTC()
+	issuedAt := time.Now().UTC()
+
+	tokenString, err := s.signToken(EmailVerificationClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    jwtIssuer,
+			Subject:   subject,
+			Audience:  jwt.ClaimStrings{emailVerificationAudience},
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			IssuedAt:  jwt.NewNumericDate(issuedAt),
+		},
+		Role:  role,
+		Email: email,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return tokenString, nil
+}
+
+// ParseEmailVerificationToken parses the given email verification token and returns its claims, or returns an error
+// if the token is invalid.
+func (s *service) ParseEmailVerificationToken(tokenString string) (EmailVerificationClaims, error) {
+	var claims EmailVerificationClaims
+
+	err := s.parseToken(tokenString, &claims, jwt.WithAudience(emailVerificationAudience))
+	if err != nil {
+		return EmailVerificationClaims{}, fmt.Errorf("%s: %w", descriptionFailedToParseEmailVerificationToken, err)
+	}
+
+	return claims, nil
+}
@@ -9,7 +9,8 @@ import (
 
 const (
 	jwtIssuer         = "server"
-	jwtExpirationTime = time.Hour * 24
+	jwtAudience       = "access" // Distinguishes access tokens from the other tokens signed with the same key.
+	jwtExpirationTime = time.Minute * 15
 
 	descriptionFailedToParseJWTWithClaims = "authn: failed to parse jwt with claims"
 )
@@ -20,30 +21,38 @@ type SubjectRole string
 const (
 	SubjectRoleUser      SubjectRole = "user"
 	SubjectRolePublisher SubjectRole = "publisher"
+	SubjectRoleAdmin     SubjectRole = "admin" // Granted to users that are administrators, besides the user role.
 )
 
 // Claims defines the JSON Web Token claims structure.
 type Claims struct {
 	jwt.RegisteredClaims
-	Roles []SubjectRole `json:"roles,omitempty"`
+	SessionID  string            `json:"sid,omitempty"`
+	Roles      []SubjectRole     `json:"roles,omitempty"`
+	Publishers map[string]string `json:"publishers,omitempty"` // Roles of the subject in the publishers it is a member of, by publisher identifier.
 }
 
-// NewJWT returns a new signed JSON Web Token with an expiration time of 24 hours and the specified claims.
-func (s *service) NewJWT(subject string, subjectRoles []SubjectRole) (string, error) {
+// NewJWT returns a new signed JSON Web Token with an expiration time of 15 minutes and the specified claims.
+// The session identifier allows the token to be revoked before it expires.
+// The publisher memberships are the roles of the subject in the publishers it is a member of, by publisher identifier.
+// Changes to them only take effect once the token is renewed.
+// It is meant to be renewed with a refresh token.
+func (s *service) NewJWT(subject, sessionID string, subjectRoles []SubjectRole, publisherMemberships map[string]string) (string, error) {
 	expiresAt := time.Now().Add(jwtExpirationTime).UTC()
 	issuedAt := time.Now().UTC()
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, Claims{
+	tokenString, err := s.signToken(Claims{
 		RegisteredClaims: jwt.RegisteredClaims{
 			Issuer:    jwtIssuer,
 			Subject:   subject,
+			Audience:  jwt.ClaimStrings{jwtAudience},
 			ExpiresAt: jwt.NewNumericDate(expiresAt),
 			IssuedAt:  jwt.NewNumericDate(issuedAt),
 		},
-		Roles: subjectRoles,
+		SessionID:  sessionID,
+		Roles:      subjectRoles,
+		Publishers: publisherMemberships,
 	})
-
-	tokenString, err := token.SignedString(s.jwtSigningKey)
 	if err != nil {
 		return "", err
 	}
@@ -52,12 +61,11 @@ func (s *service) NewJWT(subject string, subjectRoles []SubjectRole) (string, er
 }
 
 // ParseJWT parses the given token and returns the associated subject, or returns an error if the token is invalid.
+// Only access tokens are accepted, so the other tokens signed with the same key can not be used in their place.
 func (s *service) ParseJWT(tokenString string) (Claims, error) {
 	var claims Claims
 
-	_, err := jwt.ParseWithClaims(tokenString, &claims, func(t *jwt.Token) (interface{}, error) {
-		return s.jwtSigningKey, nil
-	})
+	err := s.parseToken(tokenString, &claims, jwt.WithAudience(jwtAudience))
 	if err != nil {
 		return Claims{}, fmt.Errorf("%s: %w", descriptionFailedToParseJWTWithClaims, err)
 	}
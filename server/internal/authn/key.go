@@ -0,0 +1,253 @@
+package authn
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"maps"
+	"math/big"
+	"slices"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	pemTypePrivateKey = "PRIVATE KEY"
+	pemTypePublicKey  = "PUBLIC KEY"
+
+	rsaMinKeySize = 2048
+
+	jsonWebKeyUseSignature = "sig"
+	jsonWebKeyTypeRSA      = "RSA"
+	jsonWebKeyTypeOKP      = "OKP"
+	jsonWebKeyCurveEd25519 = "Ed25519"
+
+	descriptionFailedToParseSigningKey      = "authn: failed to parse signing key"
+	descriptionFailedToParseVerificationKey = "authn: failed to parse verification key"
+)
+
+var (
+	ErrSigningKeyMissing = errors.New("authn: signing key missing")    // Returned when no signing key is specified.
+	ErrKeyInvalid        = errors.New("authn: invalid key")            // Returned when a key is not a valid PEM block.
+	ErrKeyUnsupported    = errors.New("authn: unsupported key")        // Returned when a key is neither RSA nor Ed25519.
+	ErrKeyIDNotFound     = errors.New("authn: token key id not found") // Returned when no verification key has the key id of a token.
+)
+
+// verificationKey defines a public key used to verify tokens.
+type verificationKey struct {
+	id            string
+	signingMethod jwt.SigningMethod
+	publicKey     crypto.PublicKey
+}
+
+// KeySet defines the set of keys used to sign and verify tokens. Tokens are signed with a single private key, while
+// they are verified with any of the verification keys, so that keys can be rotated without invalidating the tokens
+// signed with the previous ones.
+type KeySet struct {
+	signingKey       crypto.Signer
+	signingKeyID     string
+	signingMethod    jwt.SigningMethod
+	verificationKeys map[string]verificationKey
+}
+
+// JSONWebKey defines the public JSON Web Key structure of a verification key.
+type JSONWebKey struct {
+	KeyType   string
+	KeyID     string
+	Use       string
+	Algorithm string
+	N         string
+	E         string
+	Curve     string
+	X         string
+}
+
+// NewKeySet returns a new key set with the PEM encoded PKCS #8 signing key, and the PEM encoded PKIX verification keys
+// of previous signing keys which are still accepted. RSA keys are used with RS256 and Ed25519 keys with EdDSA. The key
+// identifier of each key is its JSON Web Key thumbprint.
+func NewKeySet(signingKeyPEM, verificationKeysPEM []byte) (KeySet, error) {
+	block, _ := pem.Decode(signingKeyPEM)
+	if block == nil {
+		if len(signingKeyPEM) == 0 {
+			return KeySet{}, ErrSigningKeyMissing
+		}
+
+		return KeySet{}, fmt.Errorf("%s: %w", descriptionFailedToParseSigningKey, ErrKeyInvalid)
+	}
+
+	if block.Type != pemTypePrivateKey {
+		return KeySet{}, fmt.Errorf("%s: %w", descriptionFailedToParseSigningKey, ErrKeyInvalid)
+	}
+
+	privateKey, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return KeySet{}, fmt.Errorf("%s: %w", descriptionFailedToParseSigningKey, err)
+	}
+
+	signingKey, ok := privateKey.(crypto.Signer)
+	if !ok {
+		return KeySet{}, fmt.Errorf("%s: %w", descriptionFailedToParseSigningKey, ErrKeyUnsupported)
+	}
+
+	signingVerificationKey, err := newVerificationKey(signingKey.Public())
+	if err != nil {
+		return KeySet{}, fmt.Errorf("%s: %w", descriptionFailedToParseSigningKey, err)
+	}
+
+	keySet := KeySet{
+		signingKey:    signingKey,
+		signingKeyID:  signingVerificationKey.id,
+		signingMethod: signingVerificationKey.signingMethod,
+		verificationKeys: map[string]verificationKey{
+			signingVerificationKey.id: signingVerificationKey,
+		},
+	}
+
+	rest := verificationKeysPEM
+
+	for {
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+
+		if block.Type != pemTypePublicKey {
+			return KeySet{}, fmt.Errorf("%s: %w", descriptionFailedToParseVerificationKey, ErrKeyInvalid)
+		}
+
+		publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return KeySet{}, fmt.Errorf("%s: %w", descriptionFailedToParseVerificationKey, err)
+		}
+
+		key, err := newVerificationKey(publicKey)
+		if err != nil {
+			return KeySet{}, fmt.Errorf("%s: %w", descriptionFailedToParseVerificationKey, err)
+		}
+
+		keySet.verificationKeys[key.id] = key
+	}
+
+	return keySet, nil
+}
+
+// JSONWebKeys returns the JSON Web Keys of the verification keys, so that other parties can verify the tokens.
+func (s *service) JSONWebKeys() []JSONWebKey {
+	jsonWebKeys := make([]JSONWebKey, 0, len(s.keySet.verificationKeys))
+
+	// The signing key is listed first, followed by the keys that are only used to verify tokens.
+	jsonWebKeys = append(jsonWebKeys, jsonWebKeyFromVerificationKey(s.keySet.verificationKeys[s.keySet.signingKeyID]))
+
+	for _, id := range slices.Sorted(maps.Keys(s.keySet.verificationKeys)) {
+		if id != s.keySet.signingKeyID {
+			jsonWebKeys = append(jsonWebKeys, jsonWebKeyFromVerificationKey(s.keySet.verificationKeys[id]))
+		}
+	}
+
+	return jsonWebKeys
+}
+
+// signToken returns the token with the specified claims signed with the signing key.
+func (s *service) signToken(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(s.keySet.signingMethod, claims)
+	token.Header["kid"] = s.keySet.signingKeyID
+
+	return token.SignedString(s.keySet.signingKey)
+}
+
+// parseToken parses the given token into the claims, verifying it with the verification key identified in its header.
+func (s *service) parseToken(tokenString string, claims jwt.Claims, options ...jwt.ParserOption) error {
+	options = append(options, jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodEdDSA.Alg()}))
+
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (interface{}, error) {
+		keyID, _ := t.Header["kid"].(string)
+
+		key, ok := s.keySet.verificationKeys[keyID]
+		if !ok || key.signingMethod.Alg() != t.Method.Alg() {
+			return nil, ErrKeyIDNotFound
+		}
+
+		return key.publicKey, nil
+	}, options...)
+
+	return err
+}
+
+// newVerificationKey returns the verification key of the public key.
+func newVerificationKey(publicKey crypto.PublicKey) (verificationKey, error) {
+	key := verificationKey{
+		publicKey: publicKey,
+	}
+
+	switch publicKey := publicKey.(type) {
+	case *rsa.PublicKey:
+		if publicKey.Size()*8 < rsaMinKeySize {
+			return verificationKey{}, ErrKeyUnsupported
+		}
+
+		key.signingMethod = jwt.SigningMethodRS256
+	case ed25519.PublicKey:
+		key.signingMethod = jwt.SigningMethodEdDSA
+	default:
+		return verificationKey{}, ErrKeyUnsupported
+	}
+
+	key.id = jsonWebKeyThumbprint(jsonWebKeyFromVerificationKey(key))
+
+	return key, nil
+}
+
+// jsonWebKeyFromVerificationKey returns the JSON Web Key of the verification key.
+func jsonWebKeyFromVerificationKey(key verificationKey) JSONWebKey {
+	jsonWebKey := JSONWebKey{
+		KeyID:     key.id,
+		Use:       jsonWebKeyUseSignature,
+		Algorithm: key.signingMethod.Alg(),
+	}
+
+	switch publicKey := key.publicKey.(type) {
+	case *rsa.PublicKey:
+		jsonWebKey.KeyType = jsonWebKeyTypeRSA
+		jsonWebKey.N = base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes())
+		jsonWebKey.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes())
+	case ed25519.PublicKey:
+		jsonWebKey.KeyType = jsonWebKeyTypeOKP
+		jsonWebKey.Curve = jsonWebKeyCurveEd25519
+		jsonWebKey.X = base64.RawURLEncoding.EncodeToString(publicKey)
+	}
+
+	return jsonWebKey
+}
+
+// jsonWebKeyThumbprint returns the RFC 7638 thumbprint of the JSON Web Key, which only includes its required members
+// in lexicographic order.
+func jsonWebKeyThumbprint(jsonWebKey JSONWebKey) string {
+	var members any
+
+	switch jsonWebKey.KeyType {
+	case jsonWebKeyTypeRSA:
+		members = struct {
+			E       string `json:"e"`
+			KeyType string `json:"kty"`
+			N       string `json:"n"`
+		}{jsonWebKey.E, jsonWebKey.KeyType, jsonWebKey.N}
+	default:
+		members = struct {
+			Curve   string `json:"crv"`
+			KeyType string `json:"kty"`
+			X       string `json:"x"`
+		}{jsonWebKey.Curve, jsonWebKey.KeyType, jsonWebKey.X}
+	}
+
+	b, _ := json.Marshal(members)
+	hash := sha256.Sum256(b)
+
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
@@ -0,0 +1,197 @@
+package authn
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newPrivateKeyPEM returns the PEM encoded PKCS #8 private key and the PEM encoded PKIX public key.
+func newPrivateKeyPEM(t *testing.T, privateKey any, publicKey any) ([]byte, []byte) {
+	t.Helper()
+
+	privateKeyDER, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	require.NoError(t, err)
+
+	publicKeyDER, err := x509.MarshalPKIXPublicKey(publicKey)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: pemTypePrivateKey, Bytes: privateKeyDER}),
+		pem.EncodeToMemory(&pem.Block{Type: pemTypePublicKey, Bytes: publicKeyDER})
+}
+
+// newEd25519KeyPEM returns a new PEM encoded Ed25519 private key and its public key.
+func newEd25519KeyPEM(t *testing.T) ([]byte, []byte) {
+	t.Helper()
+
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	return newPrivateKeyPEM(t, privateKey, publicKey)
+}
+
+// newKeySetTest returns a new key set with a random Ed25519 signing key.
+func newKeySetTest(t *testing.T) KeySet {
+	t.Helper()
+
+	signingKeyPEM, _ := newEd25519KeyPEM(t)
+
+	keySet, err := NewKeySet(signingKeyPEM, nil)
+	require.NoError(t, err)
+
+	return keySet
+}
+
+func TestNewKeySet(t *testing.T) {
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the signing key is missing", func(t *testing.T) {
+			_, err := NewKeySet(nil, nil)
+			require.ErrorIs(t, err, ErrSigningKeyMissing)
+		})
+
+		t.Run("when the signing key is not pem encoded", func(t *testing.T) {
+			_, err := NewKeySet([]byte("secret"), nil)
+			require.ErrorIs(t, err, ErrKeyInvalid)
+		})
+
+		t.Run("when the signing key is a public key", func(t *testing.T) {
+			_, publicKeyPEM := newEd25519KeyPEM(t)
+
+			_, err := NewKeySet(publicKeyPEM, nil)
+			require.ErrorIs(t, err, ErrKeyInvalid)
+		})
+
+		t.Run("when the rsa signing key is too small", func(t *testing.T) {
+			privateKey, err := rsa.GenerateKey(rand.Reader, 1024)
+			require.NoError(t, err)
+
+			privateKeyPEM, _ := newPrivateKeyPEM(t, privateKey, &privateKey.PublicKey)
+
+			_, err = NewKeySet(privateKeyPEM, nil)
+			require.ErrorIs(t, err, ErrKeyUnsupported)
+		})
+
+		t.Run("when a verification key is invalid", func(t *testing.T) {
+			signingKeyPEM, _ := newEd25519KeyPEM(t)
+
+			_, err := NewKeySet(signingKeyPEM, signingKeyPEM)
+			require.ErrorIs(t, err, ErrKeyInvalid)
+		})
+	})
+}
+
+func TestService_JWT(t *testing.T) {
+	t.Run("should successfully sign and parse jwt with an rsa key", func(t *testing.T) {
+		privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+
+		privateKeyPEM, _ := newPrivateKeyPEM(t, privateKey, &privateKey.PublicKey)
+
+		keySet, err := NewKeySet(privateKeyPEM, nil)
+		require.NoError(t, err)
+
+		s, err := New(keySet, webURLTest)
+		require.NoError(t, err)
+
+		tokenString, err := s.NewJWT("subject", "session", []SubjectRole{SubjectRoleUser}, map[string]string{"publisher": "developer"})
+		require.NoError(t, err)
+
+		token, _, err := jwt.NewParser().ParseUnverified(tokenString, &Claims{})
+		require.NoError(t, err)
+		assert.Equal(t, jwt.SigningMethodRS256.Alg(), token.Header["alg"])
+		assert.Equal(t, keySet.signingKeyID, token.Header["kid"])
+
+		claims, err := s.ParseJWT(tokenString)
+		require.NoError(t, err)
+		assert.Equal(t, "subject", claims.Subject)
+		assert.Equal(t, jwt.ClaimStrings{jwtAudience}, claims.Audience)
+		assert.Equal(t, "session", claims.SessionID)
+		assert.Equal(t, []SubjectRole{SubjectRoleUser}, claims.Roles)
+		assert.Equal(t, map[string]string{"publisher": "developer"}, claims.Publishers)
+	})
+
+	t.Run("should successfully parse jwt signed with a rotated key", func(t *testing.T) {
+		previousSigningKeyPEM, previousVerificationKeyPEM := newEd25519KeyPEM(t)
+		signingKeyPEM, _ := newEd25519KeyPEM(t)
+
+		previousKeySet, err := NewKeySet(previousSigningKeyPEM, nil)
+		require.NoError(t, err)
+
+		previous, err := New(previousKeySet, webURLTest)
+		require.NoError(t, err)
+
+		keySet, err := NewKeySet(signingKeyPEM, previousVerificationKeyPEM)
+		require.NoError(t, err)
+
+		s, err := New(keySet, webURLTest)
+		require.NoError(t, err)
+
+		tokenString, err := previous.NewJWT("subject", "session", []SubjectRole{SubjectRolePublisher}, nil)
+		require.NoError(t, err)
+
+		claims, err := s.ParseJWT(tokenString)
+		require.NoError(t, err)
+		assert.Equal(t, "subject", claims.Subject)
+
+		jsonWebKeys := s.JSONWebKeys()
+		require.Len(t, jsonWebKeys, 2)
+		assert.Equal(t, keySet.signingKeyID, jsonWebKeys[0].KeyID)
+		assert.Equal(t, previousKeySet.signingKeyID, jsonWebKeys[1].KeyID)
+
+		for _, jsonWebKey := range jsonWebKeys {
+			assert.Equal(t, jsonWebKeyTypeOKP, jsonWebKey.KeyType)
+			assert.Equal(t, jsonWebKeyCurveEd25519, jsonWebKey.Curve)
+			assert.Equal(t, jwt.SigningMethodEdDSA.Alg(), jsonWebKey.Algorithm)
+			assert.Equal(t, jsonWebKeyUseSignature, jsonWebKey.Use)
+			assert.NotEmpty(t, jsonWebKey.X)
+		}
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the jwt is signed with an unknown key", func(t *testing.T) {
+			other, err := New(newKeySetTest(t), webURLTest)
+			require.NoError(t, err)
+
+			s, err := New(newKeySetTest(t), webURLTest)
+			require.NoError(t, err)
+
+			tokenString, err := other.NewJWT("subject", "session", []SubjectRole{SubjectRoleUser}, nil)
+			require.NoError(t, err)
+
+			_, err = s.ParseJWT(tokenString)
+			require.ErrorIs(t, err, ErrKeyIDNotFound)
+		})
+
+		t.Run("when the jwt is not an access token", func(t *testing.T) {
+			s, err := New(newKeySetTest(t), webURLTest)
+			require.NoError(t, err)
+
+			tokenString, err := s.NewTwoFactorChallengeToken("subject", SubjectRoleUser)
+			require.NoError(t, err)
+
+			_, err = s.ParseJWT(tokenString)
+			require.ErrorIs(t, err, jwt.ErrTokenInvalidAudience)
+		})
+
+		t.Run("when the jwt is signed with a symmetric key", func(t *testing.T) {
+			s, err := New(newKeySetTest(t), webURLTest)
+			require.NoError(t, err)
+
+			token := jwt.NewWithClaims(jwt.SigningMethodHS256, Claims{})
+			token.Header["kid"] = s.keySet.signingKeyID
+
+			tokenString, err := token.SignedString([]byte("key"))
+			require.NoError(t, err)
+
+			_, err = s.ParseJWT(tokenString)
+			require.ErrorIs(t, err, jwt.ErrTokenSignatureInvalid)
+		})
+	})
+}
@@ -0,0 +1,86 @@
+package authn
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	oidcIdentityAudience       = "oidc-identity"
+	oidcIdentityExpirationTime = time.Minute * 30
+
+	descriptionFailedToParseOIDCIdentityToken = "authn: failed to parse oidc identity token"
+)
+
+// OIDCIdentityClaims defines the oidc identity token claims structure. The subject is the one of the external
+// provider.
+type OIDCIdentityClaims struct {
+	jwt.RegisteredClaims
+	Provider      string `json:"provider"`
+	Email         string `json:"email,omitempty"`
+	EmailVerified bool   `json:"emailVerified,omitempty"`
+}
+
+// NewOIDCNonce returns a new random nonce to bind an id token to its authorization request.
+func (s *service) NewOIDCNonce() (string, error) {
+	nonce, _, err := newOpaqueToken()
+	return nonce, err
+}
+
+// NewOIDCCodeVerifier returns a new random PKCE code verifier.
+func (s *service) NewOIDCCodeVerifier() (string, error) {
+	codeVerifier, _, err := newOpaqueToken()
+	return codeVerifier, err
+}
+
+// NewOIDCBinding returns a new random binding and its hash. The binding is kept by the browser that starts an
+// authorization, so that only that browser can complete it. Only the hash is meant to be stored.
+func (s *service) NewOIDCBinding() (string, []byte, error) {
+	return newOpaqueToken()
+}
+
+// HashOIDCBinding returns the SHA-256 hash of the oidc binding.
+func (s *service) HashOIDCBinding(binding string) []byte {
+	return hashOpaqueToken(binding)
+}
+
+// NewOIDCIdentityToken returns a new signed token, with an expiration time of 30 minutes, proving that the subject
+// authenticated with the specified external provider. It is meant to create an account or to link the identity to an
+// existing one.
+func (s *service) NewOIDCIdentityToken(provider, subject, email string, emailVerified bool) (string, error) {
+	expiresAt := time.Now().Add(oidcIdentityExpirationTime).UTC()
+	issuedAt := time.Now().UTC()
+
+	tokenString, err := s.signToken(OIDCIdentityClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    jwtIssuer,
+			Subject:   subject,
+			Audience:  jwt.ClaimStrings{oidcIdentityAudience},
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			IssuedAt:  jwt.NewNumericDate(issuedAt),
+		},
+		Provider:      provider,
+		Email:         email,
+		EmailVerified: emailVerified,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return tokenString, nil
+}
+
+// ParseOIDCIdentityToken parses the given oidc identity token and returns its claims, or returns an error if the
+// token is invalid.
+func (s *service) ParseOIDCIdentityToken(tokenString string) (OIDCIdentityClaims, error) {
+	var claims OIDCIdentityClaims
+
+	err := s.parseToken(tokenString, &claims, jwt.WithAudience(oidcIdentityAudience))
+	if err != nil {
+		return OIDCIdentityClaims{}, fmt.Errorf("%s: %w", descriptionFailedToParseOIDCIdentityToken, err)
+	}
+
+	return claims, nil
+}
@@ -1,9 +1,17 @@
 package authn
 
 import (
+	"bytes"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
 	"errors"
+	"fmt"
+	"strings"
+	"sync"
 	"unicode"
 
+	"golang.org/x/crypto/argon2"
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -13,8 +21,41 @@ const (
 	passwordMinDigits       = 1
 	passwordMinChars        = 1
 	passwordMinSpecialChars = 1
+
+	passwordHashPrefixArgon2id = "$argon2id$"
+	passwordHashSaltLength     = 16
 )
 
+var ErrPasswordHashInvalid = errors.New("authn: invalid password hash") // Returned when a password hash cannot be decoded.
+
+// passwordHashParams defines the argon2id parameters of a password hash, which are encoded in the hash itself.
+type passwordHashParams struct {
+	version   int
+	memory    uint32
+	time      uint32
+	threads   uint8
+	keyLength uint32
+}
+
+// passwordHashParamsCurrent defines the argon2id parameters of new password hashes, following the second recommended
+// option of RFC 9106. Hashes with other parameters, or older bcrypt hashes, are still checked, but should be upgraded.
+var passwordHashParamsCurrent = passwordHashParams{
+	version:   argon2.Version,
+	memory:    64 * 1024,
+	time:      3,
+	threads:   4,
+	keyLength: 32,
+}
+
+// dummyPasswordHash returns the hash of a random password, which is compared against when there is no password to
+// check, so that it takes the same time as checking an actual password.
+var dummyPasswordHash = sync.OnceValue(func() []byte {
+	password, _, _ := newOpaqueToken()
+	hash, _ := hashPassword([]byte(password), passwordHashParamsCurrent)
+
+	return hash
+})
+
 // ValidPassword returns true if the password matches the requirements, false otherwise.
 func (s *service) ValidPassword(password []byte) bool {
 	// Check password length requirements.
@@ -45,22 +86,122 @@ func (s *service) ValidPassword(password []byte) bool {
 	return digits >= passwordMinDigits && chars >= passwordMinChars && specialChars >= passwordMinSpecialChars
 }
 
-// HashPassword returns the hashed password.
+// HashPassword returns the hashed password, encoded with the current hashing parameters.
 func (s *service) HashPassword(password []byte) ([]byte, error) {
-	bytes, err := bcrypt.GenerateFromPassword(password, bcrypt.DefaultCost)
-	return bytes, err
+	return hashPassword(password, passwordHashParamsCurrent)
 }
 
-// CheckPasswordHash returns true if the password matches the hashed password, false otherwise.
-func (s *service) CheckPasswordHash(password, hash []byte) (bool, error) {
-	err := bcrypt.CompareHashAndPassword(hash, password)
+// NewUnusablePasswordHash returns the hash of a random password which is never revealed, for accounts created without
+// a password. A password can be set later with a password reset.
+func (s *service) NewUnusablePasswordHash() ([]byte, error) {
+	password, _, err := newOpaqueToken()
 	if err != nil {
-		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
-			return false, nil
+		return nil, err
+	}
+
+	return s.HashPassword([]byte(password))
+}
+
+// CheckPasswordHash returns true if the password matches the hashed password, false otherwise. Both argon2id and
+// bcrypt hashes are supported.
+func (s *service) CheckPasswordHash(password, hash []byte) (bool, error) {
+	if !bytes.HasPrefix(hash, []byte(passwordHashPrefixArgon2id)) {
+		err := bcrypt.CompareHashAndPassword(hash, password)
+		if err != nil {
+			if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+				return false, nil
+			}
+
+			return false, err
 		}
 
+		return true, nil
+	}
+
+	params, salt, key, err := decodePasswordHash(hash)
+	if err != nil {
 		return false, err
 	}
 
-	return true, nil
+	otherKey := argon2.IDKey(password, salt, params.time, params.memory, params.threads, params.keyLength)
+
+	return subtle.ConstantTimeCompare(key, otherKey) == 1, nil
+}
+
+// PasswordHashNeedsUpgrade returns true if the hashed password was not hashed with the current hashing parameters, in
+// which case the password should be hashed again once it is known, false otherwise.
+func (s *service) PasswordHashNeedsUpgrade(hash []byte) bool {
+	if !bytes.HasPrefix(hash, []byte(passwordHashPrefixArgon2id)) {
+		return true
+	}
+
+	params, _, _, err := decodePasswordHash(hash)
+	if err != nil {
+		return true
+	}
+
+	return params != passwordHashParamsCurrent
+}
+
+// CheckDummyPasswordHash compares the password against the hash of a random password. It is meant to be called
+// instead of CheckPasswordHash when there is no password to check, so that the response time does not reveal it.
+func (s *service) CheckDummyPasswordHash(password []byte) {
+	_, _ = s.CheckPasswordHash(password, dummyPasswordHash())
+}
+
+// hashPassword returns the password hashed with argon2id and the specified parameters, encoded in the PHC string
+// format, such as "$argon2id$v=19$m=65536,t=3,p=4$<salt>$<key>".
+func hashPassword(password []byte, params passwordHashParams) ([]byte, error) {
+	salt := make([]byte, passwordHashSaltLength)
+
+	_, err := rand.Read(salt)
+	if err != nil {
+		return nil, err
+	}
+
+	key := argon2.IDKey(password, salt, params.time, params.memory, params.threads, params.keyLength)
+
+	return []byte(fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		passwordHashPrefixArgon2id,
+		params.version,
+		params.memory,
+		params.time,
+		params.threads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	)), nil
+}
+
+// decodePasswordHash returns the parameters, the salt and the key of the argon2id hashed password.
+func decodePasswordHash(hash []byte) (passwordHashParams, []byte, []byte, error) {
+	parts := strings.Split(strings.TrimPrefix(string(hash), passwordHashPrefixArgon2id), "$")
+	if len(parts) != 4 {
+		return passwordHashParams{}, nil, nil, ErrPasswordHashInvalid
+	}
+
+	var params passwordHashParams
+
+	_, err := fmt.Sscanf(parts[0], "v=%d", &params.version)
+	if err != nil || params.version != argon2.Version {
+		return passwordHashParams{}, nil, nil, ErrPasswordHashInvalid
+	}
+
+	_, err = fmt.Sscanf(parts[1], "m=%d,t=%d,p=%d", &params.memory, &params.time, &params.threads)
+	if err != nil || params.time == 0 || params.threads == 0 {
+		return passwordHashParams{}, nil, nil, ErrPasswordHashInvalid
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return passwordHashParams{}, nil, nil, ErrPasswordHashInvalid
+	}
+
+	key, err := base64.RawStdEncoding.DecodeString(parts[3])
+	if err != nil || len(key) == 0 {
+		return passwordHashParams{}, nil, nil, ErrPasswordHashInvalid
+	}
+
+	params.keyLength = uint32(len(key))
+
+	return params, salt, key, nil
 }
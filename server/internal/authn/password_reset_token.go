@@ -0,0 +1,11 @@
+package authn
+
+// NewPasswordResetToken returns a new random password reset token and its hash. Only the hash is meant to be stored.
+func (s *service) NewPasswordResetToken() (string, []byte, error) {
+	return newOpaqueToken()
+}
+
+// HashPasswordResetToken returns the SHA-256 hash of the password reset token.
+func (s *service) HashPasswordResetToken(token string) []byte {
+	return hashOpaqueToken(token)
+}
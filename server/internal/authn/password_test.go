@@ -0,0 +1,57 @@
+package authn
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestService_PasswordHash(t *testing.T) {
+	s, err := New(newKeySetTest(t), webURLTest)
+	require.NoError(t, err)
+
+	password := []byte("ReallySecurePassword$123")
+
+	t.Run("should successfully hash and check password", func(t *testing.T) {
+		hash, err := s.HashPassword(password)
+		require.NoError(t, err)
+		assert.Contains(t, string(hash), "$argon2id$v=19$m=65536,t=3,p=4$")
+		assert.False(t, s.PasswordHashNeedsUpgrade(hash))
+
+		valid, err := s.CheckPasswordHash(password, hash)
+		require.NoError(t, err)
+		assert.True(t, valid)
+
+		valid, err = s.CheckPasswordHash([]byte("OtherSecurePassword$123"), hash)
+		require.NoError(t, err)
+		assert.False(t, valid)
+	})
+
+	t.Run("should successfully check password hashes that need an upgrade", func(t *testing.T) {
+		bcryptHash, err := bcrypt.GenerateFromPassword(password, bcrypt.MinCost)
+		require.NoError(t, err)
+
+		weakerParams := passwordHashParamsCurrent
+		weakerParams.time = 1
+
+		weakerHash, err := hashPassword(password, weakerParams)
+		require.NoError(t, err)
+
+		for _, hash := range [][]byte{bcryptHash, weakerHash} {
+			assert.True(t, s.PasswordHashNeedsUpgrade(hash))
+
+			valid, err := s.CheckPasswordHash(password, hash)
+			require.NoError(t, err)
+			assert.True(t, valid)
+		}
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the password hash is malformed", func(t *testing.T) {
+			_, err := s.CheckPasswordHash(password, []byte("$argon2id$v=19$m=65536,t=0,p=4$c2FsdA$a2V5"))
+			require.ErrorIs(t, err, ErrPasswordHashInvalid)
+		})
+	})
+}
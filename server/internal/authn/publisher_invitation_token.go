@@ -0,0 +1,12 @@
+package authn
+
+// NewPublisherInvitationToken returns a new random publisher invitation token and its hash. Only the hash is meant to
+// be stored.
+func (s *service) NewPublisherInvitationToken() (string, []byte, error) {
+	return newOpaqueToken()
+}
+
+// HashPublisherInvitationToken returns the SHA-256 hash of the publisher invitation token.
+func (s *service) HashPublisherInvitationToken(token string) []byte {
+	return hashOpaqueToken(token)
+}
@@ -0,0 +1,11 @@
+package authn
+
+// NewRefreshToken returns a new random refresh token and its hash. Only the hash is meant to be stored.
+func (s *service) NewRefreshToken() (string, []byte, error) {
+	return newOpaqueToken()
+}
+
+// HashRefreshToken returns the SHA-256 hash of the refresh token.
+func (s *service) HashRefreshToken(token string) []byte {
+	return hashOpaqueToken(token)
+}
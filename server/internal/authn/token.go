@@ -0,0 +1,30 @@
+package authn
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+)
+
+// opaqueTokenSize defines the amount of random bytes of an opaque token.
+const opaqueTokenSize = 32
+
+// newOpaqueToken returns a new random opaque token and its hash.
+func newOpaqueToken() (string, []byte, error) {
+	b := make([]byte, opaqueTokenSize)
+
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", nil, err
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(b)
+
+	return token, hashOpaqueToken(token), nil
+}
+
+// hashOpaqueToken returns the SHA-256 hash of the opaque token.
+func hashOpaqueToken(token string) []byte {
+	hash := sha256.Sum256([]byte(token))
+	return hash[:]
+}
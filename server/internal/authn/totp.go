@@ -0,0 +1,127 @@
+package authn
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" // Authenticator apps only support HMAC-SHA1 reliably.
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	totpIssuer     = "GOOFR Store"
+	totpSecretSize = 20               // Recommended size of an HMAC-SHA1 key.
+	totpPeriod     = 30 * time.Second // Duration of a time step.
+	totpDigits     = 6
+	totpModulo     = 1_000_000 // 10 to the power of the amount of digits.
+	totpSkew       = 1         // Amount of time steps accepted before and after the current one, to tolerate clock drift.
+
+	recoveryCodeSize = 10 // Amount of random bytes of a recovery code.
+)
+
+// totpEncoding defines the base32 encoding used by authenticator apps.
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// NewTOTPSecret returns a new random TOTP secret.
+func (s *service) NewTOTPSecret() ([]byte, error) {
+	secret := make([]byte, totpSecretSize)
+
+	_, err := rand.Read(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	return secret, nil
+}
+
+// EncodeTOTPSecret returns the base32 representation of the TOTP secret, to be entered in an authenticator app.
+func (s *service) EncodeTOTPSecret(secret []byte) string {
+	return totpEncoding.EncodeToString(secret)
+}
+
+// TOTPURI returns the otpauth URI of the TOTP secret for the specified account, to be rendered as a QR code.
+func (s *service) TOTPURI(secret []byte, accountName string) string {
+	query := url.Values{}
+	query.Set("secret", s.EncodeTOTPSecret(secret))
+	query.Set("issuer", totpIssuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(totpDigits))
+	query.Set("period", fmt.Sprint(int(totpPeriod.Seconds())))
+
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + totpIssuer + ":" + accountName,
+		RawQuery: strings.ReplaceAll(query.Encode(), "+", "%20"), // Authenticator apps expect spaces percent-encoded.
+	}
+
+	return u.String()
+}
+
+// ValidateTOTPCode reports whether the code is valid for the TOTP secret at the specified time, as defined by RFC 6238,
+// and returns the time step it matched. The step is meant to be stored, so that a code cannot be used twice.
+func (s *service) ValidateTOTPCode(secret []byte, code string, t time.Time) (int64, bool) {
+	if len(code) != totpDigits {
+		return 0, false
+	}
+
+	current := t.Unix() / int64(totpPeriod.Seconds())
+
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		if subtle.ConstantTimeCompare([]byte(totpCode(secret, step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+
+	return 0, false
+}
+
+// NewRecoveryCode returns a new random recovery code and its hash. Only the hash is meant to be stored.
+func (s *service) NewRecoveryCode() (string, []byte, error) {
+	b := make([]byte, recoveryCodeSize)
+
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", nil, err
+	}
+
+	encoded := strings.ToLower(totpEncoding.EncodeToString(b))
+
+	var code strings.Builder
+
+	for i := 0; i < len(encoded); i += 4 {
+		if i > 0 {
+			code.WriteByte('-')
+		}
+
+		code.WriteString(encoded[i:min(i+4, len(encoded))])
+	}
+
+	return code.String(), s.HashRecoveryCode(code.String()), nil
+}
+
+// HashRecoveryCode returns the SHA-256 hash of the recovery code, ignoring its case and separators.
+func (s *service) HashRecoveryCode(code string) []byte {
+	code = strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	return hashOpaqueToken(code)
+}
+
+// totpCode returns the code of the TOTP secret for the specified time step.
+func totpCode(secret []byte, step int64) string {
+	var counter [8]byte
+	binary.BigEndian.PutUint64(counter[:], uint64(step))
+
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(counter[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%0*d", totpDigits, value%totpModulo)
+}
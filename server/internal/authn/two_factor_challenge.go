@@ -0,0 +1,57 @@
+package authn
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	twoFactorChallengeAudience       = "two-factor-challenge"
+	twoFactorChallengeExpirationTime = time.Minute * 5
+
+	descriptionFailedToParseTwoFactorChallengeToken = "authn: failed to parse two-factor challenge token"
+)
+
+// TwoFactorChallengeClaims defines the two-factor challenge token claims structure.
+type TwoFactorChallengeClaims struct {
+	jwt.RegisteredClaims
+	Role SubjectRole `json:"role"`
+}
+
+// NewTwoFactorChallengeToken returns a new signed token, with an expiration time of 5 minutes, proving that the subject
+// signed in with its password. It is meant to be exchanged for tokens along with a second factor.
+func (s *service) NewTwoFactorChallengeToken(subject string, role SubjectRole) (string, error) {
+	expiresAt := time.Now().Add(twoFactorChallengeExpirationTime).UTC()
+	issuedAt := time.Now().UTC()
+
+	tokenString, err := s.signToken(TwoFactorChallengeClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    jwtIssuer,
+			Subject:   subject,
+			Audience:  jwt.ClaimStrings{twoFactorChallengeAudience},
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			IssuedAt:  jwt.NewNumericDate(issuedAt),
+		},
+		Role: role,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return tokenString, nil
+}
+
+// ParseTwoFactorChallengeToken parses the given two-factor challenge token and returns its claims, or returns an error
+// if the token is invalid.
+func (s *service) ParseTwoFactorChallengeToken(tokenString string) (TwoFactorChallengeClaims, error) {
+	var claims TwoFactorChallengeClaims
+
+	err := s.parseToken(tokenString, &claims, jwt.WithAudience(twoFactorChallengeAudience))
+	if err != nil {
+		return TwoFactorChallengeClaims{}, fmt.Errorf("%s: %w", descriptionFailedToParseTwoFactorChallengeToken, err)
+	}
+
+	return claims, nil
+}
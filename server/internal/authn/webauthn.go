@@ -0,0 +1,240 @@
+package authn
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+)
+
+const (
+	webAuthnRPDisplayName = "GOOFR Store"
+	webAuthnTimeout       = time.Minute * 5
+
+	descriptionFailedToParseWebAuthnSession  = "authn: failed to parse webauthn session"
+	descriptionFailedToParseWebAuthnResponse = "authn: failed to parse webauthn response"
+	descriptionFailedToValidateWebAuthn      = "authn: failed to validate webauthn response"
+)
+
+// ErrWebAuthnSignCountInvalid is returned when the sign count of an authenticator did not increase, which means that
+// the credential may have been cloned.
+var ErrWebAuthnSignCountInvalid = errors.New("authn: webauthn sign count did not increase")
+
+// WebAuthnSubject defines a subject which registers or authenticates with WebAuthn credentials.
+type WebAuthnSubject struct {
+	ID          []byte // User handle, which must not contain personal information.
+	Name        string
+	DisplayName string
+	Credentials []WebAuthnCredential
+}
+
+// WebAuthnCredential defines a public key credential registered by an authenticator.
+type WebAuthnCredential struct {
+	ID              []byte
+	PublicKey       []byte // COSE encoded public key.
+	AttestationType string
+	Transports      []string
+	AAGUID          []byte
+	SignCount       uint32
+}
+
+// webAuthnUser adapts a subject to the user interface of the WebAuthn library.
+type webAuthnUser struct {
+	subject WebAuthnSubject
+}
+
+func (u webAuthnUser) WebAuthnID() []byte {
+	return u.subject.ID
+}
+
+func (u webAuthnUser) WebAuthnName() string {
+	return u.subject.Name
+}
+
+func (u webAuthnUser) WebAuthnDisplayName() string {
+	return u.subject.DisplayName
+}
+
+func (u webAuthnUser) WebAuthnIcon() string {
+	return ""
+}
+
+func (u webAuthnUser) WebAuthnCredentials() []webauthn.Credential {
+	credentials := make([]webauthn.Credential, len(u.subject.Credentials))
+	for i, credential := range u.subject.Credentials {
+		credentials[i] = credential.toLibrary()
+	}
+
+	return credentials
+}
+
+// newWebAuthn returns a new WebAuthn relying party for the web application served at the specified URL.
+func newWebAuthn(webURL string) (*webauthn.WebAuthn, error) {
+	u, err := url.Parse(webURL)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := webauthn.TimeoutConfig{
+		Enforce:    true,
+		Timeout:    webAuthnTimeout,
+		TimeoutUVD: webAuthnTimeout,
+	}
+
+	return webauthn.New(&webauthn.Config{
+		RPID:          u.Hostname(),
+		RPDisplayName: webAuthnRPDisplayName,
+		RPOrigins:     []string{u.Scheme + "://" + u.Host},
+		AuthenticatorSelection: protocol.AuthenticatorSelection{
+			ResidentKey:      protocol.ResidentKeyRequirementRequired,
+			UserVerification: protocol.VerificationRequired,
+		},
+		Timeouts: webauthn.TimeoutsConfig{
+			Login:        timeout,
+			Registration: timeout,
+		},
+	})
+}
+
+// BeginWebAuthnRegistration starts the registration of a new passkey for the subject and returns the options to be
+// passed to the browser and the session to be kept until the registration is finished. Both are JSON encoded.
+func (s *service) BeginWebAuthnRegistration(subject WebAuthnSubject) ([]byte, []byte, error) {
+	user := webAuthnUser{subject: subject}
+
+	exclusions := make([]protocol.CredentialDescriptor, len(subject.Credentials))
+	for i, credential := range subject.Credentials {
+		exclusions[i] = credential.toLibrary().Descriptor()
+	}
+
+	creation, session, err := s.webAuthn.BeginRegistration(user, webauthn.WithExclusions(exclusions))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return marshalWebAuthnCeremony(creation, session)
+}
+
+// FinishWebAuthnRegistration validates the response of the browser to the registration started with the specified
+// session and returns the new credential.
+func (s *service) FinishWebAuthnRegistration(subject WebAuthnSubject, session, response []byte) (WebAuthnCredential, error) {
+	var sessionData webauthn.SessionData
+
+	err := json.Unmarshal(session, &sessionData)
+	if err != nil {
+		return WebAuthnCredential{}, fmt.Errorf("%s: %w", descriptionFailedToParseWebAuthnSession, err)
+	}
+
+	parsedResponse, err := protocol.ParseCredentialCreationResponseBody(bytes.NewReader(response))
+	if err != nil {
+		return WebAuthnCredential{}, fmt.Errorf("%s: %w", descriptionFailedToParseWebAuthnResponse, err)
+	}
+
+	credential, err := s.webAuthn.CreateCredential(webAuthnUser{subject: subject}, sessionData, parsedResponse)
+	if err != nil {
+		return WebAuthnCredential{}, fmt.Errorf("%s: %w", descriptionFailedToValidateWebAuthn, err)
+	}
+
+	return webAuthnCredentialFromLibrary(*credential), nil
+}
+
+// BeginWebAuthnLogin starts a passwordless sign in with a discoverable credential and returns the options to be passed
+// to the browser and the session to be kept until the sign in is finished. Both are JSON encoded.
+func (s *service) BeginWebAuthnLogin() ([]byte, []byte, error) {
+	assertion, session, err := s.webAuthn.BeginDiscoverableLogin(webauthn.WithUserVerification(protocol.VerificationRequired))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return marshalWebAuthnCeremony(assertion, session)
+}
+
+// FinishWebAuthnLogin validates the response of the browser to the sign in started with the specified session and
+// returns the used credential with its updated sign count. The subject owning the credential is looked up with
+// getSubject, based on the user handle and the credential identifier of the response.
+func (s *service) FinishWebAuthnLogin(session, response []byte, getSubject func(userHandle, credentialID []byte) (WebAuthnSubject, error)) (WebAuthnCredential, error) {
+	var sessionData webauthn.SessionData
+
+	err := json.Unmarshal(session, &sessionData)
+	if err != nil {
+		return WebAuthnCredential{}, fmt.Errorf("%s: %w", descriptionFailedToParseWebAuthnSession, err)
+	}
+
+	parsedResponse, err := protocol.ParseCredentialRequestResponseBody(bytes.NewReader(response))
+	if err != nil {
+		return WebAuthnCredential{}, fmt.Errorf("%s: %w", descriptionFailedToParseWebAuthnResponse, err)
+	}
+
+	credential, err := s.webAuthn.ValidateDiscoverableLogin(func(rawID, userHandle []byte) (webauthn.User, error) {
+		subject, err := getSubject(userHandle, rawID)
+		if err != nil {
+			return nil, err
+		}
+
+		return webAuthnUser{subject: subject}, nil
+	}, sessionData, parsedResponse)
+	if err != nil {
+		return WebAuthnCredential{}, fmt.Errorf("%s: %w", descriptionFailedToValidateWebAuthn, err)
+	}
+
+	if credential.Authenticator.CloneWarning {
+		return WebAuthnCredential{}, fmt.Errorf("%s: %w", descriptionFailedToValidateWebAuthn, ErrWebAuthnSignCountInvalid)
+	}
+
+	return webAuthnCredentialFromLibrary(*credential), nil
+}
+
+// marshalWebAuthnCeremony returns the JSON encoded options and session of a WebAuthn ceremony.
+func marshalWebAuthnCeremony(options any, session *webauthn.SessionData) ([]byte, []byte, error) {
+	optionsJSON, err := json.Marshal(options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sessionJSON, err := json.Marshal(session)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return optionsJSON, sessionJSON, nil
+}
+
+// toLibrary returns the credential as a WebAuthn library credential.
+func (c WebAuthnCredential) toLibrary() webauthn.Credential {
+	transports := make([]protocol.AuthenticatorTransport, len(c.Transports))
+	for i, transport := range c.Transports {
+		transports[i] = protocol.AuthenticatorTransport(transport)
+	}
+
+	return webauthn.Credential{
+		ID:              c.ID,
+		PublicKey:       c.PublicKey,
+		AttestationType: c.AttestationType,
+		Transport:       transports,
+		Authenticator: webauthn.Authenticator{
+			AAGUID:    c.AAGUID,
+			SignCount: c.SignCount,
+		},
+	}
+}
+
+// webAuthnCredentialFromLibrary returns a credential based on the WebAuthn library credential.
+func webAuthnCredentialFromLibrary(credential webauthn.Credential) WebAuthnCredential {
+	transports := make([]string, len(credential.Transport))
+	for i, transport := range credential.Transport {
+		transports[i] = string(transport)
+	}
+
+	return WebAuthnCredential{
+		ID:              credential.ID,
+		PublicKey:       credential.PublicKey,
+		AttestationType: credential.AttestationType,
+		Transports:      transports,
+		AAGUID:          credential.Authenticator.AAGUID,
+		SignCount:       credential.Authenticator.SignCount,
+	}
+}
@@ -0,0 +1,276 @@
+package authn
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const webURLTest = "http://localhost:8080"
+
+// Authenticator data flags.
+const (
+	authenticatorFlagUserPresent   = 0x01
+	authenticatorFlagUserVerified  = 0x04
+	authenticatorFlagAttestedData  = 0x40
+	authenticatorFlagsAssertion    = authenticatorFlagUserPresent | authenticatorFlagUserVerified
+	authenticatorFlagsRegistration = authenticatorFlagsAssertion | authenticatorFlagAttestedData
+)
+
+// softwareAuthenticator defines an in-memory authenticator with a single discoverable ES256 credential, so that the
+// WebAuthn ceremonies can be tested without a browser.
+type softwareAuthenticator struct {
+	origin       string
+	credentialID []byte
+	privateKey   *ecdsa.PrivateKey
+	userHandle   []byte
+	signCount    uint32
+}
+
+// ceremonyOptions defines the fields of the WebAuthn options used by the authenticator.
+type ceremonyOptions struct {
+	PublicKey struct {
+		Challenge string `json:"challenge"`
+		RPID      string `json:"rpId"`
+		RP        struct {
+			ID string `json:"id"`
+		} `json:"rp"`
+		User struct {
+			ID string `json:"id"`
+		} `json:"user"`
+	} `json:"publicKey"`
+}
+
+func newSoftwareAuthenticator(t *testing.T, origin string) *softwareAuthenticator {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	credentialID := make([]byte, 16)
+	_, err = rand.Read(credentialID)
+	require.NoError(t, err)
+
+	return &softwareAuthenticator{
+		origin:       origin,
+		credentialID: credentialID,
+		privateKey:   privateKey,
+	}
+}
+
+// create returns the response of the authenticator to the registration options.
+func (a *softwareAuthenticator) create(t *testing.T, optionsJSON []byte) []byte {
+	var options ceremonyOptions
+
+	err := json.Unmarshal(optionsJSON, &options)
+	require.NoError(t, err)
+
+	a.userHandle, err = base64.RawURLEncoding.DecodeString(options.PublicKey.User.ID)
+	require.NoError(t, err)
+
+	publicKey, err := a.privateKey.PublicKey.ECDH()
+	require.NoError(t, err)
+
+	publicKeyBytes := publicKey.Bytes() // Uncompressed point.
+
+	coseKey, err := webauthncbor.Marshal(webauthncose.EC2PublicKeyData{
+		PublicKeyData: webauthncose.PublicKeyData{
+			KeyType:   int64(webauthncose.EllipticKey),
+			Algorithm: int64(webauthncose.AlgES256),
+		},
+		Curve:  int64(webauthncose.P256),
+		XCoord: publicKeyBytes[1:33],
+		YCoord: publicKeyBytes[33:],
+	})
+	require.NoError(t, err)
+
+	authData := a.authenticatorData(options.PublicKey.RP.ID, authenticatorFlagsRegistration)
+	authData = append(authData, make([]byte, 16)...) // AAGUID.
+	authData = binary.BigEndian.AppendUint16(authData, uint16(len(a.credentialID)))
+	authData = append(authData, a.credentialID...)
+	authData = append(authData, coseKey...)
+
+	attestationObject, err := webauthncbor.Marshal(struct {
+		Format       string         `cbor:"fmt"`
+		AttStatement map[string]any `cbor:"attStmt"`
+		AuthData     []byte         `cbor:"authData"`
+	}{
+		Format:       "none",
+		AttStatement: map[string]any{},
+		AuthData:     authData,
+	})
+	require.NoError(t, err)
+
+	return a.response(t, map[string]string{
+		"clientDataJSON":    a.clientData(t, "webauthn.create", options.PublicKey.Challenge),
+		"attestationObject": base64.RawURLEncoding.EncodeToString(attestationObject),
+	})
+}
+
+// get returns the response of the authenticator to the sign in options, incrementing its sign count.
+func (a *softwareAuthenticator) get(t *testing.T, optionsJSON []byte) []byte {
+	var options ceremonyOptions
+
+	err := json.Unmarshal(optionsJSON, &options)
+	require.NoError(t, err)
+
+	a.signCount++
+
+	clientDataJSON := a.clientData(t, "webauthn.get", options.PublicKey.Challenge)
+	clientData, err := base64.RawURLEncoding.DecodeString(clientDataJSON)
+	require.NoError(t, err)
+
+	authData := a.authenticatorData(options.PublicKey.RPID, authenticatorFlagsAssertion)
+	clientDataHash := sha256.Sum256(clientData)
+	digest := sha256.Sum256(append(authData, clientDataHash[:]...))
+
+	signature, err := ecdsa.SignASN1(rand.Reader, a.privateKey, digest[:])
+	require.NoError(t, err)
+
+	return a.response(t, map[string]string{
+		"clientDataJSON":    clientDataJSON,
+		"authenticatorData": base64.RawURLEncoding.EncodeToString(authData),
+		"signature":         base64.RawURLEncoding.EncodeToString(signature),
+		"userHandle":        base64.RawURLEncoding.EncodeToString(a.userHandle),
+	})
+}
+
+func (a *softwareAuthenticator) authenticatorData(rpID string, flags byte) []byte {
+	rpIDHash := sha256.Sum256([]byte(rpID))
+
+	authData := append(rpIDHash[:], flags)
+
+	return binary.BigEndian.AppendUint32(authData, a.signCount)
+}
+
+func (a *softwareAuthenticator) clientData(t *testing.T, ceremonyType, challenge string) string {
+	clientData, err := json.Marshal(map[string]string{
+		"type":      ceremonyType,
+		"challenge": challenge,
+		"origin":    a.origin,
+	})
+	require.NoError(t, err)
+
+	return base64.RawURLEncoding.EncodeToString(clientData)
+}
+
+func (a *softwareAuthenticator) response(t *testing.T, response map[string]string) []byte {
+	credentialID := base64.RawURLEncoding.EncodeToString(a.credentialID)
+
+	body, err := json.Marshal(map[string]any{
+		"id":       credentialID,
+		"rawId":    credentialID,
+		"type":     "public-key",
+		"response": response,
+	})
+	require.NoError(t, err)
+
+	return body
+}
+
+func TestService_WebAuthn(t *testing.T) {
+	newSubject := func() WebAuthnSubject {
+		return WebAuthnSubject{
+			ID:          []byte("0123456789abcdef"),
+			Name:        "username",
+			DisplayName: "user name",
+		}
+	}
+
+	t.Run("should successfully register and sign in with a passkey", func(t *testing.T) {
+		s, err := New(newKeySetTest(t), webURLTest)
+		require.NoError(t, err)
+
+		authenticator := newSoftwareAuthenticator(t, webURLTest)
+		subject := newSubject()
+
+		options, session, err := s.BeginWebAuthnRegistration(subject)
+		require.NoError(t, err)
+
+		credential, err := s.FinishWebAuthnRegistration(subject, session, authenticator.create(t, options))
+		require.NoError(t, err)
+		assert.Equal(t, authenticator.credentialID, credential.ID)
+		assert.Equal(t, "none", credential.AttestationType)
+		assert.Zero(t, credential.SignCount)
+
+		subject.Credentials = []WebAuthnCredential{credential}
+
+		options, session, err = s.BeginWebAuthnLogin()
+		require.NoError(t, err)
+
+		credential, err = s.FinishWebAuthnLogin(session, authenticator.get(t, options), func(userHandle, credentialID []byte) (WebAuthnSubject, error) {
+			assert.Equal(t, subject.ID, userHandle)
+			assert.Equal(t, authenticator.credentialID, credentialID)
+
+			return subject, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, uint32(1), credential.SignCount)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the sign count did not increase", func(t *testing.T) {
+			s, err := New(newKeySetTest(t), webURLTest)
+			require.NoError(t, err)
+
+			authenticator := newSoftwareAuthenticator(t, webURLTest)
+			subject := newSubject()
+
+			options, session, err := s.BeginWebAuthnRegistration(subject)
+			require.NoError(t, err)
+
+			credential, err := s.FinishWebAuthnRegistration(subject, session, authenticator.create(t, options))
+			require.NoError(t, err)
+
+			credential.SignCount = 5
+			subject.Credentials = []WebAuthnCredential{credential}
+
+			options, session, err = s.BeginWebAuthnLogin()
+			require.NoError(t, err)
+
+			_, err = s.FinishWebAuthnLogin(session, authenticator.get(t, options), func(_, _ []byte) (WebAuthnSubject, error) {
+				return subject, nil
+			})
+			require.ErrorIs(t, err, ErrWebAuthnSignCountInvalid)
+		})
+
+		t.Run("when the response answers another challenge", func(t *testing.T) {
+			s, err := New(newKeySetTest(t), webURLTest)
+			require.NoError(t, err)
+
+			authenticator := newSoftwareAuthenticator(t, webURLTest)
+			subject := newSubject()
+
+			options, _, err := s.BeginWebAuthnRegistration(subject)
+			require.NoError(t, err)
+
+			_, session, err := s.BeginWebAuthnRegistration(subject)
+			require.NoError(t, err)
+
+			_, err = s.FinishWebAuthnRegistration(subject, session, authenticator.create(t, options))
+			require.Error(t, err)
+		})
+
+		t.Run("when the origin is not the web application", func(t *testing.T) {
+			s, err := New(newKeySetTest(t), webURLTest)
+			require.NoError(t, err)
+
+			authenticator := newSoftwareAuthenticator(t, "http://localhost:9090")
+			subject := newSubject()
+
+			options, session, err := s.BeginWebAuthnRegistration(subject)
+			require.NoError(t, err)
+
+			_, err = s.FinishWebAuthnRegistration(subject, session, authenticator.create(t, options))
+			require.Error(t, err)
+		})
+	})
+}
@@ -1,29 +1,57 @@
 package authz
 
 import (
+	"context"
+
+	"github.com/google/uuid"
+
 	"github.com/goofr-group/gaming-store/server/internal/authn"
 )
 
-// Roles defines the authorization roles structure.
-type Roles struct {
-	AuthzWildcards []string // Wildcards that require authorization.
+// Policy defines the authorization policy structure.
+type Policy struct {
+	AuthzWildcards             []string            // Wildcards that require authorization.
+	PublisherWildcard          string              // Wildcard that can also be authorized by the membership of the subject in the publisher.
+	OperationPermissions       map[string][]string // Permissions required by each operation, by route pattern.
+	PublisherMemberPermissions map[string][]string // Permissions granted to the members of a publisher, by member role.
+	Ownerships                 []Ownership         // Resources that must belong to the resource of another wildcard.
+	UnboundRoles               []string            // Roles that, when required by the operation, are not bound to the authorization wildcards.
+	OptionalOperations         []string            // Operations, by route pattern, that can also be called without a token.
+}
+
+// Ownership defines a resource in a wildcard that must belong to the resource in the owner wildcard.
+type Ownership struct {
+	Wildcard      string
+	OwnerWildcard string
+	Resolver      OwnershipResolver
 }
 
+// OwnershipResolver returns true if the resource with the specified identifier belongs to the owner with the specified
+// identifier, false otherwise.
+type OwnershipResolver func(ctx context.Context, ownerID, id uuid.UUID) (bool, error)
+
 // AuthenticationService defines the authentication service interface.
 type AuthenticationService interface {
 	ParseJWT(tokenString string) (authn.Claims, error)
 }
 
+// SessionService defines the session service interface.
+type SessionService interface {
+	ValidSession(ctx context.Context, id uuid.UUID) (bool, error)
+}
+
 // service defines the authorization service structure.
 type service struct {
-	roles        Roles
-	authnService AuthenticationService
+	policy         Policy
+	authnService   AuthenticationService
+	sessionService SessionService
 }
 
 // New returns a new authorization service.
-func New(roles Roles, authnService AuthenticationService) *service {
+func New(policy Policy, authnService AuthenticationService, sessionService SessionService) *service {
 	return &service{
-		roles:        roles,
-		authnService: authnService,
+		policy:         policy,
+		authnService:   authnService,
+		sessionService: sessionService,
 	}
 }
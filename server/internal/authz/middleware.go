@@ -1,13 +1,17 @@
 package authz
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
 	"slices"
 	"strings"
 
+	"github.com/google/uuid"
+
 	"github.com/goofr-group/gaming-store/server/api"
+	"github.com/goofr-group/gaming-store/server/internal/authn"
 )
 
 const (
@@ -16,27 +20,47 @@ const (
 
 	descriptionFailedToFindBearerSchemaPrefix = "authz: failed to find bearer schema prefix"
 	descriptionFailedToParseJWT               = "authz: failed to parse jwt"
-	descriptionFailedToValidateRoles          = "authz: failed to validate roles"
+	descriptionFailedToValidateSession        = "authz: failed to validate session"
+	descriptionFailedToAuthorize              = "authz: failed to authorize"
 )
 
 var (
 	ErrAuthorizationHeaderInvalid = errors.New("invalid authorization header") // Returned when the Authorization header is invalid.
 	ErrJWTInvalid                 = errors.New("invalid jwt")                  // Returned when the JWT is invalid.
+	ErrSessionInvalid             = errors.New("invalid session")              // Returned when the session of the JWT is revoked or expired.
 	ErrRolesInvalid               = errors.New("invalid roles")                // Returned when the subject does not contain any of the required roles.
 	ErrAuthorizationInvalid       = errors.New("invalid authorization")        // Returned when the subject is not the one actually contained in the path wildcard.
+	ErrOwnershipInvalid           = errors.New("invalid ownership")            // Returned when a resource in the path does not belong to the resource of its owner wildcard.
 )
 
+// claimsContextKey defines the context key of the claims of the authorized subject.
+type claimsContextKey struct{}
+
+// ClaimsFromContext returns the claims of the subject authorized by the middleware, and true if the request carried
+// them, false otherwise. Optional operations called without a token carry no claims.
+func ClaimsFromContext(ctx context.Context) (authn.Claims, bool) {
+	claims, ok := ctx.Value(claimsContextKey{}).(authn.Claims)
+	return claims, ok
+}
+
+// ContextWithClaims returns a copy of the context with the claims of the authorized subject.
+func ContextWithClaims(ctx context.Context, claims authn.Claims) context.Context {
+	return context.WithValue(ctx, claimsContextKey{}, claims)
+}
+
 // ErrorHandlerFunc defines the function to handle an error in the middleware.
 type ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
 
 // MiddlewareOptions defines the middleware options structure.
 type MiddlewareOptions struct {
-	UnauthorizedHandlerFunc ErrorHandlerFunc
-	ForbiddenHandlerFunc    ErrorHandlerFunc
+	UnauthorizedHandlerFunc        ErrorHandlerFunc
+	ForbiddenHandlerFunc           ErrorHandlerFunc
+	InternalServerErrorHandlerFunc ErrorHandlerFunc // Handles the failures to validate the session or resolve ownerships.
 }
 
-// Middleware validates the JWT in the Authorization header and ensures that the associated subject has the necessary
-// roles to access an endpoint based on the configured Roles.
+// Middleware validates the JWT in the Authorization header and its session, and ensures that the associated subject
+// is allowed to access an endpoint based on the configured Policy. Optional operations are served without claims when
+// no token is sent.
 func (s *service) Middleware(options MiddlewareOptions) func(http.Handler) http.Handler {
 	// unauthorized defines a function to handle an unauthorized HTTP response.
 	unauthorized := options.UnauthorizedHandlerFunc
@@ -54,6 +78,14 @@ func (s *service) Middleware(options MiddlewareOptions) func(http.Handler) http.
 		}
 	}
 
+	// internalServerError defines a function to handle an internal server error HTTP response.
+	internalServerError := options.InternalServerErrorHandlerFunc
+	if internalServerError == nil {
+		internalServerError = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
 	return func(nextHandler http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			ctx := r.Context()
@@ -71,6 +103,13 @@ func (s *service) Middleware(options MiddlewareOptions) func(http.Handler) http.
 				// Get token from the Authorization header.
 				authorizationValue := r.Header.Get(requestHeaderKeyAuthorization)
 
+				// Serve optional operations anonymously without a token.
+				optional := slices.Contains(s.policy.OptionalOperations, r.Pattern)
+				if optional && len(authorizationValue) == 0 {
+					nextHandler.ServeHTTP(w, r)
+					return
+				}
+
 				token, ok := strings.CutPrefix(authorizationValue, bearerSchemaPrefix)
 				if !ok {
 					unauthorized(w, r, fmt.Errorf("%s: %w", descriptionFailedToFindBearerSchemaPrefix, ErrAuthorizationHeaderInvalid))
@@ -84,17 +123,43 @@ func (s *service) Middleware(options MiddlewareOptions) func(http.Handler) http.
 					return
 				}
 
-				// Evaluate roles.
-				subjectRoles := make([]string, len(claims.Roles))
-				for i, role := range claims.Roles {
-					subjectRoles[i] = string(role)
+				// Evaluate session.
+				sessionID, err := uuid.Parse(claims.SessionID)
+				if err != nil {
+					unauthorized(w, r, fmt.Errorf("%s: %w", descriptionFailedToParseJWT, ErrJWTInvalid))
+					return
 				}
 
-				err = s.validateRoles(r, requiredRoles, subjectRoles, claims.Subject)
+				valid, err := s.sessionService.ValidSession(ctx, sessionID)
 				if err != nil {
-					forbidden(w, r, fmt.Errorf("%s: %w", descriptionFailedToValidateRoles, err))
+					internalServerError(w, r, fmt.Errorf("%s: %w", descriptionFailedToValidateSession, err))
 					return
 				}
+
+				if !valid {
+					unauthorized(w, r, fmt.Errorf("%s: %w", descriptionFailedToValidateSession, ErrSessionInvalid))
+					return
+				}
+
+				// Evaluate policy, unless the operation is optional, which leaves it to the handler to tailor the response
+				// to the subject.
+				if !optional {
+					err = s.authorize(r, requiredRoles, claims)
+					if err != nil {
+						switch {
+						case errors.Is(err, ErrRolesInvalid),
+							errors.Is(err, ErrAuthorizationInvalid),
+							errors.Is(err, ErrOwnershipInvalid):
+							forbidden(w, r, fmt.Errorf("%s: %w", descriptionFailedToAuthorize, err))
+						default:
+							internalServerError(w, r, fmt.Errorf("%s: %w", descriptionFailedToAuthorize, err))
+						}
+
+						return
+					}
+				}
+
+				r = r.WithContext(ContextWithClaims(ctx, claims))
 			}
 
 			// Serve next handler.
@@ -102,46 +167,3 @@ func (s *service) Middleware(options MiddlewareOptions) func(http.Handler) http.
 		})
 	}
 }
-
-// validateRoles validates the subject roles to access the endpoint.
-func (s *service) validateRoles(r *http.Request, requiredRoles []string, subjectRoles []string, subject string) error {
-	// Check that the subject contains at least one of the required roles.
-	containedRoles := make([]string, 0, len(requiredRoles))
-
-	for _, requiredRole := range requiredRoles {
-		if !slices.Contains(subjectRoles, requiredRole) {
-			continue
-		}
-
-		containedRoles = append(containedRoles, requiredRole)
-	}
-
-	if len(containedRoles) == 0 {
-		return ErrRolesInvalid
-	}
-
-	// Check that the subject is the one actually contained in the path wildcard.
-	var requiredAuthorization string
-
-	for _, wildcard := range s.roles.AuthzWildcards {
-		pathValue := r.PathValue(wildcard)
-		if len(pathValue) == 0 {
-			continue
-		}
-
-		requiredAuthorization = pathValue
-	}
-
-	if len(requiredAuthorization) == 0 {
-		// There is no authorization wildcard.
-		return nil
-	}
-
-	if requiredAuthorization != subject {
-		return ErrAuthorizationInvalid
-	}
-
-	// This means that the subject has the necessary roles to access the endpoint and has the authority of the data
-	// they are trying to manipulate.
-	return nil
-}
@@ -0,0 +1,167 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goofr-group/gaming-store/server/api"
+	"github.com/goofr-group/gaming-store/server/internal/authn"
+)
+
+const (
+	patternListGames = "GET /api/games"
+
+	tokenValid = "valid"
+)
+
+// authnServiceTest implements the authentication service with a single valid token.
+type authnServiceTest struct {
+	claims authn.Claims
+}
+
+func (s authnServiceTest) ParseJWT(tokenString string) (authn.Claims, error) {
+	if tokenString != tokenValid {
+		return authn.Claims{}, errors.New("invalid token")
+	}
+
+	return s.claims, nil
+}
+
+// sessionServiceTest implements the session service with a fixed result.
+type sessionServiceTest struct {
+	valid bool
+	err   error
+}
+
+func (s sessionServiceTest) ValidSession(context.Context, uuid.UUID) (bool, error) {
+	return s.valid, s.err
+}
+
+func TestService_Middleware(t *testing.T) {
+	var (
+		userID      = uuid.New()
+		publisherID = uuid.New()
+
+		userClaims = authn.Claims{
+			SessionID: uuid.NewString(),
+			Roles:     []authn.SubjectRole{authn.SubjectRoleUser},
+		}
+	)
+
+	userClaims.Subject = userID.String()
+
+	tests := []struct {
+		name           string
+		pattern        string
+		pathValues     map[string]string
+		authorization  string
+		sessionService sessionServiceTest
+		expectedStatus int
+		expectedClaims bool
+	}{
+		{
+			name:           "should serve an optional operation anonymously without a token",
+			pattern:        patternListGames,
+			sessionService: sessionServiceTest{valid: true},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "should serve an optional operation with the claims of a valid token",
+			pattern:        patternListGames,
+			authorization:  "Bearer " + tokenValid,
+			sessionService: sessionServiceTest{valid: true},
+			expectedStatus: http.StatusOK,
+			expectedClaims: true,
+		},
+		{
+			name:           "should serve an optional operation without evaluating the policy",
+			pattern:        patternListGames,
+			pathValues:     map[string]string{"publisherId": publisherID.String()},
+			authorization:  "Bearer " + tokenValid,
+			sessionService: sessionServiceTest{valid: true},
+			expectedStatus: http.StatusOK,
+			expectedClaims: true,
+		},
+		{
+			name:           "should fail an optional operation with an invalid token",
+			pattern:        patternListGames,
+			authorization:  "Bearer invalid",
+			sessionService: sessionServiceTest{valid: true},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "should fail a required operation without a token",
+			pattern:        patternGetUser,
+			pathValues:     map[string]string{"userId": userID.String()},
+			sessionService: sessionServiceTest{valid: true},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "should fail a required operation when the policy denies it",
+			pattern:        patternGetUser,
+			pathValues:     map[string]string{"userId": publisherID.String()},
+			authorization:  "Bearer " + tokenValid,
+			sessionService: sessionServiceTest{valid: true},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "should fail when the session is revoked",
+			pattern:        patternGetUser,
+			pathValues:     map[string]string{"userId": userID.String()},
+			authorization:  "Bearer " + tokenValid,
+			sessionService: sessionServiceTest{valid: false},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "should fail when the session can not be validated",
+			pattern:        patternGetUser,
+			pathValues:     map[string]string{"userId": userID.String()},
+			authorization:  "Bearer " + tokenValid,
+			sessionService: sessionServiceTest{err: errors.New("failed to get session")},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := New(Policy{
+				AuthzWildcards:     []string{"userId", "publisherId"},
+				PublisherWildcard:  "publisherId",
+				OptionalOperations: []string{patternListGames},
+			}, authnServiceTest{claims: userClaims}, test.sessionService)
+
+			var claimsFound bool
+
+			middleware := s.Middleware(MiddlewareOptions{})
+			handler := middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, claimsFound = ClaimsFromContext(r.Context())
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.Pattern = test.pattern
+			r = r.WithContext(context.WithValue(r.Context(), api.BearerAuthScopes, []string{"user", "publisher"}))
+
+			for wildcard, pathValue := range test.pathValues {
+				r.SetPathValue(wildcard, pathValue)
+			}
+
+			if len(test.authorization) != 0 {
+				r.Header.Set(requestHeaderKeyAuthorization, test.authorization)
+			}
+
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, r)
+
+			assert.Equal(t, test.expectedStatus, w.Code)
+			assert.Equal(t, test.expectedClaims, claimsFound)
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package authz
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/goofr-group/gaming-store/server/internal/authn"
+)
+
+const (
+	descriptionFailedToResolveOwnership = "authz: failed to resolve ownership"
+)
+
+// authorize evaluates the policy for the subject of the specified claims to access the endpoint of the request.
+// The subject must contain at least one of the required roles, and be the one contained in the authorization wildcards,
+// unless it is a member of the publisher in the publisher wildcard with a role that grants every permission of the
+// operation, or it is granted one of the unbound roles required. Then, every resource in the path must belong to the
+// resource of its owner wildcard.
+func (s *service) authorize(r *http.Request, requiredRoles []string, claims authn.Claims) error {
+	err := s.validateRoles(requiredRoles, claims.Roles)
+	if err != nil {
+		return err
+	}
+
+	if !s.unboundRoleGranted(requiredRoles, claims.Roles) {
+		err = s.validateWildcards(r, claims)
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.validateOwnerships(r)
+}
+
+// validateRoles validates that the subject roles contain at least one of the required roles.
+func (s *service) validateRoles(requiredRoles []string, subjectRoles []authn.SubjectRole) error {
+	for _, subjectRole := range subjectRoles {
+		if slices.Contains(requiredRoles, string(subjectRole)) {
+			return nil
+		}
+	}
+
+	return ErrRolesInvalid
+}
+
+// unboundRoleGranted returns true if the subject roles contain an unbound role that is one of the required roles,
+// false otherwise.
+func (s *service) unboundRoleGranted(requiredRoles []string, subjectRoles []authn.SubjectRole) bool {
+	for _, subjectRole := range subjectRoles {
+		if slices.Contains(s.policy.UnboundRoles, string(subjectRole)) && slices.Contains(requiredRoles, string(subjectRole)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateWildcards validates that the subject is the one actually contained in the authorization wildcards of the
+// path, or a member of the publisher with the permissions required by the operation.
+func (s *service) validateWildcards(r *http.Request, claims authn.Claims) error {
+	for _, wildcard := range s.policy.AuthzWildcards {
+		pathValue := r.PathValue(wildcard)
+		if len(pathValue) == 0 || pathValue == claims.Subject {
+			continue
+		}
+
+		if wildcard == s.policy.PublisherWildcard && s.publisherMemberGranted(r, claims.Publishers[pathValue]) {
+			continue
+		}
+
+		return ErrAuthorizationInvalid
+	}
+
+	return nil
+}
+
+// publisherMemberGranted returns true if the specified publisher member role grants every permission required by the
+// operation of the request, false otherwise. Operations without permissions are reserved to the subject itself.
+func (s *service) publisherMemberGranted(r *http.Request, publisherMemberRole string) bool {
+	requiredPermissions := s.policy.OperationPermissions[r.Pattern]
+	if len(requiredPermissions) == 0 || len(publisherMemberRole) == 0 {
+		return false
+	}
+
+	grantedPermissions := s.policy.PublisherMemberPermissions[publisherMemberRole]
+
+	for _, requiredPermission := range requiredPermissions {
+		if !slices.Contains(grantedPermissions, requiredPermission) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// validateOwnerships validates that every resource in the path belongs to the resource of its owner wildcard.
+func (s *service) validateOwnerships(r *http.Request) error {
+	for _, ownership := range s.policy.Ownerships {
+		pathValue := r.PathValue(ownership.Wildcard)
+		ownerPathValue := r.PathValue(ownership.OwnerWildcard)
+
+		if len(pathValue) == 0 || len(ownerPathValue) == 0 {
+			continue
+		}
+
+		id, err := uuid.Parse(pathValue)
+		if err != nil {
+			return ErrOwnershipInvalid
+		}
+
+		ownerID, err := uuid.Parse(ownerPathValue)
+		if err != nil {
+			return ErrOwnershipInvalid
+		}
+
+		owned, err := ownership.Resolver(r.Context(), ownerID, id)
+		if err != nil {
+			return fmt.Errorf("%s: %w", descriptionFailedToResolveOwnership, err)
+		}
+
+		if !owned {
+			return ErrOwnershipInvalid
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,275 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goofr-group/gaming-store/server/internal/authn"
+)
+
+const (
+	patternGetUser          = "GET /api/users/{userId}"
+	patternPatchPublisher   = "PATCH /api/publishers/{publisherId}"
+	patternChangePassword   = "POST /api/publishers/{publisherId}/password"
+	patternListMembers      = "GET /api/publishers/{publisherId}/members"
+	patternPatchGame        = "PATCH /api/publishers/{publisherId}/games/{gameId}"
+	patternUploadMultimedia = "PUT /api/multimedia"
+	patternReviewPublisher  = "POST /api/publishers/{publisherId}/verification"
+
+	permissionPublisherRead  = "publisher:read"
+	permissionPublisherWrite = "publisher:write"
+	permissionGamesWrite     = "games:write"
+)
+
+var errResolverTest = errors.New("resolver error")
+
+func TestService_Authorize(t *testing.T) {
+	var (
+		userID          = uuid.New()
+		publisherID     = uuid.New()
+		gameID          = uuid.New()
+		otherID         = uuid.New()
+		resolverErrorID = uuid.New()
+	)
+
+	s := New(Policy{
+		AuthzWildcards:    []string{"userId", "publisherId"},
+		PublisherWildcard: "publisherId",
+		OperationPermissions: map[string][]string{
+			patternPatchPublisher: {permissionPublisherWrite},
+			patternListMembers:    {permissionPublisherRead},
+			patternPatchGame:      {permissionGamesWrite},
+		},
+		PublisherMemberPermissions: map[string][]string{
+			"owner":     {permissionPublisherRead, permissionPublisherWrite, permissionGamesWrite},
+			"developer": {permissionPublisherRead, permissionGamesWrite},
+			"viewer":    {permissionPublisherRead},
+		},
+		Ownerships: []Ownership{
+			{
+				Wildcard:      "gameId",
+				OwnerWildcard: "publisherId",
+				Resolver: func(_ context.Context, ownerID, id uuid.UUID) (bool, error) {
+					if id == resolverErrorID {
+						return false, errResolverTest
+					}
+
+					return ownerID == publisherID && id == gameID, nil
+				},
+			},
+		},
+		UnboundRoles: []string{"admin"},
+	}, nil, nil)
+
+	userClaims := func(publishers map[string]string) authn.Claims {
+		return authn.Claims{
+			Roles:      []authn.SubjectRole{authn.SubjectRoleUser},
+			Publishers: publishers,
+		}
+	}
+
+	publisherClaims := authn.Claims{
+		Roles: []authn.SubjectRole{authn.SubjectRolePublisher},
+	}
+
+	adminClaims := authn.Claims{
+		Roles: []authn.SubjectRole{authn.SubjectRoleUser, authn.SubjectRoleAdmin},
+	}
+
+	tests := []struct {
+		name          string
+		pattern       string
+		pathValues    map[string]string
+		requiredRoles []string
+		claims        authn.Claims
+		subject       string
+		expectedError error
+	}{
+		{
+			name:          "should successfully authorize user on its own resource",
+			pattern:       patternGetUser,
+			pathValues:    map[string]string{"userId": userID.String()},
+			requiredRoles: []string{"user"},
+			claims:        userClaims(nil),
+			subject:       userID.String(),
+		},
+		{
+			name:          "should successfully authorize subject without path wildcards",
+			pattern:       patternUploadMultimedia,
+			requiredRoles: []string{"user", "publisher"},
+			claims:        userClaims(nil),
+			subject:       userID.String(),
+		},
+		{
+			name:          "should successfully authorize publisher on its own game",
+			pattern:       patternPatchGame,
+			pathValues:    map[string]string{"publisherId": publisherID.String(), "gameId": gameID.String()},
+			requiredRoles: []string{"publisher", "user"},
+			claims:        publisherClaims,
+			subject:       publisherID.String(),
+		},
+		{
+			name:          "should successfully authorize publisher on operation without permissions",
+			pattern:       patternChangePassword,
+			pathValues:    map[string]string{"publisherId": publisherID.String()},
+			requiredRoles: []string{"publisher"},
+			claims:        publisherClaims,
+			subject:       publisherID.String(),
+		},
+		{
+			name:          "should successfully authorize developer member on publisher game",
+			pattern:       patternPatchGame,
+			pathValues:    map[string]string{"publisherId": publisherID.String(), "gameId": gameID.String()},
+			requiredRoles: []string{"publisher", "user"},
+			claims:        userClaims(map[string]string{publisherID.String(): "developer"}),
+			subject:       userID.String(),
+		},
+		{
+			name:          "should successfully authorize owner member on publisher",
+			pattern:       patternPatchPublisher,
+			pathValues:    map[string]string{"publisherId": publisherID.String()},
+			requiredRoles: []string{"publisher", "user"},
+			claims:        userClaims(map[string]string{publisherID.String(): "owner"}),
+			subject:       userID.String(),
+		},
+		{
+			name:          "should successfully authorize viewer member on publisher members",
+			pattern:       patternListMembers,
+			pathValues:    map[string]string{"publisherId": publisherID.String()},
+			requiredRoles: []string{"publisher", "user"},
+			claims:        userClaims(map[string]string{publisherID.String(): "viewer"}),
+			subject:       userID.String(),
+		},
+		{
+			name:          "should successfully authorize admin on another publisher",
+			pattern:       patternReviewPublisher,
+			pathValues:    map[string]string{"publisherId": otherID.String()},
+			requiredRoles: []string{"admin"},
+			claims:        adminClaims,
+			subject:       userID.String(),
+		},
+		{
+			name:          "should fail when the subject does not contain any of the required roles",
+			pattern:       patternGetUser,
+			pathValues:    map[string]string{"userId": publisherID.String()},
+			requiredRoles: []string{"user"},
+			claims:        publisherClaims,
+			subject:       publisherID.String(),
+			expectedError: ErrRolesInvalid,
+		},
+		{
+			name:          "should fail when the user accesses another user",
+			pattern:       patternGetUser,
+			pathValues:    map[string]string{"userId": otherID.String()},
+			requiredRoles: []string{"user"},
+			claims:        userClaims(nil),
+			subject:       userID.String(),
+			expectedError: ErrAuthorizationInvalid,
+		},
+		{
+			name:          "should fail when the publisher accesses another publisher",
+			pattern:       patternPatchPublisher,
+			pathValues:    map[string]string{"publisherId": otherID.String()},
+			requiredRoles: []string{"publisher", "user"},
+			claims:        publisherClaims,
+			subject:       publisherID.String(),
+			expectedError: ErrAuthorizationInvalid,
+		},
+		{
+			name:          "should fail when the publisher patches a game of another publisher in its own path",
+			pattern:       patternPatchGame,
+			pathValues:    map[string]string{"publisherId": publisherID.String(), "gameId": otherID.String()},
+			requiredRoles: []string{"publisher", "user"},
+			claims:        publisherClaims,
+			subject:       publisherID.String(),
+			expectedError: ErrOwnershipInvalid,
+		},
+		{
+			name:          "should fail when the user is not a member of the publisher",
+			pattern:       patternPatchGame,
+			pathValues:    map[string]string{"publisherId": publisherID.String(), "gameId": gameID.String()},
+			requiredRoles: []string{"publisher", "user"},
+			claims:        userClaims(map[string]string{otherID.String(): "owner"}),
+			subject:       userID.String(),
+			expectedError: ErrAuthorizationInvalid,
+		},
+		{
+			name:          "should fail when the member role does not grant the operation permissions",
+			pattern:       patternPatchGame,
+			pathValues:    map[string]string{"publisherId": publisherID.String(), "gameId": gameID.String()},
+			requiredRoles: []string{"publisher", "user"},
+			claims:        userClaims(map[string]string{publisherID.String(): "viewer"}),
+			subject:       userID.String(),
+			expectedError: ErrAuthorizationInvalid,
+		},
+		{
+			name:          "should fail when the member accesses an operation without permissions",
+			pattern:       patternChangePassword,
+			pathValues:    map[string]string{"publisherId": publisherID.String()},
+			requiredRoles: []string{"publisher", "user"},
+			claims:        userClaims(map[string]string{publisherID.String(): "owner"}),
+			subject:       userID.String(),
+			expectedError: ErrAuthorizationInvalid,
+		},
+		{
+			name:          "should fail when the member role is unknown",
+			pattern:       patternListMembers,
+			pathValues:    map[string]string{"publisherId": publisherID.String()},
+			requiredRoles: []string{"publisher", "user"},
+			claims:        userClaims(map[string]string{publisherID.String(): "admin"}),
+			subject:       userID.String(),
+			expectedError: ErrAuthorizationInvalid,
+		},
+		{
+			name:          "should fail when the admin accesses another user on operation not requiring admin",
+			pattern:       patternGetUser,
+			pathValues:    map[string]string{"userId": otherID.String()},
+			requiredRoles: []string{"user"},
+			claims:        adminClaims,
+			subject:       userID.String(),
+			expectedError: ErrAuthorizationInvalid,
+		},
+		{
+			name:          "should fail when the user is not an admin",
+			pattern:       patternReviewPublisher,
+			pathValues:    map[string]string{"publisherId": otherID.String()},
+			requiredRoles: []string{"admin"},
+			claims:        userClaims(nil),
+			subject:       userID.String(),
+			expectedError: ErrRolesInvalid,
+		},
+		{
+			name:          "should fail when the ownership can not be resolved",
+			pattern:       patternPatchGame,
+			pathValues:    map[string]string{"publisherId": publisherID.String(), "gameId": resolverErrorID.String()},
+			requiredRoles: []string{"publisher", "user"},
+			claims:        publisherClaims,
+			subject:       publisherID.String(),
+			expectedError: fmt.Errorf("%s: %w", descriptionFailedToResolveOwnership, errResolverTest),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.Pattern = test.pattern
+
+			for wildcard, pathValue := range test.pathValues {
+				r.SetPathValue(wildcard, pathValue)
+			}
+
+			claims := test.claims
+			claims.Subject = test.subject
+
+			err := s.authorize(r, test.requiredRoles, claims)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
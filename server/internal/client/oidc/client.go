@@ -0,0 +1,128 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+
+	"github.com/goofr-group/gaming-store/server/internal/config"
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+const (
+	redirectPath = "/signin/oidc/"
+
+	descriptionFailedDiscoverProvider = "oidc: failed to discover provider"
+	descriptionFailedExchangeCode     = "oidc: failed to exchange authorization code"
+	descriptionFailedVerifyIDToken    = "oidc: failed to verify id token"
+)
+
+// errIDTokenMissing is returned when the token response of a provider does not include an id token.
+var errIDTokenMissing = errors.New("oidc: id token missing from token response")
+
+// errNonceInvalid is returned when the nonce of an id token does not match the one of the authorization request.
+var errNonceInvalid = errors.New("oidc: invalid id token nonce")
+
+// provider defines an external OpenID Connect provider structure.
+type provider struct {
+	oauth2Config oauth2.Config
+	verifier     *oidc.IDTokenVerifier
+}
+
+// client defines the OpenID Connect relying party client structure.
+type client struct {
+	providers map[string]provider
+}
+
+// idTokenClaims defines the id token claims used to create and link accounts.
+type idTokenClaims struct {
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+	Name          string `json:"name"`
+}
+
+// New returns a new OpenID Connect client for the configured providers, whose endpoints are discovered from their
+// issuer URL. Providers redirect back to the web application, which completes the sign in through the API.
+func New(ctx context.Context, config config.OIDC, webURL string) (*client, error) {
+	providers := make(map[string]provider, len(config.Providers))
+
+	for _, providerConfig := range config.Providers {
+		oidcProvider, err := oidc.NewProvider(ctx, providerConfig.IssuerURL)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %w", descriptionFailedDiscoverProvider, providerConfig.Name, err)
+		}
+
+		providers[providerConfig.Name] = provider{
+			oauth2Config: oauth2.Config{
+				ClientID:     providerConfig.ClientID,
+				ClientSecret: providerConfig.ClientSecret,
+				Endpoint:     oidcProvider.Endpoint(),
+				RedirectURL:  webURL + redirectPath + providerConfig.Name,
+				Scopes:       append([]string{oidc.ScopeOpenID}, providerConfig.Scopes...),
+			},
+			verifier: oidcProvider.Verifier(&oidc.Config{ClientID: providerConfig.ClientID}),
+		}
+	}
+
+	return &client{
+		providers: providers,
+	}, nil
+}
+
+// AuthCodeURL returns the URL of the specified provider where the subject authenticates. The state identifies the
+// authorization when the provider redirects back, the nonce is bound to the id token, and the code verifier is the
+// PKCE secret of the authorization code.
+func (c *client) AuthCodeURL(providerName, state, nonce, codeVerifier string) (string, error) {
+	provider, ok := c.providers[providerName]
+	if !ok {
+		return "", domain.ErrOIDCProviderNotFound
+	}
+
+	return provider.oauth2Config.AuthCodeURL(state, oidc.Nonce(nonce), oauth2.S256ChallengeOption(codeVerifier)), nil
+}
+
+// Exchange exchanges the authorization code for an id token of the specified provider and returns the verified
+// identity of the subject.
+func (c *client) Exchange(ctx context.Context, providerName, code, nonce, codeVerifier string) (domain.OIDCIdentity, error) {
+	provider, ok := c.providers[providerName]
+	if !ok {
+		return domain.OIDCIdentity{}, domain.ErrOIDCProviderNotFound
+	}
+
+	token, err := provider.oauth2Config.Exchange(ctx, code, oauth2.VerifierOption(codeVerifier))
+	if err != nil {
+		return domain.OIDCIdentity{}, fmt.Errorf("%s: %w", descriptionFailedExchangeCode, err)
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		return domain.OIDCIdentity{}, errIDTokenMissing
+	}
+
+	idToken, err := provider.verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return domain.OIDCIdentity{}, fmt.Errorf("%s: %w", descriptionFailedVerifyIDToken, err)
+	}
+
+	if idToken.Nonce != nonce {
+		return domain.OIDCIdentity{}, errNonceInvalid
+	}
+
+	var claims idTokenClaims
+
+	err = idToken.Claims(&claims)
+	if err != nil {
+		return domain.OIDCIdentity{}, fmt.Errorf("%s: %w", descriptionFailedVerifyIDToken, err)
+	}
+
+	return domain.OIDCIdentity{
+		Provider:      providerName,
+		Subject:       idToken.Subject,
+		Email:         domain.Email(claims.Email),
+		EmailVerified: claims.EmailVerified,
+		Name:          claims.Name,
+	}, nil
+}
@@ -0,0 +1,234 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goofr-group/gaming-store/server/internal/config"
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+const (
+	testClientID     = "client id"
+	testClientSecret = "client secret"
+	testCode         = "code"
+	testCodeVerifier = "code verifier"
+	testWebURL       = "http://localhost:5173"
+)
+
+// mockIssuer defines a local OpenID Connect issuer structure, which issues an id token with the configured nonce for
+// the test authorization code.
+type mockIssuer struct {
+	server *httptest.Server
+	signer jose.Signer
+	key    *rsa.PrivateKey
+	nonce  string
+}
+
+// newMockIssuer returns a new local OpenID Connect issuer serving the discovery, key set and token endpoints.
+func newMockIssuer(t *testing.T) *mockIssuer {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: key}, (&jose.SignerOptions{}).WithHeader("kid", "key"))
+	require.NoError(t, err)
+
+	issuer := &mockIssuer{
+		signer: signer,
+		key:    key,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", issuer.discovery)
+	mux.HandleFunc("GET /keys", issuer.keys)
+	mux.HandleFunc("POST /token", issuer.token)
+
+	issuer.server = httptest.NewServer(mux)
+	t.Cleanup(issuer.server.Close)
+
+	return issuer
+}
+
+// discovery writes the provider metadata of the issuer.
+func (i *mockIssuer) discovery(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, map[string]any{
+		"issuer":                                i.server.URL,
+		"authorization_endpoint":                i.server.URL + "/authorize",
+		"token_endpoint":                        i.server.URL + "/token",
+		"jwks_uri":                              i.server.URL + "/keys",
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+	})
+}
+
+// keys writes the public key set of the issuer.
+func (i *mockIssuer) keys(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, jose.JSONWebKeySet{
+		Keys: []jose.JSONWebKey{
+			{Key: &i.key.PublicKey, KeyID: "key", Algorithm: string(jose.RS256), Use: "sig"},
+		},
+	})
+}
+
+// token exchanges the test authorization code for a signed id token.
+func (i *mockIssuer) token(w http.ResponseWriter, r *http.Request) {
+	err := r.ParseForm()
+	if err != nil || r.PostForm.Get("code") != testCode || r.PostForm.Get("code_verifier") != testCodeVerifier {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJSON(w, map[string]string{"error": "invalid_grant"})
+
+		return
+	}
+
+	claims, _ := json.Marshal(map[string]any{
+		"iss":            i.server.URL,
+		"sub":            "subject",
+		"aud":            testClientID,
+		"exp":            time.Now().Add(time.Minute).Unix(),
+		"iat":            time.Now().Unix(),
+		"nonce":          i.nonce,
+		"email":          "user@email.com",
+		"email_verified": true,
+		"name":           "user name",
+	})
+
+	signature, err := i.signer.Sign(claims)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	idToken, _ := signature.CompactSerialize()
+
+	writeJSON(w, map[string]any{
+		"access_token": "access token",
+		"token_type":   "Bearer",
+		"expires_in":   60,
+		"id_token":     idToken,
+	})
+}
+
+// writeJSON writes the value encoded as JSON to the response.
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func newTestClient(t *testing.T, issuer *mockIssuer) *client {
+	t.Helper()
+
+	c, err := New(context.Background(), config.OIDC{
+		Providers: []config.OIDCProvider{
+			{
+				Name:         "provider",
+				IssuerURL:    issuer.server.URL,
+				ClientID:     testClientID,
+				ClientSecret: testClientSecret,
+				Scopes:       []string{"email", "profile"},
+			},
+		},
+	}, testWebURL)
+	require.NoError(t, err)
+
+	return c
+}
+
+func TestClient_AuthCodeURL(t *testing.T) {
+	t.Run("should successfully return authorization url", func(t *testing.T) {
+		issuer := newMockIssuer(t)
+		c := newTestClient(t, issuer)
+
+		authorizationURL, err := c.AuthCodeURL("provider", "state", "nonce", testCodeVerifier)
+		require.NoError(t, err)
+
+		parsedURL, err := url.Parse(authorizationURL)
+		require.NoError(t, err)
+
+		query := parsedURL.Query()
+		assert.Equal(t, issuer.server.URL+"/authorize", parsedURL.Scheme+"://"+parsedURL.Host+parsedURL.Path)
+		assert.Equal(t, testClientID, query.Get("client_id"))
+		assert.Equal(t, testWebURL+"/signin/oidc/provider", query.Get("redirect_uri"))
+		assert.Equal(t, "openid email profile", query.Get("scope"))
+		assert.Equal(t, "state", query.Get("state"))
+		assert.Equal(t, "nonce", query.Get("nonce"))
+		assert.Equal(t, "S256", query.Get("code_challenge_method"))
+		assert.NotEmpty(t, query.Get("code_challenge"))
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the provider is not found", func(t *testing.T) {
+			issuer := newMockIssuer(t)
+			c := newTestClient(t, issuer)
+
+			_, err := c.AuthCodeURL("unknown", "state", "nonce", testCodeVerifier)
+			require.ErrorIs(t, err, domain.ErrOIDCProviderNotFound)
+		})
+	})
+}
+
+func TestClient_Exchange(t *testing.T) {
+	t.Run("should successfully exchange authorization code", func(t *testing.T) {
+		ctx := context.Background()
+
+		issuer := newMockIssuer(t)
+		issuer.nonce = "nonce"
+		c := newTestClient(t, issuer)
+
+		expectedIdentity := domain.OIDCIdentity{
+			Provider:      "provider",
+			Subject:       "subject",
+			Email:         "user@email.com",
+			EmailVerified: true,
+			Name:          "user name",
+		}
+
+		identity, err := c.Exchange(ctx, "provider", testCode, "nonce", testCodeVerifier)
+		require.NoError(t, err)
+		assert.Equal(t, expectedIdentity, identity)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the nonce does not match", func(t *testing.T) {
+			ctx := context.Background()
+
+			issuer := newMockIssuer(t)
+			issuer.nonce = "other nonce"
+			c := newTestClient(t, issuer)
+
+			_, err := c.Exchange(ctx, "provider", testCode, "nonce", testCodeVerifier)
+			require.ErrorIs(t, err, errNonceInvalid)
+		})
+
+		t.Run("when the code verifier is invalid", func(t *testing.T) {
+			ctx := context.Background()
+
+			issuer := newMockIssuer(t)
+			c := newTestClient(t, issuer)
+
+			_, err := c.Exchange(ctx, "provider", testCode, "nonce", "other code verifier")
+			require.Error(t, err)
+		})
+
+		t.Run("when the provider is not found", func(t *testing.T) {
+			ctx := context.Background()
+
+			issuer := newMockIssuer(t)
+			c := newTestClient(t, issuer)
+
+			_, err := c.Exchange(ctx, "unknown", testCode, "nonce", testCodeVerifier)
+			require.ErrorIs(t, err, domain.ErrOIDCProviderNotFound)
+		})
+	})
+}
@@ -2,16 +2,21 @@ package config
 
 // Service defines the service configuration structure.
 type Service struct {
-	ServerHTTP   ServerHTTP   `yaml:"serverHTTP"`
-	Database     Database     `yaml:"database"`
-	CloudStorage CloudStorage `yaml:"cloudStorage"`
-	SMTP         SMTP         `yaml:"smtp"`
+	ServerHTTP        ServerHTTP        `yaml:"serverHTTP"`
+	Database          Database          `yaml:"database"`
+	CloudStorage      CloudStorage      `yaml:"cloudStorage"`
+	BreachedPasswords BreachedPasswords `yaml:"breachedPasswords"`
+	SMTP              SMTP              `yaml:"smtp"`
+	Web               Web               `yaml:"web"`
+	OIDC              OIDC              `yaml:"oidc"`
+	Jobs              Jobs              `yaml:"jobs"`
 }
 
 // ServerHTTP defines the http server configuration structure.
 type ServerHTTP struct {
-	Address      string `yaml:"address"`
-	WriteTimeout string `yaml:"writeTimeout"`
+	Address        string `yaml:"address"`
+	WriteTimeout   string `yaml:"writeTimeout"`
+	TrustedProxies int    `yaml:"trustedProxies"` // Number of reverse proxies that append the client address to the X-Forwarded-For header.
 }
 
 // DatabaseMigrations defines the database migrations configuration structure.
@@ -30,6 +35,13 @@ type Database struct {
 type CloudStorage struct {
 	Enabled          bool   `yaml:"enabled"`
 	BucketMultimedia string `yaml:"bucketMultimedia"`
+	BucketDocuments  string `yaml:"bucketDocuments"` // Private bucket of the publisher documents, never publicly readable.
+}
+
+// BreachedPasswords defines the breached passwords configuration structure.
+type BreachedPasswords struct {
+	Enabled   bool   `yaml:"enabled"`
+	Directory string `yaml:"directory"` // Prefix partitioned SHA-1 range files, named after the first 5 hexadecimal characters.
 }
 
 // SMTP defines the SMTP configuration structure.
@@ -41,3 +53,30 @@ type SMTP struct {
 	Password string `yaml:"password"`
 	From     string `yaml:"from"`
 }
+
+// Web defines the web application configuration structure.
+type Web struct {
+	URL string `yaml:"url"` // Base URL of the web application, used to build the links sent by email.
+}
+
+// OIDC defines the OpenID Connect configuration structure.
+type OIDC struct {
+	Providers []OIDCProvider `yaml:"providers"`
+}
+
+// OIDCProvider defines the configuration structure of an external OpenID Connect provider.
+type OIDCProvider struct {
+	Name         string   `yaml:"name"`      // Identifier of the provider in the API and in the redirect URL.
+	IssuerURL    string   `yaml:"issuerURL"` // Used to discover the provider endpoints.
+	ClientID     string   `yaml:"clientID"`
+	ClientSecret string   `yaml:"clientSecret"`
+	Scopes       []string `yaml:"scopes"` // Requested in addition to the openid scope.
+}
+
+// Jobs defines the periodic jobs configuration structure.
+type Jobs struct {
+	GamesSimilarInterval     string `yaml:"gamesSimilarInterval"`
+	GamesChartsInterval      string `yaml:"gamesChartsInterval"`
+	RecommenderTrainInterval string `yaml:"recommenderTrainInterval"`
+	ScheduledJobsInterval    string `yaml:"scheduledJobsInterval"`
+}
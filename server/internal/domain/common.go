@@ -34,6 +34,7 @@ const (
 // Common errors.
 var (
 	ErrCredentialsIncorrect = errors.New("incorrect credentials") // Returned when a username or email is not found or the password is incorrect.
+	ErrPasswordCompromised  = errors.New("password compromised")  // Returned when a new password is found in a data breach.
 )
 
 // FieldValueInvalidError is returned when a field contains an invalid value.
@@ -0,0 +1,14 @@
+package domain
+
+import "errors"
+
+// Email verification errors.
+var (
+	ErrEmailVerificationTokenInvalid = errors.New("invalid email verification token") // Returned when an email verification token is expired, malformed or issued for another email.
+)
+
+// EmailVerification defines the email verification email structure.
+type EmailVerification struct {
+	Name string
+	URL  string
+}
@@ -4,6 +4,7 @@ package domain
 const (
 	FieldParamUserID      = "userId"
 	FieldParamPublisherID = "publisherId"
+	FieldParamGameID      = "gameId"
 
 	FieldKeyFile = "file"
 
@@ -25,9 +26,26 @@ const (
 	FieldLanguages            = "languages"
 	FieldRequirements         = "requirements"
 	FieldDownloadMultimediaID = "downloadMultimediaId"
+	FieldRole                 = "role"
+	FieldStatus               = "status"
+	FieldReason               = "reason"
+	FieldNotes                = "notes"
+	FieldAction               = "action"
+	FieldRunAt                = "runAt"
+	FieldReleaseDate          = "releaseDate"
+	FieldPreviewMultimediaID  = "previewMultimediaId"
+	FieldTags                 = "tags"
+	FieldMultimedia           = "multimedia"
+	FieldGames                = "games"
 
 	FieldFilterSort   = "sort"
 	FieldFilterOrder  = "order"
 	FieldFilterLimit  = "limit"
 	FieldFilterOffset = "offset"
+	FieldFilterFacets = "facets"
+	FieldFilterQuery  = "q"
+	FieldFilterType   = "type"
+	FieldFilterWindow = "window"
+	FieldFilterStatus = "status"
+	FieldFilterFormat = "format"
 )
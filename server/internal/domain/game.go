@@ -37,7 +37,6 @@ var (
 	ErrGameNotFound                   = errors.New("game not found")                     // Returned when a game is not found.
 	ErrGamePreviewMultimediaNotFound  = errors.New("game preview multimedia not found")  // Returned when a game preview multimedia is not found.
 	ErrGameDownloadMultimediaNotFound = errors.New("game download multimedia not found") // Returned when a game download multimedia is not found.
-	ErrGameNotActive                  = errors.New("game not active")                    // Returned when a game is accessed in an inactive state.
 	ErrGameNotReleased                = errors.New("game not released")                  // Returned when a game is accessed in an unreleased state.
 )
 
@@ -153,7 +152,6 @@ func (r GameRequirements) Valid() bool {
 type EditableGame struct {
 	Title                GameTitle
 	Price                GamePrice
-	IsActive             bool
 	ReleaseDate          *time.Time
 	Description          GameDescription
 	AgeRating            GameAgeRating
@@ -168,7 +166,6 @@ type EditableGame struct {
 type EditableGamePatch struct {
 	Title                *GameTitle
 	Price                *GamePrice
-	IsActive             *bool
 	ReleaseDate          *time.Time
 	Description          *GameDescription
 	AgeRating            *GameAgeRating
@@ -185,7 +182,8 @@ type Game struct {
 	Publisher          Publisher
 	Title              GameTitle
 	Price              GamePrice
-	IsActive           bool
+	Status             GameStatus
+	ReviewNotes        *GameReviewNotes
 	ReleaseDate        *time.Time
 	Description        GameDescription
 	AgeRating          GameAgeRating
@@ -208,6 +206,7 @@ const (
 	GamePaginatedSortPrice       GamePaginatedSort = "price"
 	GamePaginatedSortReleaseDate GamePaginatedSort = "releaseDate"
 	GamePaginatedSortUserCount   GamePaginatedSort = "userCount"
+	GamePaginatedSortRelevance   GamePaginatedSort = "relevance"
 )
 
 // Field returns the name of the field to sort by.
@@ -221,24 +220,94 @@ func (s GamePaginatedSort) Valid() bool {
 	case GamePaginatedSortTitle,
 		GamePaginatedSortPrice,
 		GamePaginatedSortReleaseDate,
-		GamePaginatedSortUserCount:
+		GamePaginatedSortUserCount,
+		GamePaginatedSortRelevance:
 		return true
 	default:
 		return false
 	}
 }
 
+// GameFacet defines the game facet type.
+type GameFacet string
+
+const (
+	GameFacetTags       GameFacet = "tags"
+	GameFacetPrices     GameFacet = "prices"
+	GameFacetLanguages  GameFacet = "languages"
+	GameFacetAgeRatings GameFacet = "ageRatings"
+)
+
+// Valid returns true if the game facet is valid, false otherwise.
+func (f GameFacet) Valid() bool {
+	switch f {
+	case GameFacetTags,
+		GameFacetPrices,
+		GameFacetLanguages,
+		GameFacetAgeRatings:
+		return true
+	default:
+		return false
+	}
+}
+
+// GameFacetPriceBounds defines the lower bounds of the game price facet buckets, in ascending order.
+// Each bucket includes its lower bound and excludes the lower bound of the next one. The last bucket is unbounded.
+var GameFacetPriceBounds = []GamePrice{0, 5, 10, 20, 40}
+
+// GameFacetTag defines the game tag facet structure.
+type GameFacetTag struct {
+	Tag   Tag
+	Count int
+}
+
+// GameFacetPrice defines the game price facet structure.
+type GameFacetPrice struct {
+	Min   GamePrice
+	Max   *GamePrice // Exclusive, nil if the bucket is unbounded.
+	Count int
+}
+
+// GameFacetLanguage defines the game language facet structure.
+type GameFacetLanguage struct {
+	Language Language
+	Count    int
+}
+
+// GameFacetAgeRating defines the game age rating facet structure.
+type GameFacetAgeRating struct {
+	AgeRating GameAgeRating
+	Count     int
+}
+
+// GamesFacets defines the games facets structure.
+// Only the requested facets are set.
+type GamesFacets struct {
+	Tags       []GameFacetTag
+	Prices     []GameFacetPrice
+	Languages  []GameFacetLanguage
+	AgeRatings []GameFacetAgeRating
+}
+
+// GamesPaginatedResponse defines the games paginated response structure.
+type GamesPaginatedResponse struct {
+	PaginatedResponse[Game]
+	Facets *GamesFacets
+}
+
 // GamesPaginatedFilter defines the games filter structure.
+// The title is matched against the title, tags, features and description of the games using full-text search.
 type GamesPaginatedFilter struct {
 	PaginatedRequest[GamePaginatedSort]
 	PublisherID       *uuid.UUID
 	Title             *GameTitle
 	PriceUnder        *GamePrice
 	PriceAbove        *GamePrice
-	IsActive          *bool
+	Status            *GameStatus
 	ReleaseDateBefore *time.Time
 	ReleaseDateAfter  *time.Time
 	TagIDs            *[]uuid.UUID
+	Facets            []GameFacet // Facets to count under the filter, ignoring the pagination.
 }
 
 // GamesRecommendedPaginatedFilter defines the recommended games filter structure.
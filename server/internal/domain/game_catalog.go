@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+)
+
+// Field constraints.
+const (
+	gameCatalogMinEntries = 1
+	gameCatalogMaxEntries = 500
+
+	gameCatalogEntryMultimediaMaxLength = gameMultimediaPositionMaxValue - gameMultimediaPositionMinValue + 1
+)
+
+// GameCatalogFormat defines the format of an imported or exported game catalog.
+type GameCatalogFormat string
+
+const (
+	GameCatalogFormatJSON GameCatalogFormat = "json"
+	GameCatalogFormatCSV  GameCatalogFormat = "csv"
+)
+
+// Valid returns true if the game catalog format is known, false otherwise.
+func (f GameCatalogFormat) Valid() bool {
+	switch f {
+	case GameCatalogFormatJSON,
+		GameCatalogFormatCSV:
+		return true
+	default:
+		return false
+	}
+}
+
+// GameCatalog defines the game catalog type, with one entry for each game.
+type GameCatalog []GameCatalogEntry
+
+// Valid returns true if the amount of entries of the game catalog is valid, false otherwise.
+func (c GameCatalog) Valid() bool {
+	return len(c) >= gameCatalogMinEntries && len(c) <= gameCatalogMaxEntries
+}
+
+// GameCatalogEntry defines a game of a catalog, with its tags and multimedia.
+type GameCatalogEntry struct {
+	EditableGame
+	TagNames      []Name      // Names of existing tags.
+	MultimediaIDs []uuid.UUID // Identifiers of existing multimedia, ordered by position.
+}
+
+// NewGameCatalogEntry returns the game catalog entry of the specified game.
+func NewGameCatalogEntry(game Game) GameCatalogEntry {
+	var downloadMultimediaID *uuid.UUID
+	if game.DownloadMultimedia != nil {
+		downloadMultimediaID = &game.DownloadMultimedia.ID
+	}
+
+	tagNames := make([]Name, len(game.Tags))
+	for i, tag := range game.Tags {
+		tagNames[i] = tag.Name
+	}
+
+	multimediaIDs := make([]uuid.UUID, len(game.Multimedia))
+	for i, multimedia := range game.Multimedia {
+		multimediaIDs[i] = multimedia.ID
+	}
+
+	return GameCatalogEntry{
+		EditableGame: EditableGame{
+			Title:                game.Title,
+			Price:                game.Price,
+			ReleaseDate:          game.ReleaseDate,
+			Description:          game.Description,
+			AgeRating:            game.AgeRating,
+			Features:             game.Features,
+			Languages:            game.Languages,
+			Requirements:         game.Requirements,
+			PreviewMultimediaID:  game.PreviewMultimedia.ID,
+			DownloadMultimediaID: downloadMultimediaID,
+		},
+		TagNames:      tagNames,
+		MultimediaIDs: multimediaIDs,
+	}
+}
+
+// ValidMultimediaIDs returns true if the multimedia of the game catalog entry fit in the game multimedia positions
+// without duplicates, false otherwise.
+func (e GameCatalogEntry) ValidMultimediaIDs() bool {
+	if len(e.MultimediaIDs) > gameCatalogEntryMultimediaMaxLength {
+		return false
+	}
+
+	seen := make(map[uuid.UUID]struct{}, len(e.MultimediaIDs))
+	for _, id := range e.MultimediaIDs {
+		if _, ok := seen[id]; ok {
+			return false
+		}
+
+		seen[id] = struct{}{}
+	}
+
+	return true
+}
+
+// GameCatalogEntryError defines an invalid field of a game catalog entry.
+type GameCatalogEntryError struct {
+	Row int // Position of the entry in the catalog, starting at 1.
+	FieldValueInvalidError
+}
+
+// GameCatalogImportReport defines the result of a game catalog import.
+// The games are only created when the catalog has no invalid entries and the import is not a dry run.
+type GameCatalogImportReport struct {
+	DryRun bool
+	Errors []GameCatalogEntryError
+	Games  []Game
+}
@@ -0,0 +1,75 @@
+package domain
+
+import "time"
+
+// Game chart parameters.
+// The events of each game are weighted with an exponential time decay, so that an event loses half of its weight
+// after GameChartHalfLifeRatio of the window.
+const (
+	GameChartHalfLifeRatio = 0.25
+	GameChartMaxPerChart   = 100                 // Maximum amount of games kept for each chart.
+	GameChartNewPeriod     = 90 * 24 * time.Hour // Period after the release date in which a game is considered new.
+)
+
+// GameChartType defines the game chart type.
+type GameChartType string
+
+const (
+	GameChartTypeTopSellers     GameChartType = "topSellers"     // Most purchased games in the window.
+	GameChartTypeTrending       GameChartType = "trending"       // Games whose library additions grew the most in the window.
+	GameChartTypeNewAndTrending GameChartType = "newAndTrending" // Trending games released recently.
+)
+
+// Valid returns true if the game chart type is valid, false otherwise.
+func (t GameChartType) Valid() bool {
+	switch t {
+	case GameChartTypeTopSellers,
+		GameChartTypeTrending,
+		GameChartTypeNewAndTrending:
+		return true
+	default:
+		return false
+	}
+}
+
+// GameChartWindow defines the game chart window type.
+type GameChartWindow string
+
+const (
+	GameChartWindow1d  GameChartWindow = "1d"
+	GameChartWindow7d  GameChartWindow = "7d"
+	GameChartWindow30d GameChartWindow = "30d"
+)
+
+// GameChartWindows defines all the game chart windows.
+var GameChartWindows = []GameChartWindow{
+	GameChartWindow1d,
+	GameChartWindow7d,
+	GameChartWindow30d,
+}
+
+// Valid returns true if the game chart window is valid, false otherwise.
+func (w GameChartWindow) Valid() bool {
+	return w.Duration() != 0
+}
+
+// Duration returns the duration of the game chart window, or zero if it is invalid.
+func (w GameChartWindow) Duration() time.Duration {
+	switch w {
+	case GameChartWindow1d:
+		return 24 * time.Hour
+	case GameChartWindow7d:
+		return 7 * 24 * time.Hour
+	case GameChartWindow30d:
+		return 30 * 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+// GamesChartPaginatedFilter defines the games chart filter structure.
+type GamesChartPaginatedFilter struct {
+	PaginatedRequestBase
+	Type   GameChartType
+	Window GameChartWindow
+}
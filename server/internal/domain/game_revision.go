@@ -0,0 +1,203 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Game revision errors.
+var (
+	ErrGameRevisionNotFound = errors.New("game revision not found") // Returned when a game revision is not found.
+)
+
+// GameRevisionAuthorRole defines the role of the subject that authored a game revision.
+type GameRevisionAuthorRole string
+
+const (
+	GameRevisionAuthorRolePublisher GameRevisionAuthorRole = "publisher" // The publisher account itself.
+	GameRevisionAuthorRoleUser      GameRevisionAuthorRole = "user"      // A user that is a member of the publisher.
+)
+
+// GameRevisionAuthor defines the author of a game revision.
+type GameRevisionAuthor struct {
+	ID   uuid.UUID
+	Role GameRevisionAuthorRole
+}
+
+// GameRevisionField defines the name of a store page field tracked by the game revisions.
+type GameRevisionField string
+
+const (
+	GameRevisionFieldTitle                GameRevisionField = "title"
+	GameRevisionFieldPrice                GameRevisionField = "price"
+	GameRevisionFieldReleaseDate          GameRevisionField = "releaseDate"
+	GameRevisionFieldDescription          GameRevisionField = "description"
+	GameRevisionFieldAgeRating            GameRevisionField = "ageRating"
+	GameRevisionFieldFeatures             GameRevisionField = "features"
+	GameRevisionFieldLanguages            GameRevisionField = "languages"
+	GameRevisionFieldRequirements         GameRevisionField = "requirements"
+	GameRevisionFieldPreviewMultimediaID  GameRevisionField = "previewMultimediaId"
+	GameRevisionFieldDownloadMultimediaID GameRevisionField = "downloadMultimediaId"
+	GameRevisionFieldMultimedia           GameRevisionField = "multimedia"
+)
+
+// GameRevisionMultimedia defines the multimedia of the store page in a game revision.
+type GameRevisionMultimedia struct {
+	MultimediaID uuid.UUID              `json:"multimediaId"`
+	Position     GameMultimediaPosition `json:"position"`
+}
+
+// GameRevisionSnapshot defines the store page of a game in a game revision.
+// It is stored as JSON, so its fields must remain backwards compatible.
+type GameRevisionSnapshot struct {
+	Title                GameTitle                `json:"title"`
+	Price                GamePrice                `json:"price"`
+	ReleaseDate          *time.Time               `json:"releaseDate"`
+	Description          GameDescription          `json:"description"`
+	AgeRating            GameAgeRating            `json:"ageRating"`
+	Features             GameFeatures             `json:"features"`
+	Languages            GameLanguages            `json:"languages"`
+	Requirements         GameRequirements         `json:"requirements"`
+	PreviewMultimediaID  uuid.UUID                `json:"previewMultimediaId"`
+	DownloadMultimediaID *uuid.UUID               `json:"downloadMultimediaId"`
+	Multimedia           []GameRevisionMultimedia `json:"multimedia"` // Ordered by position.
+}
+
+// NewGameRevisionSnapshot returns the snapshot of the store page of the specified game and its multimedia positions.
+func NewGameRevisionSnapshot(game Game, multimedia []GameRevisionMultimedia) GameRevisionSnapshot {
+	var downloadMultimediaID *uuid.UUID
+	if game.DownloadMultimedia != nil {
+		downloadMultimediaID = &game.DownloadMultimedia.ID
+	}
+
+	return GameRevisionSnapshot{
+		Title:                game.Title,
+		Price:                game.Price,
+		ReleaseDate:          game.ReleaseDate,
+		Description:          game.Description,
+		AgeRating:            game.AgeRating,
+		Features:             game.Features,
+		Languages:            game.Languages,
+		Requirements:         game.Requirements,
+		PreviewMultimediaID:  game.PreviewMultimedia.ID,
+		DownloadMultimediaID: downloadMultimediaID,
+		Multimedia:           multimedia,
+	}
+}
+
+// EditableGamePatch returns the game patch that restores the snapshot.
+// A release date or download multimedia missing from the snapshot is kept as is, since a patch can not unset them.
+func (s GameRevisionSnapshot) EditableGamePatch() EditableGamePatch {
+	return EditableGamePatch{
+		Title:                &s.Title,
+		Price:                &s.Price,
+		ReleaseDate:          s.ReleaseDate,
+		Description:          &s.Description,
+		AgeRating:            &s.AgeRating,
+		Features:             &s.Features,
+		Languages:            &s.Languages,
+		Requirements:         &s.Requirements,
+		PreviewMultimediaID:  &s.PreviewMultimediaID,
+		DownloadMultimediaID: s.DownloadMultimediaID,
+	}
+}
+
+// Diff returns the fields that differ between the snapshot and the specified one.
+func (s GameRevisionSnapshot) Diff(snapshot GameRevisionSnapshot) []GameRevisionField {
+	var fields []GameRevisionField
+
+	if s.Title != snapshot.Title {
+		fields = append(fields, GameRevisionFieldTitle)
+	}
+
+	if s.Price != snapshot.Price {
+		fields = append(fields, GameRevisionFieldPrice)
+	}
+
+	if !equalTimePointers(s.ReleaseDate, snapshot.ReleaseDate) {
+		fields = append(fields, GameRevisionFieldReleaseDate)
+	}
+
+	if s.Description != snapshot.Description {
+		fields = append(fields, GameRevisionFieldDescription)
+	}
+
+	if s.AgeRating != snapshot.AgeRating {
+		fields = append(fields, GameRevisionFieldAgeRating)
+	}
+
+	if s.Features != snapshot.Features {
+		fields = append(fields, GameRevisionFieldFeatures)
+	}
+
+	if !slices.Equal(s.Languages.String(), snapshot.Languages.String()) {
+		fields = append(fields, GameRevisionFieldLanguages)
+	}
+
+	if s.Requirements != snapshot.Requirements {
+		fields = append(fields, GameRevisionFieldRequirements)
+	}
+
+	if s.PreviewMultimediaID != snapshot.PreviewMultimediaID {
+		fields = append(fields, GameRevisionFieldPreviewMultimediaID)
+	}
+
+	if !equalPointers(s.DownloadMultimediaID, snapshot.DownloadMultimediaID) {
+		fields = append(fields, GameRevisionFieldDownloadMultimediaID)
+	}
+
+	if !slices.Equal(s.Multimedia, snapshot.Multimedia) {
+		fields = append(fields, GameRevisionFieldMultimedia)
+	}
+
+	return fields
+}
+
+// EditableGameRevision defines the editable game revision structure.
+type EditableGameRevision struct {
+	Number        int
+	Author        GameRevisionAuthor
+	ChangedFields []GameRevisionField // Fields changed since the previous revision.
+	Snapshot      GameRevisionSnapshot
+}
+
+// GameRevision defines the game revision structure.
+type GameRevision struct {
+	ID     uuid.UUID
+	GameID uuid.UUID
+	EditableGameRevision
+	CreatedAt time.Time
+}
+
+// GameRevisionDiff defines the field-level difference between two game revisions.
+type GameRevisionDiff struct {
+	From   GameRevision
+	To     GameRevision
+	Fields []GameRevisionField // Fields that differ between the revisions.
+}
+
+// GameRevisionsPaginatedFilter defines the game revisions filter structure.
+type GameRevisionsPaginatedFilter struct {
+	PaginatedRequestBase
+}
+
+// equalPointers returns true if both pointers are nil, or point to equal values, false otherwise.
+func equalPointers[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+// equalTimePointers returns true if both pointers are nil, or point to the same instant, false otherwise.
+func equalTimePointers(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Equal(*b)
+}
@@ -0,0 +1,11 @@
+package domain
+
+import "github.com/google/uuid"
+
+// GameSales defines the sales of a game of a publisher.
+type GameSales struct {
+	GameID  uuid.UUID
+	Title   GameTitle
+	Units   int       // Copies sold.
+	Revenue GamePrice // Sum of the prices, before taxes.
+}
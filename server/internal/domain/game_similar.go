@@ -0,0 +1,18 @@
+package domain
+
+import "github.com/google/uuid"
+
+// Game similarity parameters.
+// The score of a similar game is the weighted sum of the Jaccard similarity of the tags of both games and the cosine
+// similarity of their owners.
+const (
+	GameSimilarTagsWeight   = 0.5
+	GameSimilarOwnersWeight = 0.5
+	GameSimilarMaxPerGame   = 50 // Maximum amount of similar games kept for each game.
+)
+
+// GamesSimilarPaginatedFilter defines the similar games filter structure.
+type GamesSimilarPaginatedFilter struct {
+	PaginatedRequestBase
+	UserID *uuid.UUID // User whose library games are excluded.
+}
@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+	"unicode/utf8"
+)
+
+const (
+	gameReviewNotesMaxLength = 500
+)
+
+// Game status errors.
+var (
+	ErrGameNotPublished            = errors.New("game not published")                 // Returned when a game is accessed without being published.
+	ErrGameStatusTransitionInvalid = errors.New("game status transition not allowed") // Returned when a game can not move from its status to the requested one.
+	ErrGameHasOwners               = errors.New("game has owners")                    // Returned when a game owned by users is deleted instead of delisted.
+	ErrGameNotEditable             = errors.New("game not editable")                  // Returned when the store page of a game is edited outside of draft or delisted.
+)
+
+// GameStatus defines the status of a game in its lifecycle.
+type GameStatus string
+
+const (
+	GameStatusDraft     GameStatus = "draft"     // Edited by the publisher, not yet submitted for review.
+	GameStatusInReview  GameStatus = "in_review" // Waits for an administrator to review it.
+	GameStatusApproved  GameStatus = "approved"  // Can be published by the publisher.
+	GameStatusPublished GameStatus = "published" // Live in the store.
+	GameStatusDelisted  GameStatus = "delisted"  // Removed from the store, but still available to its owners.
+)
+
+// gameStatusTransitions defines the statuses that a game can move to, by current status.
+var gameStatusTransitions = map[GameStatus][]GameStatus{
+	GameStatusDraft:     {GameStatusInReview},
+	GameStatusInReview:  {GameStatusApproved, GameStatusDraft},
+	GameStatusApproved:  {GameStatusPublished},
+	GameStatusPublished: {GameStatusDelisted},
+	GameStatusDelisted:  {GameStatusInReview},
+}
+
+// Valid returns true if the game status is known, false otherwise.
+func (s GameStatus) Valid() bool {
+	_, ok := gameStatusTransitions[s]
+	return ok
+}
+
+// CanTransitionTo returns true if a game in the status can move to the specified status, false otherwise.
+func (s GameStatus) CanTransitionTo(status GameStatus) bool {
+	return slices.Contains(gameStatusTransitions[s], status)
+}
+
+// Editable returns true if the store page of a game in the status can be edited, false otherwise. Only games out of the
+// store that must be submitted for review to reach it can be edited, so that every change goes through review.
+func (s GameStatus) Editable() bool {
+	return s == GameStatusDraft || s == GameStatusDelisted
+}
+
+// GameReviewNotes defines the notes left by an administrator when reviewing a game.
+type GameReviewNotes string
+
+// Valid returns true if the notes are not empty and fit the maximum length, false otherwise.
+func (n GameReviewNotes) Valid() bool {
+	length := utf8.RuneCountInString(string(n))
+	return length > 0 && length <= gameReviewNotesMaxLength
+}
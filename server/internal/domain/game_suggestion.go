@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// Field constraints.
+const (
+	gameSuggestionQueryMinLength = 1
+	gameSuggestionQueryMaxLength = 150
+
+	gameSuggestionLimitMinValue = 1
+	gameSuggestionLimitMaxValue = 20
+)
+
+// GameSuggestionType defines the game suggestion type.
+type GameSuggestionType string
+
+const (
+	GameSuggestionTypeTitle     GameSuggestionType = "title"
+	GameSuggestionTypeTag       GameSuggestionType = "tag"
+	GameSuggestionTypePublisher GameSuggestionType = "publisher"
+)
+
+// GameSuggestionQuery defines the game suggestion query type.
+type GameSuggestionQuery string
+
+// Valid returns true if the game suggestion query is valid, false otherwise.
+func (q GameSuggestionQuery) Valid() bool {
+	length := utf8.RuneCountInString(string(q))
+	return length >= gameSuggestionQueryMinLength && length <= gameSuggestionQueryMaxLength
+}
+
+// GameSuggestionLimit defines the game suggestion limit type.
+type GameSuggestionLimit int
+
+// Valid returns true if the game suggestion limit is valid, false otherwise.
+func (l GameSuggestionLimit) Valid() bool {
+	return l >= gameSuggestionLimitMinValue && l <= gameSuggestionLimitMaxValue
+}
+
+// GameSuggestion defines the game suggestion structure.
+type GameSuggestion struct {
+	Type       GameSuggestionType
+	ID         uuid.UUID // Identifier of the game, tag or publisher, depending on the type.
+	Text       string
+	Popularity int // Amount of users that own the suggested games.
+}
+
+// GameSuggestionsFilter defines the game suggestions filter structure.
+// Only the games that are active and released are considered.
+type GameSuggestionsFilter struct {
+	Query GameSuggestionQuery // Prefix to match, case insensitive.
+	Limit GameSuggestionLimit
+}
@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGameSuggestionQuery_Valid(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    GameSuggestionQuery
+		expected bool
+	}{
+		{
+			name:     "should be invalid when empty",
+			query:    "",
+			expected: false,
+		},
+		{
+			name:     "should be valid with the maximum amount of multibyte characters",
+			query:    GameSuggestionQuery(strings.Repeat("é", gameSuggestionQueryMaxLength)),
+			expected: true,
+		},
+		{
+			name:     "should be invalid above the maximum amount of characters",
+			query:    GameSuggestionQuery(strings.Repeat("é", gameSuggestionQueryMaxLength+1)),
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.query.Valid())
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/text/language"
+)
+
+// Game translation errors.
+var (
+	ErrGameTranslationNotFound = errors.New("game translation not found") // Returned when a game translation is not found.
+)
+
+// EditableGameTranslation defines the translatable fields of a game.
+type EditableGameTranslation struct {
+	Title       GameTitle
+	Description GameDescription
+	Features    GameFeatures
+}
+
+// GameTranslation defines the game translation structure.
+type GameTranslation struct {
+	GameID   uuid.UUID
+	Language Language
+	EditableGameTranslation
+	CreatedAt  time.Time
+	ModifiedAt time.Time
+}
+
+// DefaultLanguage returns the language of the untranslated fields of the game, which is the first of its languages.
+// Returns false if the game has no languages.
+func (g Game) DefaultLanguage() (Language, bool) {
+	if len(g.Languages) == 0 {
+		return Language{}, false
+	}
+
+	return g.Languages[0], true
+}
+
+// Localized returns the game with the translatable fields in the language of its translations that best matches the
+// specified preferred languages. The untranslated fields are kept if the default language of the game matches best,
+// or if no language matches.
+func (g Game) Localized(translations []GameTranslation, preferredLanguages []Language) Game {
+	defaultLanguage, ok := g.DefaultLanguage()
+	if !ok || len(translations) == 0 || len(preferredLanguages) == 0 {
+		return g
+	}
+
+	supported := make([]language.Tag, len(translations)+1)
+	supported[0] = defaultLanguage.Tag
+	for i, translation := range translations {
+		supported[i+1] = translation.Language.Tag
+	}
+
+	preferred := make([]language.Tag, len(preferredLanguages))
+	for i, preferredLanguage := range preferredLanguages {
+		preferred[i] = preferredLanguage.Tag
+	}
+
+	_, index, confidence := language.NewMatcher(supported).Match(preferred...)
+	if confidence == language.No || index == 0 {
+		return g
+	}
+
+	translation := translations[index-1]
+
+	g.Title = translation.Title
+	g.Description = translation.Description
+	g.Features = translation.Features
+
+	return g
+}
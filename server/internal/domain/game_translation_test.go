@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+func TestGame_Localized(t *testing.T) {
+	var (
+		english    = Language{language.English}
+		portuguese = Language{language.Portuguese}
+		spanish    = Language{language.Spanish}
+		japanese   = Language{language.Japanese}
+
+		game = Game{
+			Title:       "Title",
+			Description: "Description",
+			Features:    "Features",
+			Languages:   GameLanguages{english, portuguese, spanish},
+		}
+
+		portugueseTranslation = GameTranslation{
+			Language: portuguese,
+			EditableGameTranslation: EditableGameTranslation{
+				Title:       "Título",
+				Description: "Descrição",
+				Features:    "Funcionalidades",
+			},
+		}
+		spanishTranslation = GameTranslation{
+			Language: spanish,
+			EditableGameTranslation: EditableGameTranslation{
+				Title:       "Título en español",
+				Description: "Descripción",
+				Features:    "Características",
+			},
+		}
+		translations = []GameTranslation{portugueseTranslation, spanishTranslation}
+	)
+
+	// localizedGame returns the game with the translatable fields of the specified translation.
+	localizedGame := func(translation GameTranslation) Game {
+		localized := game
+		localized.Title = translation.Title
+		localized.Description = translation.Description
+		localized.Features = translation.Features
+
+		return localized
+	}
+
+	tests := []struct {
+		name               string
+		game               Game
+		translations       []GameTranslation
+		preferredLanguages []Language
+		expected           Game
+	}{
+		{
+			name:               "should return the translation of the preferred language",
+			game:               game,
+			translations:       translations,
+			preferredLanguages: []Language{portuguese},
+			expected:           localizedGame(portugueseTranslation),
+		},
+		{
+			name:               "should return the translation matching the index of its language",
+			game:               game,
+			translations:       translations,
+			preferredLanguages: []Language{spanish},
+			expected:           localizedGame(spanishTranslation),
+		},
+		{
+			name:               "should return the translation of the first preferred language that matches",
+			game:               game,
+			translations:       translations,
+			preferredLanguages: []Language{japanese, spanish, portuguese},
+			expected:           localizedGame(spanishTranslation),
+		},
+		{
+			name:               "should return the base translation of a regional preferred language",
+			game:               game,
+			translations:       translations,
+			preferredLanguages: []Language{{language.BrazilianPortuguese}},
+			expected:           localizedGame(portugueseTranslation),
+		},
+		{
+			name:               "should return the untranslated fields when the default language is preferred",
+			game:               game,
+			translations:       translations,
+			preferredLanguages: []Language{english, portuguese},
+			expected:           game,
+		},
+		{
+			name:               "should return the untranslated fields when no language matches",
+			game:               game,
+			translations:       translations,
+			preferredLanguages: []Language{japanese},
+			expected:           game,
+		},
+		{
+			name:               "should return the untranslated fields without translations",
+			game:               game,
+			preferredLanguages: []Language{portuguese},
+			expected:           game,
+		},
+		{
+			name:         "should return the untranslated fields without preferred languages",
+			game:         game,
+			translations: translations,
+			expected:     game,
+		},
+		{
+			name:               "should return the untranslated fields without a default language",
+			game:               Game{Title: "Title"},
+			translations:       translations,
+			preferredLanguages: []Language{portuguese},
+			expected:           Game{Title: "Title"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.game.Localized(test.translations, test.preferredLanguages))
+		})
+	}
+}
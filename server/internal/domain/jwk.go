@@ -0,0 +1,13 @@
+package domain
+
+// JSONWebKey defines the public JSON Web Key structure of a key used to verify tokens.
+type JSONWebKey struct {
+	KeyType   string
+	KeyID     string
+	Use       string
+	Algorithm string
+	N         string // Modulus of an RSA key.
+	E         string // Exponent of an RSA key.
+	Curve     string // Curve of an OKP key.
+	X         string // Public key of an OKP key.
+}
@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OIDCAuthorizationExpirationTime defines the duration of an authorization with an external provider since it is
+// started, which includes the time to sign in at the provider.
+const OIDCAuthorizationExpirationTime = time.Minute * 10
+
+// OpenID Connect errors.
+var (
+	ErrOIDCProviderNotFound      = errors.New("oidc provider not found")      // Returned when an oidc provider is not configured.
+	ErrOIDCAuthorizationNotFound = errors.New("oidc authorization not found") // Returned when an oidc authorization is not found or was already completed.
+	ErrOIDCAuthorizationInvalid  = errors.New("invalid oidc authorization")   // Returned when an oidc authorization is expired, was started with another provider or browser, or was rejected by the provider.
+	ErrOIDCIdentityTokenInvalid  = errors.New("invalid oidc identity token")  // Returned when an oidc identity token is expired or malformed.
+	ErrUserIdentityNotFound      = errors.New("user identity not found")      // Returned when an external identity is not linked to any user.
+	ErrUserIdentityAlreadyExists = errors.New("user identity already exists") // Returned when an external identity is already linked, or the user already linked an identity of the same provider.
+)
+
+// EditableOIDCAuthorization defines the oidc authorization data to be stored.
+type EditableOIDCAuthorization struct {
+	Provider     string
+	Nonce        string
+	CodeVerifier string
+	BindingHash  []byte
+	ExpiresAt    time.Time
+}
+
+// OIDCAuthorization defines a started authorization with an external provider. Its identifier is the state sent to
+// the provider.
+type OIDCAuthorization struct {
+	EditableOIDCAuthorization
+	ID        uuid.UUID
+	CreatedAt time.Time
+}
+
+// OIDCIdentity defines the identity of a subject verified by an external provider.
+type OIDCIdentity struct {
+	Provider      string
+	Subject       string
+	Email         Email
+	EmailVerified bool
+	Name          string
+}
+
+// EditableUserIdentity defines the user identity data to be stored.
+type EditableUserIdentity struct {
+	UserID   uuid.UUID
+	Provider string
+	Subject  string
+	Email    *Email
+}
+
+// UserIdentity defines an external identity linked to a user.
+type UserIdentity struct {
+	EditableUserIdentity
+	ID        uuid.UUID
+	CreatedAt time.Time
+}
+
+// OIDCAuthorizationStart defines a started authorization with an external provider. The binding must be kept by the
+// browser that started it, to complete it.
+type OIDCAuthorizationStart struct {
+	AuthorizationURL string
+	Binding          string
+}
+
+// OIDCSignIn defines the result of a sign in with an external provider. When the identity is not linked to any user,
+// only the identity and its token are set, so that an account can be created or linked.
+type OIDCSignIn struct {
+	SignIn
+	Identity      OIDCIdentity
+	IdentityToken string
+}
@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PasswordResetTokenExpirationTime defines the duration of a password reset token since it is issued.
+const PasswordResetTokenExpirationTime = time.Hour
+
+// Password reset errors.
+var (
+	ErrPasswordResetTokenNotFound = errors.New("password reset token not found") // Returned when a password reset token is not found.
+	ErrPasswordResetTokenInvalid  = errors.New("invalid password reset token")   // Returned when a password reset token is expired, already used or issued to another role.
+)
+
+// EditablePasswordResetToken defines the password reset token data to be stored.
+type EditablePasswordResetToken struct {
+	SubjectID   uuid.UUID
+	SubjectRole string
+	Hash        []byte
+	ExpiresAt   time.Time
+}
+
+// PasswordResetToken defines the password reset token structure.
+type PasswordResetToken struct {
+	EditablePasswordResetToken
+	ID        uuid.UUID
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+// PasswordReset defines the password reset email structure.
+type PasswordReset struct {
+	Name             string
+	URL              string
+	ExpiresInMinutes int
+}
@@ -0,0 +1,11 @@
+package domain
+
+// PeriodicJob defines a job that every instance runs periodically. Only one instance runs each job at a time.
+type PeriodicJob string
+
+const (
+	PeriodicJobScheduledJobs    PeriodicJob = "scheduled_jobs"    // Executes the due scheduled jobs.
+	PeriodicJobGamesSimilar     PeriodicJob = "games_similar"     // Refreshes the similar games.
+	PeriodicJobGamesCharts      PeriodicJob = "games_charts"      // Refreshes the games charts.
+	PeriodicJobRecommenderTrain PeriodicJob = "recommender_train" // Trains the recommender.
+)
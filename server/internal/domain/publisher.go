@@ -9,14 +9,18 @@ import (
 
 // Publisher errors.
 var (
-	ErrPublisherEmailAlreadyExists = errors.New("email already exists") // Returned when a publisher already exists with the same email.
-	ErrPublisherVatinAlreadyExists = errors.New("vatin already exists") // Returned when a publisher already exists with the same vatin.
-	ErrPublisherNotFound           = errors.New("publisher not found")  // Returned when a publisher is not found.
+	ErrPublisherEmailAlreadyExists   = errors.New("email already exists")             // Returned when a publisher already exists with the same email.
+	ErrPublisherVatinAlreadyExists   = errors.New("vatin already exists")             // Returned when a publisher already exists with the same vatin.
+	ErrPublisherNotFound             = errors.New("publisher not found")              // Returned when a publisher is not found.
+	ErrPublisherEmailNotVerified     = errors.New("publisher email not verified")     // Returned when a publisher has not verified the email yet.
+	ErrPublisherEmailAlreadyVerified = errors.New("publisher email already verified") // Returned when a publisher has already verified the email.
 )
 
 // SignInPublisher defines the sign-in publisher structure.
 type SignInPublisher struct {
+	ID       uuid.UUID
 	Email    Email
+	Name     Name
 	Password Password
 }
 
@@ -48,13 +52,16 @@ type EditablePublisherPatch struct {
 
 // Publisher defines the publisher structure.
 type Publisher struct {
-	ID                uuid.UUID
-	Email             Email
-	Name              Name
-	Address           Address
-	Country           Country
-	Vatin             Vatin
-	PictureMultimedia *Multimedia
-	CreatedAt         time.Time
-	ModifiedAt        time.Time
+	ID                 uuid.UUID
+	Email              Email
+	EmailVerifiedAt    *time.Time
+	Name               Name
+	Address            Address
+	Country            Country
+	Vatin              Vatin
+	VerificationStatus PublisherVerificationStatus
+	VerificationReason *PublisherVerificationReason
+	PictureMultimedia  *Multimedia
+	CreatedAt          time.Time
+	ModifiedAt         time.Time
 }
@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PublisherInvitationExpirationTime defines the duration of a publisher invitation since it is sent.
+const PublisherInvitationExpirationTime = time.Hour * 24 * 7
+
+// Publisher member errors.
+var (
+	ErrPublisherMemberNotFound      = errors.New("publisher member not found")      // Returned when a user is not a member of the publisher.
+	ErrPublisherMemberAlreadyExists = errors.New("publisher member already exists") // Returned when a user is already a member of the publisher.
+	ErrPublisherInvitationNotFound  = errors.New("publisher invitation not found")  // Returned when a publisher invitation is not found.
+	ErrPublisherInvitationInvalid   = errors.New("invalid publisher invitation")    // Returned when a publisher invitation is expired or already accepted.
+)
+
+// PublisherMemberRole defines the role of a member in a publisher organization.
+type PublisherMemberRole string
+
+const (
+	PublisherMemberRoleOwner     PublisherMemberRole = "owner"     // Manages the publisher and its members.
+	PublisherMemberRoleDeveloper PublisherMemberRole = "developer" // Edits the games and their multimedia.
+	PublisherMemberRoleFinance   PublisherMemberRole = "finance"   // Follows the sales and payouts.
+	PublisherMemberRoleViewer    PublisherMemberRole = "viewer"    // Reads the publisher data.
+)
+
+// Valid returns true if the role is one of the publisher member roles, false otherwise.
+func (r PublisherMemberRole) Valid() bool {
+	switch r {
+	case PublisherMemberRoleOwner,
+		PublisherMemberRoleDeveloper,
+		PublisherMemberRoleFinance,
+		PublisherMemberRoleViewer:
+		return true
+	default:
+		return false
+	}
+}
+
+// EditablePublisherMember defines the publisher member data to be stored.
+type EditablePublisherMember struct {
+	PublisherID uuid.UUID
+	UserID      uuid.UUID
+	Role        PublisherMemberRole
+}
+
+// PublisherMember defines the membership of a user in a publisher organization.
+type PublisherMember struct {
+	EditablePublisherMember
+	CreatedAt  time.Time
+	ModifiedAt time.Time
+}
+
+// EditablePublisherInvitation defines the publisher invitation data to be stored.
+type EditablePublisherInvitation struct {
+	PublisherID uuid.UUID
+	Email       Email
+	Role        PublisherMemberRole
+	Hash        []byte
+	ExpiresAt   time.Time
+}
+
+// PublisherInvitation defines an invitation sent by email to join a publisher organization.
+type PublisherInvitation struct {
+	EditablePublisherInvitation
+	ID         uuid.UUID
+	AcceptedAt *time.Time
+	CreatedAt  time.Time
+}
+
+// PublisherInvitationEmail defines the publisher invitation email structure.
+type PublisherInvitationEmail struct {
+	PublisherName string
+	Role          string
+	URL           string
+	ExpiresInDays int
+}
@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"errors"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	publisherVerificationReasonMaxLength = 500
+)
+
+// Publisher verification errors.
+var (
+	ErrPublisherNotVerified      = errors.New("publisher not verified")       // Returned when a publisher has not been verified by an administrator yet.
+	ErrPublisherDocumentNotFound = errors.New("publisher document not found") // Returned when a document is not found or was submitted by another publisher.
+)
+
+// PublisherVerificationStatus defines the status of the verification of a publisher by an administrator.
+type PublisherVerificationStatus string
+
+const (
+	PublisherVerificationStatusPending  PublisherVerificationStatus = "pending"  // Waits for an administrator to review its documents.
+	PublisherVerificationStatusVerified PublisherVerificationStatus = "verified" // Can sell games.
+	PublisherVerificationStatusRejected PublisherVerificationStatus = "rejected" // Must submit new documents to be reviewed again.
+)
+
+// ValidReview returns true if the status is one that an administrator can set when reviewing a publisher, false
+// otherwise.
+func (s PublisherVerificationStatus) ValidReview() bool {
+	return s == PublisherVerificationStatusVerified || s == PublisherVerificationStatusRejected
+}
+
+// PublisherVerificationReason defines the reason of the rejection of a publisher.
+type PublisherVerificationReason string
+
+// Valid returns true if the reason is not empty and fits the maximum length, false otherwise.
+func (r PublisherVerificationReason) Valid() bool {
+	length := utf8.RuneCountInString(string(r))
+	return length > 0 && length <= publisherVerificationReasonMaxLength
+}
+
+// EditablePublisherDocument defines the publisher document data to be stored.
+type EditablePublisherDocument struct {
+	ObjectName string // Name of the object in the private documents bucket.
+	MediaType  string
+}
+
+// PublisherDocument defines a business document submitted by a publisher for its verification.
+type PublisherDocument struct {
+	EditablePublisherDocument
+	ID          uuid.UUID
+	PublisherID uuid.UUID
+	CreatedAt   time.Time
+}
+
+// PublisherVerificationEmail defines the structure of the email that notifies a publisher of its review.
+type PublisherVerificationEmail struct {
+	PublisherName string
+	Verified      bool
+	Reason        string
+}
@@ -0,0 +1,22 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RecommenderInteraction defines the interaction of a user with a game, used to train the recommender.
+type RecommenderInteraction struct {
+	UserID    uuid.UUID
+	GameID    uuid.UUID
+	Purchased bool // True if the game was purchased, false if it was only added to the library.
+	CreatedAt time.Time
+}
+
+// RecommenderItem defines the similarity between two games learned by the recommender.
+type RecommenderItem struct {
+	GameID        uuid.UUID
+	SimilarGameID uuid.UUID
+	Score         float64
+}
@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RefreshTokenExpirationTime defines the duration of a refresh token since it is issued.
+const RefreshTokenExpirationTime = 30 * 24 * time.Hour
+
+// Refresh token errors.
+var (
+	ErrRefreshTokenNotFound = errors.New("refresh token not found")    // Returned when a refresh token is not found.
+	ErrRefreshTokenInvalid  = errors.New("invalid refresh token")      // Returned when a refresh token is expired, revoked or issued to another role.
+	ErrRefreshTokenReused   = errors.New("refresh token already used") // Returned when a rotated refresh token is used again, which revokes its session.
+)
+
+// Tokens defines the tokens issued to a subject on sign-in.
+type Tokens struct {
+	AccessToken  string // Short-lived JSON Web Token.
+	RefreshToken string // Opaque token to obtain new tokens.
+}
+
+// EditableRefreshToken defines the refresh token data to be stored.
+type EditableRefreshToken struct {
+	SessionID uuid.UUID // Session shared by all the tokens rotated from the same sign-in.
+	Hash      []byte
+	ExpiresAt time.Time
+}
+
+// RefreshToken defines the refresh token structure.
+type RefreshToken struct {
+	EditableRefreshToken
+	ID        uuid.UUID
+	RotatedAt *time.Time // Time at which the token was exchanged for new tokens.
+	CreatedAt time.Time
+}
@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"errors"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	scheduledJobErrorMaxLength = 500
+)
+
+// Scheduled job errors.
+var (
+	ErrScheduledJobNotFound = errors.New("scheduled job not found")           // Returned when a pending scheduled job is not found.
+	ErrScheduledJobFailed   = errors.New("scheduled job failed unexpectedly") // Stored as the reason of a scheduled job that failed with an unexpected error.
+)
+
+// ScheduledJobAction defines the store action executed by a scheduled job.
+type ScheduledJobAction string
+
+const (
+	ScheduledJobActionPublishGame ScheduledJobAction = "publish_game" // Publishes an approved game.
+	ScheduledJobActionDelistGame  ScheduledJobAction = "delist_game"  // Delists a published game.
+)
+
+// Valid returns true if the scheduled job action is known, false otherwise.
+func (a ScheduledJobAction) Valid() bool {
+	switch a {
+	case ScheduledJobActionPublishGame,
+		ScheduledJobActionDelistGame:
+		return true
+	default:
+		return false
+	}
+}
+
+// ScheduledJobStatus defines the status of a scheduled job.
+type ScheduledJobStatus string
+
+const (
+	ScheduledJobStatusPending   ScheduledJobStatus = "pending"   // Waits for its run time.
+	ScheduledJobStatusSucceeded ScheduledJobStatus = "succeeded" // Executed its action.
+	ScheduledJobStatusFailed    ScheduledJobStatus = "failed"    // Could not execute its action, and is not retried.
+	ScheduledJobStatusCancelled ScheduledJobStatus = "cancelled" // Cancelled before its run time.
+)
+
+// ScheduledJobError defines the reason of the failure of a scheduled job.
+type ScheduledJobError string
+
+// NewScheduledJobError returns the reason of the failure of a scheduled job based on the specified error, truncated
+// to the maximum length.
+func NewScheduledJobError(err error) ScheduledJobError {
+	message := err.Error()
+
+	if utf8.RuneCountInString(message) > scheduledJobErrorMaxLength {
+		message = string([]rune(message)[:scheduledJobErrorMaxLength])
+	}
+
+	return ScheduledJobError(message)
+}
+
+// EditableScheduledJob defines the editable scheduled job structure.
+type EditableScheduledJob struct {
+	Action ScheduledJobAction
+	RunAt  *time.Time // Defaults to the release date of the game.
+}
+
+// ScheduledJob defines the scheduled job structure.
+type ScheduledJob struct {
+	ID         uuid.UUID
+	GameID     uuid.UUID
+	Action     ScheduledJobAction
+	RunAt      time.Time
+	Status     ScheduledJobStatus
+	Error      *ScheduledJobError
+	ExecutedAt *time.Time
+	CreatedAt  time.Time
+	ModifiedAt time.Time
+}
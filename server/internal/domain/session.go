@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Field constraints.
+const (
+	SessionUserAgentMaxLength = 500
+)
+
+// Session errors.
+var (
+	ErrSessionNotFound = errors.New("session not found") // Returned when a session is not found.
+)
+
+// SessionClient defines the client of a session structure.
+type SessionClient struct {
+	UserAgent string // User agent of the device, truncated to SessionUserAgentMaxLength.
+	IPAddress string
+}
+
+// EditableSession defines the session data to be stored.
+type EditableSession struct {
+	SubjectID   uuid.UUID
+	SubjectRole string
+	Client      SessionClient
+	ExpiresAt   time.Time
+}
+
+// Session defines the session structure.
+// A session is created on sign-in and kept alive while its refresh tokens are exchanged.
+type Session struct {
+	EditableSession
+	ID         uuid.UUID
+	LastSeenAt time.Time // Time at which the tokens were last issued.
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+}
+
+// Active returns true if the session is neither revoked nor expired at the specified time, false otherwise.
+func (s Session) Active(now time.Time) bool {
+	return s.RevokedAt == nil && now.Before(s.ExpiresAt)
+}
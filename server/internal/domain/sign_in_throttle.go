@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Sign in throttle durations.
+const (
+	SignInThrottleBackoffTime    = time.Second      // Wait after the first failed attempt beyond the free ones, doubled on each further one.
+	SignInThrottleBackoffMaxTime = time.Minute * 5  // Maximum wait between failed attempts.
+	SignInThrottleResetTime      = time.Hour        // Failed attempts older than this are forgotten.
+	SignInThrottleLockoutTime    = time.Minute * 15 // Duration of a lockout.
+)
+
+// SignInThrottlePolicy defines the number of failed sign in attempts allowed before backing off and locking out.
+type SignInThrottlePolicy struct {
+	FreeAttempts    int // Failed attempts allowed without waiting.
+	LockoutAttempts int // Failed attempts which lock out further attempts.
+}
+
+// Sign in throttle policies.
+var (
+	SignInThrottlePolicyAccount          = SignInThrottlePolicy{FreeAttempts: 3, LockoutAttempts: 10}   // Applied to each account identifier.
+	SignInThrottlePolicyIP               = SignInThrottlePolicy{FreeAttempts: 20, LockoutAttempts: 100} // Applied to each client IP address.
+	SignInThrottlePolicyTwoFactor        = SignInThrottlePolicy{FreeAttempts: 3, LockoutAttempts: 10}   // Applied to the second factor of each account.
+	SignInThrottlePolicyWebAuthnCeremony = SignInThrottlePolicy{FreeAttempts: 20, LockoutAttempts: 100} // Applied to the passkey sign ins started from each client IP address.
+)
+
+// SignInThrottle defines the failed sign in attempts of an account identifier or of an IP address.
+type SignInThrottle struct {
+	Key            string
+	FailedAttempts int
+	LastFailedAt   time.Time
+	LockedUntil    *time.Time
+}
+
+// Allowed returns true if a sign in can be attempted at the specified time, which is when it is not locked out and the
+// exponential backoff since the last failed attempt has elapsed, false otherwise.
+func (t SignInThrottle) Allowed(policy SignInThrottlePolicy, now time.Time) bool {
+	if t.LockedUntil != nil && now.Before(*t.LockedUntil) {
+		return false
+	}
+
+	if t.FailedAttempts <= policy.FreeAttempts || !now.Before(t.LastFailedAt.Add(SignInThrottleResetTime)) {
+		return true
+	}
+
+	backoff := SignInThrottleBackoffMaxTime
+
+	// Avoid overflowing the shift with a large number of failed attempts.
+	if exponent := t.FailedAttempts - policy.FreeAttempts - 1; exponent < 32 {
+		backoff = min(SignInThrottleBackoffTime<<exponent, SignInThrottleBackoffMaxTime)
+	}
+
+	return !now.Before(t.LastFailedAt.Add(backoff))
+}
+
+// SignInAccount defines the account data used to check a sign in with a password.
+type SignInAccount struct {
+	ID       uuid.UUID
+	Email    Email
+	Name     string
+	Password Password
+}
+
+// SignInLockout defines the sign in lockout email structure.
+type SignInLockout struct {
+	Name             string
+	URL              string // Password reset page of the web application.
+	ExpiresInMinutes int
+}
@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RecoveryCodesCount defines the amount of recovery codes issued when two-factor authentication is enabled.
+const RecoveryCodesCount = 10
+
+// Two-factor authentication errors.
+var (
+	ErrTOTPNotFound              = errors.New("totp not found")                     // Returned when the subject has not enrolled in totp.
+	ErrTOTPAlreadyEnabled        = errors.New("totp already enabled")               // Returned when the subject has already enabled totp.
+	ErrTOTPCodeInvalid           = errors.New("invalid totp code")                  // Returned when a totp code or recovery code is incorrect or was already used.
+	ErrRecoveryCodeNotFound      = errors.New("recovery code not found")            // Returned when a recovery code is not found or was already used.
+	ErrTwoFactorChallengeInvalid = errors.New("invalid two-factor challenge token") // Returned when a two-factor challenge token is expired or issued to another role.
+)
+
+// EditableTOTP defines the totp data to be stored.
+type EditableTOTP struct {
+	SubjectID uuid.UUID
+	Secret    []byte
+}
+
+// TOTP defines the time-based one-time password structure.
+type TOTP struct {
+	EditableTOTP
+	LastUsedStep *int64
+	EnabledAt    *time.Time
+	CreatedAt    time.Time
+}
+
+// TOTPEnrollment defines the data needed to add a totp secret to an authenticator app.
+type TOTPEnrollment struct {
+	Secret string // Base32 encoded secret.
+	URI    string // The otpauth URI of the secret.
+}
+
+// SignIn defines the result of a sign-in. When two-factor authentication is enabled, only the challenge token is set,
+// and the tokens are issued once the challenge is completed.
+type SignIn struct {
+	Tokens         Tokens
+	ChallengeToken string
+}
@@ -16,12 +16,14 @@ const (
 
 // User errors.
 var (
-	ErrUserUsernameAlreadyExists = errors.New("username already exists")   // Returned when a user already exists with the same username.
-	ErrUserEmailAlreadyExists    = errors.New("email already exists")      // Returned when a user already exists with the same email.
-	ErrUserVatinAlreadyExists    = errors.New("vatin already exists")      // Returned when a user already exists with the same vatin.
-	ErrUserNotFound              = errors.New("user not found")            // Returned when a user is not found.
-	ErrUserNotOldEnough          = errors.New("user not old enough")       // Returned when a user is user not old enough to perform a certain action.
-	ErrUserBalanceInsufficient   = errors.New("user balance insufficient") // Returned when a user does not have sufficient balance.
+	ErrUserUsernameAlreadyExists = errors.New("username already exists")     // Returned when a user already exists with the same username.
+	ErrUserEmailAlreadyExists    = errors.New("email already exists")        // Returned when a user already exists with the same email.
+	ErrUserVatinAlreadyExists    = errors.New("vatin already exists")        // Returned when a user already exists with the same vatin.
+	ErrUserNotFound              = errors.New("user not found")              // Returned when a user is not found.
+	ErrUserNotOldEnough          = errors.New("user not old enough")         // Returned when a user is user not old enough to perform a certain action.
+	ErrUserBalanceInsufficient   = errors.New("user balance insufficient")   // Returned when a user does not have sufficient balance.
+	ErrUserEmailNotVerified      = errors.New("user email not verified")     // Returned when a user has not verified the email yet.
+	ErrUserEmailAlreadyVerified  = errors.New("user email already verified") // Returned when a user has already verified the email.
 )
 
 // UserDateOfBirth defines the user date of birth type.
@@ -63,9 +65,11 @@ func (d UserDateOfBirth) Value() (driver.Value, error) {
 
 // SignInUser defines the sign-in user structure.
 type SignInUser struct {
-	Username Username
-	Email    Email
-	Password Password
+	ID          uuid.UUID
+	Username    Username
+	Email       Email
+	DisplayName Name
+	Password    Password
 }
 
 // EditableUser defines the editable user structure.
@@ -104,6 +108,7 @@ type User struct {
 	ID                uuid.UUID
 	Username          Username
 	Email             Email
+	EmailVerifiedAt   *time.Time
 	DisplayName       Name
 	DateOfBirth       UserDateOfBirth
 	Address           Address
@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WebAuthnCeremonyExpirationTime defines the duration of a WebAuthn registration or sign in since it is started.
+const WebAuthnCeremonyExpirationTime = time.Minute * 5
+
+// WebAuthn errors.
+var (
+	ErrWebAuthnCeremonyNotFound        = errors.New("webauthn ceremony not found")        // Returned when a webauthn ceremony is not found or was already finished.
+	ErrWebAuthnCeremonyInvalid         = errors.New("invalid webauthn ceremony")          // Returned when a webauthn ceremony is expired or was started by another subject.
+	ErrWebAuthnCeremonyThrottled       = errors.New("webauthn ceremony throttled")        // Returned when too many webauthn ceremonies were started from a client.
+	ErrWebAuthnCredentialInvalid       = errors.New("invalid webauthn credential")        // Returned when a webauthn response fails validation or the sign count of its authenticator did not increase.
+	ErrWebAuthnCredentialAlreadyExists = errors.New("webauthn credential already exists") // Returned when a webauthn credential is already registered.
+	ErrWebAuthnCredentialNotFound      = errors.New("webauthn credential not found")      // Returned when a webauthn credential is not found.
+)
+
+// EditableWebAuthnCeremony defines the webauthn ceremony data to be stored.
+type EditableWebAuthnCeremony struct {
+	SubjectID   *uuid.UUID // Not set when signing in, since the subject is only known from the credential.
+	SubjectRole string
+	Session     []byte // JSON encoded session of the relying party.
+	ExpiresAt   time.Time
+}
+
+// WebAuthnCeremony defines a started WebAuthn registration or sign in.
+type WebAuthnCeremony struct {
+	EditableWebAuthnCeremony
+	ID        uuid.UUID
+	CreatedAt time.Time
+}
+
+// EditableWebAuthnCredential defines the webauthn credential data to be stored.
+type EditableWebAuthnCredential struct {
+	SubjectID       uuid.UUID
+	SubjectRole     string
+	CredentialID    []byte
+	PublicKey       []byte
+	AttestationType string
+	Transports      []string
+	AAGUID          []byte
+	SignCount       int64
+}
+
+// WebAuthnCredential defines the webauthn credential structure.
+type WebAuthnCredential struct {
+	EditableWebAuthnCredential
+	ID         uuid.UUID
+	LastUsedAt *time.Time
+	CreatedAt  time.Time
+}
+
+// WebAuthnOptions defines the options of a started webauthn ceremony, to be passed to the browser.
+type WebAuthnOptions struct {
+	CeremonyID uuid.UUID
+	Options    []byte // JSON encoded options.
+}
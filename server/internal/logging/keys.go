@@ -24,14 +24,37 @@ const (
 	PublisherCountry = "publisher.country"
 	PublisherVatin   = "publisher.vatin"
 
+	PublisherVerificationStatus = "publisher.verificationStatus"
+
+	PublisherDocumentID        = "publisherDocument.id"
+	PublisherDocumentMediaType = "publisherDocument.mediaType"
+
+	PublisherMemberRole = "publisherMember.role"
+
 	GameID                  = "game.id"
 	GameTitle               = "game.title"
 	GamePrice               = "game.price"
-	GameIsActive            = "game.isActive"
 	GameAgeRating           = "game.ageRating"
 	GamePreviewMultimediaID = "game.previewMultimediaID"
 
+	GameRevisionNumber     = "gameRevision.number"
+	GameRevisionFromNumber = "gameRevision.fromNumber"
+	GameRevisionToNumber   = "gameRevision.toNumber"
+
+	GameTranslationLanguage = "gameTranslation.language"
+
+	GameCatalogEntries = "gameCatalog.entries"
+	GameCatalogErrors  = "gameCatalog.errors"
+	GameCatalogDryRun  = "gameCatalog.dryRun"
+
+	ScheduledJobID     = "scheduledJob.id"
+	ScheduledJobAction = "scheduledJob.action"
+
 	TagID = "tag.id"
 
 	MultimediaID = "multimedia.id"
+
+	SessionID = "session.id"
+
+	OIDCProvider = "oidc.provider"
 )
@@ -0,0 +1,89 @@
+package recommender
+
+import (
+	"math"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+// Evaluation defines the recommender evaluation result structure.
+type Evaluation struct {
+	K         int     // Amount of recommendations per user.
+	Users     int     // Amount of users with held-out purchases.
+	Precision float64 // Mean precision at k of the users.
+}
+
+// Evaluate trains the item-based model without the most recent purchases of each user, and returns the precision at k
+// of its recommendations against those held-out purchases.
+// The holdout defines the ratio of purchases held out for each user. At least one purchase is held out, as long as
+// the user keeps at least one interaction to train with.
+func Evaluate(interactions []domain.RecommenderInteraction, neighbors, k int, holdout float64) Evaluation {
+	interactionsByUser := make(map[uuid.UUID][]domain.RecommenderInteraction)
+	for _, interaction := range interactions {
+		interactionsByUser[interaction.UserID] = append(interactionsByUser[interaction.UserID], interaction)
+	}
+
+	var (
+		trainingInteractions []domain.RecommenderInteraction
+		heldOut              = make(map[uuid.UUID][]uuid.UUID)
+	)
+
+	for userID, userInteractions := range interactionsByUser {
+		// Sort by most recent first, so that the most recent purchases are the ones held out.
+		slices.SortFunc(userInteractions, func(a, b domain.RecommenderInteraction) int {
+			return b.CreatedAt.Compare(a.CreatedAt)
+		})
+
+		var purchases int
+		for _, interaction := range userInteractions {
+			if interaction.Purchased {
+				purchases++
+			}
+		}
+
+		holdoutCount := max(int(math.Round(float64(purchases)*holdout)), 1)
+		holdoutCount = min(holdoutCount, purchases, len(userInteractions)-1)
+
+		for _, interaction := range userInteractions {
+			if interaction.Purchased && len(heldOut[userID]) < holdoutCount {
+				heldOut[userID] = append(heldOut[userID], interaction.GameID)
+				continue
+			}
+
+			trainingInteractions = append(trainingInteractions, interaction)
+		}
+	}
+
+	m := train(trainingInteractions, neighbors)
+
+	owned := make(map[uuid.UUID][]uuid.UUID)
+	for _, interaction := range trainingInteractions {
+		owned[interaction.UserID] = append(owned[interaction.UserID], interaction.GameID)
+	}
+
+	evaluation := Evaluation{K: k}
+
+	var precisionSum float64
+
+	for userID, gameIDs := range heldOut {
+		var hits int
+
+		for _, gameID := range m.recommend(owned[userID], k) {
+			if slices.Contains(gameIDs, gameID) {
+				hits++
+			}
+		}
+
+		precisionSum += float64(hits) / float64(k)
+		evaluation.Users++
+	}
+
+	if evaluation.Users > 0 {
+		evaluation.Precision = precisionSum / float64(evaluation.Users)
+	}
+
+	return evaluation
+}
@@ -0,0 +1,82 @@
+package recommender
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+// DefaultNeighbors defines the default amount of similar games kept for each game.
+const DefaultNeighbors = 50
+
+const (
+	descriptionFailedListInteractions = "recommender: failed to list interactions"
+	descriptionFailedReplaceItems     = "recommender: failed to replace items"
+	descriptionFailedListGames        = "recommender: failed to list recommended games"
+)
+
+// DataStore defines the data store interface.
+type DataStore interface {
+	ListRecommenderInteractions(ctx context.Context, tx pgx.Tx) ([]domain.RecommenderInteraction, error)
+	ReplaceRecommenderItems(ctx context.Context, tx pgx.Tx, items []domain.RecommenderItem) error
+	ListGamesRecommendedByItems(ctx context.Context, tx pgx.Tx, userID uuid.UUID, filter domain.GamesRecommendedPaginatedFilter) (domain.PaginatedResponse[domain.Game], error)
+}
+
+// Fallback defines the recommender used when there is not enough data to recommend games.
+type Fallback interface {
+	ListGamesRecommended(ctx context.Context, tx pgx.Tx, filter domain.GamesRecommendedPaginatedFilter) (domain.PaginatedResponse[domain.Game], error)
+}
+
+// itemBased defines the item-based collaborative filtering recommender structure.
+type itemBased struct {
+	dataStore DataStore
+	fallback  Fallback
+	neighbors int
+}
+
+// NewItemBased returns a new item-based collaborative filtering recommender.
+func NewItemBased(dataStore DataStore, fallback Fallback, neighbors int) *itemBased {
+	return &itemBased{
+		dataStore: dataStore,
+		fallback:  fallback,
+		neighbors: neighbors,
+	}
+}
+
+// ListGamesRecommended returns the recommended games with the specified filter.
+// The fallback is used when there is no user or the user library has no trained items.
+func (r *itemBased) ListGamesRecommended(ctx context.Context, tx pgx.Tx, filter domain.GamesRecommendedPaginatedFilter) (domain.PaginatedResponse[domain.Game], error) {
+	if filter.UserID == nil {
+		return r.fallback.ListGamesRecommended(ctx, tx, filter)
+	}
+
+	paginatedGames, err := r.dataStore.ListGamesRecommendedByItems(ctx, tx, *filter.UserID, filter)
+	if err != nil {
+		return domain.PaginatedResponse[domain.Game]{}, fmt.Errorf("%s: %w", descriptionFailedListGames, err)
+	}
+
+	if paginatedGames.Total == 0 {
+		return r.fallback.ListGamesRecommended(ctx, tx, filter)
+	}
+
+	return paginatedGames, nil
+}
+
+// Train trains the recommender with the current interactions and replaces the stored items.
+func (r *itemBased) Train(ctx context.Context, tx pgx.Tx) error {
+	interactions, err := r.dataStore.ListRecommenderInteractions(ctx, tx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", descriptionFailedListInteractions, err)
+	}
+
+	err = r.dataStore.ReplaceRecommenderItems(ctx, tx, train(interactions, r.neighbors).items())
+	if err != nil {
+		return fmt.Errorf("%s: %w", descriptionFailedReplaceItems, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package recommender
+
+import (
+	"math"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+// model defines the item-based collaborative filtering model structure.
+// It maps each game to its most similar games, sorted by descending score.
+type model map[uuid.UUID][]domain.RecommenderItem
+
+// Interaction weights. A purchase is a stronger signal of interest than a game that was only added to the library,
+// such as a free game.
+const (
+	purchasedWeight = 1.0
+	libraryWeight   = 0.5
+)
+
+// train returns the model learned from the specified interactions.
+// The similarity between two games is the cosine similarity of their owners, weighted by how each owner got the game,
+// and only the specified amount of neighbors is kept for each game.
+func train(interactions []domain.RecommenderInteraction, neighbors int) model {
+	weightsByUser := make(map[uuid.UUID]map[uuid.UUID]float64)
+	squaredNorms := make(map[uuid.UUID]float64)
+
+	for _, interaction := range interactions {
+		weight := libraryWeight
+		if interaction.Purchased {
+			weight = purchasedWeight
+		}
+
+		weights, ok := weightsByUser[interaction.UserID]
+		if !ok {
+			weights = make(map[uuid.UUID]float64)
+			weightsByUser[interaction.UserID] = weights
+		}
+
+		// Repeated interactions keep the strongest weight.
+		previous := weights[interaction.GameID]
+		if weight <= previous {
+			continue
+		}
+
+		weights[interaction.GameID] = weight
+		squaredNorms[interaction.GameID] += weight*weight - previous*previous
+	}
+
+	type pair struct {
+		gameID        uuid.UUID
+		similarGameID uuid.UUID
+	}
+
+	dotProducts := make(map[pair]float64)
+
+	for _, weights := range weightsByUser {
+		for gameID, weight := range weights {
+			for similarGameID, similarWeight := range weights {
+				if gameID != similarGameID {
+					dotProducts[pair{gameID, similarGameID}] += weight * similarWeight
+				}
+			}
+		}
+	}
+
+	m := make(model)
+
+	for p, dotProduct := range dotProducts {
+		m[p.gameID] = append(m[p.gameID], domain.RecommenderItem{
+			GameID:        p.gameID,
+			SimilarGameID: p.similarGameID,
+			Score:         dotProduct / math.Sqrt(squaredNorms[p.gameID]*squaredNorms[p.similarGameID]),
+		})
+	}
+
+	for gameID, items := range m {
+		slices.SortFunc(items, compareItems)
+
+		if len(items) > neighbors {
+			items = items[:neighbors]
+		}
+
+		m[gameID] = items
+	}
+
+	return m
+}
+
+// items returns all the items of the model.
+func (m model) items() []domain.RecommenderItem {
+	var items []domain.RecommenderItem
+
+	for _, gameItems := range m {
+		items = append(items, gameItems...)
+	}
+
+	return items
+}
+
+// recommend returns the identifiers of the k best scored games for a user that owns the specified games.
+// The score of a game is the sum of its similarities with the owned games, and the owned games are never recommended.
+func (m model) recommend(owned []uuid.UUID, k int) []uuid.UUID {
+	scores := make(map[uuid.UUID]float64)
+
+	for _, gameID := range owned {
+		for _, item := range m[gameID] {
+			if !slices.Contains(owned, item.SimilarGameID) {
+				scores[item.SimilarGameID] += item.Score
+			}
+		}
+	}
+
+	recommendations := make([]domain.RecommenderItem, 0, len(scores))
+	for gameID, score := range scores {
+		recommendations = append(recommendations, domain.RecommenderItem{SimilarGameID: gameID, Score: score})
+	}
+
+	slices.SortFunc(recommendations, compareItems)
+
+	gameIDs := make([]uuid.UUID, 0, k)
+	for i := 0; i < len(recommendations) && i < k; i++ {
+		gameIDs = append(gameIDs, recommendations[i].SimilarGameID)
+	}
+
+	return gameIDs
+}
+
+// compareItems compares the items by descending score, and then by similar game identifier so that the order is
+// deterministic.
+func compareItems(a, b domain.RecommenderItem) int {
+	switch {
+	case a.Score > b.Score:
+		return -1
+	case a.Score < b.Score:
+		return 1
+	default:
+		return slices.Compare(a.SimilarGameID[:], b.SimilarGameID[:])
+	}
+}
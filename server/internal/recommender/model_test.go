@@ -0,0 +1,119 @@
+package recommender
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+func TestModel_Recommend(t *testing.T) {
+	t.Run("should successfully recommend games owned by similar users", func(t *testing.T) {
+		var (
+			user1 = uuid.New()
+			user2 = uuid.New()
+			user3 = uuid.New()
+
+			game1 = uuid.New()
+			game2 = uuid.New()
+			game3 = uuid.New()
+
+			interactions = []domain.RecommenderInteraction{
+				{UserID: user1, GameID: game1},
+				{UserID: user1, GameID: game2},
+				{UserID: user2, GameID: game1},
+				{UserID: user2, GameID: game2},
+				{UserID: user2, GameID: game3},
+				{UserID: user3, GameID: game1},
+			}
+		)
+
+		m := train(interactions, DefaultNeighbors)
+
+		require.Len(t, m[game1], 2)
+		assert.Equal(t, game2, m[game1][0].SimilarGameID)
+		assert.InDelta(t, 0.8165, m[game1][0].Score, 0.0001)
+
+		assert.Equal(t, []uuid.UUID{game2, game3}, m.recommend([]uuid.UUID{game1}, 2))
+		assert.Equal(t, []uuid.UUID{game3}, m.recommend([]uuid.UUID{game1, game2}, 2))
+		assert.Empty(t, m.recommend([]uuid.UUID{uuid.New()}, 2))
+	})
+
+	t.Run("should successfully weight purchases over games only added to the library", func(t *testing.T) {
+		var (
+			user1 = uuid.New()
+			user2 = uuid.New()
+			user3 = uuid.New()
+			user4 = uuid.New()
+
+			game1 = uuid.New()
+			game2 = uuid.New()
+			game3 = uuid.New()
+
+			interactions = []domain.RecommenderInteraction{
+				{UserID: user1, GameID: game1, Purchased: true},
+				{UserID: user2, GameID: game1, Purchased: true},
+				{UserID: user1, GameID: game2, Purchased: true},
+				{UserID: user3, GameID: game2},
+				{UserID: user2, GameID: game3, Purchased: true},
+				{UserID: user4, GameID: game3, Purchased: true},
+			}
+		)
+
+		m := train(interactions, DefaultNeighbors)
+
+		require.Len(t, m[game1], 2)
+		assert.Equal(t, game2, m[game1][0].SimilarGameID)
+		assert.InDelta(t, 0.6325, m[game1][0].Score, 0.0001)
+		assert.Equal(t, game3, m[game1][1].SimilarGameID)
+		assert.InDelta(t, 0.5, m[game1][1].Score, 0.0001)
+	})
+
+	t.Run("should successfully keep only the specified neighbors", func(t *testing.T) {
+		var (
+			user = uuid.New()
+
+			interactions = []domain.RecommenderInteraction{
+				{UserID: user, GameID: uuid.New()},
+				{UserID: user, GameID: uuid.New()},
+				{UserID: user, GameID: uuid.New()},
+			}
+		)
+
+		m := train(interactions, 1)
+
+		assert.Len(t, m.items(), 3)
+	})
+}
+
+func TestEvaluate(t *testing.T) {
+	t.Run("should successfully evaluate the precision against held-out purchases", func(t *testing.T) {
+		var (
+			now = time.Now()
+
+			user1 = uuid.New()
+			user2 = uuid.New()
+
+			game1 = uuid.New()
+			game2 = uuid.New()
+			game3 = uuid.New()
+
+			interactions = []domain.RecommenderInteraction{
+				{UserID: user1, GameID: game1, Purchased: true, CreatedAt: now.Add(-time.Hour)},
+				{UserID: user1, GameID: game2, Purchased: true, CreatedAt: now},
+				{UserID: user2, GameID: game1, Purchased: true, CreatedAt: now.Add(-time.Hour)},
+				{UserID: user2, GameID: game2, Purchased: true, CreatedAt: now.Add(-time.Hour)},
+				{UserID: user2, GameID: game3, Purchased: true, CreatedAt: now},
+			}
+		)
+
+		evaluation := Evaluate(interactions, DefaultNeighbors, 1, 0.2)
+
+		// The first user is recommended the second game, and the second user has no other games to be recommended.
+		assert.Equal(t, Evaluation{K: 1, Users: 2, Precision: 0.5}, evaluation)
+	})
+}
@@ -0,0 +1,242 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"html/template"
+	"log/slog"
+	"net/url"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goofr-group/gaming-store/server"
+	"github.com/goofr-group/gaming-store/server/internal/authn"
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+	"github.com/goofr-group/gaming-store/server/internal/logging"
+)
+
+const (
+	descriptionFailedSendEmailVerification = "service: failed to send email verification"
+	descriptionFailedVerifyEmail           = "service: failed to verify email"
+
+	emailVerificationEmailSubject          = "GOOFR Store Email Verification"
+	emailVerificationEmailBodyTemplatePath = "templates/email/email_verification.html"
+
+	emailVerificationUserPath      = "/email-verification"
+	emailVerificationPublisherPath = "/distribute/email-verification"
+)
+
+// ResendUserEmailVerification sends a new email verification link to the user with the specified identifier.
+func (s *service) ResendUserEmailVerification(ctx context.Context, userID uuid.UUID) error {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "ResendUserEmailVerification"),
+		slog.String(logging.UserID, userID.String()),
+	}
+
+	var (
+		user domain.User
+		err  error
+	)
+
+	err = s.readOnlyTx(ctx, func(tx pgx.Tx) error {
+		user, err = s.dataStore.GetUserByID(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+
+		if user.EmailVerifiedAt != nil {
+			return domain.ErrUserEmailAlreadyVerified
+		}
+
+		return nil
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUserNotFound),
+			errors.Is(err, domain.ErrUserEmailAlreadyVerified):
+			return logInfoAndWrapError(ctx, err, descriptionFailedSendEmailVerification, logAttrs...)
+		default:
+			return logAndWrapError(ctx, err, descriptionFailedSendEmailVerification, logAttrs...)
+		}
+	}
+
+	err = s.sendEmailVerification(user.ID, authn.SubjectRoleUser, user.Email, string(user.DisplayName), emailVerificationUserPath)
+	if err != nil {
+		return logAndWrapError(ctx, err, descriptionFailedSendEmailVerification, logAttrs...)
+	}
+
+	return nil
+}
+
+// VerifyUserEmail marks the email of the user of the specified email verification token as verified.
+// Verifying an already verified email succeeds.
+func (s *service) VerifyUserEmail(ctx context.Context, token string) error {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "VerifyUserEmail"),
+	}
+
+	userID, email, err := s.parseEmailVerificationToken(token, authn.SubjectRoleUser)
+	if err != nil {
+		return logInfoAndWrapError(ctx, err, descriptionFailedVerifyEmail, logAttrs...)
+	}
+
+	err = s.readWriteTx(ctx, func(tx pgx.Tx) error {
+		user, err := s.dataStore.GetUserByID(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+
+		if user.Email != email {
+			return domain.ErrEmailVerificationTokenInvalid
+		}
+
+		if user.EmailVerifiedAt != nil {
+			return nil
+		}
+
+		return s.dataStore.VerifyUserEmail(ctx, tx, userID)
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUserNotFound):
+			return logInfoAndWrapError(ctx, domain.ErrEmailVerificationTokenInvalid, descriptionFailedVerifyEmail, logAttrs...)
+		case errors.Is(err, domain.ErrEmailVerificationTokenInvalid):
+			return logInfoAndWrapError(ctx, err, descriptionFailedVerifyEmail, logAttrs...)
+		default:
+			return logAndWrapError(ctx, err, descriptionFailedVerifyEmail, logAttrs...)
+		}
+	}
+
+	return nil
+}
+
+// ResendPublisherEmailVerification sends a new email verification link to the publisher with the specified
+// identifier.
+func (s *service) ResendPublisherEmailVerification(ctx context.Context, publisherID uuid.UUID) error {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "ResendPublisherEmailVerification"),
+		slog.String(logging.PublisherID, publisherID.String()),
+	}
+
+	var (
+		publisher domain.Publisher
+		err       error
+	)
+
+	err = s.readOnlyTx(ctx, func(tx pgx.Tx) error {
+		publisher, err = s.dataStore.GetPublisherByID(ctx, tx, publisherID)
+		if err != nil {
+			return err
+		}
+
+		if publisher.EmailVerifiedAt != nil {
+			return domain.ErrPublisherEmailAlreadyVerified
+		}
+
+		return nil
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPublisherNotFound),
+			errors.Is(err, domain.ErrPublisherEmailAlreadyVerified):
+			return logInfoAndWrapError(ctx, err, descriptionFailedSendEmailVerification, logAttrs...)
+		default:
+			return logAndWrapError(ctx, err, descriptionFailedSendEmailVerification, logAttrs...)
+		}
+	}
+
+	err = s.sendEmailVerification(publisher.ID, authn.SubjectRolePublisher, publisher.Email, string(publisher.Name), emailVerificationPublisherPath)
+	if err != nil {
+		return logAndWrapError(ctx, err, descriptionFailedSendEmailVerification, logAttrs...)
+	}
+
+	return nil
+}
+
+// VerifyPublisherEmail marks the email of the publisher of the specified email verification token as verified.
+// Verifying an already verified email succeeds.
+func (s *service) VerifyPublisherEmail(ctx context.Context, token string) error {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "VerifyPublisherEmail"),
+	}
+
+	publisherID, email, err := s.parseEmailVerificationToken(token, authn.SubjectRolePublisher)
+	if err != nil {
+		return logInfoAndWrapError(ctx, err, descriptionFailedVerifyEmail, logAttrs...)
+	}
+
+	err = s.readWriteTx(ctx, func(tx pgx.Tx) error {
+		publisher, err := s.dataStore.GetPublisherByID(ctx, tx, publisherID)
+		if err != nil {
+			return err
+		}
+
+		if publisher.Email != email {
+			return domain.ErrEmailVerificationTokenInvalid
+		}
+
+		if publisher.EmailVerifiedAt != nil {
+			return nil
+		}
+
+		return s.dataStore.VerifyPublisherEmail(ctx, tx, publisherID)
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPublisherNotFound):
+			return logInfoAndWrapError(ctx, domain.ErrEmailVerificationTokenInvalid, descriptionFailedVerifyEmail, logAttrs...)
+		case errors.Is(err, domain.ErrEmailVerificationTokenInvalid):
+			return logInfoAndWrapError(ctx, err, descriptionFailedVerifyEmail, logAttrs...)
+		default:
+			return logAndWrapError(ctx, err, descriptionFailedVerifyEmail, logAttrs...)
+		}
+	}
+
+	return nil
+}
+
+// sendEmailVerification emails the subject a link to the web application path where the specified email can be
+// verified.
+func (s *service) sendEmailVerification(subjectID uuid.UUID, role authn.SubjectRole, email domain.Email, name, path string) error {
+	token, err := s.authnService.NewEmailVerificationToken(subjectID.String(), role, string(email))
+	if err != nil {
+		return err
+	}
+
+	template, err := template.ParseFS(server.FileSystemTemplates, emailVerificationEmailBodyTemplatePath)
+	if err != nil {
+		return err
+	}
+
+	emailVerification := domain.EmailVerification{
+		Name: name,
+		URL:  s.webURL + path + "?" + url.Values{"token": {token}}.Encode(),
+	}
+
+	var body bytes.Buffer
+
+	err = template.Execute(&body, emailVerification)
+	if err != nil {
+		return err
+	}
+
+	return s.smtp.SendMailHTML([]string{string(email)}, emailVerificationEmailSubject, body.String())
+}
+
+// parseEmailVerificationToken returns the subject identifier and the email of the email verification token issued to
+// the specified role.
+func (s *service) parseEmailVerificationToken(token string, role authn.SubjectRole) (uuid.UUID, domain.Email, error) {
+	claims, err := s.authnService.ParseEmailVerificationToken(token)
+	if err != nil || claims.Role != role {
+		return uuid.UUID{}, "", domain.ErrEmailVerificationTokenInvalid
+	}
+
+	subjectID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return uuid.UUID{}, "", domain.ErrEmailVerificationTokenInvalid
+	}
+
+	return subjectID, domain.Email(claims.Email), nil
+}
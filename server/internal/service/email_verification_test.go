@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/goofr-group/gaming-store/server/internal/authn"
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+func TestService_VerifyUserEmail(t *testing.T) {
+	t.Run("should successfully verify user email", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			token = "email verification token"
+
+			user = domain.User{
+				ID:    uuid.New(),
+				Email: "user@email.com",
+			}
+
+			claims = authn.EmailVerificationClaims{
+				RegisteredClaims: jwt.RegisteredClaims{
+					Subject: user.ID.String(),
+				},
+				Role:  authn.SubjectRoleUser,
+				Email: string(user.Email),
+			}
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		gomock.InOrder(
+			s.mockAuthnService.EXPECT().
+				ParseEmailVerificationToken(token).
+				Return(claims, nil),
+			s.mockDataStore.EXPECT().
+				GetUserByID(ctx, tx, user.ID).
+				Return(user, nil),
+			s.mockDataStore.EXPECT().
+				VerifyUserEmail(ctx, tx, user.ID).
+				Return(nil),
+		)
+
+		err := s.service.VerifyUserEmail(ctx, token)
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the user email changed", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				token = "email verification token"
+
+				user = domain.User{
+					ID:    uuid.New(),
+					Email: "new@email.com",
+				}
+
+				claims = authn.EmailVerificationClaims{
+					RegisteredClaims: jwt.RegisteredClaims{
+						Subject: user.ID.String(),
+					},
+					Role:  authn.SubjectRoleUser,
+					Email: "user@email.com",
+				}
+
+				expectedError = fmt.Errorf("%s: %w", descriptionFailedVerifyEmail, domain.ErrEmailVerificationTokenInvalid)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			gomock.InOrder(
+				s.mockAuthnService.EXPECT().
+					ParseEmailVerificationToken(token).
+					Return(claims, nil),
+				s.mockDataStore.EXPECT().
+					GetUserByID(ctx, tx, user.ID).
+					Return(user, nil),
+			)
+
+			err := s.service.VerifyUserEmail(ctx, token)
+			assert.Equal(t, expectedError, err)
+		})
+
+		t.Run("when the token was issued to a publisher", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				token = "email verification token"
+
+				claims = authn.EmailVerificationClaims{
+					RegisteredClaims: jwt.RegisteredClaims{
+						Subject: uuid.New().String(),
+					},
+					Role:  authn.SubjectRolePublisher,
+					Email: "publisher@email.com",
+				}
+
+				expectedError = fmt.Errorf("%s: %w", descriptionFailedVerifyEmail, domain.ErrEmailVerificationTokenInvalid)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+
+			s.mockAuthnService.EXPECT().
+				ParseEmailVerificationToken(token).
+				Return(claims, nil)
+
+			err := s.service.VerifyUserEmail(ctx, token)
+			assert.Equal(t, expectedError, err)
+		})
+	})
+}
+
+func TestService_ResendUserEmailVerification(t *testing.T) {
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the user email is already verified", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				emailVerifiedAt = time.Now().UTC()
+				user            = domain.User{
+					ID:              uuid.New(),
+					Email:           "user@email.com",
+					EmailVerifiedAt: &emailVerifiedAt,
+				}
+
+				expectedError = fmt.Errorf("%s: %w", descriptionFailedSendEmailVerification, domain.ErrUserEmailAlreadyVerified)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadOnlyTx(ctx, ctrl)
+
+			s.mockDataStore.EXPECT().
+				GetUserByID(ctx, tx, user.ID).
+				Return(user, nil)
+
+			err := s.service.ResendUserEmailVerification(ctx, user.ID)
+			assert.Equal(t, expectedError, err)
+		})
+	})
+}
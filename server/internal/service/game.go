@@ -18,54 +18,40 @@ const (
 	descriptionFailedListGamesRecommended = "service: failed to list recommended games"
 	descriptionFailedGetGameByID          = "service: failed to get game by id"
 	descriptionFailedPatchGame            = "service: failed to patch game"
+	descriptionFailedGamePublishedBy      = "service: failed to check game publisher"
 )
 
-// CreateGame creates a new game with the specified data.
-func (s *service) CreateGame(ctx context.Context, publisherID uuid.UUID, editableGame domain.EditableGame) (domain.Game, error) {
+// CreateGame creates a new game with the specified data, and records its first revision authored by the specified
+// author.
+func (s *service) CreateGame(ctx context.Context, publisherID uuid.UUID, editableGame domain.EditableGame, author domain.GameRevisionAuthor) (domain.Game, error) {
 	logAttrs := []any{
 		slog.String(logging.ServiceMethod, "CreateGame"),
 		slog.String(logging.GameTitle, string(editableGame.Title)),
 		slog.Float64(logging.GamePrice, float64(editableGame.Price)),
-		slog.Bool(logging.GameIsActive, editableGame.IsActive),
 		slog.String(logging.GameAgeRating, string(editableGame.AgeRating)),
 		slog.String(logging.GamePreviewMultimediaID, editableGame.PreviewMultimediaID.String()),
 	}
 
-	if !editableGame.Title.Valid() {
-		return domain.Game{}, logInfoAndWrapError(ctx, &domain.FieldValueInvalidError{FieldName: domain.FieldTitle}, descriptionInvalidFieldValue, logAttrs...)
-	}
-
-	if !editableGame.Price.Valid() {
-		return domain.Game{}, logInfoAndWrapError(ctx, &domain.FieldValueInvalidError{FieldName: domain.FieldPrice}, descriptionInvalidFieldValue, logAttrs...)
-	}
-
-	if !editableGame.Description.Valid() {
-		return domain.Game{}, logInfoAndWrapError(ctx, &domain.FieldValueInvalidError{FieldName: domain.FieldDescription}, descriptionInvalidFieldValue, logAttrs...)
-	}
-
-	if !editableGame.AgeRating.Valid() {
-		return domain.Game{}, logInfoAndWrapError(ctx, &domain.FieldValueInvalidError{FieldName: domain.FieldAgeRating}, descriptionInvalidFieldValue, logAttrs...)
-	}
-
-	if !editableGame.Features.Valid() {
-		return domain.Game{}, logInfoAndWrapError(ctx, &domain.FieldValueInvalidError{FieldName: domain.FieldFeatures}, descriptionInvalidFieldValue, logAttrs...)
+	if fieldNames := editableGameInvalidFields(editableGame); len(fieldNames) != 0 {
+		return domain.Game{}, logInfoAndWrapError(ctx, &domain.FieldValueInvalidError{FieldName: fieldNames[0]}, descriptionInvalidFieldValue, logAttrs...)
 	}
 
-	if !editableGame.Languages.Valid() {
-		return domain.Game{}, logInfoAndWrapError(ctx, &domain.FieldValueInvalidError{FieldName: domain.FieldLanguages}, descriptionInvalidFieldValue, logAttrs...)
-	}
+	var game domain.Game
 
-	if !editableGame.Requirements.Valid() {
-		return domain.Game{}, logInfoAndWrapError(ctx, &domain.FieldValueInvalidError{FieldName: domain.FieldRequirements}, descriptionInvalidFieldValue, logAttrs...)
-	}
+	err := s.readWriteTx(ctx, func(tx pgx.Tx) error {
+		publisher, err := s.dataStore.GetPublisherByID(ctx, tx, publisherID)
+		if err != nil {
+			return err
+		}
 
-	if editableGame.ReleaseDate != nil && editableGame.DownloadMultimediaID == nil {
-		return domain.Game{}, logInfoAndWrapError(ctx, &domain.FieldValueInvalidError{FieldName: domain.FieldDownloadMultimediaID}, descriptionInvalidFieldValue, logAttrs...)
-	}
+		if publisher.EmailVerifiedAt == nil {
+			return domain.ErrPublisherEmailNotVerified
+		}
 
-	var game domain.Game
+		if publisher.VerificationStatus != domain.PublisherVerificationStatusVerified {
+			return domain.ErrPublisherNotVerified
+		}
 
-	err := s.readWriteTx(ctx, func(tx pgx.Tx) error {
 		id, err := s.dataStore.CreateGame(ctx, tx, publisherID, editableGame)
 		if err != nil {
 			return err
@@ -76,11 +62,13 @@ func (s *service) CreateGame(ctx context.Context, publisherID uuid.UUID, editabl
 			return err
 		}
 
-		return nil
+		return s.recordGameRevision(ctx, tx, game, author)
 	})
 	if err != nil {
 		switch {
 		case errors.Is(err, domain.ErrPublisherNotFound),
+			errors.Is(err, domain.ErrPublisherEmailNotVerified),
+			errors.Is(err, domain.ErrPublisherNotVerified),
 			errors.Is(err, domain.ErrGamePreviewMultimediaNotFound),
 			errors.Is(err, domain.ErrGameDownloadMultimediaNotFound):
 			return domain.Game{}, logInfoAndWrapError(ctx, err, descriptionFailedCreateGame, logAttrs...)
@@ -92,39 +80,68 @@ func (s *service) CreateGame(ctx context.Context, publisherID uuid.UUID, editabl
 	return game, nil
 }
 
-// ListGames returns the games with the specified filter.
-func (s *service) ListGames(ctx context.Context, filter domain.GamesPaginatedFilter) (domain.PaginatedResponse[domain.Game], error) {
+// ListGames returns the games with the specified filter, localized to the best match of the preferred languages.
+func (s *service) ListGames(ctx context.Context, filter domain.GamesPaginatedFilter, preferredLanguages []domain.Language) (domain.GamesPaginatedResponse, error) {
 	logAttrs := []any{
 		slog.String(logging.ServiceMethod, "ListGames"),
 	}
 
 	if filter.Sort != nil && !filter.Sort.Valid() {
-		return domain.PaginatedResponse[domain.Game]{}, logInfoAndWrapError(ctx, &domain.FilterValueInvalidError{FilterName: domain.FieldFilterSort}, descriptionInvalidFilterValue, logAttrs...)
+		return domain.GamesPaginatedResponse{}, logInfoAndWrapError(ctx, &domain.FilterValueInvalidError{FilterName: domain.FieldFilterSort}, descriptionInvalidFilterValue, logAttrs...)
+	}
+
+	if filter.Status != nil && !filter.Status.Valid() {
+		return domain.GamesPaginatedResponse{}, logInfoAndWrapError(ctx, &domain.FilterValueInvalidError{FilterName: domain.FieldFilterStatus}, descriptionInvalidFilterValue, logAttrs...)
 	}
 
 	if !filter.Order.Valid() {
-		return domain.PaginatedResponse[domain.Game]{}, logInfoAndWrapError(ctx, &domain.FilterValueInvalidError{FilterName: domain.FieldFilterOrder}, descriptionInvalidFilterValue, logAttrs...)
+		return domain.GamesPaginatedResponse{}, logInfoAndWrapError(ctx, &domain.FilterValueInvalidError{FilterName: domain.FieldFilterOrder}, descriptionInvalidFilterValue, logAttrs...)
 	}
 
 	if !filter.Limit.Valid() {
-		return domain.PaginatedResponse[domain.Game]{}, logInfoAndWrapError(ctx, &domain.FilterValueInvalidError{FilterName: domain.FieldFilterLimit}, descriptionInvalidFilterValue, logAttrs...)
+		return domain.GamesPaginatedResponse{}, logInfoAndWrapError(ctx, &domain.FilterValueInvalidError{FilterName: domain.FieldFilterLimit}, descriptionInvalidFilterValue, logAttrs...)
 	}
 
 	if !filter.Offset.Valid() {
-		return domain.PaginatedResponse[domain.Game]{}, logInfoAndWrapError(ctx, &domain.FilterValueInvalidError{FilterName: domain.FieldFilterOffset}, descriptionInvalidFilterValue, logAttrs...)
+		return domain.GamesPaginatedResponse{}, logInfoAndWrapError(ctx, &domain.FilterValueInvalidError{FilterName: domain.FieldFilterOffset}, descriptionInvalidFilterValue, logAttrs...)
 	}
 
-	var (
-		paginatedGames domain.PaginatedResponse[domain.Game]
-		err            error
-	)
+	for _, facet := range filter.Facets {
+		if !facet.Valid() {
+			return domain.GamesPaginatedResponse{}, logInfoAndWrapError(ctx, &domain.FilterValueInvalidError{FilterName: domain.FieldFilterFacets}, descriptionInvalidFilterValue, logAttrs...)
+		}
+	}
 
-	err = s.readOnlyTx(ctx, func(tx pgx.Tx) error {
-		paginatedGames, err = s.dataStore.ListGames(ctx, tx, filter)
-		return err
+	var paginatedGames domain.GamesPaginatedResponse
+
+	err := s.readOnlyTx(ctx, func(tx pgx.Tx) error {
+		var err error
+
+		paginatedGames.PaginatedResponse, err = s.dataStore.ListGames(ctx, tx, filter)
+		if err != nil {
+			return err
+		}
+
+		paginatedGames.Results, err = s.localizeGames(ctx, tx, paginatedGames.Results, preferredLanguages)
+		if err != nil {
+			return err
+		}
+
+		if len(filter.Facets) == 0 {
+			return nil
+		}
+
+		facets, err := s.dataStore.ListGamesFacets(ctx, tx, filter)
+		if err != nil {
+			return err
+		}
+
+		paginatedGames.Facets = &facets
+
+		return nil
 	})
 	if err != nil {
-		return domain.PaginatedResponse[domain.Game]{}, logAndWrapError(ctx, err, descriptionFailedListGames, logAttrs...)
+		return domain.GamesPaginatedResponse{}, logAndWrapError(ctx, err, descriptionFailedListGames, logAttrs...)
 	}
 
 	return paginatedGames, nil
@@ -150,7 +167,7 @@ func (s *service) ListGamesRecommended(ctx context.Context, filter domain.GamesR
 	)
 
 	err = s.readOnlyTx(ctx, func(tx pgx.Tx) error {
-		paginatedGames, err = s.dataStore.ListGamesRecommended(ctx, tx, filter)
+		paginatedGames, err = s.recommender.ListGamesRecommended(ctx, tx, filter)
 		return err
 	})
 	if err != nil {
@@ -160,8 +177,8 @@ func (s *service) ListGamesRecommended(ctx context.Context, filter domain.GamesR
 	return paginatedGames, nil
 }
 
-// GetGameByID returns the game with the specified identifier.
-func (s *service) GetGameByID(ctx context.Context, id uuid.UUID) (domain.Game, error) {
+// GetGameByID returns the game with the specified identifier, localized to the best match of the preferred languages.
+func (s *service) GetGameByID(ctx context.Context, id uuid.UUID, preferredLanguages []domain.Language) (domain.Game, error) {
 	logAttrs := []any{
 		slog.String(logging.ServiceMethod, "GetGameByID"),
 		slog.String(logging.GameID, id.String()),
@@ -174,7 +191,18 @@ func (s *service) GetGameByID(ctx context.Context, id uuid.UUID) (domain.Game, e
 
 	err = s.readOnlyTx(ctx, func(tx pgx.Tx) error {
 		game, err = s.dataStore.GetGameByID(ctx, tx, id)
-		return err
+		if err != nil {
+			return err
+		}
+
+		games, err := s.localizeGames(ctx, tx, []domain.Game{game}, preferredLanguages)
+		if err != nil {
+			return err
+		}
+
+		game = games[0]
+
+		return nil
 	})
 	if err != nil {
 		switch {
@@ -188,77 +216,174 @@ func (s *service) GetGameByID(ctx context.Context, id uuid.UUID) (domain.Game, e
 	return game, nil
 }
 
-// PatchGame modifies the game with the specified identifier.
-func (s *service) PatchGame(ctx context.Context, id uuid.UUID, editableGame domain.EditableGamePatch) (domain.Game, error) {
+// PatchGame modifies the game with the specified identifier, and records a revision authored by the specified author.
+// Only games in an editable status can be modified.
+func (s *service) PatchGame(ctx context.Context, id uuid.UUID, editableGame domain.EditableGamePatch, author domain.GameRevisionAuthor) (domain.Game, error) {
 	logAttrs := []any{
 		slog.String(logging.ServiceMethod, "PatchGame"),
 		slog.String(logging.GameID, id.String()),
 	}
 
+	err := validateEditableGamePatch(editableGame)
+	if err != nil {
+		return domain.Game{}, logInfoAndWrapError(ctx, err, descriptionInvalidFieldValue, logAttrs...)
+	}
+
+	var game domain.Game
+
+	err = s.readWriteTx(ctx, func(tx pgx.Tx) error {
+		err := s.checkGameEditable(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		game, err = s.patchGame(ctx, tx, id, editableGame, author)
+		return err
+	})
+	if err != nil {
+		var fieldValueInvalidError *domain.FieldValueInvalidError
+
+		switch {
+		case errors.Is(err, domain.ErrGameNotFound),
+			errors.Is(err, domain.ErrGameNotEditable),
+			errors.Is(err, domain.ErrGamePreviewMultimediaNotFound),
+			errors.Is(err, domain.ErrGameDownloadMultimediaNotFound):
+			return domain.Game{}, logInfoAndWrapError(ctx, err, descriptionFailedPatchGame, logAttrs...)
+		case errors.As(err, &fieldValueInvalidError):
+			return domain.Game{}, logInfoAndWrapError(ctx, err, descriptionInvalidFieldValue, logAttrs...)
+		default:
+			return domain.Game{}, logAndWrapError(ctx, err, descriptionFailedPatchGame, logAttrs...)
+		}
+	}
+
+	return game, nil
+}
+
+// editableGameInvalidFields returns the names of the invalid fields of the editable game, in the order they are
+// validated.
+func editableGameInvalidFields(editableGame domain.EditableGame) []string {
+	var fieldNames []string
+
+	if !editableGame.Title.Valid() {
+		fieldNames = append(fieldNames, domain.FieldTitle)
+	}
+
+	if !editableGame.Price.Valid() {
+		fieldNames = append(fieldNames, domain.FieldPrice)
+	}
+
+	if !editableGame.Description.Valid() {
+		fieldNames = append(fieldNames, domain.FieldDescription)
+	}
+
+	if !editableGame.AgeRating.Valid() {
+		fieldNames = append(fieldNames, domain.FieldAgeRating)
+	}
+
+	if !editableGame.Features.Valid() {
+		fieldNames = append(fieldNames, domain.FieldFeatures)
+	}
+
+	if !editableGame.Languages.Valid() {
+		fieldNames = append(fieldNames, domain.FieldLanguages)
+	}
+
+	if !editableGame.Requirements.Valid() {
+		fieldNames = append(fieldNames, domain.FieldRequirements)
+	}
+
+	if editableGame.ReleaseDate != nil && editableGame.DownloadMultimediaID == nil {
+		fieldNames = append(fieldNames, domain.FieldDownloadMultimediaID)
+	}
+
+	return fieldNames
+}
+
+// validateEditableGamePatch returns a field value invalid error for the first specified field of the game patch that
+// is invalid, nil otherwise.
+func validateEditableGamePatch(editableGame domain.EditableGamePatch) error {
 	if editableGame.Title != nil && !editableGame.Title.Valid() {
-		return domain.Game{}, logInfoAndWrapError(ctx, &domain.FieldValueInvalidError{FieldName: domain.FieldTitle}, descriptionInvalidFieldValue, logAttrs...)
+		return &domain.FieldValueInvalidError{FieldName: domain.FieldTitle}
 	}
 
 	if editableGame.Price != nil && !editableGame.Price.Valid() {
-		return domain.Game{}, logInfoAndWrapError(ctx, &domain.FieldValueInvalidError{FieldName: domain.FieldPrice}, descriptionInvalidFieldValue, logAttrs...)
+		return &domain.FieldValueInvalidError{FieldName: domain.FieldPrice}
 	}
 
 	if editableGame.Description != nil && !editableGame.Description.Valid() {
-		return domain.Game{}, logInfoAndWrapError(ctx, &domain.FieldValueInvalidError{FieldName: domain.FieldDescription}, descriptionInvalidFieldValue, logAttrs...)
+		return &domain.FieldValueInvalidError{FieldName: domain.FieldDescription}
 	}
 
 	if editableGame.AgeRating != nil && !editableGame.AgeRating.Valid() {
-		return domain.Game{}, logInfoAndWrapError(ctx, &domain.FieldValueInvalidError{FieldName: domain.FieldAgeRating}, descriptionInvalidFieldValue, logAttrs...)
+		return &domain.FieldValueInvalidError{FieldName: domain.FieldAgeRating}
 	}
 
 	if editableGame.Features != nil && !editableGame.Features.Valid() {
-		return domain.Game{}, logInfoAndWrapError(ctx, &domain.FieldValueInvalidError{FieldName: domain.FieldFeatures}, descriptionInvalidFieldValue, logAttrs...)
+		return &domain.FieldValueInvalidError{FieldName: domain.FieldFeatures}
 	}
 
 	if editableGame.Languages != nil && !editableGame.Languages.Valid() {
-		return domain.Game{}, logInfoAndWrapError(ctx, &domain.FieldValueInvalidError{FieldName: domain.FieldLanguages}, descriptionInvalidFieldValue, logAttrs...)
+		return &domain.FieldValueInvalidError{FieldName: domain.FieldLanguages}
 	}
 
 	if editableGame.Requirements != nil && !editableGame.Requirements.Valid() {
-		return domain.Game{}, logInfoAndWrapError(ctx, &domain.FieldValueInvalidError{FieldName: domain.FieldRequirements}, descriptionInvalidFieldValue, logAttrs...)
+		return &domain.FieldValueInvalidError{FieldName: domain.FieldRequirements}
 	}
 
-	var (
-		game domain.Game
-		err  error
-	)
+	return nil
+}
 
-	err = s.readWriteTx(ctx, func(tx pgx.Tx) error {
-		err = s.dataStore.PatchGame(ctx, tx, id, editableGame)
-		if err != nil {
-			return err
-		}
+// patchGame modifies the game with the specified identifier within the specified transaction, and records a revision
+// authored by the specified author. The game patch must be already validated.
+func (s *service) patchGame(ctx context.Context, tx pgx.Tx, id uuid.UUID, editableGame domain.EditableGamePatch, author domain.GameRevisionAuthor) (domain.Game, error) {
+	err := s.dataStore.PatchGame(ctx, tx, id, editableGame)
+	if err != nil {
+		return domain.Game{}, err
+	}
 
-		game, err = s.dataStore.GetGameByID(ctx, tx, id)
-		if err != nil {
-			return err
-		}
+	game, err := s.dataStore.GetGameByID(ctx, tx, id)
+	if err != nil {
+		return domain.Game{}, err
+	}
 
-		if game.ReleaseDate != nil && game.DownloadMultimedia == nil {
-			return &domain.FieldValueInvalidError{FieldName: domain.FieldDownloadMultimediaID}
-		}
+	if game.ReleaseDate != nil && game.DownloadMultimedia == nil {
+		return domain.Game{}, &domain.FieldValueInvalidError{FieldName: domain.FieldDownloadMultimediaID}
+	}
 
-		return nil
-	})
+	err = s.recordGameRevision(ctx, tx, game, author)
 	if err != nil {
-		var fieldValueInvalidError *domain.FieldValueInvalidError
+		return domain.Game{}, err
+	}
 
+	return game, nil
+}
+
+// GamePublishedBy returns true if the game with the specified identifier exists and belongs to the publisher with the
+// specified identifier, false otherwise.
+func (s *service) GamePublishedBy(ctx context.Context, publisherID, gameID uuid.UUID) (bool, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "GamePublishedBy"),
+		slog.String(logging.PublisherID, publisherID.String()),
+		slog.String(logging.GameID, gameID.String()),
+	}
+
+	var (
+		gamePublisherID uuid.UUID
+		err             error
+	)
+
+	err = s.readOnlyTx(ctx, func(tx pgx.Tx) error {
+		gamePublisherID, err = s.dataStore.GetGamePublisherID(ctx, tx, gameID)
+		return err
+	})
+	if err != nil {
 		switch {
-		case errors.Is(err, domain.ErrGameNotFound),
-			errors.Is(err, domain.ErrGamePreviewMultimediaNotFound),
-			errors.Is(err, domain.ErrGameDownloadMultimediaNotFound):
-			return domain.Game{}, logInfoAndWrapError(ctx, err, descriptionFailedPatchGame, logAttrs...)
-		case errors.As(err, &fieldValueInvalidError):
-			return domain.Game{}, logInfoAndWrapError(ctx, err, descriptionInvalidFieldValue, logAttrs...)
+		case errors.Is(err, domain.ErrGameNotFound):
+			return false, nil
 		default:
-			return domain.Game{}, logAndWrapError(ctx, err, descriptionFailedPatchGame, logAttrs...)
+			return false, logAndWrapError(ctx, err, descriptionFailedGamePublishedBy, logAttrs...)
 		}
 	}
 
-	return game, nil
+	return gamePublisherID == publisherID, nil
 }
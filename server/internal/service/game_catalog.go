@@ -0,0 +1,292 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+	"github.com/goofr-group/gaming-store/server/internal/logging"
+)
+
+const (
+	descriptionFailedImportGameCatalog = "service: failed to import game catalog"
+	descriptionFailedExportGameCatalog = "service: failed to export game catalog"
+	descriptionInvalidGameCatalog      = "service: invalid game catalog"
+
+	// gameCatalogExportPageSize defines the amount of games read at a time when exporting a game catalog.
+	gameCatalogExportPageSize = 100
+)
+
+// ImportGameCatalog validates the entries of the game catalog and, unless it is a dry run, creates a game for each of
+// them with its tags and multimedia, in a single transaction. No game is created if any entry is invalid, and the
+// invalid fields of each entry are returned in the report.
+func (s *service) ImportGameCatalog(ctx context.Context, publisherID uuid.UUID, catalog domain.GameCatalog, dryRun bool, author domain.GameRevisionAuthor) (domain.GameCatalogImportReport, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "ImportGameCatalog"),
+		slog.String(logging.PublisherID, publisherID.String()),
+		slog.Int(logging.GameCatalogEntries, len(catalog)),
+		slog.Bool(logging.GameCatalogDryRun, dryRun),
+	}
+
+	if !catalog.Valid() {
+		return domain.GameCatalogImportReport{}, logInfoAndWrapError(ctx, &domain.FieldValueInvalidError{FieldName: domain.FieldGames}, descriptionInvalidFieldValue, logAttrs...)
+	}
+
+	report := domain.GameCatalogImportReport{
+		DryRun: dryRun,
+	}
+
+	importFunc := func(tx pgx.Tx) error {
+		publisher, err := s.dataStore.GetPublisherByID(ctx, tx, publisherID)
+		if err != nil {
+			return err
+		}
+
+		if publisher.EmailVerifiedAt == nil {
+			return domain.ErrPublisherEmailNotVerified
+		}
+
+		if publisher.VerificationStatus != domain.PublisherVerificationStatusVerified {
+			return domain.ErrPublisherNotVerified
+		}
+
+		tagIDs, err := s.gameCatalogTagIDs(ctx, tx, catalog)
+		if err != nil {
+			return err
+		}
+
+		report.Errors, err = s.validateGameCatalog(ctx, tx, catalog, tagIDs)
+		if err != nil {
+			return err
+		}
+
+		if dryRun || len(report.Errors) != 0 {
+			return nil
+		}
+
+		report.Games = make([]domain.Game, len(catalog))
+		for i, entry := range catalog {
+			report.Games[i], err = s.createGameCatalogEntry(ctx, tx, publisherID, entry, tagIDs, author)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	var err error
+	if dryRun {
+		err = s.readOnlyTx(ctx, importFunc)
+	} else {
+		err = s.readWriteTx(ctx, importFunc)
+	}
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPublisherNotFound),
+			errors.Is(err, domain.ErrPublisherEmailNotVerified),
+			errors.Is(err, domain.ErrPublisherNotVerified):
+			return domain.GameCatalogImportReport{}, logInfoAndWrapError(ctx, err, descriptionFailedImportGameCatalog, logAttrs...)
+		default:
+			return domain.GameCatalogImportReport{}, logAndWrapError(ctx, err, descriptionFailedImportGameCatalog, logAttrs...)
+		}
+	}
+
+	if len(report.Errors) != 0 {
+		logAttrs = append(logAttrs, slog.Int(logging.GameCatalogErrors, len(report.Errors)))
+		logging.Logger.InfoContext(ctx, descriptionInvalidGameCatalog, logAttrs...)
+	}
+
+	return report, nil
+}
+
+// ExportGameCatalog returns the game catalog of the publisher with the specified identifier, with an entry for each of
+// its games regardless of their status.
+func (s *service) ExportGameCatalog(ctx context.Context, publisherID uuid.UUID) (domain.GameCatalog, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "ExportGameCatalog"),
+		slog.String(logging.PublisherID, publisherID.String()),
+	}
+
+	var catalog domain.GameCatalog
+
+	err := s.readOnlyTx(ctx, func(tx pgx.Tx) error {
+		filter := domain.GamesPaginatedFilter{
+			PublisherID: &publisherID,
+		}
+		filter.Limit = gameCatalogExportPageSize
+
+		for {
+			paginatedGames, err := s.dataStore.ListGames(ctx, tx, filter)
+			if err != nil {
+				return err
+			}
+
+			for _, game := range paginatedGames.Results {
+				catalog = append(catalog, domain.NewGameCatalogEntry(game))
+			}
+
+			filter.Offset += domain.PaginationOffset(len(paginatedGames.Results))
+
+			if len(paginatedGames.Results) == 0 || int(filter.Offset) >= paginatedGames.Total {
+				return nil
+			}
+		}
+	})
+	if err != nil {
+		return nil, logAndWrapError(ctx, err, descriptionFailedExportGameCatalog, logAttrs...)
+	}
+
+	return catalog, nil
+}
+
+// gameCatalogTagIDs returns the identifiers of the existing tags referenced by the game catalog, by name.
+func (s *service) gameCatalogTagIDs(ctx context.Context, tx pgx.Tx, catalog domain.GameCatalog) (map[domain.Name]uuid.UUID, error) {
+	var names []domain.Name
+	for _, entry := range catalog {
+		names = append(names, entry.TagNames...)
+	}
+
+	tagIDs := make(map[domain.Name]uuid.UUID)
+
+	if len(names) == 0 {
+		return tagIDs, nil
+	}
+
+	tags, err := s.dataStore.ListTagsByNames(ctx, tx, names)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tag := range tags {
+		tagIDs[tag.Name] = tag.ID
+	}
+
+	return tagIDs, nil
+}
+
+// validateGameCatalog returns the invalid fields of each entry of the game catalog, including references to tags or
+// multimedia that do not exist.
+func (s *service) validateGameCatalog(ctx context.Context, tx pgx.Tx, catalog domain.GameCatalog, tagIDs map[domain.Name]uuid.UUID) ([]domain.GameCatalogEntryError, error) {
+	var entryErrors []domain.GameCatalogEntryError
+
+	multimediaExists := make(map[uuid.UUID]bool)
+
+	existsMultimedia := func(id uuid.UUID) (bool, error) {
+		exists, ok := multimediaExists[id]
+		if ok {
+			return exists, nil
+		}
+
+		_, err := s.dataStore.GetMultimediaByID(ctx, tx, id)
+		if err != nil && !errors.Is(err, domain.ErrMultimediaNotFound) {
+			return false, err
+		}
+
+		multimediaExists[id] = err == nil
+
+		return err == nil, nil
+	}
+
+	for i, entry := range catalog {
+		fieldNames := editableGameInvalidFields(entry.EditableGame)
+
+		exists, err := existsMultimedia(entry.PreviewMultimediaID)
+		if err != nil {
+			return nil, err
+		}
+
+		if !exists {
+			fieldNames = append(fieldNames, domain.FieldPreviewMultimediaID)
+		}
+
+		if entry.DownloadMultimediaID != nil {
+			exists, err = existsMultimedia(*entry.DownloadMultimediaID)
+			if err != nil {
+				return nil, err
+			}
+
+			if !exists {
+				fieldNames = append(fieldNames, domain.FieldDownloadMultimediaID)
+			}
+		}
+
+		for _, name := range entry.TagNames {
+			if _, ok := tagIDs[name]; !ok {
+				fieldNames = append(fieldNames, domain.FieldTags)
+				break
+			}
+		}
+
+		validMultimedia := entry.ValidMultimediaIDs()
+		for j := 0; validMultimedia && j < len(entry.MultimediaIDs); j++ {
+			validMultimedia, err = existsMultimedia(entry.MultimediaIDs[j])
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if !validMultimedia {
+			fieldNames = append(fieldNames, domain.FieldMultimedia)
+		}
+
+		for _, fieldName := range fieldNames {
+			entryErrors = append(entryErrors, domain.GameCatalogEntryError{
+				Row:                    i + 1,
+				FieldValueInvalidError: domain.FieldValueInvalidError{FieldName: fieldName},
+			})
+		}
+	}
+
+	return entryErrors, nil
+}
+
+// createGameCatalogEntry creates the game of the validated game catalog entry with its tags and multimedia, and records
+// a revision authored by the specified author.
+func (s *service) createGameCatalogEntry(ctx context.Context, tx pgx.Tx, publisherID uuid.UUID, entry domain.GameCatalogEntry, tagIDs map[domain.Name]uuid.UUID, author domain.GameRevisionAuthor) (domain.Game, error) {
+	id, err := s.dataStore.CreateGame(ctx, tx, publisherID, entry.EditableGame)
+	if err != nil {
+		return domain.Game{}, err
+	}
+
+	createdTagIDs := make(map[uuid.UUID]struct{}, len(entry.TagNames))
+
+	for _, name := range entry.TagNames {
+		tagID := tagIDs[name]
+		if _, ok := createdTagIDs[tagID]; ok {
+			continue
+		}
+
+		err = s.dataStore.CreateGameTag(ctx, tx, id, tagID)
+		if err != nil {
+			return domain.Game{}, err
+		}
+
+		createdTagIDs[tagID] = struct{}{}
+	}
+
+	for i, multimediaID := range entry.MultimediaIDs {
+		err = s.dataStore.CreateGameMultimedia(ctx, tx, id, multimediaID, domain.EditableGameMultimedia{
+			Position: domain.GameMultimediaPosition(i),
+		})
+		if err != nil {
+			return domain.Game{}, err
+		}
+	}
+
+	game, err := s.dataStore.GetGameByID(ctx, tx, id)
+	if err != nil {
+		return domain.Game{}, err
+	}
+
+	err = s.recordGameRevision(ctx, tx, game, author)
+	if err != nil {
+		return domain.Game{}, err
+	}
+
+	return game, nil
+}
@@ -0,0 +1,275 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/text/language"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+func TestService_ImportGameCatalog(t *testing.T) {
+	t.Run("should successfully create games with their tags and multimedia", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			publisherID     = uuid.New()
+			author          = domain.GameRevisionAuthor{ID: publisherID, Role: domain.GameRevisionAuthorRolePublisher}
+			emailVerifiedAt = time.Now().UTC()
+			gameID          = uuid.New()
+			tag             = domain.Tag{ID: uuid.New(), Name: "action"}
+			previewID       = uuid.New()
+			multimediaID    = uuid.New()
+
+			entry = domain.GameCatalogEntry{
+				EditableGame: domain.EditableGame{
+					Title:       "game",
+					Price:       9.99,
+					Description: "description",
+					AgeRating:   "18",
+					Features:    "features",
+					Languages:   domain.GameLanguages{domain.Language{Tag: language.Portuguese}},
+					Requirements: domain.GameRequirements{
+						Minimum:     "minimum",
+						Recommended: "recommended",
+					},
+					PreviewMultimediaID: previewID,
+				},
+				TagNames:      []domain.Name{tag.Name, tag.Name},
+				MultimediaIDs: []uuid.UUID{multimediaID},
+			}
+			expectedGame = domain.Game{
+				ID:                gameID,
+				Title:             entry.Title,
+				Price:             entry.Price,
+				Status:            domain.GameStatusDraft,
+				Description:       entry.Description,
+				AgeRating:         entry.AgeRating,
+				Features:          entry.Features,
+				Languages:         entry.Languages,
+				Requirements:      entry.Requirements,
+				PreviewMultimedia: domain.Multimedia{ID: previewID},
+				Multimedia:        []domain.Multimedia{{ID: multimediaID}},
+				Tags:              []domain.Tag{tag},
+			}
+			multimediaPositions = []domain.GameRevisionMultimedia{{MultimediaID: multimediaID, Position: 0}}
+			expectedSnapshot    = domain.NewGameRevisionSnapshot(expectedGame, multimediaPositions)
+			expectedReport      = domain.GameCatalogImportReport{
+				Games: []domain.Game{expectedGame},
+			}
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		gomock.InOrder(
+			s.mockDataStore.EXPECT().
+				GetPublisherByID(ctx, tx, publisherID).
+				Return(domain.Publisher{ID: publisherID, EmailVerifiedAt: &emailVerifiedAt, VerificationStatus: domain.PublisherVerificationStatusVerified}, nil),
+			s.mockDataStore.EXPECT().
+				ListTagsByNames(ctx, tx, entry.TagNames).
+				Return([]domain.Tag{tag}, nil),
+			s.mockDataStore.EXPECT().
+				GetMultimediaByID(ctx, tx, previewID).
+				Return(domain.Multimedia{ID: previewID}, nil),
+			s.mockDataStore.EXPECT().
+				GetMultimediaByID(ctx, tx, multimediaID).
+				Return(domain.Multimedia{ID: multimediaID}, nil),
+			s.mockDataStore.EXPECT().
+				CreateGame(ctx, tx, publisherID, entry.EditableGame).
+				Return(gameID, nil),
+			s.mockDataStore.EXPECT().
+				CreateGameTag(ctx, tx, gameID, tag.ID).
+				Return(nil),
+			s.mockDataStore.EXPECT().
+				CreateGameMultimedia(ctx, tx, gameID, multimediaID, domain.EditableGameMultimedia{Position: 0}).
+				Return(nil),
+			s.mockDataStore.EXPECT().
+				GetGameByID(ctx, tx, gameID).
+				Return(expectedGame, nil),
+			s.mockDataStore.EXPECT().
+				GetGameMultimediaPositions(ctx, tx, gameID).
+				Return(multimediaPositions, nil),
+			s.mockDataStore.EXPECT().
+				GetLatestGameRevision(ctx, tx, gameID).
+				Return(domain.GameRevision{}, domain.ErrGameRevisionNotFound),
+			s.mockDataStore.EXPECT().
+				CreateGameRevision(ctx, tx, gameID, domain.EditableGameRevision{
+					Number:        1,
+					Author:        author,
+					ChangedFields: domain.GameRevisionSnapshot{}.Diff(expectedSnapshot),
+					Snapshot:      expectedSnapshot,
+				}).
+				Return(nil),
+		)
+
+		report, err := s.service.ImportGameCatalog(ctx, publisherID, domain.GameCatalog{entry}, false, author)
+		require.NoError(t, err)
+		assert.Equal(t, expectedReport, report)
+	})
+
+	t.Run("should successfully report the invalid fields of each entry on a dry run", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			publisherID     = uuid.New()
+			author          = domain.GameRevisionAuthor{ID: publisherID, Role: domain.GameRevisionAuthorRolePublisher}
+			emailVerifiedAt = time.Now().UTC()
+			previewID       = uuid.New()
+
+			validEntry = domain.GameCatalogEntry{
+				EditableGame: domain.EditableGame{
+					Title:       "game",
+					Price:       9.99,
+					Description: "description",
+					AgeRating:   "18",
+					Features:    "features",
+					Languages:   domain.GameLanguages{domain.Language{Tag: language.Portuguese}},
+					Requirements: domain.GameRequirements{
+						Minimum:     "minimum",
+						Recommended: "recommended",
+					},
+					PreviewMultimediaID: previewID,
+				},
+			}
+			invalidEntry = domain.GameCatalogEntry{
+				EditableGame: domain.EditableGame{
+					Title:       "other game",
+					Price:       -9.99,
+					Description: "description",
+					AgeRating:   "18",
+					Features:    "features",
+					Languages:   domain.GameLanguages{domain.Language{Tag: language.Portuguese}},
+					Requirements: domain.GameRequirements{
+						Minimum:     "minimum",
+						Recommended: "recommended",
+					},
+					PreviewMultimediaID: uuid.New(),
+				},
+				TagNames: []domain.Name{"unknown"},
+			}
+			expectedReport = domain.GameCatalogImportReport{
+				DryRun: true,
+				Errors: []domain.GameCatalogEntryError{
+					{Row: 2, FieldValueInvalidError: domain.FieldValueInvalidError{FieldName: domain.FieldPrice}},
+					{Row: 2, FieldValueInvalidError: domain.FieldValueInvalidError{FieldName: domain.FieldPreviewMultimediaID}},
+					{Row: 2, FieldValueInvalidError: domain.FieldValueInvalidError{FieldName: domain.FieldTags}},
+				},
+			}
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadOnlyTx(ctx, ctrl)
+
+		gomock.InOrder(
+			s.mockDataStore.EXPECT().
+				GetPublisherByID(ctx, tx, publisherID).
+				Return(domain.Publisher{ID: publisherID, EmailVerifiedAt: &emailVerifiedAt, VerificationStatus: domain.PublisherVerificationStatusVerified}, nil),
+			s.mockDataStore.EXPECT().
+				ListTagsByNames(ctx, tx, invalidEntry.TagNames).
+				Return(nil, nil),
+			s.mockDataStore.EXPECT().
+				GetMultimediaByID(ctx, tx, previewID).
+				Return(domain.Multimedia{ID: previewID}, nil),
+			s.mockDataStore.EXPECT().
+				GetMultimediaByID(ctx, tx, invalidEntry.PreviewMultimediaID).
+				Return(domain.Multimedia{}, domain.ErrMultimediaNotFound),
+		)
+
+		report, err := s.service.ImportGameCatalog(ctx, publisherID, domain.GameCatalog{validEntry, invalidEntry}, true, author)
+		require.NoError(t, err)
+		assert.Equal(t, expectedReport, report)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the catalog is empty", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				publisherID = uuid.New()
+				author      = domain.GameRevisionAuthor{ID: publisherID, Role: domain.GameRevisionAuthorRolePublisher}
+
+				expectedError = fmt.Errorf("%s: %w", descriptionInvalidFieldValue, &domain.FieldValueInvalidError{FieldName: domain.FieldGames})
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+
+			_, err := s.service.ImportGameCatalog(ctx, publisherID, nil, false, author)
+			assert.Equal(t, expectedError, err)
+		})
+
+		t.Run("when the publisher is not verified", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				publisherID     = uuid.New()
+				author          = domain.GameRevisionAuthor{ID: publisherID, Role: domain.GameRevisionAuthorRolePublisher}
+				emailVerifiedAt = time.Now().UTC()
+
+				expectedError = fmt.Errorf("%s: %w", descriptionFailedImportGameCatalog, domain.ErrPublisherNotVerified)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			s.mockDataStore.EXPECT().
+				GetPublisherByID(ctx, tx, publisherID).
+				Return(domain.Publisher{ID: publisherID, EmailVerifiedAt: &emailVerifiedAt, VerificationStatus: domain.PublisherVerificationStatusPending}, nil)
+
+			_, err := s.service.ImportGameCatalog(ctx, publisherID, domain.GameCatalog{{}}, false, author)
+			assert.Equal(t, expectedError, err)
+		})
+	})
+}
+
+func TestService_ExportGameCatalog(t *testing.T) {
+	t.Run("should successfully export every game of the publisher", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			publisherID = uuid.New()
+			game        = domain.Game{
+				ID:                uuid.New(),
+				Title:             "game",
+				Status:            domain.GameStatusPublished,
+				PreviewMultimedia: domain.Multimedia{ID: uuid.New()},
+				Tags:              []domain.Tag{{ID: uuid.New(), Name: "action"}},
+			}
+			expectedFilter = domain.GamesPaginatedFilter{
+				PaginatedRequest: domain.PaginatedRequest[domain.GamePaginatedSort]{
+					PaginatedRequestBase: domain.PaginatedRequestBase{Limit: gameCatalogExportPageSize},
+				},
+				PublisherID: &publisherID,
+			}
+			expectedCatalog = domain.GameCatalog{domain.NewGameCatalogEntry(game)}
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadOnlyTx(ctx, ctrl)
+
+		s.mockDataStore.EXPECT().
+			ListGames(ctx, tx, expectedFilter).
+			Return(domain.PaginatedResponse[domain.Game]{Total: 1, Results: []domain.Game{game}}, nil)
+
+		catalog, err := s.service.ExportGameCatalog(ctx, publisherID)
+		require.NoError(t, err)
+		assert.Equal(t, expectedCatalog, catalog)
+	})
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+	"github.com/goofr-group/gaming-store/server/internal/logging"
+)
+
+const (
+	descriptionFailedListGamesChart     = "service: failed to list games chart"
+	descriptionFailedRefreshGamesCharts = "service: failed to refresh games charts"
+)
+
+// ListGamesChart returns the games of the chart with the specified filter.
+func (s *service) ListGamesChart(ctx context.Context, filter domain.GamesChartPaginatedFilter) (domain.PaginatedResponse[domain.Game], error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "ListGamesChart"),
+	}
+
+	if !filter.Limit.Valid() {
+		return domain.PaginatedResponse[domain.Game]{}, logInfoAndWrapError(ctx, &domain.FilterValueInvalidError{FilterName: domain.FieldFilterLimit}, descriptionInvalidFilterValue, logAttrs...)
+	}
+
+	if !filter.Offset.Valid() {
+		return domain.PaginatedResponse[domain.Game]{}, logInfoAndWrapError(ctx, &domain.FilterValueInvalidError{FilterName: domain.FieldFilterOffset}, descriptionInvalidFilterValue, logAttrs...)
+	}
+
+	if !filter.Type.Valid() {
+		return domain.PaginatedResponse[domain.Game]{}, logInfoAndWrapError(ctx, &domain.FilterValueInvalidError{FilterName: domain.FieldFilterType}, descriptionInvalidFilterValue, logAttrs...)
+	}
+
+	if !filter.Window.Valid() {
+		return domain.PaginatedResponse[domain.Game]{}, logInfoAndWrapError(ctx, &domain.FilterValueInvalidError{FilterName: domain.FieldFilterWindow}, descriptionInvalidFilterValue, logAttrs...)
+	}
+
+	var (
+		paginatedGames domain.PaginatedResponse[domain.Game]
+		err            error
+	)
+
+	err = s.readOnlyTx(ctx, func(tx pgx.Tx) error {
+		paginatedGames, err = s.dataStore.ListGamesChart(ctx, tx, filter)
+		return err
+	})
+	if err != nil {
+		return domain.PaginatedResponse[domain.Game]{}, logAndWrapError(ctx, err, descriptionFailedListGamesChart, logAttrs...)
+	}
+
+	return paginatedGames, nil
+}
+
+// RefreshGamesCharts recomputes the charts of every type and window.
+// It is meant to be executed periodically. Only the instance holding the lock of the job executes it.
+func (s *service) RefreshGamesCharts(ctx context.Context) error {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "RefreshGamesCharts"),
+	}
+
+	err := s.periodicJobTx(ctx, domain.PeriodicJobGamesCharts, func(tx pgx.Tx) error {
+		return s.dataStore.RefreshGamesCharts(ctx, tx)
+	})
+	if err != nil {
+		return logAndWrapError(ctx, err, descriptionFailedRefreshGamesCharts, logAttrs...)
+	}
+
+	return nil
+}
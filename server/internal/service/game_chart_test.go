@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+func TestService_ListGamesChart(t *testing.T) {
+	t.Run("should successfully list games chart", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			filter = domain.GamesChartPaginatedFilter{
+				PaginatedRequestBase: domain.PaginatedRequestBase{
+					Limit: 10,
+				},
+				Type:   domain.GameChartTypeTrending,
+				Window: domain.GameChartWindow7d,
+			}
+			expectedPaginatedGames = domain.PaginatedResponse[domain.Game]{
+				Total: 1,
+				Results: []domain.Game{
+					{ID: uuid.New()},
+				},
+			}
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadOnlyTx(ctx, ctrl)
+
+		s.mockDataStore.EXPECT().
+			ListGamesChart(ctx, tx, filter).
+			Return(expectedPaginatedGames, nil)
+
+		paginatedGames, err := s.service.ListGamesChart(ctx, filter)
+		require.NoError(t, err)
+		assert.Equal(t, expectedPaginatedGames, paginatedGames)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when a filter is invalid", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				filter = domain.GamesChartPaginatedFilter{
+					PaginatedRequestBase: domain.PaginatedRequestBase{
+						Limit: 10,
+					},
+					Type:   "popular",
+					Window: domain.GameChartWindow7d,
+				}
+
+				expectedError = fmt.Errorf("%s: %w", descriptionInvalidFilterValue, &domain.FilterValueInvalidError{FilterName: domain.FieldFilterType})
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+
+			_, err := s.service.ListGamesChart(ctx, filter)
+			assert.Equal(t, expectedError, err)
+		})
+
+		t.Run("when the store fails", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				filter = domain.GamesChartPaginatedFilter{
+					PaginatedRequestBase: domain.PaginatedRequestBase{
+						Limit: 10,
+					},
+					Type:   domain.GameChartTypeTopSellers,
+					Window: domain.GameChartWindow30d,
+				}
+
+				errTest       = errors.New("error test")
+				expectedError = fmt.Errorf("%s: %w", descriptionFailedListGamesChart, errTest)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadOnlyTx(ctx, ctrl)
+
+			s.mockDataStore.EXPECT().
+				ListGamesChart(ctx, tx, filter).
+				Return(domain.PaginatedResponse[domain.Game]{}, errTest)
+
+			_, err := s.service.ListGamesChart(ctx, filter)
+			assert.Equal(t, expectedError, err)
+		})
+	})
+}
+
+func TestService_RefreshGamesCharts(t *testing.T) {
+	t.Run("should successfully refresh games charts", func(t *testing.T) {
+		ctx := context.Background()
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		gomock.InOrder(
+			s.mockDataStore.EXPECT().
+				AcquirePeriodicJobLock(ctx, tx, domain.PeriodicJobGamesCharts).
+				Return(true, nil),
+			s.mockDataStore.EXPECT().
+				RefreshGamesCharts(ctx, tx).
+				Return(nil),
+		)
+
+		err := s.service.RefreshGamesCharts(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("should successfully skip the refresh when another instance holds the lock", func(t *testing.T) {
+		ctx := context.Background()
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		s.mockDataStore.EXPECT().
+			AcquirePeriodicJobLock(ctx, tx, domain.PeriodicJobGamesCharts).
+			Return(false, nil)
+
+		err := s.service.RefreshGamesCharts(ctx)
+		require.NoError(t, err)
+	})
+}
@@ -17,8 +17,8 @@ const (
 	descriptionFailedDeleteGameMultimedia = "service: failed to delete game multimedia association"
 )
 
-// CreateGameMultimedia creates a game multimedia association.
-func (s *service) CreateGameMultimedia(ctx context.Context, gameID, multimediaID uuid.UUID, editableGameMultimedia domain.EditableGameMultimedia) error {
+// CreateGameMultimedia creates a game multimedia association, and records a revision authored by the specified author.
+func (s *service) CreateGameMultimedia(ctx context.Context, gameID, multimediaID uuid.UUID, editableGameMultimedia domain.EditableGameMultimedia, author domain.GameRevisionAuthor) error {
 	logAttrs := []any{
 		slog.String(logging.ServiceMethod, "CreateGameMultimedia"),
 		slog.String(logging.GameID, gameID.String()),
@@ -26,12 +26,28 @@ func (s *service) CreateGameMultimedia(ctx context.Context, gameID, multimediaID
 	}
 
 	err := s.readWriteTx(ctx, func(tx pgx.Tx) error {
-		return s.dataStore.CreateGameMultimedia(ctx, tx, gameID, multimediaID, editableGameMultimedia)
+		err := s.checkGameEditable(ctx, tx, gameID)
+		if err != nil {
+			return err
+		}
+
+		err = s.dataStore.CreateGameMultimedia(ctx, tx, gameID, multimediaID, editableGameMultimedia)
+		if err != nil {
+			return err
+		}
+
+		game, err := s.dataStore.GetGameByID(ctx, tx, gameID)
+		if err != nil {
+			return err
+		}
+
+		return s.recordGameRevision(ctx, tx, game, author)
 	})
 	if err != nil {
 		switch {
 		case errors.Is(err, domain.ErrGameMultimediaAlreadyExists),
 			errors.Is(err, domain.ErrGameNotFound),
+			errors.Is(err, domain.ErrGameNotEditable),
 			errors.Is(err, domain.ErrMultimediaNotFound),
 			errors.Is(err, domain.ErrGameMultimediaPositionAlreadyExists):
 			return logInfoAndWrapError(ctx, err, descriptionFailedCreateGameMultimedia, logAttrs...)
@@ -43,8 +59,9 @@ func (s *service) CreateGameMultimedia(ctx context.Context, gameID, multimediaID
 	return nil
 }
 
-// DeleteGameMultimedia deletes the game multimedia association.
-func (s *service) DeleteGameMultimedia(ctx context.Context, gameID, multimediaID uuid.UUID) error {
+// DeleteGameMultimedia deletes the game multimedia association, and records a revision authored by the specified
+// author.
+func (s *service) DeleteGameMultimedia(ctx context.Context, gameID, multimediaID uuid.UUID, author domain.GameRevisionAuthor) error {
 	logAttrs := []any{
 		slog.String(logging.ServiceMethod, "DeleteGameMultimedia"),
 		slog.String(logging.GameID, gameID.String()),
@@ -52,11 +69,28 @@ func (s *service) DeleteGameMultimedia(ctx context.Context, gameID, multimediaID
 	}
 
 	err := s.readWriteTx(ctx, func(tx pgx.Tx) error {
-		return s.dataStore.DeleteGameMultimedia(ctx, tx, gameID, multimediaID)
+		err := s.checkGameEditable(ctx, tx, gameID)
+		if err != nil {
+			return err
+		}
+
+		err = s.dataStore.DeleteGameMultimedia(ctx, tx, gameID, multimediaID)
+		if err != nil {
+			return err
+		}
+
+		game, err := s.dataStore.GetGameByID(ctx, tx, gameID)
+		if err != nil {
+			return err
+		}
+
+		return s.recordGameRevision(ctx, tx, game, author)
 	})
 	if err != nil {
 		switch {
-		case errors.Is(err, domain.ErrGameMultimediaNotFound):
+		case errors.Is(err, domain.ErrGameMultimediaNotFound),
+			errors.Is(err, domain.ErrGameNotFound),
+			errors.Is(err, domain.ErrGameNotEditable):
 			return logInfoAndWrapError(ctx, err, descriptionFailedDeleteGameMultimedia, logAttrs...)
 		default:
 			return logAndWrapError(ctx, err, descriptionFailedDeleteGameMultimedia, logAttrs...)
@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+	"github.com/goofr-group/gaming-store/server/internal/logging"
+)
+
+const (
+	descriptionFailedListGameRevisions    = "service: failed to list game revisions"
+	descriptionFailedGetGameRevisionDiff  = "service: failed to get game revision diff"
+	descriptionFailedRollbackGameRevision = "service: failed to rollback game revision"
+)
+
+// ListGameRevisions returns the revisions of the store page of the game with the specified identifier, newest first.
+func (s *service) ListGameRevisions(ctx context.Context, gameID uuid.UUID, filter domain.GameRevisionsPaginatedFilter) (domain.PaginatedResponse[domain.GameRevision], error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "ListGameRevisions"),
+		slog.String(logging.GameID, gameID.String()),
+	}
+
+	if !filter.Limit.Valid() {
+		return domain.PaginatedResponse[domain.GameRevision]{}, logInfoAndWrapError(ctx, &domain.FilterValueInvalidError{FilterName: domain.FieldFilterLimit}, descriptionInvalidFilterValue, logAttrs...)
+	}
+
+	if !filter.Offset.Valid() {
+		return domain.PaginatedResponse[domain.GameRevision]{}, logInfoAndWrapError(ctx, &domain.FilterValueInvalidError{FilterName: domain.FieldFilterOffset}, descriptionInvalidFilterValue, logAttrs...)
+	}
+
+	var (
+		paginatedGameRevisions domain.PaginatedResponse[domain.GameRevision]
+		err                    error
+	)
+
+	err = s.readOnlyTx(ctx, func(tx pgx.Tx) error {
+		paginatedGameRevisions, err = s.dataStore.ListGameRevisions(ctx, tx, gameID, filter)
+		return err
+	})
+	if err != nil {
+		return domain.PaginatedResponse[domain.GameRevision]{}, logAndWrapError(ctx, err, descriptionFailedListGameRevisions, logAttrs...)
+	}
+
+	return paginatedGameRevisions, nil
+}
+
+// GetGameRevisionDiff returns the fields of the store page of the game with the specified identifier that differ
+// between the revisions with the specified numbers.
+func (s *service) GetGameRevisionDiff(ctx context.Context, gameID uuid.UUID, fromNumber, toNumber int) (domain.GameRevisionDiff, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "GetGameRevisionDiff"),
+		slog.String(logging.GameID, gameID.String()),
+		slog.Int(logging.GameRevisionFromNumber, fromNumber),
+		slog.Int(logging.GameRevisionToNumber, toNumber),
+	}
+
+	var gameRevisionDiff domain.GameRevisionDiff
+
+	err := s.readOnlyTx(ctx, func(tx pgx.Tx) error {
+		var err error
+
+		gameRevisionDiff.From, err = s.dataStore.GetGameRevision(ctx, tx, gameID, fromNumber)
+		if err != nil {
+			return err
+		}
+
+		gameRevisionDiff.To, err = s.dataStore.GetGameRevision(ctx, tx, gameID, toNumber)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrGameRevisionNotFound):
+			return domain.GameRevisionDiff{}, logInfoAndWrapError(ctx, err, descriptionFailedGetGameRevisionDiff, logAttrs...)
+		default:
+			return domain.GameRevisionDiff{}, logAndWrapError(ctx, err, descriptionFailedGetGameRevisionDiff, logAttrs...)
+		}
+	}
+
+	gameRevisionDiff.Fields = gameRevisionDiff.From.Snapshot.Diff(gameRevisionDiff.To.Snapshot)
+
+	return gameRevisionDiff, nil
+}
+
+// RollbackGameRevision restores the store page of the game with the specified identifier to the revision with the
+// specified number, and records a new revision authored by the specified author. The restored fields go through the
+// same validation as a game patch, and only games in an editable status can be restored.
+func (s *service) RollbackGameRevision(ctx context.Context, gameID uuid.UUID, number int, author domain.GameRevisionAuthor) (domain.Game, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "RollbackGameRevision"),
+		slog.String(logging.GameID, gameID.String()),
+		slog.Int(logging.GameRevisionNumber, number),
+	}
+
+	var game domain.Game
+
+	err := s.readWriteTx(ctx, func(tx pgx.Tx) error {
+		err := s.checkGameEditable(ctx, tx, gameID)
+		if err != nil {
+			return err
+		}
+
+		gameRevision, err := s.dataStore.GetGameRevision(ctx, tx, gameID, number)
+		if err != nil {
+			return err
+		}
+
+		editableGame := gameRevision.Snapshot.EditableGamePatch()
+
+		err = validateEditableGamePatch(editableGame)
+		if err != nil {
+			return err
+		}
+
+		err = s.dataStore.ReplaceGameMultimedia(ctx, tx, gameID, gameRevision.Snapshot.Multimedia)
+		if err != nil {
+			return err
+		}
+
+		game, err = s.patchGame(ctx, tx, gameID, editableGame, author)
+		return err
+	})
+	if err != nil {
+		var fieldValueInvalidError *domain.FieldValueInvalidError
+
+		switch {
+		case errors.Is(err, domain.ErrGameRevisionNotFound),
+			errors.Is(err, domain.ErrGameNotFound),
+			errors.Is(err, domain.ErrGameNotEditable),
+			errors.Is(err, domain.ErrMultimediaNotFound),
+			errors.Is(err, domain.ErrGamePreviewMultimediaNotFound),
+			errors.Is(err, domain.ErrGameDownloadMultimediaNotFound):
+			return domain.Game{}, logInfoAndWrapError(ctx, err, descriptionFailedRollbackGameRevision, logAttrs...)
+		case errors.As(err, &fieldValueInvalidError):
+			return domain.Game{}, logInfoAndWrapError(ctx, err, descriptionInvalidFieldValue, logAttrs...)
+		default:
+			return domain.Game{}, logAndWrapError(ctx, err, descriptionFailedRollbackGameRevision, logAttrs...)
+		}
+	}
+
+	return game, nil
+}
+
+// recordGameRevision records a revision of the store page of the specified game, authored by the specified author, if
+// it changed since the latest revision.
+func (s *service) recordGameRevision(ctx context.Context, tx pgx.Tx, game domain.Game, author domain.GameRevisionAuthor) error {
+	multimedia, err := s.dataStore.GetGameMultimediaPositions(ctx, tx, game.ID)
+	if err != nil {
+		return err
+	}
+
+	snapshot := domain.NewGameRevisionSnapshot(game, multimedia)
+
+	latestGameRevision, err := s.dataStore.GetLatestGameRevision(ctx, tx, game.ID)
+	if err != nil && !errors.Is(err, domain.ErrGameRevisionNotFound) {
+		return err
+	}
+
+	changedFields := latestGameRevision.Snapshot.Diff(snapshot)
+	if len(changedFields) == 0 {
+		return nil
+	}
+
+	return s.dataStore.CreateGameRevision(ctx, tx, game.ID, domain.EditableGameRevision{
+		Number:        latestGameRevision.Number + 1,
+		Author:        author,
+		ChangedFields: changedFields,
+		Snapshot:      snapshot,
+	})
+}
@@ -0,0 +1,481 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+func TestService_ListGameRevisions(t *testing.T) {
+	t.Run("should successfully list game revisions", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			gameID = uuid.New()
+			filter = domain.GameRevisionsPaginatedFilter{
+				PaginatedRequestBase: domain.PaginatedRequestBase{Limit: 10, Offset: 0},
+			}
+
+			expectedPaginatedGameRevisions = domain.PaginatedResponse[domain.GameRevision]{
+				Total: 1,
+				Results: []domain.GameRevision{
+					{
+						ID:     uuid.New(),
+						GameID: gameID,
+						EditableGameRevision: domain.EditableGameRevision{
+							Number:        1,
+							ChangedFields: []domain.GameRevisionField{domain.GameRevisionFieldTitle},
+						},
+					},
+				},
+			}
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadOnlyTx(ctx, ctrl)
+
+		s.mockDataStore.EXPECT().
+			ListGameRevisions(ctx, tx, gameID, filter).
+			Return(expectedPaginatedGameRevisions, nil)
+
+		paginatedGameRevisions, err := s.service.ListGameRevisions(ctx, gameID, filter)
+		require.NoError(t, err)
+		assert.Equal(t, expectedPaginatedGameRevisions, paginatedGameRevisions)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the limit is invalid", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				filter = domain.GameRevisionsPaginatedFilter{
+					PaginatedRequestBase: domain.PaginatedRequestBase{Limit: 0, Offset: 0},
+				}
+
+				expectedError = fmt.Errorf("%s: %w", descriptionInvalidFilterValue, &domain.FilterValueInvalidError{FilterName: domain.FieldFilterLimit})
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+
+			_, err := s.service.ListGameRevisions(ctx, uuid.New(), filter)
+			assert.Equal(t, expectedError, err)
+		})
+	})
+}
+
+func TestService_GetGameRevisionDiff(t *testing.T) {
+	t.Run("should successfully compare game revisions", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			gameID       = uuid.New()
+			multimediaID = uuid.New()
+
+			from = domain.GameRevision{
+				ID:     uuid.New(),
+				GameID: gameID,
+				EditableGameRevision: domain.EditableGameRevision{
+					Number: 1,
+					Snapshot: domain.GameRevisionSnapshot{
+						Title:       "game",
+						Description: "description",
+						Multimedia:  []domain.GameRevisionMultimedia{{MultimediaID: multimediaID, Position: 0}},
+					},
+				},
+			}
+			to = domain.GameRevision{
+				ID:     uuid.New(),
+				GameID: gameID,
+				EditableGameRevision: domain.EditableGameRevision{
+					Number: 3,
+					Snapshot: domain.GameRevisionSnapshot{
+						Title:       "renamed",
+						Description: "description",
+						Multimedia:  []domain.GameRevisionMultimedia{{MultimediaID: multimediaID, Position: 1}},
+					},
+				},
+			}
+
+			expectedGameRevisionDiff = domain.GameRevisionDiff{
+				From:   from,
+				To:     to,
+				Fields: []domain.GameRevisionField{domain.GameRevisionFieldTitle, domain.GameRevisionFieldMultimedia},
+			}
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadOnlyTx(ctx, ctrl)
+
+		gomock.InOrder(
+			s.mockDataStore.EXPECT().
+				GetGameRevision(ctx, tx, gameID, from.Number).
+				Return(from, nil),
+			s.mockDataStore.EXPECT().
+				GetGameRevision(ctx, tx, gameID, to.Number).
+				Return(to, nil),
+		)
+
+		gameRevisionDiff, err := s.service.GetGameRevisionDiff(ctx, gameID, from.Number, to.Number)
+		require.NoError(t, err)
+		assert.Equal(t, expectedGameRevisionDiff, gameRevisionDiff)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when a revision is not found", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				gameID = uuid.New()
+
+				expectedError = fmt.Errorf("%s: %w", descriptionFailedGetGameRevisionDiff, domain.ErrGameRevisionNotFound)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadOnlyTx(ctx, ctrl)
+
+			s.mockDataStore.EXPECT().
+				GetGameRevision(ctx, tx, gameID, 1).
+				Return(domain.GameRevision{}, domain.ErrGameRevisionNotFound)
+
+			_, err := s.service.GetGameRevisionDiff(ctx, gameID, 1, 2)
+			assert.Equal(t, expectedError, err)
+		})
+	})
+}
+
+func TestService_RollbackGameRevision(t *testing.T) {
+	t.Run("should successfully rollback game revision", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			gameID              = uuid.New()
+			previewMultimediaID = uuid.New()
+			multimediaID        = uuid.New()
+			author              = domain.GameRevisionAuthor{ID: uuid.New(), Role: domain.GameRevisionAuthorRoleUser}
+
+			multimedia = []domain.GameRevisionMultimedia{{MultimediaID: multimediaID, Position: 0}}
+			snapshot   = domain.GameRevisionSnapshot{
+				Title:               "game",
+				Price:               9.99,
+				Description:         "description",
+				AgeRating:           "18",
+				Features:            "features",
+				Languages:           domain.GameLanguages{domain.Language{}},
+				Requirements:        domain.GameRequirements{Minimum: "minimum", Recommended: "recommended"},
+				PreviewMultimediaID: previewMultimediaID,
+				Multimedia:          multimedia,
+			}
+			gameRevision = domain.GameRevision{
+				ID:     uuid.New(),
+				GameID: gameID,
+				EditableGameRevision: domain.EditableGameRevision{
+					Number:   1,
+					Snapshot: snapshot,
+				},
+			}
+			latestGameRevision = domain.GameRevision{
+				ID:     uuid.New(),
+				GameID: gameID,
+				EditableGameRevision: domain.EditableGameRevision{
+					Number: 2,
+					Snapshot: domain.GameRevisionSnapshot{
+						Title:               "renamed",
+						Price:               snapshot.Price,
+						Description:         snapshot.Description,
+						AgeRating:           snapshot.AgeRating,
+						Features:            snapshot.Features,
+						Languages:           snapshot.Languages,
+						Requirements:        snapshot.Requirements,
+						PreviewMultimediaID: previewMultimediaID,
+					},
+				},
+			}
+			expectedGame = domain.Game{
+				ID:                gameID,
+				Title:             snapshot.Title,
+				Price:             snapshot.Price,
+				Description:       snapshot.Description,
+				AgeRating:         snapshot.AgeRating,
+				Features:          snapshot.Features,
+				Languages:         snapshot.Languages,
+				Requirements:      snapshot.Requirements,
+				PreviewMultimedia: domain.Multimedia{ID: previewMultimediaID},
+				Multimedia:        []domain.Multimedia{{ID: multimediaID}},
+				CreatedAt:         time.Now(),
+				ModifiedAt:        time.Now(),
+			}
+			expectedEditableGameRevision = domain.EditableGameRevision{
+				Number:        3,
+				Author:        author,
+				ChangedFields: []domain.GameRevisionField{domain.GameRevisionFieldTitle, domain.GameRevisionFieldMultimedia},
+				Snapshot:      snapshot,
+			}
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		gomock.InOrder(
+			s.mockDataStore.EXPECT().
+				GetGameByID(ctx, tx, gameID).
+				Return(domain.Game{ID: gameID, Status: domain.GameStatusDraft}, nil),
+			s.mockDataStore.EXPECT().
+				GetGameRevision(ctx, tx, gameID, gameRevision.Number).
+				Return(gameRevision, nil),
+			s.mockDataStore.EXPECT().
+				ReplaceGameMultimedia(ctx, tx, gameID, multimedia).
+				Return(nil),
+			s.mockDataStore.EXPECT().
+				PatchGame(ctx, tx, gameID, snapshot.EditableGamePatch()).
+				Return(nil),
+			s.mockDataStore.EXPECT().
+				GetGameByID(ctx, tx, gameID).
+				Return(expectedGame, nil),
+			s.mockDataStore.EXPECT().
+				GetGameMultimediaPositions(ctx, tx, gameID).
+				Return(multimedia, nil),
+			s.mockDataStore.EXPECT().
+				GetLatestGameRevision(ctx, tx, gameID).
+				Return(latestGameRevision, nil),
+			s.mockDataStore.EXPECT().
+				CreateGameRevision(ctx, tx, gameID, expectedEditableGameRevision).
+				Return(nil),
+		)
+
+		game, err := s.service.RollbackGameRevision(ctx, gameID, gameRevision.Number, author)
+		require.NoError(t, err)
+		assert.Equal(t, expectedGame, game)
+	})
+
+	t.Run("should successfully skip revision when nothing changed", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			gameID              = uuid.New()
+			previewMultimediaID = uuid.New()
+			author              = domain.GameRevisionAuthor{ID: uuid.New(), Role: domain.GameRevisionAuthorRolePublisher}
+
+			snapshot = domain.GameRevisionSnapshot{
+				Title:               "game",
+				Price:               9.99,
+				Description:         "description",
+				AgeRating:           "18",
+				Features:            "features",
+				Languages:           domain.GameLanguages{domain.Language{}},
+				Requirements:        domain.GameRequirements{Minimum: "minimum", Recommended: "recommended"},
+				PreviewMultimediaID: previewMultimediaID,
+			}
+			gameRevision = domain.GameRevision{
+				ID:     uuid.New(),
+				GameID: gameID,
+				EditableGameRevision: domain.EditableGameRevision{
+					Number:   1,
+					Snapshot: snapshot,
+				},
+			}
+			expectedGame = domain.Game{
+				ID:                gameID,
+				Title:             snapshot.Title,
+				Price:             snapshot.Price,
+				Description:       snapshot.Description,
+				AgeRating:         snapshot.AgeRating,
+				Features:          snapshot.Features,
+				Languages:         snapshot.Languages,
+				Requirements:      snapshot.Requirements,
+				PreviewMultimedia: domain.Multimedia{ID: previewMultimediaID},
+			}
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		gomock.InOrder(
+			s.mockDataStore.EXPECT().
+				GetGameByID(ctx, tx, gameID).
+				Return(domain.Game{ID: gameID, Status: domain.GameStatusDraft}, nil),
+			s.mockDataStore.EXPECT().
+				GetGameRevision(ctx, tx, gameID, gameRevision.Number).
+				Return(gameRevision, nil),
+			s.mockDataStore.EXPECT().
+				ReplaceGameMultimedia(ctx, tx, gameID, nil).
+				Return(nil),
+			s.mockDataStore.EXPECT().
+				PatchGame(ctx, tx, gameID, snapshot.EditableGamePatch()).
+				Return(nil),
+			s.mockDataStore.EXPECT().
+				GetGameByID(ctx, tx, gameID).
+				Return(expectedGame, nil),
+			s.mockDataStore.EXPECT().
+				GetGameMultimediaPositions(ctx, tx, gameID).
+				Return(nil, nil),
+			s.mockDataStore.EXPECT().
+				GetLatestGameRevision(ctx, tx, gameID).
+				Return(gameRevision, nil),
+		)
+
+		game, err := s.service.RollbackGameRevision(ctx, gameID, gameRevision.Number, author)
+		require.NoError(t, err)
+		assert.Equal(t, expectedGame, game)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the game is not editable", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				gameID = uuid.New()
+				author = domain.GameRevisionAuthor{ID: uuid.New(), Role: domain.GameRevisionAuthorRolePublisher}
+
+				expectedError = fmt.Errorf("%s: %w", descriptionFailedRollbackGameRevision, domain.ErrGameNotEditable)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			s.mockDataStore.EXPECT().
+				GetGameByID(ctx, tx, gameID).
+				Return(domain.Game{ID: gameID, Status: domain.GameStatusPublished}, nil)
+
+			_, err := s.service.RollbackGameRevision(ctx, gameID, 1, author)
+			assert.Equal(t, expectedError, err)
+		})
+
+		t.Run("when the revision is not found", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				gameID = uuid.New()
+				author = domain.GameRevisionAuthor{ID: uuid.New(), Role: domain.GameRevisionAuthorRolePublisher}
+
+				expectedError = fmt.Errorf("%s: %w", descriptionFailedRollbackGameRevision, domain.ErrGameRevisionNotFound)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			s.mockDataStore.EXPECT().
+				GetGameByID(ctx, tx, gameID).
+				Return(domain.Game{ID: gameID, Status: domain.GameStatusDraft}, nil)
+
+			s.mockDataStore.EXPECT().
+				GetGameRevision(ctx, tx, gameID, 1).
+				Return(domain.GameRevision{}, domain.ErrGameRevisionNotFound)
+
+			_, err := s.service.RollbackGameRevision(ctx, gameID, 1, author)
+			assert.Equal(t, expectedError, err)
+		})
+
+		t.Run("when a field of the revision is invalid", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				gameID = uuid.New()
+				author = domain.GameRevisionAuthor{ID: uuid.New(), Role: domain.GameRevisionAuthorRolePublisher}
+
+				gameRevision = domain.GameRevision{
+					ID:     uuid.New(),
+					GameID: gameID,
+					EditableGameRevision: domain.EditableGameRevision{
+						Number: 1,
+						Snapshot: domain.GameRevisionSnapshot{
+							Title: "",
+						},
+					},
+				}
+
+				expectedError = fmt.Errorf("%s: %w", descriptionInvalidFieldValue, &domain.FieldValueInvalidError{FieldName: domain.FieldTitle})
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			s.mockDataStore.EXPECT().
+				GetGameByID(ctx, tx, gameID).
+				Return(domain.Game{ID: gameID, Status: domain.GameStatusDraft}, nil)
+
+			s.mockDataStore.EXPECT().
+				GetGameRevision(ctx, tx, gameID, gameRevision.Number).
+				Return(gameRevision, nil)
+
+			_, err := s.service.RollbackGameRevision(ctx, gameID, gameRevision.Number, author)
+			assert.Equal(t, expectedError, err)
+		})
+
+		t.Run("when the store fails", func(t *testing.T) {
+			t.Run("when replacing the multimedia", func(t *testing.T) {
+				var (
+					ctx = context.Background()
+
+					gameID = uuid.New()
+					author = domain.GameRevisionAuthor{ID: uuid.New(), Role: domain.GameRevisionAuthorRolePublisher}
+
+					gameRevision = domain.GameRevision{
+						ID:     uuid.New(),
+						GameID: gameID,
+						EditableGameRevision: domain.EditableGameRevision{
+							Number: 1,
+							Snapshot: domain.GameRevisionSnapshot{
+								Title:        "game",
+								Description:  "description",
+								AgeRating:    "18",
+								Features:     "features",
+								Languages:    domain.GameLanguages{domain.Language{}},
+								Requirements: domain.GameRequirements{Minimum: "minimum", Recommended: "recommended"},
+							},
+						},
+					}
+
+					errTest       = errors.New("error test")
+					expectedError = fmt.Errorf("%s: %w", descriptionFailedRollbackGameRevision, errTest)
+				)
+
+				ctrl := gomock.NewController(t)
+
+				s := newServiceTest(ctrl)
+				tx := s.newReadWriteTx(ctx, ctrl)
+
+				gomock.InOrder(
+					s.mockDataStore.EXPECT().
+						GetGameByID(ctx, tx, gameID).
+						Return(domain.Game{ID: gameID, Status: domain.GameStatusDraft}, nil),
+					s.mockDataStore.EXPECT().
+						GetGameRevision(ctx, tx, gameID, gameRevision.Number).
+						Return(gameRevision, nil),
+					s.mockDataStore.EXPECT().
+						ReplaceGameMultimedia(ctx, tx, gameID, nil).
+						Return(errTest),
+				)
+
+				_, err := s.service.RollbackGameRevision(ctx, gameID, gameRevision.Number, author)
+				assert.Equal(t, expectedError, err)
+			})
+		})
+	})
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+	"github.com/goofr-group/gaming-store/server/internal/logging"
+)
+
+const descriptionFailedListPublisherGameSales = "service: failed to list publisher game sales"
+
+// ListPublisherGameSales returns the sales of every game of the publisher with the specified identifier.
+func (s *service) ListPublisherGameSales(ctx context.Context, publisherID uuid.UUID) ([]domain.GameSales, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "ListPublisherGameSales"),
+		slog.String(logging.PublisherID, publisherID.String()),
+	}
+
+	var gamesSales []domain.GameSales
+
+	err := s.readOnlyTx(ctx, func(tx pgx.Tx) error {
+		_, err := s.dataStore.GetPublisherByID(ctx, tx, publisherID)
+		if err != nil {
+			return err
+		}
+
+		gamesSales, err = s.dataStore.ListPublisherGameSales(ctx, tx, publisherID)
+		return err
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPublisherNotFound):
+			return nil, logInfoAndWrapError(ctx, err, descriptionFailedListPublisherGameSales, logAttrs...)
+		default:
+			return nil, logAndWrapError(ctx, err, descriptionFailedListPublisherGameSales, logAttrs...)
+		}
+	}
+
+	return gamesSales, nil
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+func TestService_ListPublisherGameSales(t *testing.T) {
+	t.Run("should successfully list publisher game sales", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			publisher = domain.Publisher{ID: uuid.New()}
+
+			expectedGamesSales = []domain.GameSales{
+				{
+					GameID:  uuid.New(),
+					Title:   "title",
+					Units:   2,
+					Revenue: 20,
+				},
+			}
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadOnlyTx(ctx, ctrl)
+
+		gomock.InOrder(
+			s.mockDataStore.EXPECT().
+				GetPublisherByID(ctx, tx, publisher.ID).
+				Return(publisher, nil),
+			s.mockDataStore.EXPECT().
+				ListPublisherGameSales(ctx, tx, publisher.ID).
+				Return(expectedGamesSales, nil),
+		)
+
+		gamesSales, err := s.service.ListPublisherGameSales(ctx, publisher.ID)
+		require.NoError(t, err)
+		assert.Equal(t, expectedGamesSales, gamesSales)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the publisher does not exist", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				publisherID = uuid.New()
+
+				expectedError = fmt.Errorf("%s: %w", descriptionFailedListPublisherGameSales, domain.ErrPublisherNotFound)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadOnlyTx(ctx, ctrl)
+
+			s.mockDataStore.EXPECT().
+				GetPublisherByID(ctx, tx, publisherID).
+				Return(domain.Publisher{}, domain.ErrPublisherNotFound)
+
+			_, err := s.service.ListPublisherGameSales(ctx, publisherID)
+			assert.Equal(t, expectedError, err)
+		})
+	})
+}
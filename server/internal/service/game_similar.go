@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+	"github.com/goofr-group/gaming-store/server/internal/logging"
+)
+
+const (
+	descriptionFailedListGamesSimilar    = "service: failed to list similar games"
+	descriptionFailedRefreshGamesSimilar = "service: failed to refresh similar games"
+)
+
+// ListGamesSimilar returns the games similar to the game with the specified identifier, with the specified filter.
+func (s *service) ListGamesSimilar(ctx context.Context, gameID uuid.UUID, filter domain.GamesSimilarPaginatedFilter) (domain.PaginatedResponse[domain.Game], error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "ListGamesSimilar"),
+		slog.String(logging.GameID, gameID.String()),
+	}
+
+	if !filter.Limit.Valid() {
+		return domain.PaginatedResponse[domain.Game]{}, logInfoAndWrapError(ctx, &domain.FilterValueInvalidError{FilterName: domain.FieldFilterLimit}, descriptionInvalidFilterValue, logAttrs...)
+	}
+
+	if !filter.Offset.Valid() {
+		return domain.PaginatedResponse[domain.Game]{}, logInfoAndWrapError(ctx, &domain.FilterValueInvalidError{FilterName: domain.FieldFilterOffset}, descriptionInvalidFilterValue, logAttrs...)
+	}
+
+	var (
+		paginatedGames domain.PaginatedResponse[domain.Game]
+		err            error
+	)
+
+	err = s.readOnlyTx(ctx, func(tx pgx.Tx) error {
+		_, err = s.dataStore.GetGameByID(ctx, tx, gameID)
+		if err != nil {
+			return err
+		}
+
+		paginatedGames, err = s.dataStore.ListGamesSimilar(ctx, tx, gameID, filter)
+		return err
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrGameNotFound):
+			return domain.PaginatedResponse[domain.Game]{}, logInfoAndWrapError(ctx, err, descriptionFailedListGamesSimilar, logAttrs...)
+		default:
+			return domain.PaginatedResponse[domain.Game]{}, logAndWrapError(ctx, err, descriptionFailedListGamesSimilar, logAttrs...)
+		}
+	}
+
+	return paginatedGames, nil
+}
+
+// RefreshGamesSimilar recomputes the similar games of every game.
+// It is meant to be executed periodically. Only the instance holding the lock of the job executes it.
+func (s *service) RefreshGamesSimilar(ctx context.Context) error {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "RefreshGamesSimilar"),
+	}
+
+	err := s.periodicJobTx(ctx, domain.PeriodicJobGamesSimilar, func(tx pgx.Tx) error {
+		return s.dataStore.RefreshGamesSimilar(ctx, tx)
+	})
+	if err != nil {
+		return logAndWrapError(ctx, err, descriptionFailedRefreshGamesSimilar, logAttrs...)
+	}
+
+	return nil
+}
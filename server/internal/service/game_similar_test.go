@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+func TestService_ListGamesSimilar(t *testing.T) {
+	t.Run("should successfully list similar games", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			gameID = uuid.New()
+			userID = uuid.New()
+			filter = domain.GamesSimilarPaginatedFilter{
+				PaginatedRequestBase: domain.PaginatedRequestBase{
+					Limit: 10,
+				},
+				UserID: &userID,
+			}
+			expectedPaginatedGames = domain.PaginatedResponse[domain.Game]{
+				Total: 1,
+				Results: []domain.Game{
+					{ID: uuid.New()},
+				},
+			}
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadOnlyTx(ctx, ctrl)
+
+		gomock.InOrder(
+			s.mockDataStore.EXPECT().
+				GetGameByID(ctx, tx, gameID).
+				Return(domain.Game{ID: gameID}, nil),
+			s.mockDataStore.EXPECT().
+				ListGamesSimilar(ctx, tx, gameID, filter).
+				Return(expectedPaginatedGames, nil),
+		)
+
+		paginatedGames, err := s.service.ListGamesSimilar(ctx, gameID, filter)
+		require.NoError(t, err)
+		assert.Equal(t, expectedPaginatedGames, paginatedGames)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the limit is invalid", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				gameID = uuid.New()
+				filter = domain.GamesSimilarPaginatedFilter{}
+
+				expectedError = fmt.Errorf("%s: %w", descriptionInvalidFilterValue, &domain.FilterValueInvalidError{FilterName: domain.FieldFilterLimit})
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+
+			_, err := s.service.ListGamesSimilar(ctx, gameID, filter)
+			assert.Equal(t, expectedError, err)
+		})
+
+		t.Run("when the game does not exist", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				gameID = uuid.New()
+				filter = domain.GamesSimilarPaginatedFilter{
+					PaginatedRequestBase: domain.PaginatedRequestBase{
+						Limit: 10,
+					},
+				}
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadOnlyTx(ctx, ctrl)
+
+			s.mockDataStore.EXPECT().
+				GetGameByID(ctx, tx, gameID).
+				Return(domain.Game{}, domain.ErrGameNotFound)
+
+			_, err := s.service.ListGamesSimilar(ctx, gameID, filter)
+			require.ErrorIs(t, err, domain.ErrGameNotFound)
+		})
+	})
+}
+
+func TestService_RefreshGamesSimilar(t *testing.T) {
+	t.Run("should successfully refresh similar games", func(t *testing.T) {
+		ctx := context.Background()
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		gomock.InOrder(
+			s.mockDataStore.EXPECT().
+				AcquirePeriodicJobLock(ctx, tx, domain.PeriodicJobGamesSimilar).
+				Return(true, nil),
+			s.mockDataStore.EXPECT().
+				RefreshGamesSimilar(ctx, tx).
+				Return(nil),
+		)
+
+		err := s.service.RefreshGamesSimilar(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("should successfully skip the refresh when another instance holds the lock", func(t *testing.T) {
+		ctx := context.Background()
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		s.mockDataStore.EXPECT().
+			AcquirePeriodicJobLock(ctx, tx, domain.PeriodicJobGamesSimilar).
+			Return(false, nil)
+
+		err := s.service.RefreshGamesSimilar(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the refresh fails", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				refreshErr = errors.New("refresh failed")
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			gomock.InOrder(
+				s.mockDataStore.EXPECT().
+					AcquirePeriodicJobLock(ctx, tx, domain.PeriodicJobGamesSimilar).
+					Return(true, nil),
+				s.mockDataStore.EXPECT().
+					RefreshGamesSimilar(ctx, tx).
+					Return(refreshErr),
+			)
+
+			err := s.service.RefreshGamesSimilar(ctx)
+			require.ErrorIs(t, err, refreshErr)
+		})
+	})
+}
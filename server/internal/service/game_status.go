@@ -0,0 +1,252 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+	"github.com/goofr-group/gaming-store/server/internal/logging"
+)
+
+const (
+	descriptionFailedSubmitGame  = "service: failed to submit game"
+	descriptionFailedApproveGame = "service: failed to approve game"
+	descriptionFailedRejectGame  = "service: failed to reject game"
+	descriptionFailedPublishGame = "service: failed to publish game"
+	descriptionFailedDelistGame  = "service: failed to delist game"
+	descriptionFailedDeleteGame  = "service: failed to delete game"
+)
+
+// SubmitGame submits the game with the specified identifier for review. Only verified publishers can submit games.
+func (s *service) SubmitGame(ctx context.Context, id uuid.UUID) (domain.Game, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "SubmitGame"),
+		slog.String(logging.GameID, id.String()),
+	}
+
+	var game domain.Game
+
+	err := s.readWriteTx(ctx, func(tx pgx.Tx) error {
+		var err error
+
+		game, err = s.dataStore.GetGameByID(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		publisher, err := s.dataStore.GetPublisherByID(ctx, tx, game.Publisher.ID)
+		if err != nil {
+			return err
+		}
+
+		if publisher.EmailVerifiedAt == nil {
+			return domain.ErrPublisherEmailNotVerified
+		}
+
+		if publisher.VerificationStatus != domain.PublisherVerificationStatusVerified {
+			return domain.ErrPublisherNotVerified
+		}
+
+		game, err = s.transitionGame(ctx, tx, game, domain.GameStatusInReview, nil)
+		return err
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrGameNotFound),
+			errors.Is(err, domain.ErrPublisherEmailNotVerified),
+			errors.Is(err, domain.ErrPublisherNotVerified),
+			errors.Is(err, domain.ErrGameStatusTransitionInvalid):
+			return domain.Game{}, logInfoAndWrapError(ctx, err, descriptionFailedSubmitGame, logAttrs...)
+		default:
+			return domain.Game{}, logAndWrapError(ctx, err, descriptionFailedSubmitGame, logAttrs...)
+		}
+	}
+
+	return game, nil
+}
+
+// ApproveGame approves the game in review with the specified identifier, so that its publisher can publish it.
+func (s *service) ApproveGame(ctx context.Context, id uuid.UUID, reviewNotes *domain.GameReviewNotes) (domain.Game, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "ApproveGame"),
+		slog.String(logging.GameID, id.String()),
+	}
+
+	if reviewNotes != nil && !reviewNotes.Valid() {
+		return domain.Game{}, logInfoAndWrapError(ctx, &domain.FieldValueInvalidError{FieldName: domain.FieldNotes}, descriptionInvalidFieldValue, logAttrs...)
+	}
+
+	game, err := s.changeGameStatus(ctx, id, domain.GameStatusApproved, reviewNotes)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrGameNotFound),
+			errors.Is(err, domain.ErrGameStatusTransitionInvalid):
+			return domain.Game{}, logInfoAndWrapError(ctx, err, descriptionFailedApproveGame, logAttrs...)
+		default:
+			return domain.Game{}, logAndWrapError(ctx, err, descriptionFailedApproveGame, logAttrs...)
+		}
+	}
+
+	return game, nil
+}
+
+// RejectGame sends the game in review with the specified identifier back to draft, with notes for its publisher.
+func (s *service) RejectGame(ctx context.Context, id uuid.UUID, reviewNotes domain.GameReviewNotes) (domain.Game, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "RejectGame"),
+		slog.String(logging.GameID, id.String()),
+	}
+
+	if !reviewNotes.Valid() {
+		return domain.Game{}, logInfoAndWrapError(ctx, &domain.FieldValueInvalidError{FieldName: domain.FieldNotes}, descriptionInvalidFieldValue, logAttrs...)
+	}
+
+	game, err := s.changeGameStatus(ctx, id, domain.GameStatusDraft, &reviewNotes)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrGameNotFound),
+			errors.Is(err, domain.ErrGameStatusTransitionInvalid):
+			return domain.Game{}, logInfoAndWrapError(ctx, err, descriptionFailedRejectGame, logAttrs...)
+		default:
+			return domain.Game{}, logAndWrapError(ctx, err, descriptionFailedRejectGame, logAttrs...)
+		}
+	}
+
+	return game, nil
+}
+
+// PublishGame makes the approved game with the specified identifier live in the store. Only verified publishers can
+// publish games.
+func (s *service) PublishGame(ctx context.Context, id uuid.UUID) (domain.Game, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "PublishGame"),
+		slog.String(logging.GameID, id.String()),
+	}
+
+	game, err := s.changeGameStatus(ctx, id, domain.GameStatusPublished, nil)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrGameNotFound),
+			errors.Is(err, domain.ErrPublisherNotVerified),
+			errors.Is(err, domain.ErrGameStatusTransitionInvalid):
+			return domain.Game{}, logInfoAndWrapError(ctx, err, descriptionFailedPublishGame, logAttrs...)
+		default:
+			return domain.Game{}, logAndWrapError(ctx, err, descriptionFailedPublishGame, logAttrs...)
+		}
+	}
+
+	return game, nil
+}
+
+// DelistGame removes the published game with the specified identifier from the store. Its owners keep it in their
+// libraries.
+func (s *service) DelistGame(ctx context.Context, id uuid.UUID) (domain.Game, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "DelistGame"),
+		slog.String(logging.GameID, id.String()),
+	}
+
+	game, err := s.changeGameStatus(ctx, id, domain.GameStatusDelisted, nil)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrGameNotFound),
+			errors.Is(err, domain.ErrGameStatusTransitionInvalid):
+			return domain.Game{}, logInfoAndWrapError(ctx, err, descriptionFailedDelistGame, logAttrs...)
+		default:
+			return domain.Game{}, logAndWrapError(ctx, err, descriptionFailedDelistGame, logAttrs...)
+		}
+	}
+
+	return game, nil
+}
+
+// DeleteGame deletes the game with the specified identifier. Games owned by users must be delisted instead.
+func (s *service) DeleteGame(ctx context.Context, id uuid.UUID) error {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "DeleteGame"),
+		slog.String(logging.GameID, id.String()),
+	}
+
+	err := s.readWriteTx(ctx, func(tx pgx.Tx) error {
+		return s.dataStore.DeleteGame(ctx, tx, id)
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrGameNotFound),
+			errors.Is(err, domain.ErrGameHasOwners):
+			return logInfoAndWrapError(ctx, err, descriptionFailedDeleteGame, logAttrs...)
+		default:
+			return logAndWrapError(ctx, err, descriptionFailedDeleteGame, logAttrs...)
+		}
+	}
+
+	return nil
+}
+
+// changeGameStatus moves the game with the specified identifier to the specified status in a new transaction.
+func (s *service) changeGameStatus(ctx context.Context, id uuid.UUID, status domain.GameStatus, reviewNotes *domain.GameReviewNotes) (domain.Game, error) {
+	var game domain.Game
+
+	err := s.readWriteTx(ctx, func(tx pgx.Tx) error {
+		var err error
+
+		game, err = s.dataStore.GetGameByID(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		game, err = s.transitionGame(ctx, tx, game, status, reviewNotes)
+		return err
+	})
+	if err != nil {
+		return domain.Game{}, err
+	}
+
+	return game, nil
+}
+
+// checkGameEditable returns an error if the store page of the game with the specified identifier can not be edited in
+// its status.
+func (s *service) checkGameEditable(ctx context.Context, tx pgx.Tx, id uuid.UUID) error {
+	game, err := s.dataStore.GetGameByID(ctx, tx, id)
+	if err != nil {
+		return err
+	}
+
+	if !game.Status.Editable() {
+		return domain.ErrGameNotEditable
+	}
+
+	return nil
+}
+
+// transitionGame moves the specified game to the specified status, replacing its review notes, and returns the updated
+// game. Returns an error if the lifecycle does not allow the transition, or if the game is published while its
+// publisher is not verified, since the verification may have been revoked after the game was approved or scheduled.
+func (s *service) transitionGame(ctx context.Context, tx pgx.Tx, game domain.Game, status domain.GameStatus, reviewNotes *domain.GameReviewNotes) (domain.Game, error) {
+	if !game.Status.CanTransitionTo(status) {
+		return domain.Game{}, domain.ErrGameStatusTransitionInvalid
+	}
+
+	if status == domain.GameStatusPublished {
+		publisher, err := s.dataStore.GetPublisherByID(ctx, tx, game.Publisher.ID)
+		if err != nil {
+			return domain.Game{}, err
+		}
+
+		if publisher.VerificationStatus != domain.PublisherVerificationStatusVerified {
+			return domain.Game{}, domain.ErrPublisherNotVerified
+		}
+	}
+
+	err := s.dataStore.UpdateGameStatus(ctx, tx, game.ID, status, reviewNotes)
+	if err != nil {
+		return domain.Game{}, err
+	}
+
+	return s.dataStore.GetGameByID(ctx, tx, game.ID)
+}
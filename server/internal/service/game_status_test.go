@@ -0,0 +1,354 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+func TestService_SubmitGame(t *testing.T) {
+	t.Run("should successfully submit draft game", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			emailVerifiedAt = time.Now().UTC()
+
+			publisher = domain.Publisher{
+				ID:                 uuid.New(),
+				EmailVerifiedAt:    &emailVerifiedAt,
+				VerificationStatus: domain.PublisherVerificationStatusVerified,
+			}
+			game = domain.Game{
+				ID:        uuid.New(),
+				Publisher: publisher,
+				Status:    domain.GameStatusDraft,
+			}
+			expectedGame = domain.Game{
+				ID:        game.ID,
+				Publisher: publisher,
+				Status:    domain.GameStatusInReview,
+			}
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		gomock.InOrder(
+			s.mockDataStore.EXPECT().
+				GetGameByID(ctx, tx, game.ID).
+				Return(game, nil),
+			s.mockDataStore.EXPECT().
+				GetPublisherByID(ctx, tx, publisher.ID).
+				Return(publisher, nil),
+			s.mockDataStore.EXPECT().
+				UpdateGameStatus(ctx, tx, game.ID, domain.GameStatusInReview, nil).
+				Return(nil),
+			s.mockDataStore.EXPECT().
+				GetGameByID(ctx, tx, game.ID).
+				Return(expectedGame, nil),
+		)
+
+		submittedGame, err := s.service.SubmitGame(ctx, game.ID)
+		require.NoError(t, err)
+		assert.Equal(t, expectedGame, submittedGame)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the publisher is not verified", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				emailVerifiedAt = time.Now().UTC()
+
+				publisher = domain.Publisher{
+					ID:                 uuid.New(),
+					EmailVerifiedAt:    &emailVerifiedAt,
+					VerificationStatus: domain.PublisherVerificationStatusPending,
+				}
+				game = domain.Game{
+					ID:        uuid.New(),
+					Publisher: publisher,
+					Status:    domain.GameStatusDraft,
+				}
+
+				expectedError = fmt.Errorf("%s: %w", descriptionFailedSubmitGame, domain.ErrPublisherNotVerified)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			gomock.InOrder(
+				s.mockDataStore.EXPECT().
+					GetGameByID(ctx, tx, game.ID).
+					Return(game, nil),
+				s.mockDataStore.EXPECT().
+					GetPublisherByID(ctx, tx, publisher.ID).
+					Return(publisher, nil),
+			)
+
+			_, err := s.service.SubmitGame(ctx, game.ID)
+			assert.Equal(t, expectedError, err)
+		})
+
+		t.Run("when the game is already published", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				emailVerifiedAt = time.Now().UTC()
+
+				publisher = domain.Publisher{
+					ID:                 uuid.New(),
+					EmailVerifiedAt:    &emailVerifiedAt,
+					VerificationStatus: domain.PublisherVerificationStatusVerified,
+				}
+				game = domain.Game{
+					ID:        uuid.New(),
+					Publisher: publisher,
+					Status:    domain.GameStatusPublished,
+				}
+
+				expectedError = fmt.Errorf("%s: %w", descriptionFailedSubmitGame, domain.ErrGameStatusTransitionInvalid)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			gomock.InOrder(
+				s.mockDataStore.EXPECT().
+					GetGameByID(ctx, tx, game.ID).
+					Return(game, nil),
+				s.mockDataStore.EXPECT().
+					GetPublisherByID(ctx, tx, publisher.ID).
+					Return(publisher, nil),
+			)
+
+			_, err := s.service.SubmitGame(ctx, game.ID)
+			assert.Equal(t, expectedError, err)
+		})
+	})
+}
+
+func TestService_RejectGame(t *testing.T) {
+	t.Run("should successfully send game back to draft with review notes", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			reviewNotes = domain.GameReviewNotes("missing screenshots")
+
+			game = domain.Game{
+				ID:     uuid.New(),
+				Status: domain.GameStatusInReview,
+			}
+			expectedGame = domain.Game{
+				ID:          game.ID,
+				Status:      domain.GameStatusDraft,
+				ReviewNotes: &reviewNotes,
+			}
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		gomock.InOrder(
+			s.mockDataStore.EXPECT().
+				GetGameByID(ctx, tx, game.ID).
+				Return(game, nil),
+			s.mockDataStore.EXPECT().
+				UpdateGameStatus(ctx, tx, game.ID, domain.GameStatusDraft, &reviewNotes).
+				Return(nil),
+			s.mockDataStore.EXPECT().
+				GetGameByID(ctx, tx, game.ID).
+				Return(expectedGame, nil),
+		)
+
+		rejectedGame, err := s.service.RejectGame(ctx, game.ID, reviewNotes)
+		require.NoError(t, err)
+		assert.Equal(t, expectedGame, rejectedGame)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the review notes are empty", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				expectedError = fmt.Errorf("%s: %w", descriptionInvalidFieldValue, &domain.FieldValueInvalidError{FieldName: domain.FieldNotes})
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+
+			_, err := s.service.RejectGame(ctx, uuid.New(), "")
+			assert.Equal(t, expectedError, err)
+		})
+
+		t.Run("when the game is not in review", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				game = domain.Game{
+					ID:     uuid.New(),
+					Status: domain.GameStatusApproved,
+				}
+
+				expectedError = fmt.Errorf("%s: %w", descriptionFailedRejectGame, domain.ErrGameStatusTransitionInvalid)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			s.mockDataStore.EXPECT().
+				GetGameByID(ctx, tx, game.ID).
+				Return(game, nil)
+
+			_, err := s.service.RejectGame(ctx, game.ID, "notes")
+			assert.Equal(t, expectedError, err)
+		})
+	})
+}
+
+func TestService_PublishGame(t *testing.T) {
+	t.Run("should successfully publish approved game", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			publisher = domain.Publisher{
+				ID:                 uuid.New(),
+				VerificationStatus: domain.PublisherVerificationStatusVerified,
+			}
+			game = domain.Game{
+				ID:        uuid.New(),
+				Publisher: publisher,
+				Status:    domain.GameStatusApproved,
+			}
+			expectedGame = domain.Game{
+				ID:        game.ID,
+				Publisher: publisher,
+				Status:    domain.GameStatusPublished,
+			}
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		gomock.InOrder(
+			s.mockDataStore.EXPECT().
+				GetGameByID(ctx, tx, game.ID).
+				Return(game, nil),
+			s.mockDataStore.EXPECT().
+				GetPublisherByID(ctx, tx, publisher.ID).
+				Return(publisher, nil),
+			s.mockDataStore.EXPECT().
+				UpdateGameStatus(ctx, tx, game.ID, domain.GameStatusPublished, nil).
+				Return(nil),
+			s.mockDataStore.EXPECT().
+				GetGameByID(ctx, tx, game.ID).
+				Return(expectedGame, nil),
+		)
+
+		publishedGame, err := s.service.PublishGame(ctx, game.ID)
+		require.NoError(t, err)
+		assert.Equal(t, expectedGame, publishedGame)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the publisher verification was revoked", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				publisher = domain.Publisher{
+					ID:                 uuid.New(),
+					VerificationStatus: domain.PublisherVerificationStatusRejected,
+				}
+				game = domain.Game{
+					ID:        uuid.New(),
+					Publisher: publisher,
+					Status:    domain.GameStatusApproved,
+				}
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			gomock.InOrder(
+				s.mockDataStore.EXPECT().
+					GetGameByID(ctx, tx, game.ID).
+					Return(game, nil),
+				s.mockDataStore.EXPECT().
+					GetPublisherByID(ctx, tx, publisher.ID).
+					Return(publisher, nil),
+			)
+
+			_, err := s.service.PublishGame(ctx, game.ID)
+			require.ErrorIs(t, err, domain.ErrPublisherNotVerified)
+		})
+	})
+}
+
+func TestService_DeleteGame(t *testing.T) {
+	t.Run("should successfully delete game", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			gameID = uuid.New()
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		s.mockDataStore.EXPECT().
+			DeleteGame(ctx, tx, gameID).
+			Return(nil)
+
+		err := s.service.DeleteGame(ctx, gameID)
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the game has owners", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				gameID = uuid.New()
+
+				expectedError = fmt.Errorf("%s: %w", descriptionFailedDeleteGame, domain.ErrGameHasOwners)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			s.mockDataStore.EXPECT().
+				DeleteGame(ctx, tx, gameID).
+				Return(domain.ErrGameHasOwners)
+
+			err := s.service.DeleteGame(ctx, gameID)
+			assert.Equal(t, expectedError, err)
+		})
+	})
+}
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+	"github.com/goofr-group/gaming-store/server/internal/logging"
+)
+
+const (
+	descriptionFailedListGameSuggestions = "service: failed to list game suggestions"
+)
+
+// ListGameSuggestions returns the game title, tag and publisher name suggestions with the specified filter.
+func (s *service) ListGameSuggestions(ctx context.Context, filter domain.GameSuggestionsFilter) ([]domain.GameSuggestion, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "ListGameSuggestions"),
+	}
+
+	filter.Query = domain.GameSuggestionQuery(removeExtraSpaces(string(filter.Query)))
+
+	if !filter.Query.Valid() {
+		return nil, logInfoAndWrapError(ctx, &domain.FilterValueInvalidError{FilterName: domain.FieldFilterQuery}, descriptionInvalidFilterValue, logAttrs...)
+	}
+
+	if !filter.Limit.Valid() {
+		return nil, logInfoAndWrapError(ctx, &domain.FilterValueInvalidError{FilterName: domain.FieldFilterLimit}, descriptionInvalidFilterValue, logAttrs...)
+	}
+
+	var (
+		suggestions []domain.GameSuggestion
+		err         error
+	)
+
+	err = s.readOnlyTx(ctx, func(tx pgx.Tx) error {
+		suggestions, err = s.dataStore.ListGameSuggestions(ctx, tx, filter)
+		return err
+	})
+	if err != nil {
+		return nil, logAndWrapError(ctx, err, descriptionFailedListGameSuggestions, logAttrs...)
+	}
+
+	return suggestions, nil
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+func TestService_ListGameSuggestions(t *testing.T) {
+	t.Run("should successfully list game suggestions", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			filter = domain.GameSuggestionsFilter{
+				Query: "  jump   ma ",
+				Limit: 10,
+			}
+			expectedFilter = domain.GameSuggestionsFilter{
+				Query: "jump ma",
+				Limit: 10,
+			}
+			expectedSuggestions = []domain.GameSuggestion{
+				{
+					Type:       domain.GameSuggestionTypeTitle,
+					ID:         uuid.New(),
+					Text:       "Jump Master",
+					Popularity: 3,
+				},
+			}
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadOnlyTx(ctx, ctrl)
+
+		s.mockDataStore.EXPECT().
+			ListGameSuggestions(ctx, tx, expectedFilter).
+			Return(expectedSuggestions, nil)
+
+		suggestions, err := s.service.ListGameSuggestions(ctx, filter)
+		require.NoError(t, err)
+		assert.Equal(t, expectedSuggestions, suggestions)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when a filter is invalid", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				filter = domain.GameSuggestionsFilter{
+					Query: "   ",
+					Limit: 10,
+				}
+
+				expectedError = fmt.Errorf("%s: %w", descriptionInvalidFilterValue, &domain.FilterValueInvalidError{FilterName: domain.FieldFilterQuery})
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+
+			_, err := s.service.ListGameSuggestions(ctx, filter)
+			assert.Equal(t, expectedError, err)
+		})
+
+		t.Run("when the store fails", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				filter = domain.GameSuggestionsFilter{
+					Query: "jump",
+					Limit: 10,
+				}
+
+				errTest       = errors.New("error test")
+				expectedError = fmt.Errorf("%s: %w", descriptionFailedListGameSuggestions, errTest)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadOnlyTx(ctx, ctrl)
+
+			s.mockDataStore.EXPECT().
+				ListGameSuggestions(ctx, tx, filter).
+				Return(nil, errTest)
+
+			_, err := s.service.ListGameSuggestions(ctx, filter)
+			assert.Equal(t, expectedError, err)
+		})
+	})
+}
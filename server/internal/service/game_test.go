@@ -21,13 +21,14 @@ func TestService_CreateGame(t *testing.T) {
 		var (
 			ctx = context.Background()
 
-			publisherID = uuid.New()
-			gameID      = uuid.New()
+			publisherID     = uuid.New()
+			author          = domain.GameRevisionAuthor{ID: publisherID, Role: domain.GameRevisionAuthorRolePublisher}
+			emailVerifiedAt = time.Now().UTC()
+			gameID          = uuid.New()
 
 			editableGame = domain.EditableGame{
 				Title:       "game",
 				Price:       9.99,
-				IsActive:    true,
 				Description: "description",
 				AgeRating:   "18",
 				Features:    "features",
@@ -41,7 +42,7 @@ func TestService_CreateGame(t *testing.T) {
 				ID:           gameID,
 				Title:        editableGame.Title,
 				Price:        editableGame.Price,
-				IsActive:     editableGame.IsActive,
+				Status:       domain.GameStatusDraft,
 				Description:  editableGame.Description,
 				AgeRating:    editableGame.AgeRating,
 				Features:     editableGame.Features,
@@ -50,6 +51,20 @@ func TestService_CreateGame(t *testing.T) {
 				CreatedAt:    time.Now(),
 				ModifiedAt:   time.Now(),
 			}
+			expectedEditableGameRevision = domain.EditableGameRevision{
+				Number: 1,
+				Author: author,
+				ChangedFields: []domain.GameRevisionField{
+					domain.GameRevisionFieldTitle,
+					domain.GameRevisionFieldPrice,
+					domain.GameRevisionFieldDescription,
+					domain.GameRevisionFieldAgeRating,
+					domain.GameRevisionFieldFeatures,
+					domain.GameRevisionFieldLanguages,
+					domain.GameRevisionFieldRequirements,
+				},
+				Snapshot: domain.NewGameRevisionSnapshot(expectedGame, nil),
+			}
 		)
 
 		ctrl := gomock.NewController(t)
@@ -58,15 +73,27 @@ func TestService_CreateGame(t *testing.T) {
 		tx := s.newReadWriteTx(ctx, ctrl)
 
 		gomock.InOrder(
+			s.mockDataStore.EXPECT().
+				GetPublisherByID(ctx, tx, publisherID).
+				Return(domain.Publisher{ID: publisherID, EmailVerifiedAt: &emailVerifiedAt, VerificationStatus: domain.PublisherVerificationStatusVerified}, nil),
 			s.mockDataStore.EXPECT().
 				CreateGame(ctx, tx, publisherID, editableGame).
 				Return(gameID, nil),
 			s.mockDataStore.EXPECT().
 				GetGameByID(ctx, tx, gameID).
 				Return(expectedGame, nil),
+			s.mockDataStore.EXPECT().
+				GetGameMultimediaPositions(ctx, tx, gameID).
+				Return(nil, nil),
+			s.mockDataStore.EXPECT().
+				GetLatestGameRevision(ctx, tx, gameID).
+				Return(domain.GameRevision{}, domain.ErrGameRevisionNotFound),
+			s.mockDataStore.EXPECT().
+				CreateGameRevision(ctx, tx, gameID, expectedEditableGameRevision).
+				Return(nil),
 		)
 
-		game, err := s.service.CreateGame(ctx, publisherID, editableGame)
+		game, err := s.service.CreateGame(ctx, publisherID, editableGame, author)
 		require.NoError(t, err)
 		assert.Equal(t, expectedGame, game)
 	})
@@ -77,11 +104,11 @@ func TestService_CreateGame(t *testing.T) {
 				ctx = context.Background()
 
 				publisherID = uuid.New()
+				author      = domain.GameRevisionAuthor{ID: publisherID, Role: domain.GameRevisionAuthorRolePublisher}
 
 				editableGame = domain.EditableGame{
 					Title:       "game",
 					Price:       -9.99,
-					IsActive:    true,
 					Description: "description",
 					AgeRating:   "18",
 					Features:    "features",
@@ -99,7 +126,44 @@ func TestService_CreateGame(t *testing.T) {
 
 			s := newServiceTest(ctrl)
 
-			_, err := s.service.CreateGame(ctx, publisherID, editableGame)
+			_, err := s.service.CreateGame(ctx, publisherID, editableGame, author)
+			assert.Equal(t, expectedError, err)
+		})
+
+		t.Run("when the publisher is not verified", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				publisherID     = uuid.New()
+				author          = domain.GameRevisionAuthor{ID: publisherID, Role: domain.GameRevisionAuthorRolePublisher}
+				emailVerifiedAt = time.Now().UTC()
+
+				editableGame = domain.EditableGame{
+					Title:       "game",
+					Price:       9.99,
+					Description: "description",
+					AgeRating:   "18",
+					Features:    "features",
+					Languages:   domain.GameLanguages{domain.Language{Tag: language.Portuguese}},
+					Requirements: domain.GameRequirements{
+						Minimum:     "minimum",
+						Recommended: "recommended",
+					},
+				}
+
+				expectedError = fmt.Errorf("%s: %w", descriptionFailedCreateGame, domain.ErrPublisherNotVerified)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			s.mockDataStore.EXPECT().
+				GetPublisherByID(ctx, tx, publisherID).
+				Return(domain.Publisher{ID: publisherID, EmailVerifiedAt: &emailVerifiedAt, VerificationStatus: domain.PublisherVerificationStatusPending}, nil)
+
+			_, err := s.service.CreateGame(ctx, publisherID, editableGame, author)
 			assert.Equal(t, expectedError, err)
 		})
 
@@ -108,12 +172,13 @@ func TestService_CreateGame(t *testing.T) {
 				var (
 					ctx = context.Background()
 
-					publisherID = uuid.New()
+					publisherID     = uuid.New()
+					author          = domain.GameRevisionAuthor{ID: publisherID, Role: domain.GameRevisionAuthorRolePublisher}
+					emailVerifiedAt = time.Now().UTC()
 
 					editableGame = domain.EditableGame{
 						Title:       "game",
 						Price:       9.99,
-						IsActive:    true,
 						Description: "description",
 						AgeRating:   "18",
 						Features:    "features",
@@ -134,12 +199,15 @@ func TestService_CreateGame(t *testing.T) {
 				tx := s.newReadWriteTx(ctx, ctrl)
 
 				gomock.InOrder(
+					s.mockDataStore.EXPECT().
+						GetPublisherByID(ctx, tx, publisherID).
+						Return(domain.Publisher{ID: publisherID, EmailVerifiedAt: &emailVerifiedAt, VerificationStatus: domain.PublisherVerificationStatusVerified}, nil),
 					s.mockDataStore.EXPECT().
 						CreateGame(ctx, tx, publisherID, editableGame).
 						Return(uuid.UUID{}, errTest),
 				)
 
-				_, err := s.service.CreateGame(ctx, publisherID, editableGame)
+				_, err := s.service.CreateGame(ctx, publisherID, editableGame, author)
 				assert.Equal(t, expectedError, err)
 			})
 
@@ -147,13 +215,14 @@ func TestService_CreateGame(t *testing.T) {
 				var (
 					ctx = context.Background()
 
-					publisherID = uuid.New()
-					gameID      = uuid.New()
+					publisherID     = uuid.New()
+					author          = domain.GameRevisionAuthor{ID: publisherID, Role: domain.GameRevisionAuthorRolePublisher}
+					emailVerifiedAt = time.Now().UTC()
+					gameID          = uuid.New()
 
 					editableGame = domain.EditableGame{
 						Title:       "game",
 						Price:       9.99,
-						IsActive:    true,
 						Description: "description",
 						AgeRating:   "18",
 						Features:    "features",
@@ -174,6 +243,9 @@ func TestService_CreateGame(t *testing.T) {
 				tx := s.newReadWriteTx(ctx, ctrl)
 
 				gomock.InOrder(
+					s.mockDataStore.EXPECT().
+						GetPublisherByID(ctx, tx, publisherID).
+						Return(domain.Publisher{ID: publisherID, EmailVerifiedAt: &emailVerifiedAt, VerificationStatus: domain.PublisherVerificationStatusVerified}, nil),
 					s.mockDataStore.EXPECT().
 						CreateGame(ctx, tx, publisherID, editableGame).
 						Return(gameID, nil),
@@ -182,9 +254,75 @@ func TestService_CreateGame(t *testing.T) {
 						Return(domain.Game{}, errTest),
 				)
 
-				_, err := s.service.CreateGame(ctx, publisherID, editableGame)
+				_, err := s.service.CreateGame(ctx, publisherID, editableGame, author)
 				assert.Equal(t, expectedError, err)
 			})
 		})
 	})
 }
+
+func TestService_GamePublishedBy(t *testing.T) {
+	t.Run("should successfully check game of publisher", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			publisherID = uuid.New()
+			gameID      = uuid.New()
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadOnlyTx(ctx, ctrl)
+
+		s.mockDataStore.EXPECT().
+			GetGamePublisherID(ctx, tx, gameID).
+			Return(publisherID, nil)
+
+		published, err := s.service.GamePublishedBy(ctx, publisherID, gameID)
+		require.NoError(t, err)
+		assert.True(t, published)
+	})
+
+	t.Run("should successfully reject game of another publisher", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			gameID = uuid.New()
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadOnlyTx(ctx, ctrl)
+
+		s.mockDataStore.EXPECT().
+			GetGamePublisherID(ctx, tx, gameID).
+			Return(uuid.New(), nil)
+
+		published, err := s.service.GamePublishedBy(ctx, uuid.New(), gameID)
+		require.NoError(t, err)
+		assert.False(t, published)
+	})
+
+	t.Run("should successfully reject missing game", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			gameID = uuid.New()
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadOnlyTx(ctx, ctrl)
+
+		s.mockDataStore.EXPECT().
+			GetGamePublisherID(ctx, tx, gameID).
+			Return(uuid.UUID{}, domain.ErrGameNotFound)
+
+		published, err := s.service.GamePublishedBy(ctx, uuid.New(), gameID)
+		require.NoError(t, err)
+		assert.False(t, published)
+	})
+}
@@ -0,0 +1,194 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+	"github.com/goofr-group/gaming-store/server/internal/logging"
+)
+
+const (
+	descriptionFailedListGameTranslations  = "service: failed to list game translations"
+	descriptionFailedPutGameTranslation    = "service: failed to put game translation"
+	descriptionFailedDeleteGameTranslation = "service: failed to delete game translation"
+)
+
+// ListGameTranslations returns the translations of the game with the specified identifier.
+func (s *service) ListGameTranslations(ctx context.Context, gameID uuid.UUID) ([]domain.GameTranslation, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "ListGameTranslations"),
+		slog.String(logging.GameID, gameID.String()),
+	}
+
+	var gameTranslations []domain.GameTranslation
+
+	err := s.readOnlyTx(ctx, func(tx pgx.Tx) error {
+		_, err := s.dataStore.GetGameByID(ctx, tx, gameID)
+		if err != nil {
+			return err
+		}
+
+		gameTranslations, err = s.dataStore.ListGameTranslations(ctx, tx, []uuid.UUID{gameID})
+		return err
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrGameNotFound):
+			return nil, logInfoAndWrapError(ctx, err, descriptionFailedListGameTranslations, logAttrs...)
+		default:
+			return nil, logAndWrapError(ctx, err, descriptionFailedListGameTranslations, logAttrs...)
+		}
+	}
+
+	return gameTranslations, nil
+}
+
+// PutGameTranslation creates or replaces the translation of the game with the specified identifier in the specified
+// language. The language must be one of the languages of the game other than its default language, and only games in
+// an editable status can be translated.
+func (s *service) PutGameTranslation(ctx context.Context, gameID uuid.UUID, lang domain.Language, editableGameTranslation domain.EditableGameTranslation) (domain.GameTranslation, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "PutGameTranslation"),
+		slog.String(logging.GameID, gameID.String()),
+		slog.String(logging.GameTranslationLanguage, lang.String()),
+	}
+
+	err := validateEditableGameTranslation(editableGameTranslation)
+	if err != nil {
+		return domain.GameTranslation{}, logInfoAndWrapError(ctx, err, descriptionInvalidFieldValue, logAttrs...)
+	}
+
+	var gameTranslation domain.GameTranslation
+
+	err = s.readWriteTx(ctx, func(tx pgx.Tx) error {
+		game, err := s.dataStore.GetGameByID(ctx, tx, gameID)
+		if err != nil {
+			return err
+		}
+
+		if !game.Status.Editable() {
+			return domain.ErrGameNotEditable
+		}
+
+		if !gameTranslatableLanguage(game, lang) {
+			return &domain.FieldValueInvalidError{FieldName: domain.FieldLanguage}
+		}
+
+		err = s.dataStore.PutGameTranslation(ctx, tx, gameID, lang, editableGameTranslation)
+		if err != nil {
+			return err
+		}
+
+		gameTranslation, err = s.dataStore.GetGameTranslation(ctx, tx, gameID, lang)
+		return err
+	})
+	if err != nil {
+		var domainFieldValueInvalidError *domain.FieldValueInvalidError
+
+		switch {
+		case errors.As(err, &domainFieldValueInvalidError):
+			return domain.GameTranslation{}, logInfoAndWrapError(ctx, err, descriptionInvalidFieldValue, logAttrs...)
+		case errors.Is(err, domain.ErrGameNotFound),
+			errors.Is(err, domain.ErrGameNotEditable):
+			return domain.GameTranslation{}, logInfoAndWrapError(ctx, err, descriptionFailedPutGameTranslation, logAttrs...)
+		default:
+			return domain.GameTranslation{}, logAndWrapError(ctx, err, descriptionFailedPutGameTranslation, logAttrs...)
+		}
+	}
+
+	return gameTranslation, nil
+}
+
+// DeleteGameTranslation deletes the translation of the game with the specified identifier in the specified language.
+func (s *service) DeleteGameTranslation(ctx context.Context, gameID uuid.UUID, lang domain.Language) error {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "DeleteGameTranslation"),
+		slog.String(logging.GameID, gameID.String()),
+		slog.String(logging.GameTranslationLanguage, lang.String()),
+	}
+
+	err := s.readWriteTx(ctx, func(tx pgx.Tx) error {
+		err := s.checkGameEditable(ctx, tx, gameID)
+		if err != nil {
+			return err
+		}
+
+		return s.dataStore.DeleteGameTranslation(ctx, tx, gameID, lang)
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrGameTranslationNotFound),
+			errors.Is(err, domain.ErrGameNotFound),
+			errors.Is(err, domain.ErrGameNotEditable):
+			return logInfoAndWrapError(ctx, err, descriptionFailedDeleteGameTranslation, logAttrs...)
+		default:
+			return logAndWrapError(ctx, err, descriptionFailedDeleteGameTranslation, logAttrs...)
+		}
+	}
+
+	return nil
+}
+
+// validateEditableGameTranslation returns an error if any field of the game translation is invalid.
+func validateEditableGameTranslation(editableGameTranslation domain.EditableGameTranslation) error {
+	if !editableGameTranslation.Title.Valid() {
+		return &domain.FieldValueInvalidError{FieldName: domain.FieldTitle}
+	}
+
+	if !editableGameTranslation.Description.Valid() {
+		return &domain.FieldValueInvalidError{FieldName: domain.FieldDescription}
+	}
+
+	if !editableGameTranslation.Features.Valid() {
+		return &domain.FieldValueInvalidError{FieldName: domain.FieldFeatures}
+	}
+
+	return nil
+}
+
+// gameTranslatableLanguage returns true if the game can be translated to the specified language, which must be one of
+// its languages other than the default one, false otherwise.
+func gameTranslatableLanguage(game domain.Game, lang domain.Language) bool {
+	for i, gameLanguage := range game.Languages {
+		if gameLanguage.String() == lang.String() {
+			return i != 0
+		}
+	}
+
+	return false
+}
+
+// localizeGames returns the games localized to the translations that best match the preferred languages. The
+// translations are not read if there are no preferred languages.
+func (s *service) localizeGames(ctx context.Context, tx pgx.Tx, games []domain.Game, preferredLanguages []domain.Language) ([]domain.Game, error) {
+	if len(games) == 0 || len(preferredLanguages) == 0 {
+		return games, nil
+	}
+
+	gameIDs := make([]uuid.UUID, len(games))
+	for i, game := range games {
+		gameIDs[i] = game.ID
+	}
+
+	gameTranslations, err := s.dataStore.ListGameTranslations(ctx, tx, gameIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	gameTranslationsByGameID := make(map[uuid.UUID][]domain.GameTranslation)
+	for _, gameTranslation := range gameTranslations {
+		gameTranslationsByGameID[gameTranslation.GameID] = append(gameTranslationsByGameID[gameTranslation.GameID], gameTranslation)
+	}
+
+	localizedGames := make([]domain.Game, len(games))
+	for i, game := range games {
+		localizedGames[i] = game.Localized(gameTranslationsByGameID[game.ID], preferredLanguages)
+	}
+
+	return localizedGames, nil
+}
@@ -0,0 +1,311 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/text/language"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+func TestService_PutGameTranslation(t *testing.T) {
+	t.Run("should successfully put game translation", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			portuguese = domain.Language{Tag: language.Portuguese}
+			game       = domain.Game{
+				ID:        uuid.New(),
+				Status:    domain.GameStatusDraft,
+				Languages: domain.GameLanguages{{Tag: language.English}, portuguese},
+			}
+			editableGameTranslation = domain.EditableGameTranslation{
+				Title:       "translated title",
+				Description: "translated description",
+				Features:    "translated features",
+			}
+			expectedGameTranslation = domain.GameTranslation{
+				GameID:                  game.ID,
+				Language:                portuguese,
+				EditableGameTranslation: editableGameTranslation,
+			}
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		gomock.InOrder(
+			s.mockDataStore.EXPECT().
+				GetGameByID(ctx, tx, game.ID).
+				Return(game, nil),
+			s.mockDataStore.EXPECT().
+				PutGameTranslation(ctx, tx, game.ID, portuguese, editableGameTranslation).
+				Return(nil),
+			s.mockDataStore.EXPECT().
+				GetGameTranslation(ctx, tx, game.ID, portuguese).
+				Return(expectedGameTranslation, nil),
+		)
+
+		gameTranslation, err := s.service.PutGameTranslation(ctx, game.ID, portuguese, editableGameTranslation)
+		require.NoError(t, err)
+		assert.Equal(t, expectedGameTranslation, gameTranslation)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the title is invalid", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				gameID                  = uuid.New()
+				editableGameTranslation = domain.EditableGameTranslation{
+					Description: "translated description",
+					Features:    "translated features",
+				}
+
+				expectedError = fmt.Errorf("%s: %w", descriptionInvalidFieldValue, &domain.FieldValueInvalidError{FieldName: domain.FieldTitle})
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+
+			_, err := s.service.PutGameTranslation(ctx, gameID, domain.Language{Tag: language.Portuguese}, editableGameTranslation)
+			assert.Equal(t, expectedError, err)
+		})
+
+		t.Run("when the game is not editable", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				portuguese = domain.Language{Tag: language.Portuguese}
+				game       = domain.Game{
+					ID:        uuid.New(),
+					Status:    domain.GameStatusPublished,
+					Languages: domain.GameLanguages{{Tag: language.English}, portuguese},
+				}
+				editableGameTranslation = domain.EditableGameTranslation{
+					Title:       "translated title",
+					Description: "translated description",
+					Features:    "translated features",
+				}
+
+				expectedError = fmt.Errorf("%s: %w", descriptionFailedPutGameTranslation, domain.ErrGameNotEditable)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			s.mockDataStore.EXPECT().
+				GetGameByID(ctx, tx, game.ID).
+				Return(game, nil)
+
+			_, err := s.service.PutGameTranslation(ctx, game.ID, portuguese, editableGameTranslation)
+			assert.Equal(t, expectedError, err)
+		})
+
+		t.Run("when the language is the default language of the game", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				english = domain.Language{Tag: language.English}
+				game    = domain.Game{
+					ID:        uuid.New(),
+					Status:    domain.GameStatusDraft,
+					Languages: domain.GameLanguages{english, {Tag: language.Portuguese}},
+				}
+				editableGameTranslation = domain.EditableGameTranslation{
+					Title:       "title",
+					Description: "description",
+					Features:    "features",
+				}
+
+				expectedError = fmt.Errorf("%s: %w", descriptionInvalidFieldValue, &domain.FieldValueInvalidError{FieldName: domain.FieldLanguage})
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			s.mockDataStore.EXPECT().
+				GetGameByID(ctx, tx, game.ID).
+				Return(game, nil)
+
+			_, err := s.service.PutGameTranslation(ctx, game.ID, english, editableGameTranslation)
+			assert.Equal(t, expectedError, err)
+		})
+
+		t.Run("when the language is not a language of the game", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				game = domain.Game{
+					ID:        uuid.New(),
+					Status:    domain.GameStatusDraft,
+					Languages: domain.GameLanguages{{Tag: language.English}},
+				}
+				editableGameTranslation = domain.EditableGameTranslation{
+					Title:       "translated title",
+					Description: "description",
+					Features:    "translated features",
+				}
+
+				expectedError = fmt.Errorf("%s: %w", descriptionInvalidFieldValue, &domain.FieldValueInvalidError{FieldName: domain.FieldLanguage})
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			s.mockDataStore.EXPECT().
+				GetGameByID(ctx, tx, game.ID).
+				Return(game, nil)
+
+			_, err := s.service.PutGameTranslation(ctx, game.ID, domain.Language{Tag: language.French}, editableGameTranslation)
+			assert.Equal(t, expectedError, err)
+		})
+	})
+}
+
+func TestService_DeleteGameTranslation(t *testing.T) {
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the game translation does not exist", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				gameID     = uuid.New()
+				portuguese = domain.Language{Tag: language.Portuguese}
+
+				expectedError = fmt.Errorf("%s: %w", descriptionFailedDeleteGameTranslation, domain.ErrGameTranslationNotFound)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			gomock.InOrder(
+				s.mockDataStore.EXPECT().
+					GetGameByID(ctx, tx, gameID).
+					Return(domain.Game{ID: gameID, Status: domain.GameStatusDraft}, nil),
+				s.mockDataStore.EXPECT().
+					DeleteGameTranslation(ctx, tx, gameID, portuguese).
+					Return(domain.ErrGameTranslationNotFound),
+			)
+
+			err := s.service.DeleteGameTranslation(ctx, gameID, portuguese)
+			assert.Equal(t, expectedError, err)
+		})
+	})
+}
+
+func TestService_GetGameByID(t *testing.T) {
+	var (
+		english   = domain.Language{Tag: language.English}
+		brazilian = domain.Language{Tag: language.BrazilianPortuguese}
+
+		game = domain.Game{
+			ID:          uuid.New(),
+			Title:       "title",
+			Description: "description",
+			Features:    "features",
+			Languages:   domain.GameLanguages{english, brazilian},
+		}
+		gameTranslation = domain.GameTranslation{
+			GameID:   game.ID,
+			Language: brazilian,
+			EditableGameTranslation: domain.EditableGameTranslation{
+				Title:       "translated title",
+				Description: "translated description",
+				Features:    "translated features",
+			},
+		}
+	)
+
+	t.Run("should successfully get game localized to the best matching translation", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			preferredLanguages = []domain.Language{{Tag: language.French}, {Tag: language.Portuguese}}
+			expectedGame       = domain.Game{
+				ID:          game.ID,
+				Title:       gameTranslation.Title,
+				Description: gameTranslation.Description,
+				Features:    gameTranslation.Features,
+				Languages:   game.Languages,
+			}
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadOnlyTx(ctx, ctrl)
+
+		gomock.InOrder(
+			s.mockDataStore.EXPECT().
+				GetGameByID(ctx, tx, game.ID).
+				Return(game, nil),
+			s.mockDataStore.EXPECT().
+				ListGameTranslations(ctx, tx, []uuid.UUID{game.ID}).
+				Return([]domain.GameTranslation{gameTranslation}, nil),
+		)
+
+		actualGame, err := s.service.GetGameByID(ctx, game.ID, preferredLanguages)
+		require.NoError(t, err)
+		assert.Equal(t, expectedGame, actualGame)
+	})
+
+	t.Run("should successfully get game in the default language when it matches best", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			preferredLanguages = []domain.Language{{Tag: language.BritishEnglish}, brazilian}
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadOnlyTx(ctx, ctrl)
+
+		gomock.InOrder(
+			s.mockDataStore.EXPECT().
+				GetGameByID(ctx, tx, game.ID).
+				Return(game, nil),
+			s.mockDataStore.EXPECT().
+				ListGameTranslations(ctx, tx, []uuid.UUID{game.ID}).
+				Return([]domain.GameTranslation{gameTranslation}, nil),
+		)
+
+		actualGame, err := s.service.GetGameByID(ctx, game.ID, preferredLanguages)
+		require.NoError(t, err)
+		assert.Equal(t, game, actualGame)
+	})
+
+	t.Run("should successfully get game without reading translations when there are no preferred languages", func(t *testing.T) {
+		ctx := context.Background()
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadOnlyTx(ctx, ctrl)
+
+		s.mockDataStore.EXPECT().
+			GetGameByID(ctx, tx, game.ID).
+			Return(game, nil)
+
+		actualGame, err := s.service.GetGameByID(ctx, game.ID, nil)
+		require.NoError(t, err)
+		assert.Equal(t, game, actualGame)
+	})
+}
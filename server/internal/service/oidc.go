@@ -0,0 +1,309 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goofr-group/gaming-store/server/internal/authn"
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+	"github.com/goofr-group/gaming-store/server/internal/logging"
+)
+
+const (
+	descriptionFailedBeginOIDCSignIn         = "service: failed to begin oidc sign in"
+	descriptionFailedFinishOIDCSignIn        = "service: failed to finish oidc sign in"
+	descriptionFailedCreateOIDCIdentityToken = "service: failed to create oidc identity token"
+	descriptionFailedParseOIDCIdentityToken  = "service: failed to parse oidc identity token"
+	descriptionFailedCreateUserIdentity      = "service: failed to link user identity"
+	descriptionFailedCreateUnusablePassword  = "service: failed to create unusable password"
+)
+
+// BeginUserOIDCSignIn starts a user sign in with the specified external provider and returns the URL of the provider
+// where the user authenticates, along with the binding that the browser must present to finish the sign in.
+func (s *service) BeginUserOIDCSignIn(ctx context.Context, provider string) (domain.OIDCAuthorizationStart, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "BeginUserOIDCSignIn"),
+		slog.String(logging.OIDCProvider, provider),
+	}
+
+	nonce, err := s.authnService.NewOIDCNonce()
+	if err != nil {
+		return domain.OIDCAuthorizationStart{}, logAndWrapError(ctx, err, descriptionFailedBeginOIDCSignIn, logAttrs...)
+	}
+
+	codeVerifier, err := s.authnService.NewOIDCCodeVerifier()
+	if err != nil {
+		return domain.OIDCAuthorizationStart{}, logAndWrapError(ctx, err, descriptionFailedBeginOIDCSignIn, logAttrs...)
+	}
+
+	binding, bindingHash, err := s.authnService.NewOIDCBinding()
+	if err != nil {
+		return domain.OIDCAuthorizationStart{}, logAndWrapError(ctx, err, descriptionFailedBeginOIDCSignIn, logAttrs...)
+	}
+
+	var authorizationURL string
+
+	err = s.readWriteTx(ctx, func(tx pgx.Tx) error {
+		id, err := s.dataStore.CreateOIDCAuthorization(ctx, tx, domain.EditableOIDCAuthorization{
+			Provider:     provider,
+			Nonce:        nonce,
+			CodeVerifier: codeVerifier,
+			BindingHash:  bindingHash,
+			ExpiresAt:    time.Now().Add(domain.OIDCAuthorizationExpirationTime),
+		})
+		if err != nil {
+			return err
+		}
+
+		authorizationURL, err = s.oidc.AuthCodeURL(provider, id.String(), nonce, codeVerifier)
+
+		return err
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrOIDCProviderNotFound):
+			return domain.OIDCAuthorizationStart{}, logInfoAndWrapError(ctx, err, descriptionFailedBeginOIDCSignIn, logAttrs...)
+		default:
+			return domain.OIDCAuthorizationStart{}, logAndWrapError(ctx, err, descriptionFailedBeginOIDCSignIn, logAttrs...)
+		}
+	}
+
+	return domain.OIDCAuthorizationStart{
+		AuthorizationURL: authorizationURL,
+		Binding:          binding,
+	}, nil
+}
+
+// FinishUserOIDCSignIn exchanges the authorization code returned by the specified external provider for the tokens
+// of the user linked to the identity, or a challenge token when the user has two-factor authentication enabled. When
+// the identity is not linked to any user, an identity token is returned to create an account or link the identity to
+// an existing one. The binding must be the one returned when the sign in began, so that an authorization started in
+// another browser can not be completed.
+func (s *service) FinishUserOIDCSignIn(ctx context.Context, provider, state, binding, code string, client domain.SessionClient) (domain.OIDCSignIn, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "FinishUserOIDCSignIn"),
+		slog.String(logging.OIDCProvider, provider),
+	}
+
+	authorizationID, err := uuid.Parse(state)
+	if err != nil {
+		return domain.OIDCSignIn{}, logInfoAndWrapError(ctx, domain.ErrOIDCAuthorizationInvalid, descriptionFailedFinishOIDCSignIn, logAttrs...)
+	}
+
+	var authorization domain.OIDCAuthorization
+
+	err = s.readWriteTx(ctx, func(tx pgx.Tx) error {
+		authorization, err = s.dataStore.DeleteOIDCAuthorization(ctx, tx, authorizationID)
+		if err != nil {
+			return err
+		}
+
+		if authorization.Provider != provider || !time.Now().Before(authorization.ExpiresAt) {
+			return domain.ErrOIDCAuthorizationInvalid
+		}
+
+		if subtle.ConstantTimeCompare(s.authnService.HashOIDCBinding(binding), authorization.BindingHash) != 1 {
+			return domain.ErrOIDCAuthorizationInvalid
+		}
+
+		return nil
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrOIDCAuthorizationNotFound):
+			return domain.OIDCSignIn{}, logInfoAndWrapError(ctx, domain.ErrOIDCAuthorizationInvalid, descriptionFailedFinishOIDCSignIn, logAttrs...)
+		case errors.Is(err, domain.ErrOIDCAuthorizationInvalid):
+			return domain.OIDCSignIn{}, logInfoAndWrapError(ctx, err, descriptionFailedFinishOIDCSignIn, logAttrs...)
+		default:
+			return domain.OIDCSignIn{}, logAndWrapError(ctx, err, descriptionFailedFinishOIDCSignIn, logAttrs...)
+		}
+	}
+
+	identity, err := s.oidc.Exchange(ctx, provider, code, authorization.Nonce, authorization.CodeVerifier)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrOIDCProviderNotFound):
+			return domain.OIDCSignIn{}, logInfoAndWrapError(ctx, err, descriptionFailedFinishOIDCSignIn, logAttrs...)
+		default:
+			return domain.OIDCSignIn{}, logInfoAndWrapError(ctx, domain.ErrOIDCAuthorizationInvalid, descriptionFailedFinishOIDCSignIn, append(logAttrs, logging.Error(err))...)
+		}
+	}
+
+	var userIdentity domain.UserIdentity
+
+	err = s.readOnlyTx(ctx, func(tx pgx.Tx) error {
+		userIdentity, err = s.dataStore.GetUserIdentity(ctx, tx, identity.Provider, identity.Subject)
+		return err
+	})
+	if err != nil {
+		if !errors.Is(err, domain.ErrUserIdentityNotFound) {
+			return domain.OIDCSignIn{}, logAndWrapError(ctx, err, descriptionFailedFinishOIDCSignIn, logAttrs...)
+		}
+
+		identityToken, err := s.authnService.NewOIDCIdentityToken(identity.Provider, identity.Subject, string(identity.Email), identity.EmailVerified)
+		if err != nil {
+			return domain.OIDCSignIn{}, logAndWrapError(ctx, err, descriptionFailedCreateOIDCIdentityToken, logAttrs...)
+		}
+
+		return domain.OIDCSignIn{
+			Identity:      identity,
+			IdentityToken: identityToken,
+		}, nil
+	}
+
+	signIn, err := s.signIn(ctx, userIdentity.UserID, authn.SubjectRoleUser, client, append(logAttrs, slog.String(logging.UserID, userIdentity.UserID.String())))
+	if err != nil {
+		return domain.OIDCSignIn{}, err
+	}
+
+	return domain.OIDCSignIn{
+		SignIn:   signIn,
+		Identity: identity,
+	}, nil
+}
+
+// SignUpUserOIDC creates a new user with the specified data, linked to the external identity of the identity token,
+// and returns its tokens. The email of the identity is used when no email is specified, and is marked as verified when
+// the provider verified it. The user has no password until one is set with a password reset.
+func (s *service) SignUpUserOIDC(ctx context.Context, identityToken string, editableUser domain.EditableUser, client domain.SessionClient) (domain.Tokens, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "SignUpUserOIDC"),
+		slog.String(logging.UserUsername, string(editableUser.Username)),
+		slog.String(logging.UserEmail, string(editableUser.Email)),
+		slog.String(logging.UserDisplayName, string(editableUser.DisplayName)),
+		slog.Time(logging.UserDateOfBirth, editableUser.DateOfBirth.Time()),
+		slog.String(logging.UserAddress, string(editableUser.Address)),
+		slog.String(logging.UserCountry, editableUser.Country.String()),
+		slog.String(logging.UserVatin, string(editableUser.Vatin)),
+	}
+
+	claims, err := s.authnService.ParseOIDCIdentityToken(identityToken)
+	if err != nil {
+		return domain.Tokens{}, logInfoAndWrapError(ctx, domain.ErrOIDCIdentityTokenInvalid, descriptionFailedParseOIDCIdentityToken, append(logAttrs, logging.Error(err))...)
+	}
+
+	logAttrs = append(logAttrs, slog.String(logging.OIDCProvider, claims.Provider))
+
+	if len(editableUser.Email) == 0 {
+		editableUser.Email = domain.Email(claims.Email)
+	}
+
+	editableUser = normalizeEditableUser(editableUser)
+
+	err = validateEditableUser(editableUser)
+	if err != nil {
+		return domain.Tokens{}, logInfoAndWrapError(ctx, err, descriptionInvalidFieldValue, logAttrs...)
+	}
+
+	hashedPassword, err := s.authnService.NewUnusablePasswordHash()
+	if err != nil {
+		return domain.Tokens{}, logAndWrapError(ctx, err, descriptionFailedCreateUnusablePassword, logAttrs...)
+	}
+
+	emailVerified := claims.EmailVerified && strings.EqualFold(string(editableUser.Email), claims.Email)
+
+	var user domain.User
+
+	err = s.readWriteTx(ctx, func(tx pgx.Tx) error {
+		id, err := s.dataStore.CreateUser(ctx, tx, domain.EditableUserWithPassword{
+			EditableUser: editableUser,
+			Password:     domain.Password(hashedPassword),
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = s.dataStore.CreateUserIdentity(ctx, tx, userIdentityFromClaims(id, claims))
+		if err != nil {
+			return err
+		}
+
+		if emailVerified {
+			err = s.dataStore.VerifyUserEmail(ctx, tx, id)
+			if err != nil {
+				return err
+			}
+		}
+
+		user, err = s.dataStore.GetUserByID(ctx, tx, id)
+
+		return err
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUserUsernameAlreadyExists),
+			errors.Is(err, domain.ErrUserEmailAlreadyExists),
+			errors.Is(err, domain.ErrUserVatinAlreadyExists),
+			errors.Is(err, domain.ErrUserIdentityAlreadyExists),
+			errors.Is(err, domain.ErrMultimediaNotFound):
+			return domain.Tokens{}, logInfoAndWrapError(ctx, err, descriptionFailedCreateUser, logAttrs...)
+		default:
+			return domain.Tokens{}, logAndWrapError(ctx, err, descriptionFailedCreateUser, logAttrs...)
+		}
+	}
+
+	if !emailVerified {
+		// The account is kept when the email can not be sent, since it can be sent again.
+		err = s.sendEmailVerification(user.ID, authn.SubjectRoleUser, user.Email, string(user.DisplayName), emailVerificationUserPath)
+		if err != nil {
+			logging.Logger.ErrorContext(ctx, descriptionFailedSendEmailVerification, append(logAttrs, logging.Error(err))...)
+		}
+	}
+
+	return s.newTokens(ctx, user.ID, authn.SubjectRoleUser, client, logAttrs)
+}
+
+// LinkUserIdentity links the external identity of the identity token to the user with the specified identifier, so
+// that the user can sign in with its provider.
+func (s *service) LinkUserIdentity(ctx context.Context, userID uuid.UUID, identityToken string) error {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "LinkUserIdentity"),
+		slog.String(logging.UserID, userID.String()),
+	}
+
+	claims, err := s.authnService.ParseOIDCIdentityToken(identityToken)
+	if err != nil {
+		return logInfoAndWrapError(ctx, domain.ErrOIDCIdentityTokenInvalid, descriptionFailedParseOIDCIdentityToken, append(logAttrs, logging.Error(err))...)
+	}
+
+	logAttrs = append(logAttrs, slog.String(logging.OIDCProvider, claims.Provider))
+
+	err = s.readWriteTx(ctx, func(tx pgx.Tx) error {
+		_, err := s.dataStore.CreateUserIdentity(ctx, tx, userIdentityFromClaims(userID, claims))
+		return err
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUserNotFound),
+			errors.Is(err, domain.ErrUserIdentityAlreadyExists):
+			return logInfoAndWrapError(ctx, err, descriptionFailedCreateUserIdentity, logAttrs...)
+		default:
+			return logAndWrapError(ctx, err, descriptionFailedCreateUserIdentity, logAttrs...)
+		}
+	}
+
+	return nil
+}
+
+// userIdentityFromClaims returns the user identity data of the oidc identity token claims.
+func userIdentityFromClaims(userID uuid.UUID, claims authn.OIDCIdentityClaims) domain.EditableUserIdentity {
+	userIdentity := domain.EditableUserIdentity{
+		UserID:   userID,
+		Provider: claims.Provider,
+		Subject:  claims.Subject,
+	}
+
+	if len(claims.Email) != 0 {
+		email := domain.Email(claims.Email)
+		userIdentity.Email = &email
+	}
+
+	return userIdentity
+}
@@ -0,0 +1,681 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/text/language"
+
+	"github.com/goofr-group/gaming-store/server/internal/authn"
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+func TestService_BeginUserOIDCSignIn(t *testing.T) {
+	t.Run("should successfully begin user oidc sign in", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			provider        = "provider"
+			authorizationID = uuid.New()
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		gomock.InOrder(
+			s.mockAuthnService.EXPECT().
+				NewOIDCNonce().
+				Return("nonce", nil),
+			s.mockAuthnService.EXPECT().
+				NewOIDCCodeVerifier().
+				Return("code verifier", nil),
+			s.mockAuthnService.EXPECT().
+				NewOIDCBinding().
+				Return("binding", []byte("binding hash"), nil),
+			s.mockDataStore.EXPECT().
+				CreateOIDCAuthorization(ctx, tx, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ pgx.Tx, authorization domain.EditableOIDCAuthorization) (uuid.UUID, error) {
+					assert.Equal(t, provider, authorization.Provider)
+					assert.Equal(t, "nonce", authorization.Nonce)
+					assert.Equal(t, "code verifier", authorization.CodeVerifier)
+					assert.Equal(t, []byte("binding hash"), authorization.BindingHash)
+					assert.WithinDuration(t, time.Now().Add(domain.OIDCAuthorizationExpirationTime), authorization.ExpiresAt, time.Second)
+
+					return authorizationID, nil
+				}),
+			s.mockOIDC.EXPECT().
+				AuthCodeURL(provider, authorizationID.String(), "nonce", "code verifier").
+				Return("https://provider/authorize", nil),
+		)
+
+		expectedAuthorizationStart := domain.OIDCAuthorizationStart{
+			AuthorizationURL: "https://provider/authorize",
+			Binding:          "binding",
+		}
+
+		authorizationStart, err := s.service.BeginUserOIDCSignIn(ctx, provider)
+		require.NoError(t, err)
+		assert.Equal(t, expectedAuthorizationStart, authorizationStart)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the provider is not found", func(t *testing.T) {
+			ctx := context.Background()
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			gomock.InOrder(
+				s.mockAuthnService.EXPECT().
+					NewOIDCNonce().
+					Return("nonce", nil),
+				s.mockAuthnService.EXPECT().
+					NewOIDCCodeVerifier().
+					Return("code verifier", nil),
+				s.mockAuthnService.EXPECT().
+					NewOIDCBinding().
+					Return("binding", []byte("binding hash"), nil),
+				s.mockDataStore.EXPECT().
+					CreateOIDCAuthorization(ctx, tx, gomock.Any()).
+					Return(uuid.New(), nil),
+				s.mockOIDC.EXPECT().
+					AuthCodeURL("unknown", gomock.Any(), "nonce", "code verifier").
+					Return("", domain.ErrOIDCProviderNotFound),
+			)
+
+			_, err := s.service.BeginUserOIDCSignIn(ctx, "unknown")
+			require.ErrorIs(t, err, domain.ErrOIDCProviderNotFound)
+		})
+	})
+}
+
+func TestService_FinishUserOIDCSignIn(t *testing.T) {
+	newAuthorization := func(provider string, expiresAt time.Time) domain.OIDCAuthorization {
+		return domain.OIDCAuthorization{
+			EditableOIDCAuthorization: domain.EditableOIDCAuthorization{
+				Provider:     provider,
+				Nonce:        "nonce",
+				CodeVerifier: "code verifier",
+				BindingHash:  []byte("binding hash"),
+				ExpiresAt:    expiresAt,
+			},
+			ID: uuid.New(),
+		}
+	}
+
+	identity := domain.OIDCIdentity{
+		Provider:      "provider",
+		Subject:       "subject",
+		Email:         "user@email.com",
+		EmailVerified: true,
+		Name:          "user name",
+	}
+
+	t.Run("should successfully sign in user linked to the identity", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			authorization = newAuthorization("provider", time.Now().Add(time.Minute))
+			userID        = uuid.New()
+			client        = domain.SessionClient{UserAgent: "user agent", IPAddress: "127.0.0.1"}
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+		readOnlyTx := s.newReadOnlyTx(ctx, ctrl)
+
+		sessionID := uuid.New()
+
+		gomock.InOrder(
+			s.mockDataStore.EXPECT().
+				DeleteOIDCAuthorization(ctx, tx, authorization.ID).
+				Return(authorization, nil),
+			s.mockAuthnService.EXPECT().
+				HashOIDCBinding("binding").
+				Return([]byte("binding hash")),
+			s.mockOIDC.EXPECT().
+				Exchange(ctx, "provider", "code", "nonce", "code verifier").
+				Return(identity, nil),
+			s.mockDataStore.EXPECT().
+				GetUserIdentity(ctx, readOnlyTx, "provider", "subject").
+				Return(domain.UserIdentity{EditableUserIdentity: domain.EditableUserIdentity{UserID: userID}}, nil),
+			s.mockDataStore.EXPECT().
+				GetTOTPBySubjectID(ctx, readOnlyTx, userID).
+				Return(domain.TOTP{}, domain.ErrTOTPNotFound),
+			s.mockAuthnService.EXPECT().
+				NewRefreshToken().
+				Return("refresh token", []byte("refresh token hash"), nil),
+			s.mockDataStore.EXPECT().
+				CreateSession(ctx, tx, gomock.Any()).
+				Return(sessionID, nil),
+			s.mockDataStore.EXPECT().
+				CreateRefreshToken(ctx, tx, gomock.Any()).
+				Return(uuid.New(), nil),
+			s.mockDataStore.EXPECT().
+				GetUserAdmin(ctx, tx, userID).
+				Return(false, nil),
+			s.mockDataStore.EXPECT().
+				ListUserPublisherMembers(ctx, tx, userID).
+				Return(nil, nil),
+			s.mockAuthnService.EXPECT().
+				NewJWT(userID.String(), sessionID.String(), []authn.SubjectRole{authn.SubjectRoleUser}, nil).
+				Return("access token", nil),
+		)
+
+		expectedSignIn := domain.OIDCSignIn{
+			SignIn: domain.SignIn{
+				Tokens: domain.Tokens{
+					AccessToken:  "access token",
+					RefreshToken: "refresh token",
+				},
+			},
+			Identity: identity,
+		}
+
+		signIn, err := s.service.FinishUserOIDCSignIn(ctx, "provider", authorization.ID.String(), "binding", "code", client)
+		require.NoError(t, err)
+		assert.Equal(t, expectedSignIn, signIn)
+	})
+
+	t.Run("should successfully return identity token when the identity is not linked", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			authorization = newAuthorization("provider", time.Now().Add(time.Minute))
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+		readOnlyTx := s.newReadOnlyTx(ctx, ctrl)
+
+		gomock.InOrder(
+			s.mockDataStore.EXPECT().
+				DeleteOIDCAuthorization(ctx, tx, authorization.ID).
+				Return(authorization, nil),
+			s.mockAuthnService.EXPECT().
+				HashOIDCBinding("binding").
+				Return([]byte("binding hash")),
+			s.mockOIDC.EXPECT().
+				Exchange(ctx, "provider", "code", "nonce", "code verifier").
+				Return(identity, nil),
+			s.mockDataStore.EXPECT().
+				GetUserIdentity(ctx, readOnlyTx, "provider", "subject").
+				Return(domain.UserIdentity{}, domain.ErrUserIdentityNotFound),
+			s.mockAuthnService.EXPECT().
+				NewOIDCIdentityToken("provider", "subject", "user@email.com", true).
+				Return("identity token", nil),
+		)
+
+		expectedSignIn := domain.OIDCSignIn{
+			Identity:      identity,
+			IdentityToken: "identity token",
+		}
+
+		signIn, err := s.service.FinishUserOIDCSignIn(ctx, "provider", authorization.ID.String(), "binding", "code", domain.SessionClient{})
+		require.NoError(t, err)
+		assert.Equal(t, expectedSignIn, signIn)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the state is invalid", func(t *testing.T) {
+			ctx := context.Background()
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+
+			_, err := s.service.FinishUserOIDCSignIn(ctx, "provider", "state", "binding", "code", domain.SessionClient{})
+			require.ErrorIs(t, err, domain.ErrOIDCAuthorizationInvalid)
+		})
+
+		t.Run("when the authorization is not found", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				authorizationID = uuid.New()
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			s.mockDataStore.EXPECT().
+				DeleteOIDCAuthorization(ctx, tx, authorizationID).
+				Return(domain.OIDCAuthorization{}, domain.ErrOIDCAuthorizationNotFound)
+
+			_, err := s.service.FinishUserOIDCSignIn(ctx, "provider", authorizationID.String(), "binding", "code", domain.SessionClient{})
+			require.ErrorIs(t, err, domain.ErrOIDCAuthorizationInvalid)
+		})
+
+		t.Run("when the authorization is of another provider", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				authorization = newAuthorization("other provider", time.Now().Add(time.Minute))
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			s.mockDataStore.EXPECT().
+				DeleteOIDCAuthorization(ctx, tx, authorization.ID).
+				Return(authorization, nil)
+
+			_, err := s.service.FinishUserOIDCSignIn(ctx, "provider", authorization.ID.String(), "binding", "code", domain.SessionClient{})
+			require.ErrorIs(t, err, domain.ErrOIDCAuthorizationInvalid)
+		})
+
+		t.Run("when the authorization is expired", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				authorization = newAuthorization("provider", time.Now().Add(-time.Minute))
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			s.mockDataStore.EXPECT().
+				DeleteOIDCAuthorization(ctx, tx, authorization.ID).
+				Return(authorization, nil)
+
+			_, err := s.service.FinishUserOIDCSignIn(ctx, "provider", authorization.ID.String(), "binding", "code", domain.SessionClient{})
+			require.ErrorIs(t, err, domain.ErrOIDCAuthorizationInvalid)
+		})
+
+		t.Run("when the authorization was started by another browser", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				authorization = newAuthorization("provider", time.Now().Add(time.Minute))
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			gomock.InOrder(
+				s.mockDataStore.EXPECT().
+					DeleteOIDCAuthorization(ctx, tx, authorization.ID).
+					Return(authorization, nil),
+				s.mockAuthnService.EXPECT().
+					HashOIDCBinding("other binding").
+					Return([]byte("other binding hash")),
+			)
+
+			_, err := s.service.FinishUserOIDCSignIn(ctx, "provider", authorization.ID.String(), "other binding", "code", domain.SessionClient{})
+			require.ErrorIs(t, err, domain.ErrOIDCAuthorizationInvalid)
+		})
+
+		t.Run("when the code exchange fails", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				authorization = newAuthorization("provider", time.Now().Add(time.Minute))
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			gomock.InOrder(
+				s.mockDataStore.EXPECT().
+					DeleteOIDCAuthorization(ctx, tx, authorization.ID).
+					Return(authorization, nil),
+				s.mockAuthnService.EXPECT().
+					HashOIDCBinding("binding").
+					Return([]byte("binding hash")),
+				s.mockOIDC.EXPECT().
+					Exchange(ctx, "provider", "code", "nonce", "code verifier").
+					Return(domain.OIDCIdentity{}, errors.New("invalid id token")),
+			)
+
+			_, err := s.service.FinishUserOIDCSignIn(ctx, "provider", authorization.ID.String(), "binding", "code", domain.SessionClient{})
+			require.ErrorIs(t, err, domain.ErrOIDCAuthorizationInvalid)
+		})
+	})
+}
+
+func TestService_SignUpUserOIDC(t *testing.T) {
+	editableUser := domain.EditableUser{
+		Username:    "username",
+		DisplayName: "user name",
+		DateOfBirth: domain.UserDateOfBirth(time.Date(2000, 2, 1, 0, 0, 0, 0, time.UTC)),
+		Address:     "address",
+		Country:     domain.Country{Region: language.MustParseRegion("pt")},
+		Vatin:       "123456789",
+	}
+
+	claims := authn.OIDCIdentityClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject: "subject",
+		},
+		Provider:      "provider",
+		Email:         "user@email.com",
+		EmailVerified: true,
+	}
+
+	email := domain.Email("user@email.com")
+
+	expectedUserIdentity := func(userID uuid.UUID) domain.EditableUserIdentity {
+		return domain.EditableUserIdentity{
+			UserID:   userID,
+			Provider: "provider",
+			Subject:  "subject",
+			Email:    &email,
+		}
+	}
+
+	t.Run("should successfully sign up user with the verified email of the identity", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			userID = uuid.New()
+			user   = domain.User{ID: userID, Email: email}
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		expectedEditableUser := editableUser
+		expectedEditableUser.Email = email
+
+		sessionID := uuid.New()
+
+		gomock.InOrder(
+			s.mockAuthnService.EXPECT().
+				ParseOIDCIdentityToken("identity token").
+				Return(claims, nil),
+			s.mockAuthnService.EXPECT().
+				NewUnusablePasswordHash().
+				Return([]byte("unusable password"), nil),
+			s.mockDataStore.EXPECT().
+				CreateUser(ctx, tx, domain.EditableUserWithPassword{
+					EditableUser: expectedEditableUser,
+					Password:     "unusable password",
+				}).
+				Return(userID, nil),
+			s.mockDataStore.EXPECT().
+				CreateUserIdentity(ctx, tx, expectedUserIdentity(userID)).
+				Return(uuid.New(), nil),
+			s.mockDataStore.EXPECT().
+				VerifyUserEmail(ctx, tx, userID).
+				Return(nil),
+			s.mockDataStore.EXPECT().
+				GetUserByID(ctx, tx, userID).
+				Return(user, nil),
+			s.mockAuthnService.EXPECT().
+				NewRefreshToken().
+				Return("refresh token", []byte("refresh token hash"), nil),
+			s.mockDataStore.EXPECT().
+				CreateSession(ctx, tx, gomock.Any()).
+				Return(sessionID, nil),
+			s.mockDataStore.EXPECT().
+				CreateRefreshToken(ctx, tx, gomock.Any()).
+				Return(uuid.New(), nil),
+			s.mockDataStore.EXPECT().
+				GetUserAdmin(ctx, tx, userID).
+				Return(false, nil),
+			s.mockDataStore.EXPECT().
+				ListUserPublisherMembers(ctx, tx, userID).
+				Return(nil, nil),
+			s.mockAuthnService.EXPECT().
+				NewJWT(userID.String(), sessionID.String(), []authn.SubjectRole{authn.SubjectRoleUser}, nil).
+				Return("access token", nil),
+		)
+
+		expectedTokens := domain.Tokens{
+			AccessToken:  "access token",
+			RefreshToken: "refresh token",
+		}
+
+		tokens, err := s.service.SignUpUserOIDC(ctx, "identity token", editableUser, domain.SessionClient{})
+		require.NoError(t, err)
+		assert.Equal(t, expectedTokens, tokens)
+	})
+
+	t.Run("should successfully sign up user and send email verification when the email differs", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			userID     = uuid.New()
+			otherEmail = domain.Email("other@email.com")
+			user       = domain.User{ID: userID, Email: otherEmail, DisplayName: "user name"}
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		expectedEditableUser := editableUser
+		expectedEditableUser.Email = otherEmail
+
+		sessionID := uuid.New()
+
+		gomock.InOrder(
+			s.mockAuthnService.EXPECT().
+				ParseOIDCIdentityToken("identity token").
+				Return(claims, nil),
+			s.mockAuthnService.EXPECT().
+				NewUnusablePasswordHash().
+				Return([]byte("unusable password"), nil),
+			s.mockDataStore.EXPECT().
+				CreateUser(ctx, tx, domain.EditableUserWithPassword{
+					EditableUser: expectedEditableUser,
+					Password:     "unusable password",
+				}).
+				Return(userID, nil),
+			s.mockDataStore.EXPECT().
+				CreateUserIdentity(ctx, tx, expectedUserIdentity(userID)).
+				Return(uuid.New(), nil),
+			s.mockDataStore.EXPECT().
+				GetUserByID(ctx, tx, userID).
+				Return(user, nil),
+			s.mockAuthnService.EXPECT().
+				NewEmailVerificationToken(userID.String(), authn.SubjectRoleUser, string(otherEmail)).
+				Return("email verification token", nil),
+			s.mockSMTP.EXPECT().
+				SendMailHTML([]string{string(otherEmail)}, emailVerificationEmailSubject, gomock.Any()).
+				Return(nil),
+			s.mockAuthnService.EXPECT().
+				NewRefreshToken().
+				Return("refresh token", []byte("refresh token hash"), nil),
+			s.mockDataStore.EXPECT().
+				CreateSession(ctx, tx, gomock.Any()).
+				Return(sessionID, nil),
+			s.mockDataStore.EXPECT().
+				CreateRefreshToken(ctx, tx, gomock.Any()).
+				Return(uuid.New(), nil),
+			s.mockDataStore.EXPECT().
+				GetUserAdmin(ctx, tx, userID).
+				Return(false, nil),
+			s.mockDataStore.EXPECT().
+				ListUserPublisherMembers(ctx, tx, userID).
+				Return(nil, nil),
+			s.mockAuthnService.EXPECT().
+				NewJWT(userID.String(), sessionID.String(), []authn.SubjectRole{authn.SubjectRoleUser}, nil).
+				Return("access token", nil),
+		)
+
+		editableUserWithEmail := editableUser
+		editableUserWithEmail.Email = otherEmail
+
+		_, err := s.service.SignUpUserOIDC(ctx, "identity token", editableUserWithEmail, domain.SessionClient{})
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the identity token is invalid", func(t *testing.T) {
+			ctx := context.Background()
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+
+			s.mockAuthnService.EXPECT().
+				ParseOIDCIdentityToken("identity token").
+				Return(authn.OIDCIdentityClaims{}, errors.New("invalid token"))
+
+			_, err := s.service.SignUpUserOIDC(ctx, "identity token", editableUser, domain.SessionClient{})
+			require.ErrorIs(t, err, domain.ErrOIDCIdentityTokenInvalid)
+		})
+
+		t.Run("when a field is invalid", func(t *testing.T) {
+			ctx := context.Background()
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+
+			s.mockAuthnService.EXPECT().
+				ParseOIDCIdentityToken("identity token").
+				Return(claims, nil)
+
+			invalidEditableUser := editableUser
+			invalidEditableUser.Vatin = ""
+
+			_, err := s.service.SignUpUserOIDC(ctx, "identity token", invalidEditableUser, domain.SessionClient{})
+
+			var fieldValueInvalidError *domain.FieldValueInvalidError
+			require.ErrorAs(t, err, &fieldValueInvalidError)
+			assert.Equal(t, domain.FieldVatin, fieldValueInvalidError.FieldName)
+		})
+
+		t.Run("when the identity is already linked", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				userID = uuid.New()
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			gomock.InOrder(
+				s.mockAuthnService.EXPECT().
+					ParseOIDCIdentityToken("identity token").
+					Return(claims, nil),
+				s.mockAuthnService.EXPECT().
+					NewUnusablePasswordHash().
+					Return([]byte("unusable password"), nil),
+				s.mockDataStore.EXPECT().
+					CreateUser(ctx, tx, gomock.Any()).
+					Return(userID, nil),
+				s.mockDataStore.EXPECT().
+					CreateUserIdentity(ctx, tx, expectedUserIdentity(userID)).
+					Return(uuid.Nil, domain.ErrUserIdentityAlreadyExists),
+			)
+
+			_, err := s.service.SignUpUserOIDC(ctx, "identity token", editableUser, domain.SessionClient{})
+			require.ErrorIs(t, err, domain.ErrUserIdentityAlreadyExists)
+		})
+	})
+}
+
+func TestService_LinkUserIdentity(t *testing.T) {
+	claims := authn.OIDCIdentityClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject: "subject",
+		},
+		Provider: "provider",
+	}
+
+	t.Run("should successfully link user identity", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			userID = uuid.New()
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		gomock.InOrder(
+			s.mockAuthnService.EXPECT().
+				ParseOIDCIdentityToken("identity token").
+				Return(claims, nil),
+			s.mockDataStore.EXPECT().
+				CreateUserIdentity(ctx, tx, domain.EditableUserIdentity{
+					UserID:   userID,
+					Provider: "provider",
+					Subject:  "subject",
+				}).
+				Return(uuid.New(), nil),
+		)
+
+		err := s.service.LinkUserIdentity(ctx, userID, "identity token")
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the identity token is invalid", func(t *testing.T) {
+			ctx := context.Background()
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+
+			s.mockAuthnService.EXPECT().
+				ParseOIDCIdentityToken("identity token").
+				Return(authn.OIDCIdentityClaims{}, errors.New("invalid token"))
+
+			err := s.service.LinkUserIdentity(ctx, uuid.New(), "identity token")
+			require.ErrorIs(t, err, domain.ErrOIDCIdentityTokenInvalid)
+		})
+
+		t.Run("when the identity is already linked", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				userID = uuid.New()
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			gomock.InOrder(
+				s.mockAuthnService.EXPECT().
+					ParseOIDCIdentityToken("identity token").
+					Return(claims, nil),
+				s.mockDataStore.EXPECT().
+					CreateUserIdentity(ctx, tx, gomock.Any()).
+					Return(uuid.Nil, domain.ErrUserIdentityAlreadyExists),
+			)
+
+			err := s.service.LinkUserIdentity(ctx, userID, "identity token")
+			require.ErrorIs(t, err, domain.ErrUserIdentityAlreadyExists)
+		})
+	})
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goofr-group/gaming-store/server/internal/authn"
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+const (
+	descriptionFailedCheckPasswordBreached = "service: failed to check breached password"
+	descriptionPasswordCompromised         = "service: password compromised"
+	descriptionFailedCheckCurrentPassword  = "service: failed to check current password"
+	descriptionFailedUpdatePassword        = "service: failed to update password"
+)
+
+// validateNewPassword validates a password chosen by a subject, which must satisfy the password rules and must not be
+// found in the breached password corpus.
+func (s *service) validateNewPassword(ctx context.Context, password domain.Password, logAttrs []any) error {
+	if !s.authnService.ValidPassword([]byte(password)) {
+		return logInfoAndWrapError(ctx, &domain.FieldValueInvalidError{FieldName: domain.FieldPassword}, descriptionInvalidFieldValue, logAttrs...)
+	}
+
+	compromised, err := s.breachStore.Compromised([]byte(password))
+	if err != nil {
+		return logAndWrapError(ctx, err, descriptionFailedCheckPasswordBreached, logAttrs...)
+	}
+
+	if compromised {
+		return logInfoAndWrapError(ctx, domain.ErrPasswordCompromised, descriptionPasswordCompromised, logAttrs...)
+	}
+
+	return nil
+}
+
+// changePassword sets a new password for the subject with the specified identifier and role, after checking its
+// current password against the hash returned by the get password function, and revokes every other session of the
+// subject than the specified one. Failed checks of the current password are throttled like failed sign ins, per
+// subject and per IP address of the client, so that a stolen session can not be used to guess the password. The
+// throttles are checked and the check is recorded in the same transaction, which locks them, like failed sign ins.
+func (s *service) changePassword(ctx context.Context, id, sessionID uuid.UUID, role authn.SubjectRole, currentPassword, newPassword domain.Password, client domain.SessionClient, getPassword func(ctx context.Context, tx pgx.Tx, id uuid.UUID) (domain.Password, error), updatePassword func(ctx context.Context, tx pgx.Tx, id uuid.UUID, password domain.Password) error, logAttrs []any) error {
+	err := s.validateNewPassword(ctx, newPassword, logAttrs)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	accountKey := signInThrottleKey(role, signInThrottleKeyAccount, id.String())
+	rules := signInThrottleIPRule([]signInThrottleRule{{key: accountKey, policy: domain.SignInThrottlePolicyAccount}}, role, client)
+
+	var (
+		allowed bool
+		valid   bool
+	)
+
+	err = s.lockingTx(ctx, func(tx pgx.Tx) error {
+		hashedPassword, err := getPassword(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		allowed, err = s.lockSignInThrottles(ctx, tx, rules, now)
+		if err != nil || !allowed {
+			return err
+		}
+
+		valid, err = s.authnService.CheckPasswordHash([]byte(currentPassword), []byte(hashedPassword))
+		if err != nil {
+			return err
+		}
+
+		if !valid {
+			_, err = s.failSignInThrottles(ctx, tx, rules, now)
+			return err
+		}
+
+		return s.dataStore.DeleteSignInThrottle(ctx, tx, accountKey)
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) || errors.Is(err, domain.ErrPublisherNotFound) {
+			return logInfoAndWrapError(ctx, err, descriptionFailedCheckCurrentPassword, logAttrs...)
+		}
+
+		return logAndWrapError(ctx, err, descriptionFailedCheckCurrentPassword, logAttrs...)
+	}
+
+	if !allowed {
+		return logInfoAndWrapError(ctx, domain.ErrCredentialsIncorrect, descriptionSignInThrottled, logAttrs...)
+	}
+
+	if !valid {
+		return logInfoAndWrapError(ctx, domain.ErrCredentialsIncorrect, descriptionFailedCheckPasswordHash, logAttrs...)
+	}
+
+	newHashedPassword, err := s.authnService.HashPassword([]byte(newPassword))
+	if err != nil {
+		return logAndWrapError(ctx, err, descriptionFailedHashPassword, logAttrs...)
+	}
+
+	err = s.readWriteTx(ctx, func(tx pgx.Tx) error {
+		err := updatePassword(ctx, tx, id, domain.Password(newHashedPassword))
+		if err != nil {
+			return err
+		}
+
+		return s.dataStore.RevokeOtherSessions(ctx, tx, id, sessionID)
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) || errors.Is(err, domain.ErrPublisherNotFound) {
+			return logInfoAndWrapError(ctx, err, descriptionFailedUpdatePassword, logAttrs...)
+		}
+
+		return logAndWrapError(ctx, err, descriptionFailedUpdatePassword, logAttrs...)
+	}
+
+	return nil
+}
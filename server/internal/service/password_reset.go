@@ -0,0 +1,204 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"html/template"
+	"log/slog"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goofr-group/gaming-store/server"
+	"github.com/goofr-group/gaming-store/server/internal/authn"
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+	"github.com/goofr-group/gaming-store/server/internal/logging"
+)
+
+const (
+	descriptionFailedRequestPasswordReset = "service: failed to request password reset"
+	descriptionFailedResetPassword        = "service: failed to reset password"
+
+	passwordResetEmailSubject          = "GOOFR Store Password Reset"
+	passwordResetEmailBodyTemplatePath = "templates/email/password_reset.html"
+
+	passwordResetUserPath      = "/password-reset"
+	passwordResetPublisherPath = "/distribute/password-reset"
+)
+
+// RequestUserPasswordReset sends a password reset link to the user with the specified email.
+// An unknown email is not reported, so that it can not be used to find out which accounts exist.
+func (s *service) RequestUserPasswordReset(ctx context.Context, email domain.Email) error {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "RequestUserPasswordReset"),
+	}
+
+	if !email.Valid() {
+		return logInfoAndWrapError(ctx, &domain.FieldValueInvalidError{FieldName: domain.FieldEmail}, descriptionInvalidFieldValue, logAttrs...)
+	}
+
+	err := s.readWriteTx(ctx, func(tx pgx.Tx) error {
+		user, err := s.dataStore.GetUserByEmail(ctx, tx, email)
+		if err != nil {
+			return err
+		}
+
+		return s.sendPasswordReset(ctx, tx, user.ID, authn.SubjectRoleUser, user.Email, string(user.DisplayName), passwordResetUserPath)
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUserNotFound):
+			logging.Logger.InfoContext(ctx, descriptionFailedRequestPasswordReset, append(logAttrs, logging.Error(err))...)
+			return nil
+		default:
+			return logAndWrapError(ctx, err, descriptionFailedRequestPasswordReset, logAttrs...)
+		}
+	}
+
+	return nil
+}
+
+// ResetUserPassword sets a new password for the user of the specified password reset token and signs the user out
+// everywhere.
+func (s *service) ResetUserPassword(ctx context.Context, token string, password domain.Password) error {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "ResetUserPassword"),
+	}
+
+	return s.resetPassword(ctx, token, password, authn.SubjectRoleUser, s.dataStore.UpdateUserPassword, logAttrs)
+}
+
+// RequestPublisherPasswordReset sends a password reset link to the publisher with the specified email.
+// An unknown email is not reported, so that it can not be used to find out which accounts exist.
+func (s *service) RequestPublisherPasswordReset(ctx context.Context, email domain.Email) error {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "RequestPublisherPasswordReset"),
+	}
+
+	if !email.Valid() {
+		return logInfoAndWrapError(ctx, &domain.FieldValueInvalidError{FieldName: domain.FieldEmail}, descriptionInvalidFieldValue, logAttrs...)
+	}
+
+	err := s.readWriteTx(ctx, func(tx pgx.Tx) error {
+		publisher, err := s.dataStore.GetPublisherByEmail(ctx, tx, email)
+		if err != nil {
+			return err
+		}
+
+		return s.sendPasswordReset(ctx, tx, publisher.ID, authn.SubjectRolePublisher, publisher.Email, string(publisher.Name), passwordResetPublisherPath)
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPublisherNotFound):
+			logging.Logger.InfoContext(ctx, descriptionFailedRequestPasswordReset, append(logAttrs, logging.Error(err))...)
+			return nil
+		default:
+			return logAndWrapError(ctx, err, descriptionFailedRequestPasswordReset, logAttrs...)
+		}
+	}
+
+	return nil
+}
+
+// ResetPublisherPassword sets a new password for the publisher of the specified password reset token and signs the
+// publisher out everywhere.
+func (s *service) ResetPublisherPassword(ctx context.Context, token string, password domain.Password) error {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "ResetPublisherPassword"),
+	}
+
+	return s.resetPassword(ctx, token, password, authn.SubjectRolePublisher, s.dataStore.UpdatePublisherPassword, logAttrs)
+}
+
+// sendPasswordReset creates a password reset token for the specified subject and emails it a link to the web
+// application path where the token can be used.
+func (s *service) sendPasswordReset(ctx context.Context, tx pgx.Tx, subjectID uuid.UUID, role authn.SubjectRole, email domain.Email, name, path string) error {
+	token, tokenHash, err := s.authnService.NewPasswordResetToken()
+	if err != nil {
+		return err
+	}
+
+	_, err = s.dataStore.CreatePasswordResetToken(ctx, tx, domain.EditablePasswordResetToken{
+		SubjectID:   subjectID,
+		SubjectRole: string(role),
+		Hash:        tokenHash,
+		ExpiresAt:   time.Now().UTC().Add(domain.PasswordResetTokenExpirationTime),
+	})
+	if err != nil {
+		return err
+	}
+
+	template, err := template.ParseFS(server.FileSystemTemplates, passwordResetEmailBodyTemplatePath)
+	if err != nil {
+		return err
+	}
+
+	passwordReset := domain.PasswordReset{
+		Name:             name,
+		URL:              s.webURL + path + "?" + url.Values{"token": {token}}.Encode(),
+		ExpiresInMinutes: int(domain.PasswordResetTokenExpirationTime.Minutes()),
+	}
+
+	var body bytes.Buffer
+
+	err = template.Execute(&body, passwordReset)
+	if err != nil {
+		return err
+	}
+
+	return s.smtp.SendMailHTML([]string{string(email)}, passwordResetEmailSubject, body.String())
+}
+
+// resetPassword uses the password reset token issued to the specified role to set a new password, and revokes every
+// session of the subject, so that all the previously issued tokens stop working.
+func (s *service) resetPassword(ctx context.Context, token string, password domain.Password, role authn.SubjectRole, updatePassword func(ctx context.Context, tx pgx.Tx, id uuid.UUID, password domain.Password) error, logAttrs []any) error {
+	err := s.validateNewPassword(ctx, password, logAttrs)
+	if err != nil {
+		return err
+	}
+
+	hashedPassword, err := s.authnService.HashPassword([]byte(password))
+	if err != nil {
+		return logAndWrapError(ctx, err, descriptionFailedHashPassword, logAttrs...)
+	}
+
+	err = s.readWriteTx(ctx, func(tx pgx.Tx) error {
+		passwordResetToken, err := s.dataStore.GetPasswordResetTokenByHash(ctx, tx, s.authnService.HashPasswordResetToken(token))
+		if err != nil {
+			return err
+		}
+
+		if passwordResetToken.SubjectRole != string(role) ||
+			passwordResetToken.UsedAt != nil ||
+			!time.Now().UTC().Before(passwordResetToken.ExpiresAt) {
+			return domain.ErrPasswordResetTokenInvalid
+		}
+
+		err = s.dataStore.UsePasswordResetToken(ctx, tx, passwordResetToken.ID)
+		if err != nil {
+			return err
+		}
+
+		err = updatePassword(ctx, tx, passwordResetToken.SubjectID, domain.Password(hashedPassword))
+		if err != nil {
+			return err
+		}
+
+		return s.dataStore.RevokeSessions(ctx, tx, passwordResetToken.SubjectID)
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPasswordResetTokenNotFound):
+			return logInfoAndWrapError(ctx, domain.ErrPasswordResetTokenInvalid, descriptionFailedResetPassword, logAttrs...)
+		case errors.Is(err, domain.ErrPasswordResetTokenInvalid):
+			return logInfoAndWrapError(ctx, err, descriptionFailedResetPassword, logAttrs...)
+		default:
+			return logAndWrapError(ctx, err, descriptionFailedResetPassword, logAttrs...)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/goofr-group/gaming-store/server/internal/authn"
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+func TestService_RequestUserPasswordReset(t *testing.T) {
+	t.Run("should successfully send user password reset email", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			token     = "3q2-7wXy1Kc9Tn0GmPbD4sVh8LrJz6QaUeFiNoYxWtA"
+			tokenHash = []byte("password reset token hash")
+
+			user = domain.User{
+				ID: uuid.New(),
+			}
+		)
+
+		user.Email = "user@email.com"
+		user.DisplayName = "user name"
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		s.mockDataStore.EXPECT().
+			GetUserByEmail(ctx, tx, user.Email).
+			Return(user, nil)
+
+		s.mockAuthnService.EXPECT().
+			NewPasswordResetToken().
+			Return(token, tokenHash, nil)
+
+		s.mockDataStore.EXPECT().
+			CreatePasswordResetToken(ctx, tx, gomock.Any()).
+			Return(uuid.New(), nil)
+
+		s.mockSMTP.EXPECT().
+			SendMailHTML([]string{string(user.Email)}, passwordResetEmailSubject, gomock.Any()).
+			DoAndReturn(func(_ []string, _ string, body string) error {
+				assert.Contains(t, body, webURLTest+passwordResetUserPath+"?token="+token)
+
+				return nil
+			})
+
+		err := s.service.RequestUserPasswordReset(ctx, user.Email)
+		require.NoError(t, err)
+	})
+
+	t.Run("should successfully ignore unknown user email", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			email = domain.Email("user@email.com")
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		s.mockDataStore.EXPECT().
+			GetUserByEmail(ctx, tx, email).
+			Return(domain.User{}, domain.ErrUserNotFound)
+
+		err := s.service.RequestUserPasswordReset(ctx, email)
+		require.NoError(t, err)
+	})
+}
+
+func TestService_ResetUserPassword(t *testing.T) {
+	t.Run("should successfully reset user password", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			token          = "password reset token"
+			tokenHash      = []byte("password reset token hash")
+			password       = domain.Password("password")
+			hashedPassword = []byte("hashed password")
+
+			passwordResetToken = domain.PasswordResetToken{
+				EditablePasswordResetToken: domain.EditablePasswordResetToken{
+					SubjectID:   uuid.New(),
+					SubjectRole: string(authn.SubjectRoleUser),
+					Hash:        tokenHash,
+					ExpiresAt:   time.Now().UTC().Add(time.Hour),
+				},
+				ID: uuid.New(),
+			}
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		s.mockAuthnService.EXPECT().
+			ValidPassword([]byte(password)).
+			Return(true)
+
+		s.mockBreachStore.EXPECT().
+			Compromised([]byte(password)).
+			Return(false, nil)
+
+		s.mockAuthnService.EXPECT().
+			HashPassword([]byte(password)).
+			Return(hashedPassword, nil)
+
+		s.mockAuthnService.EXPECT().
+			HashPasswordResetToken(token).
+			Return(tokenHash)
+
+		s.mockDataStore.EXPECT().
+			GetPasswordResetTokenByHash(ctx, tx, tokenHash).
+			Return(passwordResetToken, nil)
+
+		s.mockDataStore.EXPECT().
+			UsePasswordResetToken(ctx, tx, passwordResetToken.ID).
+			Return(nil)
+
+		s.mockDataStore.EXPECT().
+			UpdateUserPassword(ctx, tx, passwordResetToken.SubjectID, domain.Password(hashedPassword)).
+			Return(nil)
+
+		s.mockDataStore.EXPECT().
+			RevokeSessions(ctx, tx, passwordResetToken.SubjectID).
+			Return(nil)
+
+		err := s.service.ResetUserPassword(ctx, token, password)
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the password reset token was already used", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				token     = "password reset token"
+				tokenHash = []byte("password reset token hash")
+				password  = domain.Password("password")
+				usedAt    = time.Now().UTC()
+
+				passwordResetToken = domain.PasswordResetToken{
+					EditablePasswordResetToken: domain.EditablePasswordResetToken{
+						SubjectID:   uuid.New(),
+						SubjectRole: string(authn.SubjectRoleUser),
+						Hash:        tokenHash,
+						ExpiresAt:   time.Now().UTC().Add(time.Hour),
+					},
+					ID:     uuid.New(),
+					UsedAt: &usedAt,
+				}
+
+				expectedError = fmt.Errorf("%s: %w", descriptionFailedResetPassword, domain.ErrPasswordResetTokenInvalid)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			s.mockAuthnService.EXPECT().
+				ValidPassword([]byte(password)).
+				Return(true)
+
+			s.mockBreachStore.EXPECT().
+				Compromised([]byte(password)).
+				Return(false, nil)
+
+			s.mockAuthnService.EXPECT().
+				HashPassword([]byte(password)).
+				Return([]byte("hashed password"), nil)
+
+			s.mockAuthnService.EXPECT().
+				HashPasswordResetToken(token).
+				Return(tokenHash)
+
+			s.mockDataStore.EXPECT().
+				GetPasswordResetTokenByHash(ctx, tx, tokenHash).
+				Return(passwordResetToken, nil)
+
+			err := s.service.ResetUserPassword(ctx, token, password)
+			assert.Equal(t, expectedError, err)
+		})
+
+		t.Run("when the password is invalid", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				password = domain.Password("password")
+
+				expectedError = fmt.Errorf("%s: %w", descriptionInvalidFieldValue, &domain.FieldValueInvalidError{FieldName: domain.FieldPassword})
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+
+			s.mockAuthnService.EXPECT().
+				ValidPassword([]byte(password)).
+				Return(false)
+
+			err := s.service.ResetUserPassword(ctx, "password reset token", password)
+			assert.Equal(t, expectedError, err)
+		})
+
+		t.Run("when the password is compromised", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				password = domain.Password("password123")
+
+				expectedError = fmt.Errorf("%s: %w", descriptionPasswordCompromised, domain.ErrPasswordCompromised)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+
+			s.mockAuthnService.EXPECT().
+				ValidPassword([]byte(password)).
+				Return(true)
+
+			s.mockBreachStore.EXPECT().
+				Compromised([]byte(password)).
+				Return(true, nil)
+
+			err := s.service.ResetUserPassword(ctx, "password reset token", password)
+			assert.Equal(t, expectedError, err)
+		})
+	})
+}
@@ -18,7 +18,6 @@ const (
 	descriptionFailedCreatePublisher     = "service: failed to create publisher"
 	descriptionFailedGetPublisherByID    = "service: failed to get publisher by id"
 	descriptionFailedGetPublisherByEmail = "service: failed to get publisher by email"
-	descriptionFailedGetPublisherSignIn  = "service: failed to get publisher sign-in"
 	descriptionFailedPatchPublisher      = "service: failed to patch publisher"
 )
 
@@ -41,10 +40,6 @@ func (s *service) CreatePublisher(ctx context.Context, editablePublisher domain.
 		return domain.Publisher{}, logInfoAndWrapError(ctx, &domain.FieldValueInvalidError{FieldName: domain.FieldEmail}, descriptionInvalidFieldValue, logAttrs...)
 	}
 
-	if !s.authnService.ValidPassword([]byte(editablePublisher.Password)) {
-		return domain.Publisher{}, logInfoAndWrapError(ctx, &domain.FieldValueInvalidError{FieldName: domain.FieldPassword}, descriptionInvalidFieldValue, logAttrs...)
-	}
-
 	if !editablePublisher.Name.Valid() {
 		return domain.Publisher{}, logInfoAndWrapError(ctx, &domain.FieldValueInvalidError{FieldName: domain.FieldName}, descriptionInvalidFieldValue, logAttrs...)
 	}
@@ -61,6 +56,11 @@ func (s *service) CreatePublisher(ctx context.Context, editablePublisher domain.
 		return domain.Publisher{}, logInfoAndWrapError(ctx, &domain.FieldValueInvalidError{FieldName: domain.FieldVatin}, descriptionInvalidFieldValue, logAttrs...)
 	}
 
+	err := s.validateNewPassword(ctx, editablePublisher.Password, logAttrs)
+	if err != nil {
+		return domain.Publisher{}, err
+	}
+
 	hashedPassword, err := s.authnService.HashPassword([]byte(editablePublisher.Password))
 	if err != nil {
 		return domain.Publisher{}, logAndWrapError(ctx, err, descriptionFailedHashPassword, logAttrs...)
@@ -94,6 +94,12 @@ func (s *service) CreatePublisher(ctx context.Context, editablePublisher domain.
 		}
 	}
 
+	// The account is kept when the email can not be sent, since it can be sent again.
+	err = s.sendEmailVerification(publisher.ID, authn.SubjectRolePublisher, publisher.Email, string(publisher.Name), emailVerificationPublisherPath)
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, descriptionFailedSendEmailVerification, append(logAttrs, logging.Error(err))...)
+	}
+
 	return publisher, nil
 }
 
@@ -197,11 +203,33 @@ func (s *service) PatchPublisher(ctx context.Context, id uuid.UUID, editablePubl
 		}
 	}
 
+	if editablePublisher.Email != nil && publisher.EmailVerifiedAt == nil {
+		err = s.sendEmailVerification(publisher.ID, authn.SubjectRolePublisher, publisher.Email, string(publisher.Name), emailVerificationPublisherPath)
+		if err != nil {
+			logging.Logger.ErrorContext(ctx, descriptionFailedSendEmailVerification, append(logAttrs, logging.Error(err))...)
+		}
+	}
+
 	return publisher, nil
 }
 
-// SignInPublisher returns a JSON Web Token for the specified email and password.
-func (s *service) SignInPublisher(ctx context.Context, email domain.Email, password domain.Password) (string, error) {
+// ChangePublisherPassword sets a new password for the publisher with the specified identifier, which requires its
+// current password, and revokes every other session of the publisher than the specified one. Failed checks of the current
+// password are throttled per publisher and per IP address of the client.
+func (s *service) ChangePublisherPassword(ctx context.Context, id, sessionID uuid.UUID, currentPassword, newPassword domain.Password, client domain.SessionClient) error {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "ChangePublisherPassword"),
+		slog.String(logging.PublisherID, id.String()),
+		slog.String(logging.SessionID, sessionID.String()),
+	}
+
+	return s.changePassword(ctx, id, sessionID, authn.SubjectRolePublisher, currentPassword, newPassword, client, s.dataStore.GetPublisherPassword, s.dataStore.UpdatePublisherPassword, logAttrs)
+}
+
+// SignInPublisher returns an access token and a refresh token for the specified email and password, or a two-factor
+// challenge token when the publisher has two-factor authentication enabled.
+// Failed attempts are throttled per publisher and per IP address of the client.
+func (s *service) SignInPublisher(ctx context.Context, email domain.Email, password domain.Password, client domain.SessionClient) (domain.SignIn, error) {
 	logAttrs := []any{
 		slog.String(logging.ServiceMethod, "SignInPublisher"),
 		slog.String(logging.PublisherEmail, string(email)),
@@ -209,49 +237,50 @@ func (s *service) SignInPublisher(ctx context.Context, email domain.Email, passw
 
 	email = domain.Email(strings.ToLower(string(email)))
 
-	var (
-		signIn domain.SignInPublisher
-		err    error
-	)
-
-	err = s.readOnlyTx(ctx, func(tx pgx.Tx) error {
-		signIn, err = s.dataStore.GetPublisherSignIn(ctx, tx, email)
-		return err
-	})
-	if err != nil {
-		switch {
-		case errors.Is(err, domain.ErrPublisherNotFound):
-			return "", logInfoAndWrapError(ctx, domain.ErrCredentialsIncorrect, descriptionFailedGetPublisherSignIn, logAttrs...)
-		default:
-			return "", logAndWrapError(ctx, err, descriptionFailedGetPublisherSignIn, logAttrs...)
+	account, err := s.checkSignInPassword(ctx, authn.SubjectRolePublisher, password, client, func(tx pgx.Tx) (domain.SignInAccount, error) {
+		signIn, err := s.dataStore.GetPublisherSignIn(ctx, tx, email)
+		if err != nil {
+			return domain.SignInAccount{}, err
 		}
-	}
 
-	valid, err := s.authnService.CheckPasswordHash([]byte(password), []byte(signIn.Password))
+		return domain.SignInAccount{
+			ID:       signIn.ID,
+			Email:    signIn.Email,
+			Name:     string(signIn.Name),
+			Password: signIn.Password,
+		}, nil
+	}, s.dataStore.UpdatePublisherPassword, passwordResetPublisherPath, logAttrs)
 	if err != nil {
-		return "", logAndWrapError(ctx, err, descriptionFailedCheckPasswordHash, logAttrs...)
-	}
-
-	if !valid {
-		return "", logInfoAndWrapError(ctx, domain.ErrCredentialsIncorrect, descriptionFailedCheckPasswordHash, logAttrs...)
+		return domain.SignIn{}, err
 	}
 
 	var publisher domain.Publisher
 
 	err = s.readOnlyTx(ctx, func(tx pgx.Tx) error {
-		publisher, err = s.dataStore.GetPublisherByEmail(ctx, tx, signIn.Email)
+		publisher, err = s.dataStore.GetPublisherByEmail(ctx, tx, account.Email)
 		return err
 	})
 	if err != nil {
-		return "", logAndWrapError(ctx, err, descriptionFailedGetPublisherByEmail, logAttrs...)
+		return domain.SignIn{}, logAndWrapError(ctx, err, descriptionFailedGetPublisherByEmail, logAttrs...)
 	}
 
-	role := authn.SubjectRolePublisher
+	return s.signIn(ctx, publisher.ID, authn.SubjectRolePublisher, client, logAttrs)
+}
 
-	token, err := s.authnService.NewJWT(publisher.ID.String(), []authn.SubjectRole{role})
-	if err != nil {
-		return "", logAndWrapError(ctx, err, descriptionFailedCreateJWT, logAttrs...)
+// RefreshPublisherTokens exchanges the specified publisher refresh token for a new access token and a new refresh token.
+func (s *service) RefreshPublisherTokens(ctx context.Context, refreshToken string, client domain.SessionClient) (domain.Tokens, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "RefreshPublisherTokens"),
+	}
+
+	return s.refreshTokens(ctx, refreshToken, authn.SubjectRolePublisher, client, logAttrs)
+}
+
+// SignOutPublisher revokes the session of the specified publisher refresh token.
+func (s *service) SignOutPublisher(ctx context.Context, refreshToken string) error {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "SignOutPublisher"),
 	}
 
-	return token, nil
+	return s.revokeRefreshToken(ctx, refreshToken, authn.SubjectRolePublisher, logAttrs)
 }
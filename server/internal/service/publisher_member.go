@@ -0,0 +1,304 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"html/template"
+	"log/slog"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goofr-group/gaming-store/server"
+	"github.com/goofr-group/gaming-store/server/internal/authn"
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+	"github.com/goofr-group/gaming-store/server/internal/logging"
+)
+
+const (
+	descriptionFailedListPublisherMembers     = "service: failed to list publisher members"
+	descriptionFailedListUserPublisherMembers = "service: failed to list user publisher members"
+	descriptionFailedInvitePublisherMember    = "service: failed to invite publisher member"
+	descriptionFailedAcceptPublisherInvite    = "service: failed to accept publisher invitation"
+	descriptionFailedUpdatePublisherMember    = "service: failed to update publisher member"
+	descriptionFailedDeletePublisherMember    = "service: failed to delete publisher member"
+
+	publisherInvitationEmailSubject          = "GOOFR Store Publisher Invitation"
+	publisherInvitationEmailBodyTemplatePath = "templates/email/publisher_invitation.html"
+
+	publisherInvitationPath = "/publisher-invitation"
+)
+
+// ListPublisherMembers returns the members of the publisher with the specified identifier.
+func (s *service) ListPublisherMembers(ctx context.Context, publisherID uuid.UUID) ([]domain.PublisherMember, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "ListPublisherMembers"),
+		slog.String(logging.PublisherID, publisherID.String()),
+	}
+
+	var publisherMembers []domain.PublisherMember
+
+	err := s.readOnlyTx(ctx, func(tx pgx.Tx) error {
+		_, err := s.dataStore.GetPublisherByID(ctx, tx, publisherID)
+		if err != nil {
+			return err
+		}
+
+		publisherMembers, err = s.dataStore.ListPublisherMembers(ctx, tx, publisherID)
+		return err
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPublisherNotFound):
+			return nil, logInfoAndWrapError(ctx, err, descriptionFailedListPublisherMembers, logAttrs...)
+		default:
+			return nil, logAndWrapError(ctx, err, descriptionFailedListPublisherMembers, logAttrs...)
+		}
+	}
+
+	return publisherMembers, nil
+}
+
+// InvitePublisherMember emails an invitation to join the publisher with the specified identifier with the specified
+// role. The invitation is accepted by a signed in user, who does not need to have the same email.
+func (s *service) InvitePublisherMember(ctx context.Context, publisherID uuid.UUID, email domain.Email, role domain.PublisherMemberRole) error {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "InvitePublisherMember"),
+		slog.String(logging.PublisherID, publisherID.String()),
+		slog.String(logging.PublisherMemberRole, string(role)),
+	}
+
+	email = domain.Email(strings.ToLower(string(email)))
+
+	if !email.Valid() {
+		return logInfoAndWrapError(ctx, &domain.FieldValueInvalidError{FieldName: domain.FieldEmail}, descriptionInvalidFieldValue, logAttrs...)
+	}
+
+	if !role.Valid() {
+		return logInfoAndWrapError(ctx, &domain.FieldValueInvalidError{FieldName: domain.FieldRole}, descriptionInvalidFieldValue, logAttrs...)
+	}
+
+	err := s.readWriteTx(ctx, func(tx pgx.Tx) error {
+		publisher, err := s.dataStore.GetPublisherByID(ctx, tx, publisherID)
+		if err != nil {
+			return err
+		}
+
+		return s.sendPublisherInvitation(ctx, tx, publisher, email, role)
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPublisherNotFound):
+			return logInfoAndWrapError(ctx, err, descriptionFailedInvitePublisherMember, logAttrs...)
+		default:
+			return logAndWrapError(ctx, err, descriptionFailedInvitePublisherMember, logAttrs...)
+		}
+	}
+
+	return nil
+}
+
+// AcceptPublisherInvitation adds the user with the specified identifier as a member of the publisher of the specified
+// invitation token, with the role of the invitation. An invitation can only be accepted once.
+func (s *service) AcceptPublisherInvitation(ctx context.Context, userID uuid.UUID, token string) (domain.PublisherMember, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "AcceptPublisherInvitation"),
+		slog.String(logging.UserID, userID.String()),
+	}
+
+	var publisherMember domain.PublisherMember
+
+	err := s.readWriteTx(ctx, func(tx pgx.Tx) error {
+		publisherInvitation, err := s.dataStore.GetPublisherInvitationByHash(ctx, tx, s.authnService.HashPublisherInvitationToken(token))
+		if err != nil {
+			return err
+		}
+
+		if publisherInvitation.AcceptedAt != nil || !time.Now().UTC().Before(publisherInvitation.ExpiresAt) {
+			return domain.ErrPublisherInvitationInvalid
+		}
+
+		err = s.dataStore.AcceptPublisherInvitation(ctx, tx, publisherInvitation.ID)
+		if err != nil {
+			return err
+		}
+
+		err = s.dataStore.CreatePublisherMember(ctx, tx, domain.EditablePublisherMember{
+			PublisherID: publisherInvitation.PublisherID,
+			UserID:      userID,
+			Role:        publisherInvitation.Role,
+		})
+		if err != nil {
+			return err
+		}
+
+		publisherMember, err = s.dataStore.GetPublisherMember(ctx, tx, publisherInvitation.PublisherID, userID)
+		return err
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPublisherInvitationNotFound):
+			return domain.PublisherMember{}, logInfoAndWrapError(ctx, domain.ErrPublisherInvitationInvalid, descriptionFailedAcceptPublisherInvite, logAttrs...)
+		case errors.Is(err, domain.ErrPublisherInvitationInvalid),
+			errors.Is(err, domain.ErrPublisherMemberAlreadyExists),
+			errors.Is(err, domain.ErrUserNotFound):
+			return domain.PublisherMember{}, logInfoAndWrapError(ctx, err, descriptionFailedAcceptPublisherInvite, logAttrs...)
+		default:
+			return domain.PublisherMember{}, logAndWrapError(ctx, err, descriptionFailedAcceptPublisherInvite, logAttrs...)
+		}
+	}
+
+	return publisherMember, nil
+}
+
+// UpdatePublisherMemberRole changes the role of the specified user in the publisher with the specified identifier.
+func (s *service) UpdatePublisherMemberRole(ctx context.Context, publisherID, userID uuid.UUID, role domain.PublisherMemberRole) (domain.PublisherMember, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "UpdatePublisherMemberRole"),
+		slog.String(logging.PublisherID, publisherID.String()),
+		slog.String(logging.UserID, userID.String()),
+		slog.String(logging.PublisherMemberRole, string(role)),
+	}
+
+	if !role.Valid() {
+		return domain.PublisherMember{}, logInfoAndWrapError(ctx, &domain.FieldValueInvalidError{FieldName: domain.FieldRole}, descriptionInvalidFieldValue, logAttrs...)
+	}
+
+	var publisherMember domain.PublisherMember
+
+	err := s.readWriteTx(ctx, func(tx pgx.Tx) error {
+		err := s.dataStore.UpdatePublisherMemberRole(ctx, tx, publisherID, userID, role)
+		if err != nil {
+			return err
+		}
+
+		publisherMember, err = s.dataStore.GetPublisherMember(ctx, tx, publisherID, userID)
+		return err
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPublisherMemberNotFound):
+			return domain.PublisherMember{}, logInfoAndWrapError(ctx, err, descriptionFailedUpdatePublisherMember, logAttrs...)
+		default:
+			return domain.PublisherMember{}, logAndWrapError(ctx, err, descriptionFailedUpdatePublisherMember, logAttrs...)
+		}
+	}
+
+	return publisherMember, nil
+}
+
+// DeletePublisherMember removes the specified user from the members of the publisher with the specified identifier.
+// The user keeps its access until its access token expires.
+func (s *service) DeletePublisherMember(ctx context.Context, publisherID, userID uuid.UUID) error {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "DeletePublisherMember"),
+		slog.String(logging.PublisherID, publisherID.String()),
+		slog.String(logging.UserID, userID.String()),
+	}
+
+	err := s.readWriteTx(ctx, func(tx pgx.Tx) error {
+		return s.dataStore.DeletePublisherMember(ctx, tx, publisherID, userID)
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPublisherMemberNotFound):
+			return logInfoAndWrapError(ctx, err, descriptionFailedDeletePublisherMember, logAttrs...)
+		default:
+			return logAndWrapError(ctx, err, descriptionFailedDeletePublisherMember, logAttrs...)
+		}
+	}
+
+	return nil
+}
+
+// ListUserPublisherMembers returns the memberships of the user with the specified identifier in publishers.
+func (s *service) ListUserPublisherMembers(ctx context.Context, userID uuid.UUID) ([]domain.PublisherMember, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "ListUserPublisherMembers"),
+		slog.String(logging.UserID, userID.String()),
+	}
+
+	var publisherMembers []domain.PublisherMember
+
+	err := s.readOnlyTx(ctx, func(tx pgx.Tx) error {
+		var err error
+
+		publisherMembers, err = s.dataStore.ListUserPublisherMembers(ctx, tx, userID)
+		return err
+	})
+	if err != nil {
+		return nil, logAndWrapError(ctx, err, descriptionFailedListUserPublisherMembers, logAttrs...)
+	}
+
+	return publisherMembers, nil
+}
+
+// publisherMemberships returns the roles of the subject with the specified identifier in the publishers it is a member
+// of, by publisher identifier. Only users can be members of publishers.
+func (s *service) publisherMemberships(ctx context.Context, tx pgx.Tx, subjectID uuid.UUID, role authn.SubjectRole) (map[string]string, error) {
+	if role != authn.SubjectRoleUser {
+		return nil, nil
+	}
+
+	publisherMembers, err := s.dataStore.ListUserPublisherMembers(ctx, tx, subjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(publisherMembers) == 0 {
+		return nil, nil
+	}
+
+	publisherMemberships := make(map[string]string, len(publisherMembers))
+
+	for _, publisherMember := range publisherMembers {
+		publisherMemberships[publisherMember.PublisherID.String()] = string(publisherMember.Role)
+	}
+
+	return publisherMemberships, nil
+}
+
+// sendPublisherInvitation creates an invitation to join the publisher with the specified role, and emails it a link to
+// the web application path where the invitation can be accepted.
+func (s *service) sendPublisherInvitation(ctx context.Context, tx pgx.Tx, publisher domain.Publisher, email domain.Email, role domain.PublisherMemberRole) error {
+	token, tokenHash, err := s.authnService.NewPublisherInvitationToken()
+	if err != nil {
+		return err
+	}
+
+	_, err = s.dataStore.CreatePublisherInvitation(ctx, tx, domain.EditablePublisherInvitation{
+		PublisherID: publisher.ID,
+		Email:       email,
+		Role:        role,
+		Hash:        tokenHash,
+		ExpiresAt:   time.Now().UTC().Add(domain.PublisherInvitationExpirationTime),
+	})
+	if err != nil {
+		return err
+	}
+
+	template, err := template.ParseFS(server.FileSystemTemplates, publisherInvitationEmailBodyTemplatePath)
+	if err != nil {
+		return err
+	}
+
+	publisherInvitation := domain.PublisherInvitationEmail{
+		PublisherName: string(publisher.Name),
+		Role:          string(role),
+		URL:           s.webURL + publisherInvitationPath + "?" + url.Values{"token": {token}}.Encode(),
+		ExpiresInDays: int(domain.PublisherInvitationExpirationTime.Hours() / 24),
+	}
+
+	var body bytes.Buffer
+
+	err = template.Execute(&body, publisherInvitation)
+	if err != nil {
+		return err
+	}
+
+	return s.smtp.SendMailHTML([]string{string(email)}, publisherInvitationEmailSubject, body.String())
+}
@@ -0,0 +1,296 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+func TestService_InvitePublisherMember(t *testing.T) {
+	t.Run("should successfully send publisher invitation email", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			token     = "3q2-7wXy1Kc9Tn0GmPbD4sVh8LrJz6QaUeFiNoYxWtA"
+			tokenHash = []byte("publisher invitation token hash")
+			email     = domain.Email("member@email.com")
+			role      = domain.PublisherMemberRoleDeveloper
+
+			publisher = domain.Publisher{
+				ID:   uuid.New(),
+				Name: "publisher name",
+			}
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		gomock.InOrder(
+			s.mockDataStore.EXPECT().
+				GetPublisherByID(ctx, tx, publisher.ID).
+				Return(publisher, nil),
+			s.mockAuthnService.EXPECT().
+				NewPublisherInvitationToken().
+				Return(token, tokenHash, nil),
+			s.mockDataStore.EXPECT().
+				CreatePublisherInvitation(ctx, tx, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ pgx.Tx, editablePublisherInvitation domain.EditablePublisherInvitation) (uuid.UUID, error) {
+					assert.Equal(t, publisher.ID, editablePublisherInvitation.PublisherID)
+					assert.Equal(t, email, editablePublisherInvitation.Email)
+					assert.Equal(t, role, editablePublisherInvitation.Role)
+					assert.Equal(t, tokenHash, editablePublisherInvitation.Hash)
+
+					return uuid.New(), nil
+				}),
+			s.mockSMTP.EXPECT().
+				SendMailHTML([]string{string(email)}, publisherInvitationEmailSubject, gomock.Any()).
+				DoAndReturn(func(_ []string, _ string, body string) error {
+					assert.Contains(t, body, webURLTest+publisherInvitationPath+"?token="+token)
+					assert.Contains(t, body, string(publisher.Name))
+
+					return nil
+				}),
+		)
+
+		err := s.service.InvitePublisherMember(ctx, publisher.ID, email, role)
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the role is invalid", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				expectedError = fmt.Errorf("%s: %w", descriptionInvalidFieldValue, &domain.FieldValueInvalidError{FieldName: domain.FieldRole})
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+
+			err := s.service.InvitePublisherMember(ctx, uuid.New(), "member@email.com", "admin")
+			assert.Equal(t, expectedError, err)
+		})
+
+		t.Run("when the publisher does not exist", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				publisherID = uuid.New()
+
+				expectedError = fmt.Errorf("%s: %w", descriptionFailedInvitePublisherMember, domain.ErrPublisherNotFound)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			s.mockDataStore.EXPECT().
+				GetPublisherByID(ctx, tx, publisherID).
+				Return(domain.Publisher{}, domain.ErrPublisherNotFound)
+
+			err := s.service.InvitePublisherMember(ctx, publisherID, "member@email.com", domain.PublisherMemberRoleViewer)
+			assert.Equal(t, expectedError, err)
+		})
+	})
+}
+
+func TestService_AcceptPublisherInvitation(t *testing.T) {
+	t.Run("should successfully accept publisher invitation", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			token     = "publisher invitation token"
+			tokenHash = []byte("publisher invitation token hash")
+			userID    = uuid.New()
+
+			publisherInvitation = domain.PublisherInvitation{
+				EditablePublisherInvitation: domain.EditablePublisherInvitation{
+					PublisherID: uuid.New(),
+					Email:       "member@email.com",
+					Role:        domain.PublisherMemberRoleFinance,
+					Hash:        tokenHash,
+					ExpiresAt:   time.Now().UTC().Add(time.Hour),
+				},
+				ID: uuid.New(),
+			}
+
+			editablePublisherMember = domain.EditablePublisherMember{
+				PublisherID: publisherInvitation.PublisherID,
+				UserID:      userID,
+				Role:        domain.PublisherMemberRoleFinance,
+			}
+
+			expectedPublisherMember = domain.PublisherMember{
+				EditablePublisherMember: editablePublisherMember,
+			}
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		gomock.InOrder(
+			s.mockAuthnService.EXPECT().
+				HashPublisherInvitationToken(token).
+				Return(tokenHash),
+			s.mockDataStore.EXPECT().
+				GetPublisherInvitationByHash(ctx, tx, tokenHash).
+				Return(publisherInvitation, nil),
+			s.mockDataStore.EXPECT().
+				AcceptPublisherInvitation(ctx, tx, publisherInvitation.ID).
+				Return(nil),
+			s.mockDataStore.EXPECT().
+				CreatePublisherMember(ctx, tx, editablePublisherMember).
+				Return(nil),
+			s.mockDataStore.EXPECT().
+				GetPublisherMember(ctx, tx, publisherInvitation.PublisherID, userID).
+				Return(expectedPublisherMember, nil),
+		)
+
+		publisherMember, err := s.service.AcceptPublisherInvitation(ctx, userID, token)
+		require.NoError(t, err)
+		assert.Equal(t, expectedPublisherMember, publisherMember)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the publisher invitation is expired", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				token     = "publisher invitation token"
+				tokenHash = []byte("publisher invitation token hash")
+
+				publisherInvitation = domain.PublisherInvitation{
+					EditablePublisherInvitation: domain.EditablePublisherInvitation{
+						PublisherID: uuid.New(),
+						Role:        domain.PublisherMemberRoleViewer,
+						Hash:        tokenHash,
+						ExpiresAt:   time.Now().UTC().Add(-time.Hour),
+					},
+					ID: uuid.New(),
+				}
+
+				expectedError = fmt.Errorf("%s: %w", descriptionFailedAcceptPublisherInvite, domain.ErrPublisherInvitationInvalid)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			s.mockAuthnService.EXPECT().
+				HashPublisherInvitationToken(token).
+				Return(tokenHash)
+
+			s.mockDataStore.EXPECT().
+				GetPublisherInvitationByHash(ctx, tx, tokenHash).
+				Return(publisherInvitation, nil)
+
+			_, err := s.service.AcceptPublisherInvitation(ctx, uuid.New(), token)
+			assert.Equal(t, expectedError, err)
+		})
+
+		t.Run("when the publisher invitation does not exist", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				token     = "publisher invitation token"
+				tokenHash = []byte("publisher invitation token hash")
+
+				expectedError = fmt.Errorf("%s: %w", descriptionFailedAcceptPublisherInvite, domain.ErrPublisherInvitationInvalid)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			s.mockAuthnService.EXPECT().
+				HashPublisherInvitationToken(token).
+				Return(tokenHash)
+
+			s.mockDataStore.EXPECT().
+				GetPublisherInvitationByHash(ctx, tx, tokenHash).
+				Return(domain.PublisherInvitation{}, domain.ErrPublisherInvitationNotFound)
+
+			_, err := s.service.AcceptPublisherInvitation(ctx, uuid.New(), token)
+			assert.Equal(t, expectedError, err)
+		})
+	})
+}
+
+func TestService_UpdatePublisherMemberRole(t *testing.T) {
+	t.Run("should successfully update publisher member role", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			publisherID = uuid.New()
+			userID      = uuid.New()
+
+			expectedPublisherMember = domain.PublisherMember{
+				EditablePublisherMember: domain.EditablePublisherMember{
+					PublisherID: publisherID,
+					UserID:      userID,
+					Role:        domain.PublisherMemberRoleOwner,
+				},
+			}
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		gomock.InOrder(
+			s.mockDataStore.EXPECT().
+				UpdatePublisherMemberRole(ctx, tx, publisherID, userID, domain.PublisherMemberRoleOwner).
+				Return(nil),
+			s.mockDataStore.EXPECT().
+				GetPublisherMember(ctx, tx, publisherID, userID).
+				Return(expectedPublisherMember, nil),
+		)
+
+		publisherMember, err := s.service.UpdatePublisherMemberRole(ctx, publisherID, userID, domain.PublisherMemberRoleOwner)
+		require.NoError(t, err)
+		assert.Equal(t, expectedPublisherMember, publisherMember)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the publisher member does not exist", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				publisherID = uuid.New()
+				userID      = uuid.New()
+
+				expectedError = fmt.Errorf("%s: %w", descriptionFailedUpdatePublisherMember, domain.ErrPublisherMemberNotFound)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			s.mockDataStore.EXPECT().
+				UpdatePublisherMemberRole(ctx, tx, publisherID, userID, domain.PublisherMemberRoleViewer).
+				Return(domain.ErrPublisherMemberNotFound)
+
+			_, err := s.service.UpdatePublisherMemberRole(ctx, publisherID, userID, domain.PublisherMemberRoleViewer)
+			assert.Equal(t, expectedError, err)
+		})
+	})
+}
@@ -8,11 +8,13 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	"golang.org/x/text/language"
 
+	"github.com/goofr-group/gaming-store/server/internal/authn"
 	"github.com/goofr-group/gaming-store/server/internal/domain"
 )
 
@@ -59,6 +61,9 @@ func TestService_CreatePublisher(t *testing.T) {
 			s.mockAuthnService.EXPECT().
 				ValidPassword([]byte(editablePublisher.Password)).
 				Return(true),
+			s.mockBreachStore.EXPECT().
+				Compromised([]byte(editablePublisher.Password)).
+				Return(false, nil),
 			s.mockAuthnService.EXPECT().
 				HashPassword([]byte(editablePublisher.Password)).
 				Return(expectedPassword, nil),
@@ -68,6 +73,12 @@ func TestService_CreatePublisher(t *testing.T) {
 			s.mockDataStore.EXPECT().
 				GetPublisherByID(ctx, tx, publisherID).
 				Return(expectedPublisher, nil),
+			s.mockAuthnService.EXPECT().
+				NewEmailVerificationToken(publisherID.String(), authn.SubjectRolePublisher, string(expectedPublisher.Email)).
+				Return("email verification token", nil),
+			s.mockSMTP.EXPECT().
+				SendMailHTML([]string{string(expectedPublisher.Email)}, emailVerificationEmailSubject, gomock.Any()).
+				Return(nil),
 		)
 
 		publisher, err := s.service.CreatePublisher(ctx, editablePublisher)
@@ -102,6 +113,41 @@ func TestService_CreatePublisher(t *testing.T) {
 			assert.Equal(t, expectedError, err)
 		})
 
+		t.Run("when the password is compromised", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				editablePublisher = domain.EditablePublisherWithPassword{
+					EditablePublisher: domain.EditablePublisher{
+						Email:   "publisher@email.com",
+						Name:    "publisher name",
+						Address: "address",
+						Country: domain.Country{Region: language.MustParseRegion("pt")},
+						Vatin:   "123456789",
+					},
+					Password: "password123",
+				}
+
+				expectedError = fmt.Errorf("%s: %w", descriptionPasswordCompromised, domain.ErrPasswordCompromised)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+
+			gomock.InOrder(
+				s.mockAuthnService.EXPECT().
+					ValidPassword([]byte(editablePublisher.Password)).
+					Return(true),
+				s.mockBreachStore.EXPECT().
+					Compromised([]byte(editablePublisher.Password)).
+					Return(true, nil),
+			)
+
+			_, err := s.service.CreatePublisher(ctx, editablePublisher)
+			assert.Equal(t, expectedError, err)
+		})
+
 		t.Run("when the authn service fails", func(t *testing.T) {
 			t.Run("when validating the password", func(t *testing.T) {
 				var (
@@ -162,6 +208,9 @@ func TestService_CreatePublisher(t *testing.T) {
 					s.mockAuthnService.EXPECT().
 						ValidPassword([]byte(editablePublisher.Password)).
 						Return(true),
+					s.mockBreachStore.EXPECT().
+						Compromised([]byte(editablePublisher.Password)).
+						Return(false, nil),
 					s.mockAuthnService.EXPECT().
 						HashPassword([]byte(editablePublisher.Password)).
 						Return(nil, errTest),
@@ -207,6 +256,9 @@ func TestService_CreatePublisher(t *testing.T) {
 					s.mockAuthnService.EXPECT().
 						ValidPassword([]byte(editablePublisher.Password)).
 						Return(true),
+					s.mockBreachStore.EXPECT().
+						Compromised([]byte(editablePublisher.Password)).
+						Return(false, nil),
 					s.mockAuthnService.EXPECT().
 						HashPassword([]byte(editablePublisher.Password)).
 						Return(expectedPassword, nil),
@@ -254,6 +306,9 @@ func TestService_CreatePublisher(t *testing.T) {
 					s.mockAuthnService.EXPECT().
 						ValidPassword([]byte(editablePublisher.Password)).
 						Return(true),
+					s.mockBreachStore.EXPECT().
+						Compromised([]byte(editablePublisher.Password)).
+						Return(false, nil),
 					s.mockAuthnService.EXPECT().
 						HashPassword([]byte(editablePublisher.Password)).
 						Return(expectedPassword, nil),
@@ -271,3 +326,286 @@ func TestService_CreatePublisher(t *testing.T) {
 		})
 	})
 }
+
+func TestService_SignInPublisher(t *testing.T) {
+	var (
+		email    = domain.Email("publisher@email.com")
+		password = domain.Password("password")
+		client   = domain.SessionClient{UserAgent: "user agent", IPAddress: "127.0.0.1"}
+
+		signIn = domain.SignInPublisher{
+			ID:       uuid.New(),
+			Email:    email,
+			Name:     "publisher name",
+			Password: "hashed password",
+		}
+
+		accountKey = "publisher:account:" + signIn.ID.String()
+		ipKey      = "publisher:ip:127.0.0.1"
+	)
+
+	t.Run("should successfully sign in publisher and reset its failed attempts", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			publisher = domain.Publisher{ID: uuid.New()}
+			sessionID = uuid.New()
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		readOnlyTx := s.newReadOnlyTx(ctx, ctrl)
+		lockingTx := s.newLockingTx(ctx, ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		gomock.InOrder(
+			s.mockDataStore.EXPECT().
+				GetPublisherSignIn(ctx, lockingTx, email).
+				Return(signIn, nil),
+			s.mockDataStore.EXPECT().
+				ListSignInThrottlesForUpdate(ctx, lockingTx, []string{accountKey, ipKey}).
+				Return([]domain.SignInThrottle{
+					{Key: accountKey, FailedAttempts: 2, LastFailedAt: time.Now()},
+				}, nil),
+			s.mockAuthnService.EXPECT().
+				CheckPasswordHash([]byte(password), []byte(signIn.Password)).
+				Return(true, nil),
+			s.mockDataStore.EXPECT().
+				DeleteSignInThrottle(ctx, lockingTx, accountKey).
+				Return(nil),
+			s.mockAuthnService.EXPECT().
+				PasswordHashNeedsUpgrade([]byte(signIn.Password)).
+				Return(false),
+			s.mockDataStore.EXPECT().
+				GetPublisherByEmail(ctx, readOnlyTx, email).
+				Return(publisher, nil),
+			s.mockDataStore.EXPECT().
+				GetTOTPBySubjectID(ctx, readOnlyTx, publisher.ID).
+				Return(domain.TOTP{}, domain.ErrTOTPNotFound),
+			s.mockAuthnService.EXPECT().
+				NewRefreshToken().
+				Return("refresh token", []byte("refresh token hash"), nil),
+			s.mockDataStore.EXPECT().
+				CreateSession(ctx, tx, gomock.Any()).
+				Return(sessionID, nil),
+			s.mockDataStore.EXPECT().
+				CreateRefreshToken(ctx, tx, gomock.Any()).
+				Return(uuid.New(), nil),
+			s.mockAuthnService.EXPECT().
+				NewJWT(publisher.ID.String(), sessionID.String(), []authn.SubjectRole{authn.SubjectRolePublisher}, nil).
+				Return("access token", nil),
+		)
+
+		expectedSignIn := domain.SignIn{
+			Tokens: domain.Tokens{
+				AccessToken:  "access token",
+				RefreshToken: "refresh token",
+			},
+		}
+
+		actualSignIn, err := s.service.SignInPublisher(ctx, "Publisher@Email.com", password, client)
+		require.NoError(t, err)
+		assert.Equal(t, expectedSignIn, actualSignIn)
+	})
+
+	t.Run("should successfully sign in publisher and upgrade its password hash", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			publisher = domain.Publisher{ID: signIn.ID}
+			sessionID = uuid.New()
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		readOnlyTx := s.newReadOnlyTx(ctx, ctrl)
+		lockingTx := s.newLockingTx(ctx, ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		gomock.InOrder(
+			s.mockDataStore.EXPECT().
+				GetPublisherSignIn(ctx, lockingTx, email).
+				Return(signIn, nil),
+			s.mockDataStore.EXPECT().
+				ListSignInThrottlesForUpdate(ctx, lockingTx, []string{accountKey, ipKey}).
+				Return([]domain.SignInThrottle{}, nil),
+			s.mockAuthnService.EXPECT().
+				CheckPasswordHash([]byte(password), []byte(signIn.Password)).
+				Return(true, nil),
+			s.mockDataStore.EXPECT().
+				DeleteSignInThrottle(ctx, lockingTx, accountKey).
+				Return(nil),
+			s.mockAuthnService.EXPECT().
+				PasswordHashNeedsUpgrade([]byte(signIn.Password)).
+				Return(true),
+			s.mockAuthnService.EXPECT().
+				HashPassword([]byte(password)).
+				Return([]byte("upgraded hashed password"), nil),
+			s.mockDataStore.EXPECT().
+				UpdatePublisherPassword(ctx, lockingTx, signIn.ID, domain.Password("upgraded hashed password")).
+				Return(nil),
+			s.mockDataStore.EXPECT().
+				GetPublisherByEmail(ctx, readOnlyTx, email).
+				Return(publisher, nil),
+			s.mockDataStore.EXPECT().
+				GetTOTPBySubjectID(ctx, readOnlyTx, publisher.ID).
+				Return(domain.TOTP{}, domain.ErrTOTPNotFound),
+			s.mockAuthnService.EXPECT().
+				NewRefreshToken().
+				Return("refresh token", []byte("refresh token hash"), nil),
+			s.mockDataStore.EXPECT().
+				CreateSession(ctx, tx, gomock.Any()).
+				Return(sessionID, nil),
+			s.mockDataStore.EXPECT().
+				CreateRefreshToken(ctx, tx, gomock.Any()).
+				Return(uuid.New(), nil),
+			s.mockAuthnService.EXPECT().
+				NewJWT(publisher.ID.String(), sessionID.String(), []authn.SubjectRole{authn.SubjectRolePublisher}, nil).
+				Return("access token", nil),
+		)
+
+		_, err := s.service.SignInPublisher(ctx, email, password, client)
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the account is locked out", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				lockedUntil = time.Now().Add(time.Minute)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			lockingTx := s.newLockingTx(ctx, ctrl)
+
+			gomock.InOrder(
+				s.mockDataStore.EXPECT().
+					GetPublisherSignIn(ctx, lockingTx, email).
+					Return(signIn, nil),
+				s.mockDataStore.EXPECT().
+					ListSignInThrottlesForUpdate(ctx, lockingTx, []string{accountKey, ipKey}).
+					Return([]domain.SignInThrottle{
+						{Key: accountKey, LastFailedAt: time.Now(), LockedUntil: &lockedUntil},
+					}, nil),
+				s.mockAuthnService.EXPECT().
+					CheckDummyPasswordHash([]byte(password)),
+			)
+
+			_, err := s.service.SignInPublisher(ctx, email, password, client)
+			require.ErrorIs(t, err, domain.ErrCredentialsIncorrect)
+		})
+
+		t.Run("when the backoff of the ip address has not elapsed", func(t *testing.T) {
+			ctx := context.Background()
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			lockingTx := s.newLockingTx(ctx, ctrl)
+
+			gomock.InOrder(
+				s.mockDataStore.EXPECT().
+					GetPublisherSignIn(ctx, lockingTx, email).
+					Return(signIn, nil),
+				s.mockDataStore.EXPECT().
+					ListSignInThrottlesForUpdate(ctx, lockingTx, []string{accountKey, ipKey}).
+					Return([]domain.SignInThrottle{
+						{Key: ipKey, FailedAttempts: domain.SignInThrottlePolicyIP.FreeAttempts + 1, LastFailedAt: time.Now()},
+					}, nil),
+				s.mockAuthnService.EXPECT().
+					CheckDummyPasswordHash([]byte(password)),
+			)
+
+			_, err := s.service.SignInPublisher(ctx, email, password, client)
+			require.ErrorIs(t, err, domain.ErrCredentialsIncorrect)
+		})
+
+		t.Run("when the publisher is not found", func(t *testing.T) {
+			ctx := context.Background()
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			lockingTx := s.newLockingTx(ctx, ctrl)
+
+			gomock.InOrder(
+				s.mockDataStore.EXPECT().
+					GetPublisherSignIn(ctx, lockingTx, email).
+					Return(domain.SignInPublisher{}, domain.ErrPublisherNotFound),
+				s.mockDataStore.EXPECT().
+					ListSignInThrottlesForUpdate(ctx, lockingTx, []string{ipKey}).
+					Return([]domain.SignInThrottle{}, nil),
+				s.mockAuthnService.EXPECT().
+					CheckDummyPasswordHash([]byte(password)),
+				s.mockDataStore.EXPECT().
+					FailSignInThrottle(ctx, lockingTx, ipKey, gomock.Any(), gomock.Any()).
+					Return(domain.SignInThrottle{Key: ipKey, FailedAttempts: 1}, nil),
+			)
+
+			_, err := s.service.SignInPublisher(ctx, email, password, client)
+			require.ErrorIs(t, err, domain.ErrCredentialsIncorrect)
+		})
+
+		t.Run("when the password is incorrect too many times", func(t *testing.T) {
+			ctx := context.Background()
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			lockingTx := s.newLockingTx(ctx, ctrl)
+
+			sent := make(chan string, 1)
+
+			gomock.InOrder(
+				s.mockDataStore.EXPECT().
+					GetPublisherSignIn(ctx, lockingTx, email).
+					Return(signIn, nil),
+				s.mockDataStore.EXPECT().
+					ListSignInThrottlesForUpdate(ctx, lockingTx, []string{accountKey, ipKey}).
+					Return([]domain.SignInThrottle{}, nil),
+				s.mockAuthnService.EXPECT().
+					CheckPasswordHash([]byte(password), []byte(signIn.Password)).
+					Return(false, nil),
+				s.mockDataStore.EXPECT().
+					FailSignInThrottle(ctx, lockingTx, accountKey, gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ pgx.Tx, key string, failedAt, resetAt time.Time) (domain.SignInThrottle, error) {
+						assert.Equal(t, domain.SignInThrottleResetTime, failedAt.Sub(resetAt))
+
+						return domain.SignInThrottle{Key: key, FailedAttempts: domain.SignInThrottlePolicyAccount.LockoutAttempts}, nil
+					}),
+				s.mockDataStore.EXPECT().
+					LockSignInThrottle(ctx, lockingTx, accountKey, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ pgx.Tx, _ string, lockedUntil time.Time) error {
+						assert.WithinDuration(t, time.Now().Add(domain.SignInThrottleLockoutTime), lockedUntil, time.Second)
+						return nil
+					}),
+				s.mockDataStore.EXPECT().
+					FailSignInThrottle(ctx, lockingTx, ipKey, gomock.Any(), gomock.Any()).
+					Return(domain.SignInThrottle{Key: ipKey, FailedAttempts: 10}, nil),
+			)
+
+			s.mockSMTP.EXPECT().
+				SendMailHTML([]string{string(email)}, signInLockoutEmailSubject, gomock.Any()).
+				DoAndReturn(func(_ []string, _ string, body string) error {
+					sent <- body
+					return nil
+				})
+
+			_, err := s.service.SignInPublisher(ctx, email, password, client)
+			require.ErrorIs(t, err, domain.ErrCredentialsIncorrect)
+
+			select {
+			case body := <-sent:
+				assert.Contains(t, body, "publisher name")
+				assert.Contains(t, body, passwordResetPublisherPath)
+			case <-time.After(time.Second):
+				t.Fatal("sign in lockout email was not sent")
+			}
+		})
+	})
+}
@@ -0,0 +1,257 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"html/template"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goofr-group/gaming-store/server"
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+	"github.com/goofr-group/gaming-store/server/internal/logging"
+)
+
+const (
+	descriptionFailedCreatePublisherDocument       = "service: failed to create publisher document"
+	descriptionFailedCreatePublisherDocumentObject = "service: failed to create publisher document object"
+	descriptionFailedGetPublisherDocument          = "service: failed to get publisher document"
+	descriptionFailedGetPublisherDocumentObject    = "service: failed to get publisher document object"
+	descriptionFailedListPublisherDocuments        = "service: failed to list publisher documents"
+	descriptionFailedListPendingPublishers         = "service: failed to list pending publishers"
+	descriptionFailedReviewPublisher               = "service: failed to review publisher"
+
+	publisherVerificationEmailSubject          = "GOOFR Store Publisher Verification"
+	publisherVerificationEmailBodyTemplatePath = "templates/email/publisher_verification.html"
+)
+
+// UploadPublisherDocument stores the specified file as a business document of the publisher with the specified
+// identifier. Documents are kept in a private bucket, named after their publisher, so that they are never shared nor
+// publicly readable. A rejected publisher goes back to pending, to be reviewed again.
+func (s *service) UploadPublisherDocument(ctx context.Context, publisherID uuid.UUID, file []byte, mediaType string) (domain.PublisherDocument, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "UploadPublisherDocument"),
+		slog.String(logging.PublisherID, publisherID.String()),
+		slog.String(logging.PublisherDocumentMediaType, mediaType),
+	}
+
+	err := s.readOnlyTx(ctx, func(tx pgx.Tx) error {
+		_, err := s.dataStore.GetPublisherByID(ctx, tx, publisherID)
+		return err
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPublisherNotFound):
+			return domain.PublisherDocument{}, logInfoAndWrapError(ctx, err, descriptionFailedCreatePublisherDocument, logAttrs...)
+		default:
+			return domain.PublisherDocument{}, logAndWrapError(ctx, err, descriptionFailedCreatePublisherDocument, logAttrs...)
+		}
+	}
+
+	objectName := publisherID.String() + "/" + uuid.NewString()
+
+	err = s.objectStore.CreateDocumentObject(ctx, objectName, mediaType, bytes.NewReader(file))
+	if err != nil {
+		return domain.PublisherDocument{}, logAndWrapError(ctx, err, descriptionFailedCreatePublisherDocumentObject, logAttrs...)
+	}
+
+	var publisherDocument domain.PublisherDocument
+
+	err = s.readWriteTx(ctx, func(tx pgx.Tx) error {
+		publisher, err := s.dataStore.GetPublisherByID(ctx, tx, publisherID)
+		if err != nil {
+			return err
+		}
+
+		id, err := s.dataStore.CreatePublisherDocument(ctx, tx, publisherID, domain.EditablePublisherDocument{
+			ObjectName: objectName,
+			MediaType:  mediaType,
+		})
+		if err != nil {
+			return err
+		}
+
+		if publisher.VerificationStatus == domain.PublisherVerificationStatusRejected {
+			err = s.dataStore.UpdatePublisherVerification(ctx, tx, publisherID, domain.PublisherVerificationStatusPending, nil)
+			if err != nil {
+				return err
+			}
+		}
+
+		publisherDocument, err = s.dataStore.GetPublisherDocument(ctx, tx, publisherID, id)
+		return err
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPublisherNotFound):
+			return domain.PublisherDocument{}, logInfoAndWrapError(ctx, err, descriptionFailedCreatePublisherDocument, logAttrs...)
+		default:
+			return domain.PublisherDocument{}, logAndWrapError(ctx, err, descriptionFailedCreatePublisherDocument, logAttrs...)
+		}
+	}
+
+	return publisherDocument, nil
+}
+
+// ListPublisherDocuments returns the business documents submitted by the publisher with the specified identifier.
+func (s *service) ListPublisherDocuments(ctx context.Context, publisherID uuid.UUID) ([]domain.PublisherDocument, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "ListPublisherDocuments"),
+		slog.String(logging.PublisherID, publisherID.String()),
+	}
+
+	var publisherDocuments []domain.PublisherDocument
+
+	err := s.readOnlyTx(ctx, func(tx pgx.Tx) error {
+		_, err := s.dataStore.GetPublisherByID(ctx, tx, publisherID)
+		if err != nil {
+			return err
+		}
+
+		publisherDocuments, err = s.dataStore.ListPublisherDocuments(ctx, tx, publisherID)
+		return err
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPublisherNotFound):
+			return nil, logInfoAndWrapError(ctx, err, descriptionFailedListPublisherDocuments, logAttrs...)
+		default:
+			return nil, logAndWrapError(ctx, err, descriptionFailedListPublisherDocuments, logAttrs...)
+		}
+	}
+
+	return publisherDocuments, nil
+}
+
+// GetPublisherDocumentFile returns the business document with the specified identifier of the publisher with the
+// specified identifier, along with the content of its file. A document of another publisher is not found.
+func (s *service) GetPublisherDocumentFile(ctx context.Context, publisherID, documentID uuid.UUID) (domain.PublisherDocument, []byte, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "GetPublisherDocumentFile"),
+		slog.String(logging.PublisherID, publisherID.String()),
+		slog.String(logging.PublisherDocumentID, documentID.String()),
+	}
+
+	var publisherDocument domain.PublisherDocument
+
+	err := s.readOnlyTx(ctx, func(tx pgx.Tx) error {
+		var err error
+
+		publisherDocument, err = s.dataStore.GetPublisherDocument(ctx, tx, publisherID, documentID)
+		return err
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPublisherDocumentNotFound):
+			return domain.PublisherDocument{}, nil, logInfoAndWrapError(ctx, err, descriptionFailedGetPublisherDocument, logAttrs...)
+		default:
+			return domain.PublisherDocument{}, nil, logAndWrapError(ctx, err, descriptionFailedGetPublisherDocument, logAttrs...)
+		}
+	}
+
+	file, err := s.objectStore.GetDocumentObject(ctx, publisherDocument.ObjectName)
+	if err != nil {
+		return domain.PublisherDocument{}, nil, logAndWrapError(ctx, err, descriptionFailedGetPublisherDocumentObject, logAttrs...)
+	}
+
+	return publisherDocument, file, nil
+}
+
+// ListPendingPublishers returns the publishers waiting to be reviewed, oldest first.
+func (s *service) ListPendingPublishers(ctx context.Context) ([]domain.Publisher, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "ListPendingPublishers"),
+	}
+
+	var publishers []domain.Publisher
+
+	err := s.readOnlyTx(ctx, func(tx pgx.Tx) error {
+		var err error
+
+		publishers, err = s.dataStore.ListPublishersByVerificationStatus(ctx, tx, domain.PublisherVerificationStatusPending)
+		return err
+	})
+	if err != nil {
+		return nil, logAndWrapError(ctx, err, descriptionFailedListPendingPublishers, logAttrs...)
+	}
+
+	return publishers, nil
+}
+
+// ReviewPublisher verifies or rejects the publisher with the specified identifier, and emails it the result. A
+// rejection requires a reason.
+func (s *service) ReviewPublisher(ctx context.Context, publisherID uuid.UUID, status domain.PublisherVerificationStatus, reason *domain.PublisherVerificationReason) (domain.Publisher, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "ReviewPublisher"),
+		slog.String(logging.PublisherID, publisherID.String()),
+		slog.String(logging.PublisherVerificationStatus, string(status)),
+	}
+
+	if !status.ValidReview() {
+		return domain.Publisher{}, logInfoAndWrapError(ctx, &domain.FieldValueInvalidError{FieldName: domain.FieldStatus}, descriptionInvalidFieldValue, logAttrs...)
+	}
+
+	if status == domain.PublisherVerificationStatusVerified {
+		// The reason of a previous rejection no longer applies.
+		reason = nil
+	}
+
+	if status == domain.PublisherVerificationStatusRejected && (reason == nil || !reason.Valid()) {
+		return domain.Publisher{}, logInfoAndWrapError(ctx, &domain.FieldValueInvalidError{FieldName: domain.FieldReason}, descriptionInvalidFieldValue, logAttrs...)
+	}
+
+	var publisher domain.Publisher
+
+	err := s.readWriteTx(ctx, func(tx pgx.Tx) error {
+		err := s.dataStore.UpdatePublisherVerification(ctx, tx, publisherID, status, reason)
+		if err != nil {
+			return err
+		}
+
+		publisher, err = s.dataStore.GetPublisherByID(ctx, tx, publisherID)
+		if err != nil {
+			return err
+		}
+
+		return s.sendPublisherVerification(publisher)
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPublisherNotFound):
+			return domain.Publisher{}, logInfoAndWrapError(ctx, err, descriptionFailedReviewPublisher, logAttrs...)
+		default:
+			return domain.Publisher{}, logAndWrapError(ctx, err, descriptionFailedReviewPublisher, logAttrs...)
+		}
+	}
+
+	return publisher, nil
+}
+
+// sendPublisherVerification emails the result of the review to the specified publisher.
+func (s *service) sendPublisherVerification(publisher domain.Publisher) error {
+	template, err := template.ParseFS(server.FileSystemTemplates, publisherVerificationEmailBodyTemplatePath)
+	if err != nil {
+		return err
+	}
+
+	publisherVerification := domain.PublisherVerificationEmail{
+		PublisherName: string(publisher.Name),
+		Verified:      publisher.VerificationStatus == domain.PublisherVerificationStatusVerified,
+	}
+
+	if publisher.VerificationReason != nil {
+		publisherVerification.Reason = string(*publisher.VerificationReason)
+	}
+
+	var body bytes.Buffer
+
+	err = template.Execute(&body, publisherVerification)
+	if err != nil {
+		return err
+	}
+
+	return s.smtp.SendMailHTML([]string{string(publisher.Email)}, publisherVerificationEmailSubject, body.String())
+}
@@ -0,0 +1,338 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+func TestService_UploadPublisherDocument(t *testing.T) {
+	t.Run("should successfully upload publisher document", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			publisherID = uuid.New()
+			documentID  = uuid.New()
+			file        = []byte("document")
+			mediaType   = "application/pdf"
+
+			objectName string
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		readOnlyTx := s.newReadOnlyTx(ctx, ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		gomock.InOrder(
+			s.mockDataStore.EXPECT().
+				GetPublisherByID(ctx, readOnlyTx, publisherID).
+				Return(domain.Publisher{ID: publisherID, VerificationStatus: domain.PublisherVerificationStatusPending}, nil),
+			s.mockObjectStore.EXPECT().
+				CreateDocumentObject(ctx, gomock.Any(), mediaType, gomock.Any()).
+				DoAndReturn(func(_ context.Context, name, _ string, reader io.Reader) error {
+					objectName = name
+
+					content, err := io.ReadAll(reader)
+					require.NoError(t, err)
+					assert.Equal(t, file, content)
+
+					return nil
+				}),
+			s.mockDataStore.EXPECT().
+				GetPublisherByID(ctx, tx, publisherID).
+				Return(domain.Publisher{ID: publisherID, VerificationStatus: domain.PublisherVerificationStatusPending}, nil),
+			s.mockDataStore.EXPECT().
+				CreatePublisherDocument(ctx, tx, publisherID, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ pgx.Tx, _ uuid.UUID, editablePublisherDocument domain.EditablePublisherDocument) (uuid.UUID, error) {
+					assert.Equal(t, objectName, editablePublisherDocument.ObjectName)
+					assert.Equal(t, mediaType, editablePublisherDocument.MediaType)
+
+					return documentID, nil
+				}),
+			s.mockDataStore.EXPECT().
+				GetPublisherDocument(ctx, tx, publisherID, documentID).
+				Return(domain.PublisherDocument{ID: documentID, PublisherID: publisherID}, nil),
+		)
+
+		document, err := s.service.UploadPublisherDocument(ctx, publisherID, file, mediaType)
+		require.NoError(t, err)
+		assert.Equal(t, documentID, document.ID)
+		assert.True(t, strings.HasPrefix(objectName, publisherID.String()+"/"))
+	})
+
+	t.Run("should successfully set rejected publisher back to pending", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			publisherID = uuid.New()
+			documentID  = uuid.New()
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		readOnlyTx := s.newReadOnlyTx(ctx, ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		gomock.InOrder(
+			s.mockDataStore.EXPECT().
+				GetPublisherByID(ctx, readOnlyTx, publisherID).
+				Return(domain.Publisher{ID: publisherID, VerificationStatus: domain.PublisherVerificationStatusRejected}, nil),
+			s.mockObjectStore.EXPECT().
+				CreateDocumentObject(ctx, gomock.Any(), "application/pdf", gomock.Any()).
+				Return(nil),
+			s.mockDataStore.EXPECT().
+				GetPublisherByID(ctx, tx, publisherID).
+				Return(domain.Publisher{ID: publisherID, VerificationStatus: domain.PublisherVerificationStatusRejected}, nil),
+			s.mockDataStore.EXPECT().
+				CreatePublisherDocument(ctx, tx, publisherID, gomock.Any()).
+				Return(documentID, nil),
+			s.mockDataStore.EXPECT().
+				UpdatePublisherVerification(ctx, tx, publisherID, domain.PublisherVerificationStatusPending, nil).
+				Return(nil),
+			s.mockDataStore.EXPECT().
+				GetPublisherDocument(ctx, tx, publisherID, documentID).
+				Return(domain.PublisherDocument{ID: documentID, PublisherID: publisherID}, nil),
+		)
+
+		_, err := s.service.UploadPublisherDocument(ctx, publisherID, []byte("document"), "application/pdf")
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the publisher does not exist", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				publisherID = uuid.New()
+
+				expectedError = fmt.Errorf("%s: %w", descriptionFailedCreatePublisherDocument, domain.ErrPublisherNotFound)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadOnlyTx(ctx, ctrl)
+
+			s.mockDataStore.EXPECT().
+				GetPublisherByID(ctx, tx, publisherID).
+				Return(domain.Publisher{}, domain.ErrPublisherNotFound)
+
+			_, err := s.service.UploadPublisherDocument(ctx, publisherID, []byte("document"), "application/pdf")
+			assert.Equal(t, expectedError, err)
+		})
+	})
+}
+
+func TestService_GetPublisherDocumentFile(t *testing.T) {
+	t.Run("should successfully get publisher document file", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			publisherID = uuid.New()
+			document    = domain.PublisherDocument{
+				EditablePublisherDocument: domain.EditablePublisherDocument{
+					ObjectName: publisherID.String() + "/object",
+					MediaType:  "application/pdf",
+				},
+				ID:          uuid.New(),
+				PublisherID: publisherID,
+			}
+			file = []byte("document")
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadOnlyTx(ctx, ctrl)
+
+		gomock.InOrder(
+			s.mockDataStore.EXPECT().
+				GetPublisherDocument(ctx, tx, publisherID, document.ID).
+				Return(document, nil),
+			s.mockObjectStore.EXPECT().
+				GetDocumentObject(ctx, document.ObjectName).
+				Return(file, nil),
+		)
+
+		actualDocument, actualFile, err := s.service.GetPublisherDocumentFile(ctx, publisherID, document.ID)
+		require.NoError(t, err)
+		assert.Equal(t, document, actualDocument)
+		assert.Equal(t, file, actualFile)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the document belongs to another publisher", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				publisherID = uuid.New()
+				documentID  = uuid.New()
+
+				expectedError = fmt.Errorf("%s: %w", descriptionFailedGetPublisherDocument, domain.ErrPublisherDocumentNotFound)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadOnlyTx(ctx, ctrl)
+
+			s.mockDataStore.EXPECT().
+				GetPublisherDocument(ctx, tx, publisherID, documentID).
+				Return(domain.PublisherDocument{}, domain.ErrPublisherDocumentNotFound)
+
+			_, _, err := s.service.GetPublisherDocumentFile(ctx, publisherID, documentID)
+			assert.Equal(t, expectedError, err)
+		})
+	})
+}
+
+func TestService_ReviewPublisher(t *testing.T) {
+	t.Run("should successfully verify publisher", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			publisher = domain.Publisher{
+				ID:                 uuid.New(),
+				Email:              "publisher@email.com",
+				Name:               "publisher name",
+				VerificationStatus: domain.PublisherVerificationStatusVerified,
+			}
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		gomock.InOrder(
+			s.mockDataStore.EXPECT().
+				UpdatePublisherVerification(ctx, tx, publisher.ID, domain.PublisherVerificationStatusVerified, nil).
+				Return(nil),
+			s.mockDataStore.EXPECT().
+				GetPublisherByID(ctx, tx, publisher.ID).
+				Return(publisher, nil),
+			s.mockSMTP.EXPECT().
+				SendMailHTML([]string{string(publisher.Email)}, publisherVerificationEmailSubject, gomock.Any()).
+				DoAndReturn(func(_ []string, _ string, body string) error {
+					assert.Contains(t, body, string(publisher.Name))
+					assert.Contains(t, body, "was verified")
+
+					return nil
+				}),
+		)
+
+		reviewedPublisher, err := s.service.ReviewPublisher(ctx, publisher.ID, domain.PublisherVerificationStatusVerified, nil)
+		require.NoError(t, err)
+		assert.Equal(t, publisher, reviewedPublisher)
+	})
+
+	t.Run("should successfully reject publisher with reason", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			reason = domain.PublisherVerificationReason("the documents are not legible")
+
+			publisher = domain.Publisher{
+				ID:                 uuid.New(),
+				Email:              "publisher@email.com",
+				Name:               "publisher name",
+				VerificationStatus: domain.PublisherVerificationStatusRejected,
+				VerificationReason: &reason,
+			}
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		gomock.InOrder(
+			s.mockDataStore.EXPECT().
+				UpdatePublisherVerification(ctx, tx, publisher.ID, domain.PublisherVerificationStatusRejected, &reason).
+				Return(nil),
+			s.mockDataStore.EXPECT().
+				GetPublisherByID(ctx, tx, publisher.ID).
+				Return(publisher, nil),
+			s.mockSMTP.EXPECT().
+				SendMailHTML([]string{string(publisher.Email)}, publisherVerificationEmailSubject, gomock.Any()).
+				DoAndReturn(func(_ []string, _ string, body string) error {
+					assert.Contains(t, body, string(reason))
+
+					return nil
+				}),
+		)
+
+		reviewedPublisher, err := s.service.ReviewPublisher(ctx, publisher.ID, domain.PublisherVerificationStatusRejected, &reason)
+		require.NoError(t, err)
+		assert.Equal(t, publisher, reviewedPublisher)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the status is invalid", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				expectedError = fmt.Errorf("%s: %w", descriptionInvalidFieldValue, &domain.FieldValueInvalidError{FieldName: domain.FieldStatus})
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+
+			_, err := s.service.ReviewPublisher(ctx, uuid.New(), domain.PublisherVerificationStatusPending, nil)
+			assert.Equal(t, expectedError, err)
+		})
+
+		t.Run("when the rejection has no reason", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				expectedError = fmt.Errorf("%s: %w", descriptionInvalidFieldValue, &domain.FieldValueInvalidError{FieldName: domain.FieldReason})
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+
+			_, err := s.service.ReviewPublisher(ctx, uuid.New(), domain.PublisherVerificationStatusRejected, nil)
+			assert.Equal(t, expectedError, err)
+		})
+
+		t.Run("when the store fails", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				publisherID = uuid.New()
+
+				errTest       = errors.New("error test")
+				expectedError = fmt.Errorf("%s: %w", descriptionFailedReviewPublisher, errTest)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			s.mockDataStore.EXPECT().
+				UpdatePublisherVerification(ctx, tx, publisherID, domain.PublisherVerificationStatusVerified, nil).
+				Return(errTest)
+
+			_, err := s.service.ReviewPublisher(ctx, publisherID, domain.PublisherVerificationStatusVerified, nil)
+			assert.Equal(t, expectedError, err)
+		})
+	})
+}
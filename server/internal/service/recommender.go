@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+	"github.com/goofr-group/gaming-store/server/internal/logging"
+)
+
+const (
+	descriptionFailedTrainRecommender = "service: failed to train recommender"
+)
+
+// TrainRecommender trains the game recommender with the current users libraries and purchases.
+// It is meant to be executed periodically. Only the instance holding the lock of the job executes it.
+func (s *service) TrainRecommender(ctx context.Context) error {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "TrainRecommender"),
+	}
+
+	err := s.periodicJobTx(ctx, domain.PeriodicJobRecommenderTrain, func(tx pgx.Tx) error {
+		return s.recommender.Train(ctx, tx)
+	})
+	if err != nil {
+		return logAndWrapError(ctx, err, descriptionFailedTrainRecommender, logAttrs...)
+	}
+
+	return nil
+}
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+func TestService_TrainRecommender(t *testing.T) {
+	t.Run("should successfully train recommender", func(t *testing.T) {
+		ctx := context.Background()
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		gomock.InOrder(
+			s.mockDataStore.EXPECT().
+				AcquirePeriodicJobLock(ctx, tx, domain.PeriodicJobRecommenderTrain).
+				Return(true, nil),
+			s.mockRecommender.EXPECT().
+				Train(ctx, tx).
+				Return(nil),
+		)
+
+		err := s.service.TrainRecommender(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("should successfully skip the training when another instance holds the lock", func(t *testing.T) {
+		ctx := context.Background()
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		s.mockDataStore.EXPECT().
+			AcquirePeriodicJobLock(ctx, tx, domain.PeriodicJobRecommenderTrain).
+			Return(false, nil)
+
+		err := s.service.TrainRecommender(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the training fails", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				trainErr = errors.New("train failed")
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			gomock.InOrder(
+				s.mockDataStore.EXPECT().
+					AcquirePeriodicJobLock(ctx, tx, domain.PeriodicJobRecommenderTrain).
+					Return(true, nil),
+				s.mockRecommender.EXPECT().
+					Train(ctx, tx).
+					Return(trainErr),
+			)
+
+			err := s.service.TrainRecommender(ctx)
+			require.ErrorIs(t, err, trainErr)
+		})
+	})
+}
+
+func TestService_ListGamesRecommended(t *testing.T) {
+	t.Run("should successfully list games recommended to the user", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			userID = uuid.New()
+			filter = domain.GamesRecommendedPaginatedFilter{
+				PaginatedRequestBase: domain.PaginatedRequestBase{
+					Limit: 10,
+				},
+				UserID: &userID,
+			}
+			expectedPaginatedGames = domain.PaginatedResponse[domain.Game]{
+				Total: 1,
+				Results: []domain.Game{
+					{ID: uuid.New()},
+				},
+			}
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadOnlyTx(ctx, ctrl)
+
+		s.mockRecommender.EXPECT().
+			ListGamesRecommended(ctx, tx, filter).
+			Return(expectedPaginatedGames, nil)
+
+		paginatedGames, err := s.service.ListGamesRecommended(ctx, filter)
+		require.NoError(t, err)
+		assert.Equal(t, expectedPaginatedGames, paginatedGames)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the limit is invalid", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				filter = domain.GamesRecommendedPaginatedFilter{}
+
+				expectedError = fmt.Errorf("%s: %w", descriptionInvalidFilterValue, &domain.FilterValueInvalidError{FilterName: domain.FieldFilterLimit})
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+
+			_, err := s.service.ListGamesRecommended(ctx, filter)
+			assert.Equal(t, expectedError, err)
+		})
+
+		t.Run("when the recommender fails", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				filter = domain.GamesRecommendedPaginatedFilter{
+					PaginatedRequestBase: domain.PaginatedRequestBase{
+						Limit: 10,
+					},
+				}
+				recommenderErr = errors.New("recommender failed")
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadOnlyTx(ctx, ctrl)
+
+			s.mockRecommender.EXPECT().
+				ListGamesRecommended(ctx, tx, filter).
+				Return(domain.PaginatedResponse[domain.Game]{}, recommenderErr)
+
+			_, err := s.service.ListGamesRecommended(ctx, filter)
+			require.ErrorIs(t, err, recommenderErr)
+		})
+	})
+}
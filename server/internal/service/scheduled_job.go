@@ -0,0 +1,216 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+	"github.com/goofr-group/gaming-store/server/internal/logging"
+)
+
+const (
+	descriptionFailedCreateScheduledJob = "service: failed to create scheduled job"
+	descriptionFailedListScheduledJobs  = "service: failed to list scheduled jobs"
+	descriptionFailedCancelScheduledJob = "service: failed to cancel scheduled job"
+	descriptionFailedRunScheduledJobs   = "service: failed to run scheduled jobs"
+	descriptionFailedRunScheduledJob    = "service: failed to run scheduled job"
+
+	// scheduledJobsBatchSize defines the maximum amount of due scheduled jobs executed on each run.
+	scheduledJobsBatchSize = 100
+)
+
+// CreateScheduledJob creates a scheduled job of the game with the specified identifier. Games are published at their
+// release date unless another run time is specified, which can not be in the past.
+func (s *service) CreateScheduledJob(ctx context.Context, gameID uuid.UUID, editableScheduledJob domain.EditableScheduledJob) (domain.ScheduledJob, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "CreateScheduledJob"),
+		slog.String(logging.GameID, gameID.String()),
+		slog.String(logging.ScheduledJobAction, string(editableScheduledJob.Action)),
+	}
+
+	if !editableScheduledJob.Action.Valid() {
+		return domain.ScheduledJob{}, logInfoAndWrapError(ctx, &domain.FieldValueInvalidError{FieldName: domain.FieldAction}, descriptionInvalidFieldValue, logAttrs...)
+	}
+
+	if editableScheduledJob.RunAt != nil && editableScheduledJob.RunAt.Before(time.Now()) {
+		return domain.ScheduledJob{}, logInfoAndWrapError(ctx, &domain.FieldValueInvalidError{FieldName: domain.FieldRunAt}, descriptionInvalidFieldValue, logAttrs...)
+	}
+
+	var scheduledJob domain.ScheduledJob
+
+	err := s.readWriteTx(ctx, func(tx pgx.Tx) error {
+		if editableScheduledJob.RunAt == nil {
+			if editableScheduledJob.Action != domain.ScheduledJobActionPublishGame {
+				return &domain.FieldValueInvalidError{FieldName: domain.FieldRunAt}
+			}
+
+			game, err := s.dataStore.GetGameByID(ctx, tx, gameID)
+			if err != nil {
+				return err
+			}
+
+			if game.ReleaseDate == nil {
+				return &domain.FieldValueInvalidError{FieldName: domain.FieldRunAt}
+			}
+
+			editableScheduledJob.RunAt = game.ReleaseDate
+		}
+
+		id, err := s.dataStore.CreateScheduledJob(ctx, tx, gameID, editableScheduledJob)
+		if err != nil {
+			return err
+		}
+
+		scheduledJob, err = s.dataStore.GetScheduledJobByID(ctx, tx, id)
+		return err
+	})
+	if err != nil {
+		var domainFieldValueInvalidError *domain.FieldValueInvalidError
+
+		switch {
+		case errors.As(err, &domainFieldValueInvalidError):
+			return domain.ScheduledJob{}, logInfoAndWrapError(ctx, err, descriptionInvalidFieldValue, logAttrs...)
+		case errors.Is(err, domain.ErrGameNotFound):
+			return domain.ScheduledJob{}, logInfoAndWrapError(ctx, err, descriptionFailedCreateScheduledJob, logAttrs...)
+		default:
+			return domain.ScheduledJob{}, logAndWrapError(ctx, err, descriptionFailedCreateScheduledJob, logAttrs...)
+		}
+	}
+
+	return scheduledJob, nil
+}
+
+// ListScheduledJobs returns the scheduled jobs of the game with the specified identifier.
+func (s *service) ListScheduledJobs(ctx context.Context, gameID uuid.UUID) ([]domain.ScheduledJob, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "ListScheduledJobs"),
+		slog.String(logging.GameID, gameID.String()),
+	}
+
+	var scheduledJobs []domain.ScheduledJob
+
+	err := s.readOnlyTx(ctx, func(tx pgx.Tx) error {
+		var err error
+
+		scheduledJobs, err = s.dataStore.ListScheduledJobs(ctx, tx, gameID)
+		return err
+	})
+	if err != nil {
+		return nil, logAndWrapError(ctx, err, descriptionFailedListScheduledJobs, logAttrs...)
+	}
+
+	return scheduledJobs, nil
+}
+
+// CancelScheduledJob cancels the pending scheduled job with the specified identifier of the game with the specified
+// identifier.
+func (s *service) CancelScheduledJob(ctx context.Context, gameID, id uuid.UUID) error {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "CancelScheduledJob"),
+		slog.String(logging.GameID, gameID.String()),
+		slog.String(logging.ScheduledJobID, id.String()),
+	}
+
+	err := s.readWriteTx(ctx, func(tx pgx.Tx) error {
+		return s.dataStore.CancelScheduledJob(ctx, tx, gameID, id)
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrScheduledJobNotFound):
+			return logInfoAndWrapError(ctx, err, descriptionFailedCancelScheduledJob, logAttrs...)
+		default:
+			return logAndWrapError(ctx, err, descriptionFailedCancelScheduledJob, logAttrs...)
+		}
+	}
+
+	return nil
+}
+
+// RunScheduledJobs executes the due scheduled jobs. Only the instance holding the lock of the job executes them, the
+// others skip the run. Each job runs in its own savepoint, so a job that fails is marked as failed, without its
+// writes, and is not retried, while the other jobs of the batch still run.
+// It is meant to be executed periodically.
+func (s *service) RunScheduledJobs(ctx context.Context) error {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "RunScheduledJobs"),
+	}
+
+	err := s.periodicJobTx(ctx, domain.PeriodicJobScheduledJobs, func(tx pgx.Tx) error {
+		scheduledJobs, err := s.dataStore.ListDueScheduledJobs(ctx, tx, time.Now().UTC(), scheduledJobsBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, scheduledJob := range scheduledJobs {
+			status := domain.ScheduledJobStatusSucceeded
+
+			var jobError *domain.ScheduledJobError
+
+			err = savepointTx(ctx, tx, func(tx pgx.Tx) error {
+				return s.runScheduledJob(ctx, tx, scheduledJob)
+			})
+			if err != nil {
+				jobLogAttrs := []any{
+					slog.String(logging.ScheduledJobID, scheduledJob.ID.String()),
+					slog.String(logging.ScheduledJobAction, string(scheduledJob.Action)),
+					logging.Error(err),
+				}
+
+				switch {
+				case errors.Is(err, domain.ErrGameNotFound),
+					errors.Is(err, domain.ErrPublisherNotVerified),
+					errors.Is(err, domain.ErrGameStatusTransitionInvalid):
+					logging.Logger.InfoContext(ctx, descriptionFailedRunScheduledJob, jobLogAttrs...)
+				default:
+					logging.Logger.ErrorContext(ctx, descriptionFailedRunScheduledJob, jobLogAttrs...)
+
+					// The reason is shown to the publisher, so unexpected errors are not exposed.
+					err = domain.ErrScheduledJobFailed
+				}
+
+				status = domain.ScheduledJobStatusFailed
+				e := domain.NewScheduledJobError(err)
+				jobError = &e
+			}
+
+			err = s.dataStore.CompleteScheduledJob(ctx, tx, scheduledJob.ID, status, jobError)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return logAndWrapError(ctx, err, descriptionFailedRunScheduledJobs, logAttrs...)
+	}
+
+	return nil
+}
+
+// runScheduledJob executes the action of the specified scheduled job.
+func (s *service) runScheduledJob(ctx context.Context, tx pgx.Tx, scheduledJob domain.ScheduledJob) error {
+	var status domain.GameStatus
+
+	switch scheduledJob.Action {
+	case domain.ScheduledJobActionPublishGame:
+		status = domain.GameStatusPublished
+	case domain.ScheduledJobActionDelistGame:
+		status = domain.GameStatusDelisted
+	default:
+		return domain.ErrGameStatusTransitionInvalid
+	}
+
+	game, err := s.dataStore.GetGameByID(ctx, tx, scheduledJob.GameID)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.transitionGame(ctx, tx, game, status, nil)
+	return err
+}
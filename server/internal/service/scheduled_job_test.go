@@ -0,0 +1,324 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+func TestService_CreateScheduledJob(t *testing.T) {
+	t.Run("should successfully create publish job at the release date of the game", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			releaseDate = time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC)
+
+			game = domain.Game{
+				ID:          uuid.New(),
+				Status:      domain.GameStatusApproved,
+				ReleaseDate: &releaseDate,
+			}
+			editableScheduledJob = domain.EditableScheduledJob{
+				Action: domain.ScheduledJobActionPublishGame,
+			}
+			expectedEditableScheduledJob = domain.EditableScheduledJob{
+				Action: domain.ScheduledJobActionPublishGame,
+				RunAt:  &releaseDate,
+			}
+			expectedScheduledJob = domain.ScheduledJob{
+				ID:     uuid.New(),
+				GameID: game.ID,
+				Action: domain.ScheduledJobActionPublishGame,
+				RunAt:  releaseDate,
+				Status: domain.ScheduledJobStatusPending,
+			}
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		gomock.InOrder(
+			s.mockDataStore.EXPECT().
+				GetGameByID(ctx, tx, game.ID).
+				Return(game, nil),
+			s.mockDataStore.EXPECT().
+				CreateScheduledJob(ctx, tx, game.ID, expectedEditableScheduledJob).
+				Return(expectedScheduledJob.ID, nil),
+			s.mockDataStore.EXPECT().
+				GetScheduledJobByID(ctx, tx, expectedScheduledJob.ID).
+				Return(expectedScheduledJob, nil),
+		)
+
+		scheduledJob, err := s.service.CreateScheduledJob(ctx, game.ID, editableScheduledJob)
+		require.NoError(t, err)
+		assert.Equal(t, expectedScheduledJob, scheduledJob)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the action is invalid", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				gameID               = uuid.New()
+				editableScheduledJob = domain.EditableScheduledJob{
+					Action: "archive_game",
+				}
+
+				expectedError = fmt.Errorf("%s: %w", descriptionInvalidFieldValue, &domain.FieldValueInvalidError{FieldName: domain.FieldAction})
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+
+			_, err := s.service.CreateScheduledJob(ctx, gameID, editableScheduledJob)
+			assert.Equal(t, expectedError, err)
+		})
+
+		t.Run("when the run time is in the past", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				runAt                = time.Now().Add(-time.Hour)
+				editableScheduledJob = domain.EditableScheduledJob{
+					Action: domain.ScheduledJobActionPublishGame,
+					RunAt:  &runAt,
+				}
+
+				expectedError = fmt.Errorf("%s: %w", descriptionInvalidFieldValue, &domain.FieldValueInvalidError{FieldName: domain.FieldRunAt})
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+
+			_, err := s.service.CreateScheduledJob(ctx, uuid.New(), editableScheduledJob)
+			assert.Equal(t, expectedError, err)
+		})
+
+		t.Run("when the game has no release date and no run time is specified", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				game = domain.Game{
+					ID:     uuid.New(),
+					Status: domain.GameStatusApproved,
+				}
+				editableScheduledJob = domain.EditableScheduledJob{
+					Action: domain.ScheduledJobActionPublishGame,
+				}
+
+				expectedError = fmt.Errorf("%s: %w", descriptionInvalidFieldValue, &domain.FieldValueInvalidError{FieldName: domain.FieldRunAt})
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			s.mockDataStore.EXPECT().
+				GetGameByID(ctx, tx, game.ID).
+				Return(game, nil)
+
+			_, err := s.service.CreateScheduledJob(ctx, game.ID, editableScheduledJob)
+			assert.Equal(t, expectedError, err)
+		})
+	})
+}
+
+func TestService_CancelScheduledJob(t *testing.T) {
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the scheduled job is not pending", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				gameID = uuid.New()
+				id     = uuid.New()
+
+				expectedError = fmt.Errorf("%s: %w", descriptionFailedCancelScheduledJob, domain.ErrScheduledJobNotFound)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			s.mockDataStore.EXPECT().
+				CancelScheduledJob(ctx, tx, gameID, id).
+				Return(domain.ErrScheduledJobNotFound)
+
+			err := s.service.CancelScheduledJob(ctx, gameID, id)
+			assert.Equal(t, expectedError, err)
+		})
+	})
+}
+
+func TestService_RunScheduledJobs(t *testing.T) {
+	t.Run("should successfully run due scheduled jobs", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			approvedGame = domain.Game{
+				ID:     uuid.New(),
+				Status: domain.GameStatusApproved,
+			}
+			draftGame = domain.Game{
+				ID:     uuid.New(),
+				Status: domain.GameStatusDraft,
+			}
+			publishJob = domain.ScheduledJob{
+				ID:     uuid.New(),
+				GameID: approvedGame.ID,
+				Action: domain.ScheduledJobActionPublishGame,
+				Status: domain.ScheduledJobStatusPending,
+			}
+			delistJob = domain.ScheduledJob{
+				ID:     uuid.New(),
+				GameID: draftGame.ID,
+				Action: domain.ScheduledJobActionDelistGame,
+				Status: domain.ScheduledJobStatusPending,
+			}
+
+			expectedJobError = domain.NewScheduledJobError(domain.ErrGameStatusTransitionInvalid)
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		// Each job runs in a savepoint of the same transaction.
+		tx.(*MockTx).EXPECT().
+			Begin(ctx).
+			Return(tx, nil).
+			AnyTimes()
+
+		gomock.InOrder(
+			s.mockDataStore.EXPECT().
+				AcquirePeriodicJobLock(ctx, tx, domain.PeriodicJobScheduledJobs).
+				Return(true, nil),
+			s.mockDataStore.EXPECT().
+				ListDueScheduledJobs(ctx, tx, gomock.Any(), scheduledJobsBatchSize).
+				Return([]domain.ScheduledJob{publishJob, delistJob}, nil),
+			s.mockDataStore.EXPECT().
+				GetGameByID(ctx, tx, approvedGame.ID).
+				Return(approvedGame, nil),
+			s.mockDataStore.EXPECT().
+				GetPublisherByID(ctx, tx, approvedGame.Publisher.ID).
+				Return(domain.Publisher{VerificationStatus: domain.PublisherVerificationStatusVerified}, nil),
+			s.mockDataStore.EXPECT().
+				UpdateGameStatus(ctx, tx, approvedGame.ID, domain.GameStatusPublished, nil).
+				Return(nil),
+			s.mockDataStore.EXPECT().
+				GetGameByID(ctx, tx, approvedGame.ID).
+				Return(approvedGame, nil),
+			s.mockDataStore.EXPECT().
+				CompleteScheduledJob(ctx, tx, publishJob.ID, domain.ScheduledJobStatusSucceeded, nil).
+				Return(nil),
+			s.mockDataStore.EXPECT().
+				GetGameByID(ctx, tx, draftGame.ID).
+				Return(draftGame, nil),
+			s.mockDataStore.EXPECT().
+				CompleteScheduledJob(ctx, tx, delistJob.ID, domain.ScheduledJobStatusFailed, &expectedJobError).
+				Return(nil),
+		)
+
+		err := s.service.RunScheduledJobs(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("should successfully run the other scheduled jobs when one fails unexpectedly", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			approvedGame = domain.Game{
+				ID:     uuid.New(),
+				Status: domain.GameStatusApproved,
+			}
+			failingJob = domain.ScheduledJob{
+				ID:     uuid.New(),
+				GameID: uuid.New(),
+				Action: domain.ScheduledJobActionPublishGame,
+				Status: domain.ScheduledJobStatusPending,
+			}
+			publishJob = domain.ScheduledJob{
+				ID:     uuid.New(),
+				GameID: approvedGame.ID,
+				Action: domain.ScheduledJobActionPublishGame,
+				Status: domain.ScheduledJobStatusPending,
+			}
+
+			expectedJobError = domain.NewScheduledJobError(domain.ErrScheduledJobFailed)
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		tx.(*MockTx).EXPECT().
+			Begin(ctx).
+			Return(tx, nil).
+			AnyTimes()
+
+		gomock.InOrder(
+			s.mockDataStore.EXPECT().
+				AcquirePeriodicJobLock(ctx, tx, domain.PeriodicJobScheduledJobs).
+				Return(true, nil),
+			s.mockDataStore.EXPECT().
+				ListDueScheduledJobs(ctx, tx, gomock.Any(), scheduledJobsBatchSize).
+				Return([]domain.ScheduledJob{failingJob, publishJob}, nil),
+			s.mockDataStore.EXPECT().
+				GetGameByID(ctx, tx, failingJob.GameID).
+				Return(domain.Game{}, errors.New("connection reset")),
+			s.mockDataStore.EXPECT().
+				CompleteScheduledJob(ctx, tx, failingJob.ID, domain.ScheduledJobStatusFailed, &expectedJobError).
+				Return(nil),
+			s.mockDataStore.EXPECT().
+				GetGameByID(ctx, tx, approvedGame.ID).
+				Return(approvedGame, nil),
+			s.mockDataStore.EXPECT().
+				GetPublisherByID(ctx, tx, approvedGame.Publisher.ID).
+				Return(domain.Publisher{VerificationStatus: domain.PublisherVerificationStatusVerified}, nil),
+			s.mockDataStore.EXPECT().
+				UpdateGameStatus(ctx, tx, approvedGame.ID, domain.GameStatusPublished, nil).
+				Return(nil),
+			s.mockDataStore.EXPECT().
+				GetGameByID(ctx, tx, approvedGame.ID).
+				Return(approvedGame, nil),
+			s.mockDataStore.EXPECT().
+				CompleteScheduledJob(ctx, tx, publishJob.ID, domain.ScheduledJobStatusSucceeded, nil).
+				Return(nil),
+		)
+
+		err := s.service.RunScheduledJobs(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("should successfully skip the run when another instance holds the scheduler lock", func(t *testing.T) {
+		ctx := context.Background()
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		s.mockDataStore.EXPECT().
+			AcquirePeriodicJobLock(ctx, tx, domain.PeriodicJobScheduledJobs).
+			Return(false, nil)
+
+		err := s.service.RunScheduledJobs(ctx)
+		require.NoError(t, err)
+	})
+}
@@ -1,6 +1,6 @@
 package service
 
-//go:generate go run go.uber.org/mock/mockgen -destination mock_service_test.gen.go -package service -typed . AuthenticationService,DataStore,ObjectStore,SMTP
+//go:generate go run go.uber.org/mock/mockgen -destination mock_service_test.gen.go -package service -typed . AuthenticationService,DataStore,ObjectStore,BreachStore,SMTP,OIDC,Recommender
 //go:generate go run go.uber.org/mock/mockgen -destination mock_tx_test.gen.go -package service -typed github.com/jackc/pgx/v5 Tx
 
 import (
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -29,9 +30,42 @@ const (
 type AuthenticationService interface {
 	ValidPassword(password []byte) bool
 	HashPassword(password []byte) ([]byte, error)
+	NewUnusablePasswordHash() ([]byte, error)
 	CheckPasswordHash(password, hash []byte) (bool, error)
-
-	NewJWT(subject string, subjectRoles []authn.SubjectRole) (string, error)
+	PasswordHashNeedsUpgrade(hash []byte) bool
+	CheckDummyPasswordHash(password []byte)
+
+	NewJWT(subject, sessionID string, subjectRoles []authn.SubjectRole, publisherMemberships map[string]string) (string, error)
+	JSONWebKeys() []authn.JSONWebKey
+	NewRefreshToken() (string, []byte, error)
+	HashRefreshToken(token string) []byte
+	NewPasswordResetToken() (string, []byte, error)
+	HashPasswordResetToken(token string) []byte
+	NewPublisherInvitationToken() (string, []byte, error)
+	HashPublisherInvitationToken(token string) []byte
+	NewEmailVerificationToken(subject string, role authn.SubjectRole, email string) (string, error)
+	ParseEmailVerificationToken(tokenString string) (authn.EmailVerificationClaims, error)
+
+	NewTOTPSecret() ([]byte, error)
+	EncodeTOTPSecret(secret []byte) string
+	TOTPURI(secret []byte, accountName string) string
+	ValidateTOTPCode(secret []byte, code string, t time.Time) (int64, bool)
+	NewRecoveryCode() (string, []byte, error)
+	HashRecoveryCode(code string) []byte
+	NewTwoFactorChallengeToken(subject string, role authn.SubjectRole) (string, error)
+	ParseTwoFactorChallengeToken(tokenString string) (authn.TwoFactorChallengeClaims, error)
+
+	BeginWebAuthnRegistration(subject authn.WebAuthnSubject) ([]byte, []byte, error)
+	FinishWebAuthnRegistration(subject authn.WebAuthnSubject, session, response []byte) (authn.WebAuthnCredential, error)
+	BeginWebAuthnLogin() ([]byte, []byte, error)
+	FinishWebAuthnLogin(session, response []byte, getSubject func(userHandle, credentialID []byte) (authn.WebAuthnSubject, error)) (authn.WebAuthnCredential, error)
+
+	NewOIDCNonce() (string, error)
+	NewOIDCCodeVerifier() (string, error)
+	NewOIDCBinding() (string, []byte, error)
+	HashOIDCBinding(binding string) []byte
+	NewOIDCIdentityToken(provider, subject, email string, emailVerified bool) (string, error)
+	ParseOIDCIdentityToken(tokenString string) (authn.OIDCIdentityClaims, error)
 }
 
 // DataStore defines the data store interface.
@@ -41,6 +75,16 @@ type DataStore interface {
 	GetUserByEmail(ctx context.Context, tx pgx.Tx, email domain.Email) (domain.User, error)
 	GetUserSignIn(ctx context.Context, tx pgx.Tx, username domain.Username, email domain.Email) (domain.SignInUser, error)
 	PatchUser(ctx context.Context, tx pgx.Tx, id uuid.UUID, editableUser domain.EditableUserPatch) error
+	GetUserAdmin(ctx context.Context, tx pgx.Tx, id uuid.UUID) (bool, error)
+	GetUserPassword(ctx context.Context, tx pgx.Tx, id uuid.UUID) (domain.Password, error)
+	UpdateUserPassword(ctx context.Context, tx pgx.Tx, id uuid.UUID, password domain.Password) error
+	VerifyUserEmail(ctx context.Context, tx pgx.Tx, id uuid.UUID) error
+
+	ListSignInThrottles(ctx context.Context, tx pgx.Tx, keys []string) ([]domain.SignInThrottle, error)
+	ListSignInThrottlesForUpdate(ctx context.Context, tx pgx.Tx, keys []string) ([]domain.SignInThrottle, error)
+	FailSignInThrottle(ctx context.Context, tx pgx.Tx, key string, failedAt, resetAt time.Time) (domain.SignInThrottle, error)
+	LockSignInThrottle(ctx context.Context, tx pgx.Tx, key string, lockedUntil time.Time) error
+	DeleteSignInThrottle(ctx context.Context, tx pgx.Tx, key string) error
 
 	CreateUserCartGame(ctx context.Context, tx pgx.Tx, userID, gameID uuid.UUID) error
 	ListUserCart(ctx context.Context, tx pgx.Tx, userID uuid.UUID, filter domain.UserCartPaginatedFilter) (domain.PaginatedResponse[domain.Game], error)
@@ -55,25 +99,110 @@ type DataStore interface {
 	GetPublisherByEmail(ctx context.Context, tx pgx.Tx, email domain.Email) (domain.Publisher, error)
 	GetPublisherSignIn(ctx context.Context, tx pgx.Tx, email domain.Email) (domain.SignInPublisher, error)
 	PatchPublisher(ctx context.Context, tx pgx.Tx, id uuid.UUID, editablePublisher domain.EditablePublisherPatch) error
+	GetPublisherPassword(ctx context.Context, tx pgx.Tx, id uuid.UUID) (domain.Password, error)
+	UpdatePublisherPassword(ctx context.Context, tx pgx.Tx, id uuid.UUID, password domain.Password) error
+	VerifyPublisherEmail(ctx context.Context, tx pgx.Tx, id uuid.UUID) error
+
+	CreatePublisherDocument(ctx context.Context, tx pgx.Tx, publisherID uuid.UUID, editablePublisherDocument domain.EditablePublisherDocument) (uuid.UUID, error)
+	GetPublisherDocument(ctx context.Context, tx pgx.Tx, publisherID, id uuid.UUID) (domain.PublisherDocument, error)
+	ListPublisherDocuments(ctx context.Context, tx pgx.Tx, publisherID uuid.UUID) ([]domain.PublisherDocument, error)
+	ListPublishersByVerificationStatus(ctx context.Context, tx pgx.Tx, status domain.PublisherVerificationStatus) ([]domain.Publisher, error)
+	UpdatePublisherVerification(ctx context.Context, tx pgx.Tx, id uuid.UUID, status domain.PublisherVerificationStatus, reason *domain.PublisherVerificationReason) error
+
+	CreatePublisherMember(ctx context.Context, tx pgx.Tx, editablePublisherMember domain.EditablePublisherMember) error
+	ListPublisherMembers(ctx context.Context, tx pgx.Tx, publisherID uuid.UUID) ([]domain.PublisherMember, error)
+	ListUserPublisherMembers(ctx context.Context, tx pgx.Tx, userID uuid.UUID) ([]domain.PublisherMember, error)
+	GetPublisherMember(ctx context.Context, tx pgx.Tx, publisherID, userID uuid.UUID) (domain.PublisherMember, error)
+	UpdatePublisherMemberRole(ctx context.Context, tx pgx.Tx, publisherID, userID uuid.UUID, role domain.PublisherMemberRole) error
+	DeletePublisherMember(ctx context.Context, tx pgx.Tx, publisherID, userID uuid.UUID) error
+	CreatePublisherInvitation(ctx context.Context, tx pgx.Tx, editablePublisherInvitation domain.EditablePublisherInvitation) (uuid.UUID, error)
+	GetPublisherInvitationByHash(ctx context.Context, tx pgx.Tx, hash []byte) (domain.PublisherInvitation, error)
+	AcceptPublisherInvitation(ctx context.Context, tx pgx.Tx, id uuid.UUID) error
+
+	ListPublisherGameSales(ctx context.Context, tx pgx.Tx, publisherID uuid.UUID) ([]domain.GameSales, error)
 
 	CreateGame(ctx context.Context, tx pgx.Tx, publisherID uuid.UUID, editableGame domain.EditableGame) (uuid.UUID, error)
 	ListGames(ctx context.Context, tx pgx.Tx, filter domain.GamesPaginatedFilter) (domain.PaginatedResponse[domain.Game], error)
-	ListGamesRecommended(ctx context.Context, tx pgx.Tx, filter domain.GamesRecommendedPaginatedFilter) (domain.PaginatedResponse[domain.Game], error)
+	ListGamesFacets(ctx context.Context, tx pgx.Tx, filter domain.GamesPaginatedFilter) (domain.GamesFacets, error)
+	ListGameSuggestions(ctx context.Context, tx pgx.Tx, filter domain.GameSuggestionsFilter) ([]domain.GameSuggestion, error)
+	ListGamesSimilar(ctx context.Context, tx pgx.Tx, gameID uuid.UUID, filter domain.GamesSimilarPaginatedFilter) (domain.PaginatedResponse[domain.Game], error)
+	RefreshGamesSimilar(ctx context.Context, tx pgx.Tx) error
+	ListGamesChart(ctx context.Context, tx pgx.Tx, filter domain.GamesChartPaginatedFilter) (domain.PaginatedResponse[domain.Game], error)
+	RefreshGamesCharts(ctx context.Context, tx pgx.Tx) error
 	GetGameByID(ctx context.Context, tx pgx.Tx, id uuid.UUID) (domain.Game, error)
+	GetGamePublisherID(ctx context.Context, tx pgx.Tx, id uuid.UUID) (uuid.UUID, error)
 	PatchGame(ctx context.Context, tx pgx.Tx, id uuid.UUID, editableGame domain.EditableGamePatch) error
+	UpdateGameStatus(ctx context.Context, tx pgx.Tx, id uuid.UUID, status domain.GameStatus, reviewNotes *domain.GameReviewNotes) error
+	DeleteGame(ctx context.Context, tx pgx.Tx, id uuid.UUID) error
+
+	CreateGameRevision(ctx context.Context, tx pgx.Tx, gameID uuid.UUID, editableGameRevision domain.EditableGameRevision) error
+	ListGameRevisions(ctx context.Context, tx pgx.Tx, gameID uuid.UUID, filter domain.GameRevisionsPaginatedFilter) (domain.PaginatedResponse[domain.GameRevision], error)
+	GetGameRevision(ctx context.Context, tx pgx.Tx, gameID uuid.UUID, number int) (domain.GameRevision, error)
+	GetLatestGameRevision(ctx context.Context, tx pgx.Tx, gameID uuid.UUID) (domain.GameRevision, error)
+
+	PutGameTranslation(ctx context.Context, tx pgx.Tx, gameID uuid.UUID, lang domain.Language, editableGameTranslation domain.EditableGameTranslation) error
+	GetGameTranslation(ctx context.Context, tx pgx.Tx, gameID uuid.UUID, lang domain.Language) (domain.GameTranslation, error)
+	ListGameTranslations(ctx context.Context, tx pgx.Tx, gameIDs []uuid.UUID) ([]domain.GameTranslation, error)
+	DeleteGameTranslation(ctx context.Context, tx pgx.Tx, gameID uuid.UUID, lang domain.Language) error
+
+	AcquirePeriodicJobLock(ctx context.Context, tx pgx.Tx, job domain.PeriodicJob) (bool, error)
+	CreateScheduledJob(ctx context.Context, tx pgx.Tx, gameID uuid.UUID, editableScheduledJob domain.EditableScheduledJob) (uuid.UUID, error)
+	GetScheduledJobByID(ctx context.Context, tx pgx.Tx, id uuid.UUID) (domain.ScheduledJob, error)
+	ListScheduledJobs(ctx context.Context, tx pgx.Tx, gameID uuid.UUID) ([]domain.ScheduledJob, error)
+	ListDueScheduledJobs(ctx context.Context, tx pgx.Tx, now time.Time, limit int) ([]domain.ScheduledJob, error)
+	CompleteScheduledJob(ctx context.Context, tx pgx.Tx, id uuid.UUID, status domain.ScheduledJobStatus, jobError *domain.ScheduledJobError) error
+	CancelScheduledJob(ctx context.Context, tx pgx.Tx, gameID, id uuid.UUID) error
 
 	CreateGameTag(ctx context.Context, tx pgx.Tx, gameID, tagID uuid.UUID) error
 	DeleteGameTag(ctx context.Context, tx pgx.Tx, gameID, tagID uuid.UUID) error
 
 	CreateGameMultimedia(ctx context.Context, tx pgx.Tx, gameID, multimediaID uuid.UUID, editableGameMultimedia domain.EditableGameMultimedia) error
+	GetGameMultimediaPositions(ctx context.Context, tx pgx.Tx, gameID uuid.UUID) ([]domain.GameRevisionMultimedia, error)
+	ReplaceGameMultimedia(ctx context.Context, tx pgx.Tx, gameID uuid.UUID, multimedia []domain.GameRevisionMultimedia) error
 	DeleteGameMultimedia(ctx context.Context, tx pgx.Tx, gameID, multimediaID uuid.UUID) error
 
 	ListTags(ctx context.Context, tx pgx.Tx, filter domain.TagsPaginatedFilter) (domain.PaginatedResponse[domain.Tag], error)
+	ListTagsByNames(ctx context.Context, tx pgx.Tx, names []domain.Name) ([]domain.Tag, error)
 
 	CreateMultimedia(ctx context.Context, tx pgx.Tx, multimedia domain.MultimediaObject) (uuid.UUID, error)
 	GetMultimediaByID(ctx context.Context, tx pgx.Tx, id uuid.UUID) (domain.Multimedia, error)
 	GetMultimediaByChecksumAndMediaType(ctx context.Context, tx pgx.Tx, checksum int64, mediaType string) (domain.Multimedia, error)
 
+	CreateRefreshToken(ctx context.Context, tx pgx.Tx, editableRefreshToken domain.EditableRefreshToken) (uuid.UUID, error)
+	GetRefreshTokenByHash(ctx context.Context, tx pgx.Tx, hash []byte) (domain.RefreshToken, error)
+	RotateRefreshToken(ctx context.Context, tx pgx.Tx, id uuid.UUID) error
+
+	CreateSession(ctx context.Context, tx pgx.Tx, editableSession domain.EditableSession) (uuid.UUID, error)
+	GetSessionByID(ctx context.Context, tx pgx.Tx, id uuid.UUID) (domain.Session, error)
+	ListSessions(ctx context.Context, tx pgx.Tx, subjectID uuid.UUID) ([]domain.Session, error)
+	TouchSession(ctx context.Context, tx pgx.Tx, id uuid.UUID, client domain.SessionClient, expiresAt time.Time) error
+	RevokeSession(ctx context.Context, tx pgx.Tx, subjectID, id uuid.UUID) error
+	RevokeSessions(ctx context.Context, tx pgx.Tx, subjectID uuid.UUID) error
+	RevokeOtherSessions(ctx context.Context, tx pgx.Tx, subjectID, sessionID uuid.UUID) error
+
+	CreatePasswordResetToken(ctx context.Context, tx pgx.Tx, editablePasswordResetToken domain.EditablePasswordResetToken) (uuid.UUID, error)
+	GetPasswordResetTokenByHash(ctx context.Context, tx pgx.Tx, hash []byte) (domain.PasswordResetToken, error)
+	UsePasswordResetToken(ctx context.Context, tx pgx.Tx, id uuid.UUID) error
+
+	CreateTOTP(ctx context.Context, tx pgx.Tx, editableTOTP domain.EditableTOTP) error
+	GetTOTPBySubjectID(ctx context.Context, tx pgx.Tx, subjectID uuid.UUID) (domain.TOTP, error)
+	EnableTOTP(ctx context.Context, tx pgx.Tx, subjectID uuid.UUID, step int64) error
+	UseTOTP(ctx context.Context, tx pgx.Tx, subjectID uuid.UUID, step int64) error
+	CreateRecoveryCodes(ctx context.Context, tx pgx.Tx, subjectID uuid.UUID, hashes [][]byte) error
+	UseRecoveryCode(ctx context.Context, tx pgx.Tx, subjectID uuid.UUID, hash []byte) error
+
+	CreateWebAuthnCeremony(ctx context.Context, tx pgx.Tx, editableWebAuthnCeremony domain.EditableWebAuthnCeremony) (uuid.UUID, error)
+	DeleteWebAuthnCeremony(ctx context.Context, tx pgx.Tx, id uuid.UUID) (domain.WebAuthnCeremony, error)
+	DeleteExpiredWebAuthnCeremonies(ctx context.Context, tx pgx.Tx, expiredAt time.Time) error
+	CreateWebAuthnCredential(ctx context.Context, tx pgx.Tx, editableWebAuthnCredential domain.EditableWebAuthnCredential) (uuid.UUID, error)
+	ListWebAuthnCredentials(ctx context.Context, tx pgx.Tx, subjectID uuid.UUID) ([]domain.WebAuthnCredential, error)
+	UseWebAuthnCredential(ctx context.Context, tx pgx.Tx, id uuid.UUID, signCount int64) error
+
+	CreateOIDCAuthorization(ctx context.Context, tx pgx.Tx, editableOIDCAuthorization domain.EditableOIDCAuthorization) (uuid.UUID, error)
+	DeleteOIDCAuthorization(ctx context.Context, tx pgx.Tx, id uuid.UUID) (domain.OIDCAuthorization, error)
+	CreateUserIdentity(ctx context.Context, tx pgx.Tx, editableUserIdentity domain.EditableUserIdentity) (uuid.UUID, error)
+	GetUserIdentity(ctx context.Context, tx pgx.Tx, provider, subject string) (domain.UserIdentity, error)
+
 	NewTx(ctx context.Context, isoLevel pgx.TxIsoLevel, accessMode pgx.TxAccessMode) (pgx.Tx, error)
 }
 
@@ -81,6 +210,13 @@ type DataStore interface {
 type ObjectStore interface {
 	CreateMultimediaObject(ctx context.Context, name string, reader io.Reader) error
 	GetMultimediaObject(ctx context.Context, name string) (domain.MultimediaObject, error)
+	CreateDocumentObject(ctx context.Context, name, mediaType string, reader io.Reader) error
+	GetDocumentObject(ctx context.Context, name string) ([]byte, error)
+}
+
+// BreachStore defines the breached password store interface.
+type BreachStore interface {
+	Compromised(password []byte) (bool, error)
 }
 
 // SMTP defines the smtp interface.
@@ -88,21 +224,41 @@ type SMTP interface {
 	SendMailHTML(to []string, subject string, body string) error
 }
 
+// OIDC defines the OpenID Connect client interface.
+type OIDC interface {
+	AuthCodeURL(provider, state, nonce, codeVerifier string) (string, error)
+	Exchange(ctx context.Context, provider, code, nonce, codeVerifier string) (domain.OIDCIdentity, error)
+}
+
+// Recommender defines the game recommender interface.
+type Recommender interface {
+	ListGamesRecommended(ctx context.Context, tx pgx.Tx, filter domain.GamesRecommendedPaginatedFilter) (domain.PaginatedResponse[domain.Game], error)
+	Train(ctx context.Context, tx pgx.Tx) error
+}
+
 // service defines the service structure.
 type service struct {
 	authnService AuthenticationService
 	dataStore    DataStore
 	objectStore  ObjectStore
+	breachStore  BreachStore
 	smtp         SMTP
+	oidc         OIDC
+	recommender  Recommender
+	webURL       string
 }
 
 // New returns a new http handler.
-func New(authnService AuthenticationService, dataStore DataStore, objectStore ObjectStore, smtp SMTP) *service {
+func New(authnService AuthenticationService, dataStore DataStore, objectStore ObjectStore, breachStore BreachStore, smtp SMTP, oidc OIDC, recommender Recommender, webURL string) *service {
 	return &service{
 		authnService: authnService,
 		dataStore:    dataStore,
 		objectStore:  objectStore,
+		breachStore:  breachStore,
 		smtp:         smtp,
+		oidc:         oidc,
+		recommender:  recommender,
+		webURL:       webURL,
 	}
 }
 
@@ -146,6 +302,56 @@ func (s *service) readWriteTx(ctx context.Context, f func(pgx.Tx) error) error {
 	return tx.Commit(ctx)
 }
 
+// lockingTx returns a read and write transaction wrapper for functions which lock the rows they read before updating
+// them. Its read committed isolation level lets concurrent transactions wait for each other's locks and then read the
+// committed rows, instead of failing to serialize.
+func (s *service) lockingTx(ctx context.Context, f func(pgx.Tx) error) error {
+	tx, err := s.dataStore.NewTx(ctx, pgx.ReadCommitted, pgx.ReadWrite)
+	if err != nil {
+		return err
+	}
+	defer rollbackFunc(ctx, tx)()
+
+	if err := f(tx); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// savepointTx returns a wrapper of a savepoint of the specified transaction, so that a failure of f only discards the
+// writes of f.
+func savepointTx(ctx context.Context, tx pgx.Tx, f func(pgx.Tx) error) error {
+	savepoint, err := tx.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer rollbackFunc(ctx, savepoint)()
+
+	if err := f(savepoint); err != nil {
+		return err
+	}
+
+	return savepoint.Commit(ctx)
+}
+
+// periodicJobTx returns a read and write transaction wrapper of the specified periodic job. Only the instance holding
+// the lock of the job executes f, the others skip it.
+func (s *service) periodicJobTx(ctx context.Context, job domain.PeriodicJob, f func(pgx.Tx) error) error {
+	return s.readWriteTx(ctx, func(tx pgx.Tx) error {
+		acquired, err := s.dataStore.AcquirePeriodicJobLock(ctx, tx, job)
+		if err != nil {
+			return err
+		}
+
+		if !acquired {
+			return nil
+		}
+
+		return f(tx)
+	})
+}
+
 // logInfoAndWrapError logs the error at the info level and returns the error wrapped with the provided description.
 func logInfoAndWrapError(ctx context.Context, err error, description string, logAttrs ...any) error {
 	logAttrs = append(logAttrs, logging.Error(err))
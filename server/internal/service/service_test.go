@@ -7,33 +7,48 @@ import (
 	"go.uber.org/mock/gomock"
 )
 
+const webURLTest = "http://localhost:8080"
+
 type serviceTest struct {
 	*service
 
 	mockAuthnService *MockAuthenticationService
 	mockDataStore    *MockDataStore
 	mockObjectStore  *MockObjectStore
+	mockBreachStore  *MockBreachStore
 	mockSMTP         *MockSMTP
+	mockOIDC         *MockOIDC
+	mockRecommender  *MockRecommender
 }
 
 func newServiceTest(ctrl *gomock.Controller) serviceTest {
 	mockAuthnService := NewMockAuthenticationService(ctrl)
 	mockDataStore := NewMockDataStore(ctrl)
 	mockObjectStore := NewMockObjectStore(ctrl)
+	mockBreachStore := NewMockBreachStore(ctrl)
 	mockSMTP := NewMockSMTP(ctrl)
+	mockOIDC := NewMockOIDC(ctrl)
+	mockRecommender := NewMockRecommender(ctrl)
 
 	return serviceTest{
 		service: New(
 			mockAuthnService,
 			mockDataStore,
 			mockObjectStore,
+			mockBreachStore,
 			mockSMTP,
+			mockOIDC,
+			mockRecommender,
+			webURLTest,
 		),
 
 		mockAuthnService: mockAuthnService,
 		mockDataStore:    mockDataStore,
 		mockObjectStore:  mockObjectStore,
+		mockBreachStore:  mockBreachStore,
 		mockSMTP:         mockSMTP,
+		mockOIDC:         mockOIDC,
+		mockRecommender:  mockRecommender,
 	}
 }
 
@@ -56,3 +71,43 @@ func (s serviceTest) newReadWriteTx(ctx context.Context, ctrl *gomock.Controller
 
 	return mockTx
 }
+
+func (s serviceTest) newReadOnlyTx(ctx context.Context, ctrl *gomock.Controller) pgx.Tx {
+	mockTx := NewMockTx(ctrl)
+
+	s.mockDataStore.EXPECT().
+		NewTx(ctx, pgx.ReadCommitted, pgx.ReadOnly).
+		Return(mockTx, nil).
+		AnyTimes()
+
+	mockTx.EXPECT().
+		Commit(ctx).
+		Return(nil).
+		AnyTimes()
+	mockTx.EXPECT().
+		Rollback(ctx).
+		Return(nil).
+		AnyTimes()
+
+	return mockTx
+}
+
+func (s serviceTest) newLockingTx(ctx context.Context, ctrl *gomock.Controller) pgx.Tx {
+	mockTx := NewMockTx(ctrl)
+
+	s.mockDataStore.EXPECT().
+		NewTx(ctx, pgx.ReadCommitted, pgx.ReadWrite).
+		Return(mockTx, nil).
+		AnyTimes()
+
+	mockTx.EXPECT().
+		Commit(ctx).
+		Return(nil).
+		AnyTimes()
+	mockTx.EXPECT().
+		Rollback(ctx).
+		Return(nil).
+		AnyTimes()
+
+	return mockTx
+}
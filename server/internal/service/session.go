@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+	"github.com/goofr-group/gaming-store/server/internal/logging"
+)
+
+const (
+	descriptionFailedListUserSessions   = "service: failed to list user sessions"
+	descriptionFailedDeleteUserSession  = "service: failed to delete user session"
+	descriptionFailedDeleteUserSessions = "service: failed to delete user sessions"
+	descriptionFailedValidSession       = "service: failed to validate session"
+)
+
+// ListUserSessions returns the active sessions of the user with the specified identifier.
+func (s *service) ListUserSessions(ctx context.Context, userID uuid.UUID) ([]domain.Session, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "ListUserSessions"),
+		slog.String(logging.UserID, userID.String()),
+	}
+
+	var (
+		sessions []domain.Session
+		err      error
+	)
+
+	err = s.readOnlyTx(ctx, func(tx pgx.Tx) error {
+		sessions, err = s.dataStore.ListSessions(ctx, tx, userID)
+		return err
+	})
+	if err != nil {
+		return nil, logAndWrapError(ctx, err, descriptionFailedListUserSessions, logAttrs...)
+	}
+
+	return sessions, nil
+}
+
+// DeleteUserSession revokes the session with the specified identifier of the user with the specified identifier.
+func (s *service) DeleteUserSession(ctx context.Context, userID, sessionID uuid.UUID) error {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "DeleteUserSession"),
+		slog.String(logging.UserID, userID.String()),
+		slog.String(logging.SessionID, sessionID.String()),
+	}
+
+	err := s.readWriteTx(ctx, func(tx pgx.Tx) error {
+		return s.dataStore.RevokeSession(ctx, tx, userID, sessionID)
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrSessionNotFound):
+			return logInfoAndWrapError(ctx, err, descriptionFailedDeleteUserSession, logAttrs...)
+		default:
+			return logAndWrapError(ctx, err, descriptionFailedDeleteUserSession, logAttrs...)
+		}
+	}
+
+	return nil
+}
+
+// DeleteUserSessions revokes all the sessions of the user with the specified identifier, signing them out everywhere.
+func (s *service) DeleteUserSessions(ctx context.Context, userID uuid.UUID) error {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "DeleteUserSessions"),
+		slog.String(logging.UserID, userID.String()),
+	}
+
+	err := s.readWriteTx(ctx, func(tx pgx.Tx) error {
+		return s.dataStore.RevokeSessions(ctx, tx, userID)
+	})
+	if err != nil {
+		return logAndWrapError(ctx, err, descriptionFailedDeleteUserSessions, logAttrs...)
+	}
+
+	return nil
+}
+
+// ValidSession returns true if the session with the specified identifier exists and is active, false otherwise.
+func (s *service) ValidSession(ctx context.Context, id uuid.UUID) (bool, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "ValidSession"),
+		slog.String(logging.SessionID, id.String()),
+	}
+
+	var (
+		session domain.Session
+		err     error
+	)
+
+	err = s.readOnlyTx(ctx, func(tx pgx.Tx) error {
+		session, err = s.dataStore.GetSessionByID(ctx, tx, id)
+		return err
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrSessionNotFound):
+			return false, nil
+		default:
+			return false, logAndWrapError(ctx, err, descriptionFailedValidSession, logAttrs...)
+		}
+	}
+
+	return session.Active(time.Now().UTC()), nil
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+func TestService_DeleteUserSession(t *testing.T) {
+	t.Run("should successfully delete user session", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			userID    = uuid.New()
+			sessionID = uuid.New()
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		s.mockDataStore.EXPECT().
+			RevokeSession(ctx, tx, userID, sessionID).
+			Return(nil)
+
+		err := s.service.DeleteUserSession(ctx, userID, sessionID)
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the session is not found", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				userID    = uuid.New()
+				sessionID = uuid.New()
+
+				expectedError = fmt.Errorf("%s: %w", descriptionFailedDeleteUserSession, domain.ErrSessionNotFound)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			s.mockDataStore.EXPECT().
+				RevokeSession(ctx, tx, userID, sessionID).
+				Return(domain.ErrSessionNotFound)
+
+			err := s.service.DeleteUserSession(ctx, userID, sessionID)
+			assert.Equal(t, expectedError, err)
+		})
+	})
+}
+
+func TestService_ValidSession(t *testing.T) {
+	t.Run("should successfully validate active session", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			session = domain.Session{
+				EditableSession: domain.EditableSession{
+					ExpiresAt: time.Now().UTC().Add(time.Hour),
+				},
+				ID: uuid.New(),
+			}
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadOnlyTx(ctx, ctrl)
+
+		s.mockDataStore.EXPECT().
+			GetSessionByID(ctx, tx, session.ID).
+			Return(session, nil)
+
+		valid, err := s.service.ValidSession(ctx, session.ID)
+		require.NoError(t, err)
+		assert.True(t, valid)
+	})
+
+	t.Run("should successfully invalidate revoked session", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			revokedAt = time.Now().UTC()
+
+			session = domain.Session{
+				EditableSession: domain.EditableSession{
+					ExpiresAt: time.Now().UTC().Add(time.Hour),
+				},
+				ID:        uuid.New(),
+				RevokedAt: &revokedAt,
+			}
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadOnlyTx(ctx, ctrl)
+
+		s.mockDataStore.EXPECT().
+			GetSessionByID(ctx, tx, session.ID).
+			Return(session, nil)
+
+		valid, err := s.service.ValidSession(ctx, session.ID)
+		require.NoError(t, err)
+		assert.False(t, valid)
+	})
+
+	t.Run("should successfully invalidate missing session", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			sessionID = uuid.New()
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadOnlyTx(ctx, ctrl)
+
+		s.mockDataStore.EXPECT().
+			GetSessionByID(ctx, tx, sessionID).
+			Return(domain.Session{}, domain.ErrSessionNotFound)
+
+		valid, err := s.service.ValidSession(ctx, sessionID)
+		require.NoError(t, err)
+		assert.False(t, valid)
+	})
+}
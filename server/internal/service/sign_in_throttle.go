@@ -0,0 +1,276 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"html/template"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goofr-group/gaming-store/server"
+	"github.com/goofr-group/gaming-store/server/internal/authn"
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+	"github.com/goofr-group/gaming-store/server/internal/logging"
+)
+
+const (
+	descriptionFailedCheckSignInPassword = "service: failed to check sign in password"
+	descriptionFailedCheckSignInAccount  = "service: failed to check sign in account"
+	descriptionFailedRecordSignInFailure = "service: failed to record failed sign in attempt"
+	descriptionFailedSendSignInLockout   = "service: failed to send sign in lockout"
+	descriptionFailedUpgradePasswordHash = "service: failed to upgrade password hash"
+	descriptionSignInThrottled           = "service: sign in throttled"
+
+	signInLockoutEmailSubject          = "GOOFR Store Sign In Locked"
+	signInLockoutEmailBodyTemplatePath = "templates/email/sign_in_lockout.html"
+
+	signInThrottleKeySeparator        = ":"
+	signInThrottleKeyAccount          = "account"
+	signInThrottleKeyIP               = "ip"
+	signInThrottleKeyTwoFactor        = "two-factor"
+	signInThrottleKeyWebAuthnCeremony = "webauthn-ceremony"
+)
+
+// signInThrottleKey returns the key of the sign in throttle of the specified kind and value for the specified role.
+func signInThrottleKey(role authn.SubjectRole, kind, value string) string {
+	return string(role) + signInThrottleKeySeparator + kind + signInThrottleKeySeparator + value
+}
+
+// signInThrottleRule defines a sign in throttle key and the policy applied to it.
+type signInThrottleRule struct {
+	key    string
+	policy domain.SignInThrottlePolicy
+}
+
+// signInThrottleIPRule appends the rule of the IP address of the client to the specified rules, if the address is
+// known.
+func signInThrottleIPRule(rules []signInThrottleRule, role authn.SubjectRole, client domain.SessionClient) []signInThrottleRule {
+	if len(client.IPAddress) == 0 {
+		return rules
+	}
+
+	return append(rules, signInThrottleRule{
+		key:    signInThrottleKey(role, signInThrottleKeyIP, client.IPAddress),
+		policy: domain.SignInThrottlePolicyIP,
+	})
+}
+
+// signInThrottlesAllowed returns true if an attempt is allowed at the specified time by every one of the specified
+// rules, false otherwise.
+func (s *service) signInThrottlesAllowed(ctx context.Context, tx pgx.Tx, rules []signInThrottleRule, now time.Time) (bool, error) {
+	signInThrottles, err := s.dataStore.ListSignInThrottles(ctx, tx, signInThrottleKeys(rules))
+	if err != nil {
+		return false, err
+	}
+
+	return signInThrottlesAllow(rules, signInThrottles, now), nil
+}
+
+// lockSignInThrottles locks the sign in throttles of the specified rules until the end of the specified transaction,
+// and returns true if an attempt is allowed at the specified time by every one of them, false otherwise. Concurrent
+// attempts on the same keys wait for the transaction to end, so that each one is checked against the failures recorded
+// by the previous ones.
+func (s *service) lockSignInThrottles(ctx context.Context, tx pgx.Tx, rules []signInThrottleRule, now time.Time) (bool, error) {
+	signInThrottles, err := s.dataStore.ListSignInThrottlesForUpdate(ctx, tx, signInThrottleKeys(rules))
+	if err != nil {
+		return false, err
+	}
+
+	return signInThrottlesAllow(rules, signInThrottles, now), nil
+}
+
+// signInThrottleKeys returns the keys of the specified rules.
+func signInThrottleKeys(rules []signInThrottleRule) []string {
+	keys := make([]string, len(rules))
+
+	for i, rule := range rules {
+		keys[i] = rule.key
+	}
+
+	return keys
+}
+
+// signInThrottlesAllow returns true if an attempt is allowed at the specified time by every one of the specified sign
+// in throttles, under the policy of their rule, false otherwise.
+func signInThrottlesAllow(rules []signInThrottleRule, signInThrottles []domain.SignInThrottle, now time.Time) bool {
+	policies := make(map[string]domain.SignInThrottlePolicy, len(rules))
+
+	for _, rule := range rules {
+		policies[rule.key] = rule.policy
+	}
+
+	for _, signInThrottle := range signInThrottles {
+		if !signInThrottle.Allowed(policies[signInThrottle.Key], now) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// failSignInThrottles records a failed attempt at the specified time in each of the specified rules, and locks out the
+// ones that reached the lockout attempts of their policy. Returns the keys that were locked out.
+func (s *service) failSignInThrottles(ctx context.Context, tx pgx.Tx, rules []signInThrottleRule, now time.Time) ([]string, error) {
+	var lockedOutKeys []string
+
+	for _, rule := range rules {
+		signInThrottle, err := s.dataStore.FailSignInThrottle(ctx, tx, rule.key, now, now.Add(-domain.SignInThrottleResetTime))
+		if err != nil {
+			return nil, err
+		}
+
+		if signInThrottle.FailedAttempts < rule.policy.LockoutAttempts {
+			continue
+		}
+
+		err = s.dataStore.LockSignInThrottle(ctx, tx, rule.key, now.Add(domain.SignInThrottleLockoutTime))
+		if err != nil {
+			return nil, err
+		}
+
+		lockedOutKeys = append(lockedOutKeys, rule.key)
+	}
+
+	return lockedOutKeys, nil
+}
+
+// checkSignInPassword checks the password of the account returned by the get sign in account function, while
+// throttling the failed attempts on the account and from the IP address of the client. The account is throttled by its
+// identifier, so that every username or email resolving to it shares the same attempts. Failed attempts beyond the
+// free ones must wait an exponential backoff, and too many lock the account or the IP address out for a while, in
+// which case the account holder is notified by email. On success, a password hash which was not hashed with the
+// current hashing parameters is upgraded with the update password function.
+//
+// The throttles are checked and the attempt is recorded in the same transaction, which locks them, so that concurrent
+// attempts can not all pass the check before any of their failures is recorded.
+//
+// Every failure, including an unknown account or a throttled attempt, returns the same error after checking a password
+// hash, so that neither the response nor its timing reveals which accounts exist.
+func (s *service) checkSignInPassword(ctx context.Context, role authn.SubjectRole, password domain.Password, client domain.SessionClient, getSignInAccount func(tx pgx.Tx) (domain.SignInAccount, error), updatePassword func(ctx context.Context, tx pgx.Tx, id uuid.UUID, password domain.Password) error, passwordResetPath string, logAttrs []any) (domain.SignInAccount, error) {
+	now := time.Now().UTC()
+
+	var (
+		account       domain.SignInAccount
+		accountKey    string
+		found         bool
+		allowed       bool
+		valid         bool
+		lockedOutKeys []string
+	)
+
+	err := s.lockingTx(ctx, func(tx pgx.Tx) error {
+		var (
+			rules []signInThrottleRule
+			err   error
+		)
+
+		account, err = getSignInAccount(tx)
+		switch {
+		case err == nil:
+			found = true
+			accountKey = signInThrottleKey(role, signInThrottleKeyAccount, account.ID.String())
+			rules = append(rules, signInThrottleRule{key: accountKey, policy: domain.SignInThrottlePolicyAccount})
+		case !errors.Is(err, domain.ErrUserNotFound) && !errors.Is(err, domain.ErrPublisherNotFound):
+			return err
+		}
+
+		rules = signInThrottleIPRule(rules, role, client)
+
+		allowed, err = s.lockSignInThrottles(ctx, tx, rules, now)
+		if err != nil {
+			return err
+		}
+
+		if !allowed {
+			s.authnService.CheckDummyPasswordHash([]byte(password))
+			return nil
+		}
+
+		if found {
+			valid, err = s.authnService.CheckPasswordHash([]byte(password), []byte(account.Password))
+			if err != nil {
+				return err
+			}
+		} else {
+			s.authnService.CheckDummyPasswordHash([]byte(password))
+		}
+
+		if !valid {
+			lockedOutKeys, err = s.failSignInThrottles(ctx, tx, rules, now)
+			return err
+		}
+
+		err = s.dataStore.DeleteSignInThrottle(ctx, tx, accountKey)
+		if err != nil {
+			return err
+		}
+
+		if !s.authnService.PasswordHashNeedsUpgrade([]byte(account.Password)) {
+			return nil
+		}
+
+		upgradedPassword, err := s.authnService.HashPassword([]byte(password))
+		if err != nil {
+			// The sign in goes on with the previous hash, which is upgraded on a later sign in.
+			logging.Logger.ErrorContext(ctx, descriptionFailedUpgradePasswordHash, append(logAttrs, logging.Error(err))...)
+			return nil
+		}
+
+		return updatePassword(ctx, tx, account.ID, domain.Password(upgradedPassword))
+	})
+	if err != nil {
+		return domain.SignInAccount{}, logAndWrapError(ctx, err, descriptionFailedCheckSignInPassword, logAttrs...)
+	}
+
+	if !allowed {
+		return domain.SignInAccount{}, logInfoAndWrapError(ctx, domain.ErrCredentialsIncorrect, descriptionSignInThrottled, logAttrs...)
+	}
+
+	if valid {
+		return account, nil
+	}
+
+	if found && slices.Contains(lockedOutKeys, accountKey) {
+		// The email is sent in the background, so that the response time does not reveal that the account exists.
+		go func(ctx context.Context) {
+			err := s.sendSignInLockout(account, passwordResetPath)
+			if err != nil {
+				logging.Logger.ErrorContext(ctx, descriptionFailedSendSignInLockout, append(logAttrs, logging.Error(err))...)
+			}
+		}(context.WithoutCancel(ctx))
+	}
+
+	if !found {
+		return domain.SignInAccount{}, logInfoAndWrapError(ctx, domain.ErrCredentialsIncorrect, descriptionFailedCheckSignInAccount, logAttrs...)
+	}
+
+	return domain.SignInAccount{}, logInfoAndWrapError(ctx, domain.ErrCredentialsIncorrect, descriptionFailedCheckPasswordHash, logAttrs...)
+}
+
+// sendSignInLockout emails the account holder that signing in is locked out after too many failed attempts, with a
+// link to the web application path where the password can be reset.
+func (s *service) sendSignInLockout(account domain.SignInAccount, passwordResetPath string) error {
+	template, err := template.ParseFS(server.FileSystemTemplates, signInLockoutEmailBodyTemplatePath)
+	if err != nil {
+		return err
+	}
+
+	signInLockout := domain.SignInLockout{
+		Name:             account.Name,
+		URL:              s.webURL + passwordResetPath,
+		ExpiresInMinutes: int(domain.SignInThrottleLockoutTime.Minutes()),
+	}
+
+	var body bytes.Buffer
+
+	err = template.Execute(&body, signInLockout)
+	if err != nil {
+		return err
+	}
+
+	return s.smtp.SendMailHTML([]string{string(account.Email)}, signInLockoutEmailSubject, body.String())
+}
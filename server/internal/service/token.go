@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goofr-group/gaming-store/server/internal/authn"
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+const (
+	descriptionFailedCreateRefreshToken = "service: failed to create refresh token"
+	descriptionFailedRefreshTokens      = "service: failed to refresh tokens"
+	descriptionFailedRevokeRefreshToken = "service: failed to revoke refresh token"
+)
+
+// newTokens starts a new session for the specified subject and returns its access token and refresh token.
+func (s *service) newTokens(ctx context.Context, subjectID uuid.UUID, role authn.SubjectRole, client domain.SessionClient, logAttrs []any) (domain.Tokens, error) {
+	refreshToken, refreshTokenHash, err := s.authnService.NewRefreshToken()
+	if err != nil {
+		return domain.Tokens{}, logAndWrapError(ctx, err, descriptionFailedCreateRefreshToken, logAttrs...)
+	}
+
+	var (
+		sessionID            uuid.UUID
+		subjectRoles         []authn.SubjectRole
+		publisherMemberships map[string]string
+	)
+
+	err = s.readWriteTx(ctx, func(tx pgx.Tx) error {
+		expiresAt := time.Now().UTC().Add(domain.RefreshTokenExpirationTime)
+
+		sessionID, err = s.dataStore.CreateSession(ctx, tx, domain.EditableSession{
+			SubjectID:   subjectID,
+			SubjectRole: string(role),
+			Client:      client,
+			ExpiresAt:   expiresAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = s.dataStore.CreateRefreshToken(ctx, tx, domain.EditableRefreshToken{
+			SessionID: sessionID,
+			Hash:      refreshTokenHash,
+			ExpiresAt: expiresAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		subjectRoles, err = s.subjectRoles(ctx, tx, subjectID, role)
+		if err != nil {
+			return err
+		}
+
+		publisherMemberships, err = s.publisherMemberships(ctx, tx, subjectID, role)
+		return err
+	})
+	if err != nil {
+		return domain.Tokens{}, logAndWrapError(ctx, err, descriptionFailedCreateRefreshToken, logAttrs...)
+	}
+
+	accessToken, err := s.authnService.NewJWT(subjectID.String(), sessionID.String(), subjectRoles, publisherMemberships)
+	if err != nil {
+		return domain.Tokens{}, logAndWrapError(ctx, err, descriptionFailedCreateJWT, logAttrs...)
+	}
+
+	return domain.Tokens{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+// subjectRoles returns the roles of the subject with the specified identifier that signed in with the specified role.
+// Users can also be administrators.
+func (s *service) subjectRoles(ctx context.Context, tx pgx.Tx, subjectID uuid.UUID, role authn.SubjectRole) ([]authn.SubjectRole, error) {
+	if role != authn.SubjectRoleUser {
+		return []authn.SubjectRole{role}, nil
+	}
+
+	admin, err := s.dataStore.GetUserAdmin(ctx, tx, subjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if admin {
+		return []authn.SubjectRole{role, authn.SubjectRoleAdmin}, nil
+	}
+
+	return []authn.SubjectRole{role}, nil
+}
+
+// refreshTokens exchanges the refresh token issued to the specified role for a new access token and a new refresh
+// token of the same session. A refresh token can only be exchanged once, and using it again revokes its whole session,
+// since it means that the token was leaked.
+func (s *service) refreshTokens(ctx context.Context, refreshToken string, role authn.SubjectRole, client domain.SessionClient, logAttrs []any) (domain.Tokens, error) {
+	newRefreshToken, newRefreshTokenHash, err := s.authnService.NewRefreshToken()
+	if err != nil {
+		return domain.Tokens{}, logAndWrapError(ctx, err, descriptionFailedCreateRefreshToken, logAttrs...)
+	}
+
+	var (
+		session              domain.Session
+		reused               bool
+		subjectRoles         []authn.SubjectRole
+		publisherMemberships map[string]string
+	)
+
+	err = s.readWriteTx(ctx, func(tx pgx.Tx) error {
+		current, err := s.dataStore.GetRefreshTokenByHash(ctx, tx, s.authnService.HashRefreshToken(refreshToken))
+		if err != nil {
+			return err
+		}
+
+		session, err = s.dataStore.GetSessionByID(ctx, tx, current.SessionID)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now().UTC()
+
+		if session.SubjectRole != string(role) || !session.Active(now) || !now.Before(current.ExpiresAt) {
+			return domain.ErrRefreshTokenInvalid
+		}
+
+		if current.RotatedAt != nil {
+			reused = true
+			return s.dataStore.RevokeSession(ctx, tx, session.SubjectID, session.ID)
+		}
+
+		err = s.dataStore.RotateRefreshToken(ctx, tx, current.ID)
+		if err != nil {
+			return err
+		}
+
+		expiresAt := now.Add(domain.RefreshTokenExpirationTime)
+
+		err = s.dataStore.TouchSession(ctx, tx, session.ID, client, expiresAt)
+		if err != nil {
+			return err
+		}
+
+		_, err = s.dataStore.CreateRefreshToken(ctx, tx, domain.EditableRefreshToken{
+			SessionID: session.ID,
+			Hash:      newRefreshTokenHash,
+			ExpiresAt: expiresAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		subjectRoles, err = s.subjectRoles(ctx, tx, session.SubjectID, role)
+		if err != nil {
+			return err
+		}
+
+		publisherMemberships, err = s.publisherMemberships(ctx, tx, session.SubjectID, role)
+		return err
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrRefreshTokenNotFound):
+			return domain.Tokens{}, logInfoAndWrapError(ctx, domain.ErrRefreshTokenInvalid, descriptionFailedRefreshTokens, logAttrs...)
+		case errors.Is(err, domain.ErrRefreshTokenInvalid):
+			return domain.Tokens{}, logInfoAndWrapError(ctx, err, descriptionFailedRefreshTokens, logAttrs...)
+		default:
+			return domain.Tokens{}, logAndWrapError(ctx, err, descriptionFailedRefreshTokens, logAttrs...)
+		}
+	}
+
+	if reused {
+		return domain.Tokens{}, logInfoAndWrapError(ctx, domain.ErrRefreshTokenReused, descriptionFailedRefreshTokens, logAttrs...)
+	}
+
+	accessToken, err := s.authnService.NewJWT(session.SubjectID.String(), session.ID.String(), subjectRoles, publisherMemberships)
+	if err != nil {
+		return domain.Tokens{}, logAndWrapError(ctx, err, descriptionFailedCreateJWT, logAttrs...)
+	}
+
+	return domain.Tokens{
+		AccessToken:  accessToken,
+		RefreshToken: newRefreshToken,
+	}, nil
+}
+
+// revokeRefreshToken revokes the session of the refresh token issued to the specified role. Revoking an already
+// revoked session succeeds.
+func (s *service) revokeRefreshToken(ctx context.Context, refreshToken string, role authn.SubjectRole, logAttrs []any) error {
+	err := s.readWriteTx(ctx, func(tx pgx.Tx) error {
+		current, err := s.dataStore.GetRefreshTokenByHash(ctx, tx, s.authnService.HashRefreshToken(refreshToken))
+		if err != nil {
+			return err
+		}
+
+		session, err := s.dataStore.GetSessionByID(ctx, tx, current.SessionID)
+		if err != nil {
+			return err
+		}
+
+		if session.SubjectRole != string(role) {
+			return domain.ErrRefreshTokenInvalid
+		}
+
+		return s.dataStore.RevokeSession(ctx, tx, session.SubjectID, session.ID)
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrRefreshTokenNotFound):
+			return logInfoAndWrapError(ctx, domain.ErrRefreshTokenInvalid, descriptionFailedRevokeRefreshToken, logAttrs...)
+		case errors.Is(err, domain.ErrRefreshTokenInvalid):
+			return logInfoAndWrapError(ctx, err, descriptionFailedRevokeRefreshToken, logAttrs...)
+		default:
+			return logAndWrapError(ctx, err, descriptionFailedRevokeRefreshToken, logAttrs...)
+		}
+	}
+
+	return nil
+}
+
+// ListJSONWebKeys returns the public keys used to verify the tokens, so that other parties can verify them.
+func (s *service) ListJSONWebKeys(_ context.Context) []domain.JSONWebKey {
+	authnJSONWebKeys := s.authnService.JSONWebKeys()
+	jsonWebKeys := make([]domain.JSONWebKey, len(authnJSONWebKeys))
+
+	for i, jsonWebKey := range authnJSONWebKeys {
+		jsonWebKeys[i] = domain.JSONWebKey(jsonWebKey)
+	}
+
+	return jsonWebKeys
+}
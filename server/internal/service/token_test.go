@@ -0,0 +1,398 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/goofr-group/gaming-store/server/internal/authn"
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+func TestService_RefreshUserTokens(t *testing.T) {
+	t.Run("should successfully refresh user tokens", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			refreshToken        = "refresh token"
+			refreshTokenHash    = []byte("refresh token hash")
+			newRefreshToken     = "new refresh token"
+			newRefreshTokenHash = []byte("new refresh token hash")
+			accessToken         = "access token"
+
+			client = domain.SessionClient{
+				UserAgent: "user agent",
+				IPAddress: "127.0.0.1",
+			}
+
+			session = domain.Session{
+				EditableSession: domain.EditableSession{
+					SubjectID:   uuid.New(),
+					SubjectRole: string(authn.SubjectRoleUser),
+					ExpiresAt:   time.Now().UTC().Add(time.Hour),
+				},
+				ID: uuid.New(),
+			}
+
+			current = domain.RefreshToken{
+				EditableRefreshToken: domain.EditableRefreshToken{
+					SessionID: session.ID,
+					Hash:      refreshTokenHash,
+					ExpiresAt: time.Now().UTC().Add(time.Hour),
+				},
+				ID: uuid.New(),
+			}
+
+			publisherMembers = []domain.PublisherMember{
+				{
+					EditablePublisherMember: domain.EditablePublisherMember{
+						PublisherID: uuid.New(),
+						UserID:      session.SubjectID,
+						Role:        domain.PublisherMemberRoleDeveloper,
+					},
+				},
+			}
+			publisherMemberships = map[string]string{
+				publisherMembers[0].PublisherID.String(): string(domain.PublisherMemberRoleDeveloper),
+			}
+
+			expectedTokens = domain.Tokens{
+				AccessToken:  accessToken,
+				RefreshToken: newRefreshToken,
+			}
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		s.mockAuthnService.EXPECT().
+			NewRefreshToken().
+			Return(newRefreshToken, newRefreshTokenHash, nil)
+
+		s.mockAuthnService.EXPECT().
+			HashRefreshToken(refreshToken).
+			Return(refreshTokenHash)
+
+		s.mockDataStore.EXPECT().
+			GetRefreshTokenByHash(ctx, tx, refreshTokenHash).
+			Return(current, nil)
+
+		s.mockDataStore.EXPECT().
+			GetSessionByID(ctx, tx, session.ID).
+			Return(session, nil)
+
+		s.mockDataStore.EXPECT().
+			RotateRefreshToken(ctx, tx, current.ID).
+			Return(nil)
+
+		s.mockDataStore.EXPECT().
+			TouchSession(ctx, tx, session.ID, client, gomock.Any()).
+			Return(nil)
+
+		s.mockDataStore.EXPECT().
+			CreateRefreshToken(ctx, tx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ pgx.Tx, editableRefreshToken domain.EditableRefreshToken) (uuid.UUID, error) {
+				assert.Equal(t, session.ID, editableRefreshToken.SessionID)
+				assert.Equal(t, newRefreshTokenHash, editableRefreshToken.Hash)
+
+				return uuid.New(), nil
+			})
+
+		s.mockDataStore.EXPECT().
+			GetUserAdmin(ctx, tx, session.SubjectID).
+			Return(false, nil)
+
+		s.mockDataStore.EXPECT().
+			ListUserPublisherMembers(ctx, tx, session.SubjectID).
+			Return(publisherMembers, nil)
+
+		s.mockAuthnService.EXPECT().
+			NewJWT(session.SubjectID.String(), session.ID.String(), []authn.SubjectRole{authn.SubjectRoleUser}, publisherMemberships).
+			Return(accessToken, nil)
+
+		tokens, err := s.service.RefreshUserTokens(ctx, refreshToken, client)
+		require.NoError(t, err)
+		assert.Equal(t, expectedTokens, tokens)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the refresh token was already rotated", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				refreshToken     = "refresh token"
+				refreshTokenHash = []byte("refresh token hash")
+				rotatedAt        = time.Now().UTC()
+
+				session = domain.Session{
+					EditableSession: domain.EditableSession{
+						SubjectID:   uuid.New(),
+						SubjectRole: string(authn.SubjectRoleUser),
+						ExpiresAt:   time.Now().UTC().Add(time.Hour),
+					},
+					ID: uuid.New(),
+				}
+
+				current = domain.RefreshToken{
+					EditableRefreshToken: domain.EditableRefreshToken{
+						SessionID: session.ID,
+						Hash:      refreshTokenHash,
+						ExpiresAt: time.Now().UTC().Add(time.Hour),
+					},
+					ID:        uuid.New(),
+					RotatedAt: &rotatedAt,
+				}
+
+				expectedError = fmt.Errorf("%s: %w", descriptionFailedRefreshTokens, domain.ErrRefreshTokenReused)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			s.mockAuthnService.EXPECT().
+				NewRefreshToken().
+				Return("new refresh token", []byte("new refresh token hash"), nil)
+
+			s.mockAuthnService.EXPECT().
+				HashRefreshToken(refreshToken).
+				Return(refreshTokenHash)
+
+			s.mockDataStore.EXPECT().
+				GetRefreshTokenByHash(ctx, tx, refreshTokenHash).
+				Return(current, nil)
+
+			s.mockDataStore.EXPECT().
+				GetSessionByID(ctx, tx, session.ID).
+				Return(session, nil)
+
+			s.mockDataStore.EXPECT().
+				RevokeSession(ctx, tx, session.SubjectID, session.ID).
+				Return(nil)
+
+			_, err := s.service.RefreshUserTokens(ctx, refreshToken, domain.SessionClient{})
+			assert.Equal(t, expectedError, err)
+		})
+
+		t.Run("when the session was revoked", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				refreshToken     = "refresh token"
+				refreshTokenHash = []byte("refresh token hash")
+				revokedAt        = time.Now().UTC()
+
+				session = domain.Session{
+					EditableSession: domain.EditableSession{
+						SubjectID:   uuid.New(),
+						SubjectRole: string(authn.SubjectRoleUser),
+						ExpiresAt:   time.Now().UTC().Add(time.Hour),
+					},
+					ID:        uuid.New(),
+					RevokedAt: &revokedAt,
+				}
+
+				current = domain.RefreshToken{
+					EditableRefreshToken: domain.EditableRefreshToken{
+						SessionID: session.ID,
+						Hash:      refreshTokenHash,
+						ExpiresAt: time.Now().UTC().Add(time.Hour),
+					},
+					ID: uuid.New(),
+				}
+
+				expectedError = fmt.Errorf("%s: %w", descriptionFailedRefreshTokens, domain.ErrRefreshTokenInvalid)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			s.mockAuthnService.EXPECT().
+				NewRefreshToken().
+				Return("new refresh token", []byte("new refresh token hash"), nil)
+
+			s.mockAuthnService.EXPECT().
+				HashRefreshToken(refreshToken).
+				Return(refreshTokenHash)
+
+			s.mockDataStore.EXPECT().
+				GetRefreshTokenByHash(ctx, tx, refreshTokenHash).
+				Return(current, nil)
+
+			s.mockDataStore.EXPECT().
+				GetSessionByID(ctx, tx, session.ID).
+				Return(session, nil)
+
+			_, err := s.service.RefreshUserTokens(ctx, refreshToken, domain.SessionClient{})
+			assert.Equal(t, expectedError, err)
+		})
+
+		t.Run("when the refresh token was issued to a publisher", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				refreshToken     = "refresh token"
+				refreshTokenHash = []byte("refresh token hash")
+
+				session = domain.Session{
+					EditableSession: domain.EditableSession{
+						SubjectID:   uuid.New(),
+						SubjectRole: string(authn.SubjectRolePublisher),
+						ExpiresAt:   time.Now().UTC().Add(time.Hour),
+					},
+					ID: uuid.New(),
+				}
+
+				current = domain.RefreshToken{
+					EditableRefreshToken: domain.EditableRefreshToken{
+						SessionID: session.ID,
+						Hash:      refreshTokenHash,
+						ExpiresAt: time.Now().UTC().Add(time.Hour),
+					},
+					ID: uuid.New(),
+				}
+
+				expectedError = fmt.Errorf("%s: %w", descriptionFailedRefreshTokens, domain.ErrRefreshTokenInvalid)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			s.mockAuthnService.EXPECT().
+				NewRefreshToken().
+				Return("new refresh token", []byte("new refresh token hash"), nil)
+
+			s.mockAuthnService.EXPECT().
+				HashRefreshToken(refreshToken).
+				Return(refreshTokenHash)
+
+			s.mockDataStore.EXPECT().
+				GetRefreshTokenByHash(ctx, tx, refreshTokenHash).
+				Return(current, nil)
+
+			s.mockDataStore.EXPECT().
+				GetSessionByID(ctx, tx, session.ID).
+				Return(session, nil)
+
+			_, err := s.service.RefreshUserTokens(ctx, refreshToken, domain.SessionClient{})
+			assert.Equal(t, expectedError, err)
+		})
+	})
+}
+
+func TestService_SignOutUser(t *testing.T) {
+	t.Run("should successfully sign out user", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			refreshToken     = "refresh token"
+			refreshTokenHash = []byte("refresh token hash")
+
+			session = domain.Session{
+				EditableSession: domain.EditableSession{
+					SubjectID:   uuid.New(),
+					SubjectRole: string(authn.SubjectRoleUser),
+				},
+				ID: uuid.New(),
+			}
+
+			current = domain.RefreshToken{
+				EditableRefreshToken: domain.EditableRefreshToken{
+					SessionID: session.ID,
+					Hash:      refreshTokenHash,
+				},
+				ID: uuid.New(),
+			}
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		s.mockAuthnService.EXPECT().
+			HashRefreshToken(refreshToken).
+			Return(refreshTokenHash)
+
+		s.mockDataStore.EXPECT().
+			GetRefreshTokenByHash(ctx, tx, refreshTokenHash).
+			Return(current, nil)
+
+		s.mockDataStore.EXPECT().
+			GetSessionByID(ctx, tx, session.ID).
+			Return(session, nil)
+
+		s.mockDataStore.EXPECT().
+			RevokeSession(ctx, tx, session.SubjectID, session.ID).
+			Return(nil)
+
+		err := s.service.SignOutUser(ctx, refreshToken)
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the refresh token is not found", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				refreshToken     = "refresh token"
+				refreshTokenHash = []byte("refresh token hash")
+
+				expectedError = fmt.Errorf("%s: %w", descriptionFailedRevokeRefreshToken, domain.ErrRefreshTokenInvalid)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			s.mockAuthnService.EXPECT().
+				HashRefreshToken(refreshToken).
+				Return(refreshTokenHash)
+
+			s.mockDataStore.EXPECT().
+				GetRefreshTokenByHash(ctx, tx, refreshTokenHash).
+				Return(domain.RefreshToken{}, domain.ErrRefreshTokenNotFound)
+
+			err := s.service.SignOutUser(ctx, refreshToken)
+			assert.Equal(t, expectedError, err)
+		})
+	})
+}
+
+func TestService_ListJSONWebKeys(t *testing.T) {
+	t.Run("should successfully list json web keys", func(t *testing.T) {
+		ctx := context.Background()
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+
+		s.mockAuthnService.EXPECT().
+			JSONWebKeys().
+			Return([]authn.JSONWebKey{
+				{KeyType: "OKP", KeyID: "key id", Use: "sig", Algorithm: "EdDSA", Curve: "Ed25519", X: "x"},
+			})
+
+		expectedJSONWebKeys := []domain.JSONWebKey{
+			{KeyType: "OKP", KeyID: "key id", Use: "sig", Algorithm: "EdDSA", Curve: "Ed25519", X: "x"},
+		}
+
+		jsonWebKeys := s.service.ListJSONWebKeys(ctx)
+		assert.Equal(t, expectedJSONWebKeys, jsonWebKeys)
+	})
+}
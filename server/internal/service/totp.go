@@ -0,0 +1,297 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goofr-group/gaming-store/server/internal/authn"
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+	"github.com/goofr-group/gaming-store/server/internal/logging"
+)
+
+const (
+	descriptionFailedCreateTOTP                   = "service: failed to create totp"
+	descriptionFailedEnableTOTP                   = "service: failed to enable totp"
+	descriptionFailedCreateRecoveryCode           = "service: failed to create recovery code"
+	descriptionFailedGetTOTP                      = "service: failed to get totp"
+	descriptionFailedCreateTwoFactorChallenge     = "service: failed to create two-factor challenge token"
+	descriptionFailedCompleteTwoFactorChallenge   = "service: failed to complete two-factor challenge"
+	descriptionFailedParseTwoFactorChallengeToken = "service: failed to parse two-factor challenge token"
+)
+
+// CreateUserTOTP starts the totp enrollment of the user with the specified identifier and returns the secret to be
+// added to an authenticator app. Two-factor authentication is only enabled once a code of the secret is verified.
+func (s *service) CreateUserTOTP(ctx context.Context, userID uuid.UUID) (domain.TOTPEnrollment, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "CreateUserTOTP"),
+		slog.String(logging.UserID, userID.String()),
+	}
+
+	return s.createTOTP(ctx, userID, func(tx pgx.Tx) (domain.Email, error) {
+		user, err := s.dataStore.GetUserByID(ctx, tx, userID)
+		return user.Email, err
+	}, logAttrs)
+}
+
+// EnableUserTOTP enables the two-factor authentication of the user with the specified identifier, if the code matches
+// the enrolled totp secret, and returns the recovery codes.
+func (s *service) EnableUserTOTP(ctx context.Context, userID uuid.UUID, code string) ([]string, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "EnableUserTOTP"),
+		slog.String(logging.UserID, userID.String()),
+	}
+
+	return s.enableTOTP(ctx, userID, code, logAttrs)
+}
+
+// SignInUserTOTP completes the sign-in of a user with two-factor authentication enabled, by exchanging the challenge
+// token and a totp code or a recovery code for an access token and a refresh token.
+func (s *service) SignInUserTOTP(ctx context.Context, challengeToken, code string, client domain.SessionClient) (domain.Tokens, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "SignInUserTOTP"),
+	}
+
+	return s.signInTOTP(ctx, challengeToken, code, authn.SubjectRoleUser, client, logAttrs)
+}
+
+// CreatePublisherTOTP starts the totp enrollment of the publisher with the specified identifier and returns the secret
+// to be added to an authenticator app. Two-factor authentication is only enabled once a code of the secret is verified.
+func (s *service) CreatePublisherTOTP(ctx context.Context, publisherID uuid.UUID) (domain.TOTPEnrollment, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "CreatePublisherTOTP"),
+		slog.String(logging.PublisherID, publisherID.String()),
+	}
+
+	return s.createTOTP(ctx, publisherID, func(tx pgx.Tx) (domain.Email, error) {
+		publisher, err := s.dataStore.GetPublisherByID(ctx, tx, publisherID)
+		return publisher.Email, err
+	}, logAttrs)
+}
+
+// EnablePublisherTOTP enables the two-factor authentication of the publisher with the specified identifier, if the
+// code matches the enrolled totp secret, and returns the recovery codes.
+func (s *service) EnablePublisherTOTP(ctx context.Context, publisherID uuid.UUID, code string) ([]string, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "EnablePublisherTOTP"),
+		slog.String(logging.PublisherID, publisherID.String()),
+	}
+
+	return s.enableTOTP(ctx, publisherID, code, logAttrs)
+}
+
+// SignInPublisherTOTP completes the sign-in of a publisher with two-factor authentication enabled, by exchanging the
+// challenge token and a totp code or a recovery code for an access token and a refresh token.
+func (s *service) SignInPublisherTOTP(ctx context.Context, challengeToken, code string, client domain.SessionClient) (domain.Tokens, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "SignInPublisherTOTP"),
+	}
+
+	return s.signInTOTP(ctx, challengeToken, code, authn.SubjectRolePublisher, client, logAttrs)
+}
+
+// createTOTP stores a new totp secret for the specified subject, whose email is returned by getEmail, and returns its
+// enrollment data.
+func (s *service) createTOTP(ctx context.Context, subjectID uuid.UUID, getEmail func(tx pgx.Tx) (domain.Email, error), logAttrs []any) (domain.TOTPEnrollment, error) {
+	secret, err := s.authnService.NewTOTPSecret()
+	if err != nil {
+		return domain.TOTPEnrollment{}, logAndWrapError(ctx, err, descriptionFailedCreateTOTP, logAttrs...)
+	}
+
+	var email domain.Email
+
+	err = s.readWriteTx(ctx, func(tx pgx.Tx) error {
+		email, err = getEmail(tx)
+		if err != nil {
+			return err
+		}
+
+		return s.dataStore.CreateTOTP(ctx, tx, domain.EditableTOTP{
+			SubjectID: subjectID,
+			Secret:    secret,
+		})
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUserNotFound),
+			errors.Is(err, domain.ErrPublisherNotFound),
+			errors.Is(err, domain.ErrTOTPAlreadyEnabled):
+			return domain.TOTPEnrollment{}, logInfoAndWrapError(ctx, err, descriptionFailedCreateTOTP, logAttrs...)
+		default:
+			return domain.TOTPEnrollment{}, logAndWrapError(ctx, err, descriptionFailedCreateTOTP, logAttrs...)
+		}
+	}
+
+	return domain.TOTPEnrollment{
+		Secret: s.authnService.EncodeTOTPSecret(secret),
+		URI:    s.authnService.TOTPURI(secret, string(email)),
+	}, nil
+}
+
+// enableTOTP enables the totp of the specified subject if the code is valid, replacing its recovery codes.
+func (s *service) enableTOTP(ctx context.Context, subjectID uuid.UUID, code string, logAttrs []any) ([]string, error) {
+	recoveryCodes := make([]string, domain.RecoveryCodesCount)
+	recoveryCodeHashes := make([][]byte, domain.RecoveryCodesCount)
+
+	for i := range recoveryCodes {
+		var err error
+
+		recoveryCodes[i], recoveryCodeHashes[i], err = s.authnService.NewRecoveryCode()
+		if err != nil {
+			return nil, logAndWrapError(ctx, err, descriptionFailedCreateRecoveryCode, logAttrs...)
+		}
+	}
+
+	err := s.readWriteTx(ctx, func(tx pgx.Tx) error {
+		totp, err := s.dataStore.GetTOTPBySubjectID(ctx, tx, subjectID)
+		if err != nil {
+			return err
+		}
+
+		if totp.EnabledAt != nil {
+			return domain.ErrTOTPAlreadyEnabled
+		}
+
+		step, ok := s.authnService.ValidateTOTPCode(totp.Secret, code, time.Now())
+		if !ok {
+			return domain.ErrTOTPCodeInvalid
+		}
+
+		err = s.dataStore.EnableTOTP(ctx, tx, subjectID, step)
+		if err != nil {
+			return err
+		}
+
+		return s.dataStore.CreateRecoveryCodes(ctx, tx, subjectID, recoveryCodeHashes)
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrTOTPNotFound),
+			errors.Is(err, domain.ErrTOTPAlreadyEnabled),
+			errors.Is(err, domain.ErrTOTPCodeInvalid):
+			return nil, logInfoAndWrapError(ctx, err, descriptionFailedEnableTOTP, logAttrs...)
+		default:
+			return nil, logAndWrapError(ctx, err, descriptionFailedEnableTOTP, logAttrs...)
+		}
+	}
+
+	return recoveryCodes, nil
+}
+
+// signIn returns the tokens of the specified subject, which has just proven its password, or a challenge token when
+// the subject has two-factor authentication enabled.
+func (s *service) signIn(ctx context.Context, subjectID uuid.UUID, role authn.SubjectRole, client domain.SessionClient, logAttrs []any) (domain.SignIn, error) {
+	var (
+		totp domain.TOTP
+		err  error
+	)
+
+	err = s.readOnlyTx(ctx, func(tx pgx.Tx) error {
+		totp, err = s.dataStore.GetTOTPBySubjectID(ctx, tx, subjectID)
+		return err
+	})
+	if err != nil && !errors.Is(err, domain.ErrTOTPNotFound) {
+		return domain.SignIn{}, logAndWrapError(ctx, err, descriptionFailedGetTOTP, logAttrs...)
+	}
+
+	if err != nil || totp.EnabledAt == nil {
+		tokens, err := s.newTokens(ctx, subjectID, role, client, logAttrs)
+		if err != nil {
+			return domain.SignIn{}, err
+		}
+
+		return domain.SignIn{Tokens: tokens}, nil
+	}
+
+	challengeToken, err := s.authnService.NewTwoFactorChallengeToken(subjectID.String(), role)
+	if err != nil {
+		return domain.SignIn{}, logAndWrapError(ctx, err, descriptionFailedCreateTwoFactorChallenge, logAttrs...)
+	}
+
+	return domain.SignIn{ChallengeToken: challengeToken}, nil
+}
+
+// signInTOTP exchanges the challenge token issued to the specified role and a totp code or a recovery code for new
+// tokens. Each code can only be used once. Failed attempts are throttled per subject and per IP address of the client,
+// independently of the password attempts, so that signing in with the password again does not reset them.
+func (s *service) signInTOTP(ctx context.Context, challengeToken, code string, role authn.SubjectRole, client domain.SessionClient, logAttrs []any) (domain.Tokens, error) {
+	claims, err := s.authnService.ParseTwoFactorChallengeToken(challengeToken)
+	if err != nil {
+		return domain.Tokens{}, logInfoAndWrapError(ctx, domain.ErrTwoFactorChallengeInvalid, descriptionFailedParseTwoFactorChallengeToken, append(logAttrs, logging.Error(err))...)
+	}
+
+	subjectID, err := uuid.Parse(claims.Subject)
+	if err != nil || claims.Role != role {
+		return domain.Tokens{}, logInfoAndWrapError(ctx, domain.ErrTwoFactorChallengeInvalid, descriptionFailedParseTwoFactorChallengeToken, logAttrs...)
+	}
+
+	now := time.Now().UTC()
+
+	twoFactorKey := signInThrottleKey(role, signInThrottleKeyTwoFactor, subjectID.String())
+	rules := signInThrottleIPRule([]signInThrottleRule{{key: twoFactorKey, policy: domain.SignInThrottlePolicyTwoFactor}}, role, client)
+
+	var allowed bool
+
+	err = s.readWriteTx(ctx, func(tx pgx.Tx) error {
+		allowed, err = s.signInThrottlesAllowed(ctx, tx, rules, now)
+		if err != nil || !allowed {
+			return err
+		}
+
+		totp, err := s.dataStore.GetTOTPBySubjectID(ctx, tx, subjectID)
+		if err != nil {
+			return err
+		}
+
+		if totp.EnabledAt == nil {
+			return domain.ErrTwoFactorChallengeInvalid
+		}
+
+		step, ok := s.authnService.ValidateTOTPCode(totp.Secret, code, now)
+		if ok {
+			err = s.dataStore.UseTOTP(ctx, tx, subjectID, step)
+		} else {
+			err = s.dataStore.UseRecoveryCode(ctx, tx, subjectID, s.authnService.HashRecoveryCode(code))
+			if errors.Is(err, domain.ErrRecoveryCodeNotFound) {
+				err = domain.ErrTOTPCodeInvalid
+			}
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return s.dataStore.DeleteSignInThrottle(ctx, tx, twoFactorKey)
+	})
+	if err == nil && !allowed {
+		return domain.Tokens{}, logInfoAndWrapError(ctx, domain.ErrTOTPCodeInvalid, descriptionSignInThrottled, logAttrs...)
+	}
+
+	if errors.Is(err, domain.ErrTOTPCodeInvalid) {
+		failErr := s.readWriteTx(ctx, func(tx pgx.Tx) error {
+			_, err := s.failSignInThrottles(ctx, tx, rules, now)
+			return err
+		})
+		if failErr != nil {
+			return domain.Tokens{}, logAndWrapError(ctx, failErr, descriptionFailedRecordSignInFailure, logAttrs...)
+		}
+	}
+
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrTOTPNotFound):
+			return domain.Tokens{}, logInfoAndWrapError(ctx, domain.ErrTwoFactorChallengeInvalid, descriptionFailedCompleteTwoFactorChallenge, logAttrs...)
+		case errors.Is(err, domain.ErrTwoFactorChallengeInvalid),
+			errors.Is(err, domain.ErrTOTPCodeInvalid):
+			return domain.Tokens{}, logInfoAndWrapError(ctx, err, descriptionFailedCompleteTwoFactorChallenge, logAttrs...)
+		default:
+			return domain.Tokens{}, logAndWrapError(ctx, err, descriptionFailedCompleteTwoFactorChallenge, logAttrs...)
+		}
+	}
+
+	return s.newTokens(ctx, subjectID, role, client, logAttrs)
+}
@@ -0,0 +1,542 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/goofr-group/gaming-store/server/internal/authn"
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+func TestService_CreateUserTOTP(t *testing.T) {
+	t.Run("should successfully create user totp", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			secret = []byte("secret")
+
+			user = domain.User{
+				ID: uuid.New(),
+			}
+		)
+
+		user.Email = "user@email.com"
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		gomock.InOrder(
+			s.mockAuthnService.EXPECT().
+				NewTOTPSecret().
+				Return(secret, nil),
+			s.mockDataStore.EXPECT().
+				GetUserByID(ctx, tx, user.ID).
+				Return(user, nil),
+			s.mockDataStore.EXPECT().
+				CreateTOTP(ctx, tx, domain.EditableTOTP{SubjectID: user.ID, Secret: secret}).
+				Return(nil),
+			s.mockAuthnService.EXPECT().
+				EncodeTOTPSecret(secret).
+				Return("ONSWG4TFOQ"),
+			s.mockAuthnService.EXPECT().
+				TOTPURI(secret, string(user.Email)).
+				Return("otpauth://totp"),
+		)
+
+		expectedEnrollment := domain.TOTPEnrollment{
+			Secret: "ONSWG4TFOQ",
+			URI:    "otpauth://totp",
+		}
+
+		enrollment, err := s.service.CreateUserTOTP(ctx, user.ID)
+		require.NoError(t, err)
+		assert.Equal(t, expectedEnrollment, enrollment)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when totp is already enabled", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				user = domain.User{
+					ID: uuid.New(),
+				}
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			s.mockAuthnService.EXPECT().
+				NewTOTPSecret().
+				Return([]byte("secret"), nil)
+
+			s.mockDataStore.EXPECT().
+				GetUserByID(ctx, tx, user.ID).
+				Return(user, nil)
+
+			s.mockDataStore.EXPECT().
+				CreateTOTP(ctx, tx, gomock.Any()).
+				Return(domain.ErrTOTPAlreadyEnabled)
+
+			_, err := s.service.CreateUserTOTP(ctx, user.ID)
+			require.ErrorIs(t, err, domain.ErrTOTPAlreadyEnabled)
+		})
+	})
+}
+
+func TestService_EnableUserTOTP(t *testing.T) {
+	t.Run("should successfully enable user totp", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			userID = uuid.New()
+			code   = "123456"
+			step   = int64(58000000)
+
+			totp = domain.TOTP{
+				EditableTOTP: domain.EditableTOTP{
+					SubjectID: userID,
+					Secret:    []byte("secret"),
+				},
+			}
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		s.mockAuthnService.EXPECT().
+			NewRecoveryCode().
+			Return("aaaa-bbbb-cccc-dddd", []byte("recovery code hash"), nil).
+			Times(domain.RecoveryCodesCount)
+
+		s.mockDataStore.EXPECT().
+			GetTOTPBySubjectID(ctx, tx, userID).
+			Return(totp, nil)
+
+		s.mockAuthnService.EXPECT().
+			ValidateTOTPCode(totp.Secret, code, gomock.Any()).
+			Return(step, true)
+
+		s.mockDataStore.EXPECT().
+			EnableTOTP(ctx, tx, userID, step).
+			Return(nil)
+
+		s.mockDataStore.EXPECT().
+			CreateRecoveryCodes(ctx, tx, userID, gomock.Len(domain.RecoveryCodesCount)).
+			Return(nil)
+
+		recoveryCodes, err := s.service.EnableUserTOTP(ctx, userID, code)
+		require.NoError(t, err)
+		assert.Len(t, recoveryCodes, domain.RecoveryCodesCount)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the code is invalid", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				userID = uuid.New()
+				code   = "123456"
+
+				totp = domain.TOTP{
+					EditableTOTP: domain.EditableTOTP{
+						SubjectID: userID,
+						Secret:    []byte("secret"),
+					},
+				}
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			s.mockAuthnService.EXPECT().
+				NewRecoveryCode().
+				Return("aaaa-bbbb-cccc-dddd", []byte("recovery code hash"), nil).
+				AnyTimes()
+
+			s.mockDataStore.EXPECT().
+				GetTOTPBySubjectID(ctx, tx, userID).
+				Return(totp, nil)
+
+			s.mockAuthnService.EXPECT().
+				ValidateTOTPCode(totp.Secret, code, gomock.Any()).
+				Return(int64(0), false)
+
+			_, err := s.service.EnableUserTOTP(ctx, userID, code)
+			require.ErrorIs(t, err, domain.ErrTOTPCodeInvalid)
+		})
+
+		t.Run("when totp is already enabled", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				userID    = uuid.New()
+				enabledAt = time.Now()
+
+				totp = domain.TOTP{
+					EditableTOTP: domain.EditableTOTP{
+						SubjectID: userID,
+						Secret:    []byte("secret"),
+					},
+					EnabledAt: &enabledAt,
+				}
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			s.mockAuthnService.EXPECT().
+				NewRecoveryCode().
+				Return("aaaa-bbbb-cccc-dddd", []byte("recovery code hash"), nil).
+				AnyTimes()
+
+			s.mockDataStore.EXPECT().
+				GetTOTPBySubjectID(ctx, tx, userID).
+				Return(totp, nil)
+
+			_, err := s.service.EnableUserTOTP(ctx, userID, "123456")
+			require.ErrorIs(t, err, domain.ErrTOTPAlreadyEnabled)
+		})
+	})
+}
+
+func TestService_SignInUserTOTP(t *testing.T) {
+	newChallengeClaims := func(subject string, role authn.SubjectRole) authn.TwoFactorChallengeClaims {
+		return authn.TwoFactorChallengeClaims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				Subject: subject,
+			},
+			Role: role,
+		}
+	}
+
+	t.Run("should successfully sign in user with a totp code", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			challengeToken = "challenge token"
+			code           = "123456"
+			step           = int64(58000000)
+			client         = domain.SessionClient{UserAgent: "user agent", IPAddress: "127.0.0.1"}
+			enabledAt      = time.Now()
+
+			totp = domain.TOTP{
+				EditableTOTP: domain.EditableTOTP{
+					SubjectID: uuid.New(),
+					Secret:    []byte("secret"),
+				},
+				EnabledAt: &enabledAt,
+			}
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		sessionID := uuid.New()
+
+		twoFactorKey := "user:two-factor:" + totp.SubjectID.String()
+
+		gomock.InOrder(
+			s.mockAuthnService.EXPECT().
+				ParseTwoFactorChallengeToken(challengeToken).
+				Return(newChallengeClaims(totp.SubjectID.String(), authn.SubjectRoleUser), nil),
+			s.mockDataStore.EXPECT().
+				ListSignInThrottles(ctx, tx, []string{twoFactorKey, "user:ip:127.0.0.1"}).
+				Return([]domain.SignInThrottle{{Key: twoFactorKey, FailedAttempts: 2, LastFailedAt: time.Now()}}, nil),
+			s.mockDataStore.EXPECT().
+				GetTOTPBySubjectID(ctx, tx, totp.SubjectID).
+				Return(totp, nil),
+			s.mockAuthnService.EXPECT().
+				ValidateTOTPCode(totp.Secret, code, gomock.Any()).
+				Return(step, true),
+			s.mockDataStore.EXPECT().
+				UseTOTP(ctx, tx, totp.SubjectID, step).
+				Return(nil),
+			s.mockDataStore.EXPECT().
+				DeleteSignInThrottle(ctx, tx, twoFactorKey).
+				Return(nil),
+			s.mockAuthnService.EXPECT().
+				NewRefreshToken().
+				Return("refresh token", []byte("refresh token hash"), nil),
+			s.mockDataStore.EXPECT().
+				CreateSession(ctx, tx, gomock.Any()).
+				Return(sessionID, nil),
+			s.mockDataStore.EXPECT().
+				CreateRefreshToken(ctx, tx, gomock.Any()).
+				Return(uuid.New(), nil),
+			s.mockDataStore.EXPECT().
+				GetUserAdmin(ctx, tx, totp.SubjectID).
+				Return(false, nil),
+			s.mockDataStore.EXPECT().
+				ListUserPublisherMembers(ctx, tx, totp.SubjectID).
+				Return(nil, nil),
+			s.mockAuthnService.EXPECT().
+				NewJWT(totp.SubjectID.String(), sessionID.String(), []authn.SubjectRole{authn.SubjectRoleUser}, nil).
+				Return("access token", nil),
+		)
+
+		expectedTokens := domain.Tokens{
+			AccessToken:  "access token",
+			RefreshToken: "refresh token",
+		}
+
+		tokens, err := s.service.SignInUserTOTP(ctx, challengeToken, code, client)
+		require.NoError(t, err)
+		assert.Equal(t, expectedTokens, tokens)
+	})
+
+	t.Run("should successfully sign in user with a recovery code", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			challengeToken   = "challenge token"
+			recoveryCode     = "aaaa-bbbb-cccc-dddd"
+			recoveryCodeHash = []byte("recovery code hash")
+			enabledAt        = time.Now()
+
+			totp = domain.TOTP{
+				EditableTOTP: domain.EditableTOTP{
+					SubjectID: uuid.New(),
+					Secret:    []byte("secret"),
+				},
+				EnabledAt: &enabledAt,
+			}
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		s.mockAuthnService.EXPECT().
+			ParseTwoFactorChallengeToken(challengeToken).
+			Return(newChallengeClaims(totp.SubjectID.String(), authn.SubjectRoleUser), nil)
+
+		s.mockDataStore.EXPECT().
+			ListSignInThrottles(ctx, tx, []string{"user:two-factor:" + totp.SubjectID.String()}).
+			Return([]domain.SignInThrottle{}, nil)
+
+		s.mockDataStore.EXPECT().
+			GetTOTPBySubjectID(ctx, tx, totp.SubjectID).
+			Return(totp, nil)
+
+		s.mockAuthnService.EXPECT().
+			ValidateTOTPCode(totp.Secret, recoveryCode, gomock.Any()).
+			Return(int64(0), false)
+
+		s.mockAuthnService.EXPECT().
+			HashRecoveryCode(recoveryCode).
+			Return(recoveryCodeHash)
+
+		s.mockDataStore.EXPECT().
+			UseRecoveryCode(ctx, tx, totp.SubjectID, recoveryCodeHash).
+			Return(nil)
+
+		s.mockDataStore.EXPECT().
+			DeleteSignInThrottle(ctx, tx, "user:two-factor:"+totp.SubjectID.String()).
+			Return(nil)
+
+		s.mockAuthnService.EXPECT().
+			NewRefreshToken().
+			Return("refresh token", []byte("refresh token hash"), nil)
+
+		s.mockDataStore.EXPECT().
+			CreateSession(ctx, tx, gomock.Any()).
+			Return(uuid.New(), nil)
+
+		s.mockDataStore.EXPECT().
+			CreateRefreshToken(ctx, tx, gomock.Any()).
+			Return(uuid.New(), nil)
+
+		s.mockDataStore.EXPECT().
+			GetUserAdmin(ctx, tx, totp.SubjectID).
+			Return(false, nil)
+
+		s.mockDataStore.EXPECT().
+			ListUserPublisherMembers(ctx, tx, totp.SubjectID).
+			Return(nil, nil)
+
+		s.mockAuthnService.EXPECT().
+			NewJWT(totp.SubjectID.String(), gomock.Any(), []authn.SubjectRole{authn.SubjectRoleUser}, nil).
+			Return("access token", nil)
+
+		_, err := s.service.SignInUserTOTP(ctx, challengeToken, recoveryCode, domain.SessionClient{})
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the challenge token was issued to a publisher", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				challengeToken = "challenge token"
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+
+			s.mockAuthnService.EXPECT().
+				ParseTwoFactorChallengeToken(challengeToken).
+				Return(newChallengeClaims(uuid.NewString(), authn.SubjectRolePublisher), nil)
+
+			_, err := s.service.SignInUserTOTP(ctx, challengeToken, "123456", domain.SessionClient{})
+			require.ErrorIs(t, err, domain.ErrTwoFactorChallengeInvalid)
+		})
+
+		t.Run("when the second factor is locked out", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				challengeToken = "challenge token"
+				subjectID      = uuid.New()
+				twoFactorKey   = "user:two-factor:" + subjectID.String()
+				lockedUntil    = time.Now().Add(time.Minute)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			s.mockAuthnService.EXPECT().
+				ParseTwoFactorChallengeToken(challengeToken).
+				Return(newChallengeClaims(subjectID.String(), authn.SubjectRoleUser), nil)
+
+			s.mockDataStore.EXPECT().
+				ListSignInThrottles(ctx, tx, []string{twoFactorKey}).
+				Return([]domain.SignInThrottle{{Key: twoFactorKey, LastFailedAt: time.Now(), LockedUntil: &lockedUntil}}, nil)
+
+			_, err := s.service.SignInUserTOTP(ctx, challengeToken, "123456", domain.SessionClient{})
+			require.ErrorIs(t, err, domain.ErrTOTPCodeInvalid)
+		})
+
+		t.Run("when the code was already used", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				challengeToken = "challenge token"
+				code           = "123456"
+				step           = int64(58000000)
+				enabledAt      = time.Now()
+
+				totp = domain.TOTP{
+					EditableTOTP: domain.EditableTOTP{
+						SubjectID: uuid.New(),
+						Secret:    []byte("secret"),
+					},
+					EnabledAt: &enabledAt,
+				}
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			s.mockAuthnService.EXPECT().
+				ParseTwoFactorChallengeToken(challengeToken).
+				Return(newChallengeClaims(totp.SubjectID.String(), authn.SubjectRoleUser), nil)
+
+			s.mockDataStore.EXPECT().
+				ListSignInThrottles(ctx, tx, []string{"user:two-factor:" + totp.SubjectID.String()}).
+				Return([]domain.SignInThrottle{}, nil)
+
+			s.mockDataStore.EXPECT().
+				GetTOTPBySubjectID(ctx, tx, totp.SubjectID).
+				Return(totp, nil)
+
+			s.mockAuthnService.EXPECT().
+				ValidateTOTPCode(totp.Secret, code, gomock.Any()).
+				Return(step, true)
+
+			s.mockDataStore.EXPECT().
+				UseTOTP(ctx, tx, totp.SubjectID, step).
+				Return(domain.ErrTOTPCodeInvalid)
+
+			s.mockDataStore.EXPECT().
+				FailSignInThrottle(ctx, tx, "user:two-factor:"+totp.SubjectID.String(), gomock.Any(), gomock.Any()).
+				Return(domain.SignInThrottle{FailedAttempts: 1}, nil)
+
+			_, err := s.service.SignInUserTOTP(ctx, challengeToken, code, domain.SessionClient{})
+			require.ErrorIs(t, err, domain.ErrTOTPCodeInvalid)
+		})
+
+		t.Run("when the recovery code is not found", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				challengeToken = "challenge token"
+				code           = "aaaa-bbbb-cccc-dddd"
+				enabledAt      = time.Now()
+
+				totp = domain.TOTP{
+					EditableTOTP: domain.EditableTOTP{
+						SubjectID: uuid.New(),
+						Secret:    []byte("secret"),
+					},
+					EnabledAt: &enabledAt,
+				}
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			s.mockAuthnService.EXPECT().
+				ParseTwoFactorChallengeToken(challengeToken).
+				Return(newChallengeClaims(totp.SubjectID.String(), authn.SubjectRoleUser), nil)
+
+			s.mockDataStore.EXPECT().
+				ListSignInThrottles(ctx, tx, []string{"user:two-factor:" + totp.SubjectID.String()}).
+				Return([]domain.SignInThrottle{}, nil)
+
+			s.mockDataStore.EXPECT().
+				GetTOTPBySubjectID(ctx, tx, totp.SubjectID).
+				Return(totp, nil)
+
+			s.mockAuthnService.EXPECT().
+				ValidateTOTPCode(totp.Secret, code, gomock.Any()).
+				Return(int64(0), false)
+
+			s.mockAuthnService.EXPECT().
+				HashRecoveryCode(code).
+				Return([]byte("recovery code hash"))
+
+			s.mockDataStore.EXPECT().
+				UseRecoveryCode(ctx, tx, totp.SubjectID, gomock.Any()).
+				Return(domain.ErrRecoveryCodeNotFound)
+
+			s.mockDataStore.EXPECT().
+				FailSignInThrottle(ctx, tx, "user:two-factor:"+totp.SubjectID.String(), gomock.Any(), gomock.Any()).
+				Return(domain.SignInThrottle{FailedAttempts: domain.SignInThrottlePolicyTwoFactor.LockoutAttempts}, nil)
+
+			s.mockDataStore.EXPECT().
+				LockSignInThrottle(ctx, tx, "user:two-factor:"+totp.SubjectID.String(), gomock.Any()).
+				Return(nil)
+
+			_, err := s.service.SignInUserTOTP(ctx, challengeToken, code, domain.SessionClient{})
+			require.ErrorIs(t, err, domain.ErrTOTPCodeInvalid)
+		})
+	})
+}
@@ -18,7 +18,6 @@ const (
 	descriptionFailedCreateUser     = "service: failed to create user"
 	descriptionFailedGetUserByID    = "service: failed to get user by id"
 	descriptionFailedGetUserByEmail = "service: failed to get user by email"
-	descriptionFailedGetUserSignIn  = "service: failed to get user sign-in"
 	descriptionFailedPatchUser      = "service: failed to patch user"
 )
 
@@ -35,42 +34,16 @@ func (s *service) CreateUser(ctx context.Context, editableUser domain.EditableUs
 		slog.String(logging.UserVatin, string(editableUser.Vatin)),
 	}
 
-	editableUser.Username = domain.Username(replaceSpacesWithHyphen(string(editableUser.Username)))
-	editableUser.Username = domain.Username(strings.ToLower(string(editableUser.Username)))
-	editableUser.Email = domain.Email(strings.ToLower(string(editableUser.Email)))
-	editableUser.DisplayName = domain.Name(removeExtraSpaces(string(editableUser.DisplayName)))
-	editableUser.Address = domain.Address(removeExtraSpaces(string(editableUser.Address)))
-
-	if !editableUser.Username.Valid() {
-		return domain.User{}, logInfoAndWrapError(ctx, &domain.FieldValueInvalidError{FieldName: domain.FieldUsername}, descriptionInvalidFieldValue, logAttrs...)
-	}
-
-	if !editableUser.Email.Valid() {
-		return domain.User{}, logInfoAndWrapError(ctx, &domain.FieldValueInvalidError{FieldName: domain.FieldEmail}, descriptionInvalidFieldValue, logAttrs...)
-	}
-
-	if !s.authnService.ValidPassword([]byte(editableUser.Password)) {
-		return domain.User{}, logInfoAndWrapError(ctx, &domain.FieldValueInvalidError{FieldName: domain.FieldPassword}, descriptionInvalidFieldValue, logAttrs...)
-	}
-
-	if !editableUser.DisplayName.Valid() {
-		return domain.User{}, logInfoAndWrapError(ctx, &domain.FieldValueInvalidError{FieldName: domain.FieldDisplayName}, descriptionInvalidFieldValue, logAttrs...)
-	}
-
-	if !editableUser.DateOfBirth.Valid() {
-		return domain.User{}, logInfoAndWrapError(ctx, &domain.FieldValueInvalidError{FieldName: domain.FieldDateOfBirth}, descriptionInvalidFieldValue, logAttrs...)
-	}
+	editableUser.EditableUser = normalizeEditableUser(editableUser.EditableUser)
 
-	if !editableUser.Address.Valid() {
-		return domain.User{}, logInfoAndWrapError(ctx, &domain.FieldValueInvalidError{FieldName: domain.FieldAddress}, descriptionInvalidFieldValue, logAttrs...)
-	}
-
-	if !editableUser.Country.Valid() {
-		return domain.User{}, logInfoAndWrapError(ctx, &domain.FieldValueInvalidError{FieldName: domain.FieldCountry}, descriptionInvalidFieldValue, logAttrs...)
+	err := validateEditableUser(editableUser.EditableUser)
+	if err != nil {
+		return domain.User{}, logInfoAndWrapError(ctx, err, descriptionInvalidFieldValue, logAttrs...)
 	}
 
-	if !editableUser.Vatin.Valid() {
-		return domain.User{}, logInfoAndWrapError(ctx, &domain.FieldValueInvalidError{FieldName: domain.FieldVatin}, descriptionInvalidFieldValue, logAttrs...)
+	err = s.validateNewPassword(ctx, editableUser.Password, logAttrs)
+	if err != nil {
+		return domain.User{}, err
 	}
 
 	hashedPassword, err := s.authnService.HashPassword([]byte(editableUser.Password))
@@ -107,6 +80,12 @@ func (s *service) CreateUser(ctx context.Context, editableUser domain.EditableUs
 		}
 	}
 
+	// The account is kept when the email can not be sent, since it can be sent again.
+	err = s.sendEmailVerification(user.ID, authn.SubjectRoleUser, user.Email, string(user.DisplayName), emailVerificationUserPath)
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, descriptionFailedSendEmailVerification, append(logAttrs, logging.Error(err))...)
+	}
+
 	return user, nil
 }
 
@@ -225,11 +204,34 @@ func (s *service) PatchUser(ctx context.Context, id uuid.UUID, editableUser doma
 		}
 	}
 
+	if editableUser.Email != nil && user.EmailVerifiedAt == nil {
+		err = s.sendEmailVerification(user.ID, authn.SubjectRoleUser, user.Email, string(user.DisplayName), emailVerificationUserPath)
+		if err != nil {
+			logging.Logger.ErrorContext(ctx, descriptionFailedSendEmailVerification, append(logAttrs, logging.Error(err))...)
+		}
+	}
+
 	return user, nil
 }
 
-// SignInUser returns a JSON Web Token for the specified username or email and password.
-func (s *service) SignInUser(ctx context.Context, username domain.Username, email domain.Email, password domain.Password) (string, error) {
+// ChangeUserPassword sets a new password for the user with the specified identifier, which requires its
+// current password, and revokes every other session of the user than the specified one. Failed checks of the current
+// password are throttled per user and per IP address of the client.
+func (s *service) ChangeUserPassword(ctx context.Context, id, sessionID uuid.UUID, currentPassword, newPassword domain.Password, client domain.SessionClient) error {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "ChangeUserPassword"),
+		slog.String(logging.UserID, id.String()),
+		slog.String(logging.SessionID, sessionID.String()),
+	}
+
+	return s.changePassword(ctx, id, sessionID, authn.SubjectRoleUser, currentPassword, newPassword, client, s.dataStore.GetUserPassword, s.dataStore.UpdateUserPassword, logAttrs)
+}
+
+// SignInUser returns an access token and a refresh token for the specified username or email and password, or a
+// two-factor challenge token when the user has two-factor authentication enabled.
+// Failed attempts are throttled per user, whether it is identified by username or email, and per IP address of the
+// client.
+func (s *service) SignInUser(ctx context.Context, username domain.Username, email domain.Email, password domain.Password, client domain.SessionClient) (domain.SignIn, error) {
 	logAttrs := []any{
 		slog.String(logging.ServiceMethod, "SignInUser"),
 		slog.String(logging.UserUsername, string(username)),
@@ -239,49 +241,83 @@ func (s *service) SignInUser(ctx context.Context, username domain.Username, emai
 	username = domain.Username(strings.ToLower(string(username)))
 	email = domain.Email(strings.ToLower(string(email)))
 
-	var (
-		signIn domain.SignInUser
-		err    error
-	)
-
-	err = s.readOnlyTx(ctx, func(tx pgx.Tx) error {
-		signIn, err = s.dataStore.GetUserSignIn(ctx, tx, username, email)
-		return err
-	})
-	if err != nil {
-		switch {
-		case errors.Is(err, domain.ErrUserNotFound):
-			return "", logInfoAndWrapError(ctx, domain.ErrCredentialsIncorrect, descriptionFailedGetUserSignIn, logAttrs...)
-		default:
-			return "", logAndWrapError(ctx, err, descriptionFailedGetUserSignIn, logAttrs...)
+	account, err := s.checkSignInPassword(ctx, authn.SubjectRoleUser, password, client, func(tx pgx.Tx) (domain.SignInAccount, error) {
+		signIn, err := s.dataStore.GetUserSignIn(ctx, tx, username, email)
+		if err != nil {
+			return domain.SignInAccount{}, err
 		}
-	}
 
-	valid, err := s.authnService.CheckPasswordHash([]byte(password), []byte(signIn.Password))
+		return domain.SignInAccount{
+			ID:       signIn.ID,
+			Email:    signIn.Email,
+			Name:     string(signIn.DisplayName),
+			Password: signIn.Password,
+		}, nil
+	}, s.dataStore.UpdateUserPassword, passwordResetUserPath, logAttrs)
 	if err != nil {
-		return "", logAndWrapError(ctx, err, descriptionFailedCheckPasswordHash, logAttrs...)
-	}
-
-	if !valid {
-		return "", logInfoAndWrapError(ctx, domain.ErrCredentialsIncorrect, descriptionFailedCheckPasswordHash, logAttrs...)
+		return domain.SignIn{}, err
 	}
 
 	var user domain.User
 
 	err = s.readOnlyTx(ctx, func(tx pgx.Tx) error {
-		user, err = s.dataStore.GetUserByEmail(ctx, tx, signIn.Email)
+		user, err = s.dataStore.GetUserByEmail(ctx, tx, account.Email)
 		return err
 	})
 	if err != nil {
-		return "", logAndWrapError(ctx, err, descriptionFailedGetUserByEmail, logAttrs...)
+		return domain.SignIn{}, logAndWrapError(ctx, err, descriptionFailedGetUserByEmail, logAttrs...)
 	}
 
-	role := authn.SubjectRoleUser
+	return s.signIn(ctx, user.ID, authn.SubjectRoleUser, client, logAttrs)
+}
 
-	token, err := s.authnService.NewJWT(user.ID.String(), []authn.SubjectRole{role})
-	if err != nil {
-		return "", logAndWrapError(ctx, err, descriptionFailedCreateJWT, logAttrs...)
+// RefreshUserTokens exchanges the specified user refresh token for a new access token and a new refresh token.
+func (s *service) RefreshUserTokens(ctx context.Context, refreshToken string, client domain.SessionClient) (domain.Tokens, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "RefreshUserTokens"),
 	}
 
-	return token, nil
+	return s.refreshTokens(ctx, refreshToken, authn.SubjectRoleUser, client, logAttrs)
+}
+
+// SignOutUser revokes the session of the specified user refresh token.
+func (s *service) SignOutUser(ctx context.Context, refreshToken string) error {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "SignOutUser"),
+	}
+
+	return s.revokeRefreshToken(ctx, refreshToken, authn.SubjectRoleUser, logAttrs)
+}
+
+// normalizeEditableUser returns the user data with a lower case username and email, and without extra spaces.
+func normalizeEditableUser(editableUser domain.EditableUser) domain.EditableUser {
+	editableUser.Username = domain.Username(replaceSpacesWithHyphen(string(editableUser.Username)))
+	editableUser.Username = domain.Username(strings.ToLower(string(editableUser.Username)))
+	editableUser.Email = domain.Email(strings.ToLower(string(editableUser.Email)))
+	editableUser.DisplayName = domain.Name(removeExtraSpaces(string(editableUser.DisplayName)))
+	editableUser.Address = domain.Address(removeExtraSpaces(string(editableUser.Address)))
+
+	return editableUser
+}
+
+// validateEditableUser returns a field value invalid error for the first invalid field of the user data.
+func validateEditableUser(editableUser domain.EditableUser) error {
+	switch {
+	case !editableUser.Username.Valid():
+		return &domain.FieldValueInvalidError{FieldName: domain.FieldUsername}
+	case !editableUser.Email.Valid():
+		return &domain.FieldValueInvalidError{FieldName: domain.FieldEmail}
+	case !editableUser.DisplayName.Valid():
+		return &domain.FieldValueInvalidError{FieldName: domain.FieldDisplayName}
+	case !editableUser.DateOfBirth.Valid():
+		return &domain.FieldValueInvalidError{FieldName: domain.FieldDateOfBirth}
+	case !editableUser.Address.Valid():
+		return &domain.FieldValueInvalidError{FieldName: domain.FieldAddress}
+	case !editableUser.Country.Valid():
+		return &domain.FieldValueInvalidError{FieldName: domain.FieldCountry}
+	case !editableUser.Vatin.Valid():
+		return &domain.FieldValueInvalidError{FieldName: domain.FieldVatin}
+	default:
+		return nil
+	}
 }
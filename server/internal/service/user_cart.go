@@ -43,8 +43,8 @@ func (s *service) CreateUserCartGame(ctx context.Context, userID, gameID uuid.UU
 			return err
 		}
 
-		if !game.IsActive {
-			return domain.ErrGameNotActive
+		if game.Status != domain.GameStatusPublished {
+			return domain.ErrGameNotPublished
 		}
 
 		now := time.Now().UTC()
@@ -85,7 +85,7 @@ func (s *service) CreateUserCartGame(ctx context.Context, userID, gameID uuid.UU
 		case errors.Is(err, domain.ErrUserCartGameAlreadyExists),
 			errors.Is(err, domain.ErrUserNotFound),
 			errors.Is(err, domain.ErrGameNotFound),
-			errors.Is(err, domain.ErrGameNotActive),
+			errors.Is(err, domain.ErrGameNotPublished),
 			errors.Is(err, domain.ErrGameNotReleased),
 			errors.Is(err, domain.ErrUserNotOldEnough),
 			errors.Is(err, domain.ErrUserLibraryGameAlreadyExists):
@@ -173,6 +173,10 @@ func (s *service) PurchaseUserCart(ctx context.Context, userID uuid.UUID) error
 			return err
 		}
 
+		if user.EmailVerifiedAt == nil {
+			return domain.ErrUserEmailNotVerified
+		}
+
 		var games []domain.Game
 
 		for {
@@ -205,6 +209,19 @@ func (s *service) PurchaseUserCart(ctx context.Context, userID uuid.UUID) error
 			return domain.ErrUserCartEmpty
 		}
 
+		for i, game := range games {
+			game, err = s.dataStore.GetGameByID(ctx, tx, game.ID)
+			if err != nil {
+				return err
+			}
+
+			if game.Status != domain.GameStatusPublished {
+				return domain.ErrGameNotPublished
+			}
+
+			games[i] = game
+		}
+
 		var subtotal domain.GamePrice
 		for _, game := range games {
 			subtotal += game.Price
@@ -264,7 +281,9 @@ func (s *service) PurchaseUserCart(ctx context.Context, userID uuid.UUID) error
 	if err != nil {
 		switch {
 		case errors.Is(err, domain.ErrUserNotFound),
+			errors.Is(err, domain.ErrUserEmailNotVerified),
 			errors.Is(err, domain.ErrUserCartEmpty),
+			errors.Is(err, domain.ErrGameNotPublished),
 			errors.Is(err, domain.ErrUserBalanceInsufficient):
 			return logInfoAndWrapError(ctx, err, descriptionFailedPurchaseUserCart, logAttrs...)
 		default:
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -20,18 +21,24 @@ func TestService_PurchaseUserCart(t *testing.T) {
 
 			userID = uuid.New()
 
-			user = domain.User{
-				Email:   "user@email.com",
-				Balance: 30,
+			emailVerifiedAt = time.Now().UTC()
+			user            = domain.User{
+				Email:           "user@email.com",
+				EmailVerifiedAt: &emailVerifiedAt,
+				Balance:         30,
 			}
 			userCart = domain.PaginatedResponse[domain.Game]{
 				Total: 2,
 				Results: []domain.Game{
 					{
-						Price: 5,
+						ID:     uuid.New(),
+						Price:  5,
+						Status: domain.GameStatusPublished,
 					},
 					{
-						Price: 15,
+						ID:     uuid.New(),
+						Price:  15,
+						Status: domain.GameStatusPublished,
 					},
 				},
 			}
@@ -61,6 +68,12 @@ func TestService_PurchaseUserCart(t *testing.T) {
 					},
 				}).
 				Return(userCart, nil),
+			s.mockDataStore.EXPECT().
+				GetGameByID(ctx, tx, userCart.Results[0].ID).
+				Return(userCart.Results[0], nil),
+			s.mockDataStore.EXPECT().
+				GetGameByID(ctx, tx, userCart.Results[1].ID).
+				Return(userCart.Results[1], nil),
 			s.mockDataStore.EXPECT().
 				PatchUser(ctx, tx, userID, domain.EditableUserPatch{
 					Balance: &newUserBalance,
@@ -85,9 +98,11 @@ func TestService_PurchaseUserCart(t *testing.T) {
 
 				userID = uuid.New()
 
-				user = domain.User{
-					Email:   "user@email.com",
-					Balance: 30,
+				emailVerifiedAt = time.Now().UTC()
+				user            = domain.User{
+					Email:           "user@email.com",
+					EmailVerifiedAt: &emailVerifiedAt,
+					Balance:         30,
 				}
 				userCart = domain.PaginatedResponse[domain.Game]{
 					Total:   0,
@@ -124,7 +139,7 @@ func TestService_PurchaseUserCart(t *testing.T) {
 			assert.Equal(t, expectedError, err)
 		})
 
-		t.Run("when the user balance is insufficient", func(t *testing.T) {
+		t.Run("when the user email is not verified", func(t *testing.T) {
 			var (
 				ctx = context.Background()
 
@@ -132,16 +147,112 @@ func TestService_PurchaseUserCart(t *testing.T) {
 
 				user = domain.User{
 					Email:   "user@email.com",
-					Balance: 10,
+					Balance: 30,
+				}
+
+				expectedError = fmt.Errorf("%s: %w", descriptionFailedPurchaseUserCart, domain.ErrUserEmailNotVerified)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			gomock.InOrder(
+				s.mockDataStore.EXPECT().
+					GetUserByID(ctx, tx, userID).
+					Return(user, nil),
+			)
+
+			err := s.service.PurchaseUserCart(ctx, userID)
+			assert.Equal(t, expectedError, err)
+		})
+
+		t.Run("when a game in the user cart is no longer published", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				userID = uuid.New()
+
+				emailVerifiedAt = time.Now().UTC()
+				user            = domain.User{
+					Email:           "user@email.com",
+					EmailVerifiedAt: &emailVerifiedAt,
+					Balance:         30,
+				}
+				userCart = domain.PaginatedResponse[domain.Game]{
+					Total: 1,
+					Results: []domain.Game{
+						{
+							ID:     uuid.New(),
+							Price:  5,
+							Status: domain.GameStatusPublished,
+						},
+					},
+				}
+				delistedGame = domain.Game{
+					ID:     userCart.Results[0].ID,
+					Price:  5,
+					Status: domain.GameStatusDelisted,
+				}
+
+				expectedError = fmt.Errorf("%s: %w", descriptionFailedPurchaseUserCart, domain.ErrGameNotPublished)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			gomock.InOrder(
+				s.mockDataStore.EXPECT().
+					GetUserByID(ctx, tx, userID).
+					Return(user, nil),
+				s.mockDataStore.EXPECT().
+					ListUserCart(ctx, tx, userID, domain.UserCartPaginatedFilter{
+						PaginatedRequest: domain.PaginatedRequest[domain.UserCartPaginatedSort]{
+							PaginatedRequestBase: domain.PaginatedRequestBase{
+								Limit:  listUserCartPaginatedLimit,
+								Offset: 0,
+							},
+							Sort:  domain.UserCartPaginatedSortCreatedAt,
+							Order: domain.PaginationOrderDesc,
+						},
+					}).
+					Return(userCart, nil),
+				s.mockDataStore.EXPECT().
+					GetGameByID(ctx, tx, delistedGame.ID).
+					Return(delistedGame, nil),
+			)
+
+			err := s.service.PurchaseUserCart(ctx, userID)
+			assert.Equal(t, expectedError, err)
+		})
+
+		t.Run("when the user balance is insufficient", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				userID = uuid.New()
+
+				emailVerifiedAt = time.Now().UTC()
+				user            = domain.User{
+					Email:           "user@email.com",
+					EmailVerifiedAt: &emailVerifiedAt,
+					Balance:         10,
 				}
 				userCart = domain.PaginatedResponse[domain.Game]{
 					Total: 2,
 					Results: []domain.Game{
 						{
-							Price: 5,
+							ID:     uuid.New(),
+							Price:  5,
+							Status: domain.GameStatusPublished,
 						},
 						{
-							Price: 15,
+							ID:     uuid.New(),
+							Price:  15,
+							Status: domain.GameStatusPublished,
 						},
 					},
 				}
@@ -170,6 +281,12 @@ func TestService_PurchaseUserCart(t *testing.T) {
 						},
 					}).
 					Return(userCart, nil),
+				s.mockDataStore.EXPECT().
+					GetGameByID(ctx, tx, userCart.Results[0].ID).
+					Return(userCart.Results[0], nil),
+				s.mockDataStore.EXPECT().
+					GetGameByID(ctx, tx, userCart.Results[1].ID).
+					Return(userCart.Results[1], nil),
 			)
 
 			err := s.service.PurchaseUserCart(ctx, userID)
@@ -208,9 +325,11 @@ func TestService_PurchaseUserCart(t *testing.T) {
 
 					userID = uuid.New()
 
-					user = domain.User{
-						Email:   "user@email.com",
-						Balance: 30,
+					emailVerifiedAt = time.Now().UTC()
+					user            = domain.User{
+						Email:           "user@email.com",
+						EmailVerifiedAt: &emailVerifiedAt,
+						Balance:         30,
 					}
 
 					errTest       = errors.New("error test")
@@ -250,18 +369,24 @@ func TestService_PurchaseUserCart(t *testing.T) {
 
 					userID = uuid.New()
 
-					user = domain.User{
-						Email:   "user@email.com",
-						Balance: 30,
+					emailVerifiedAt = time.Now().UTC()
+					user            = domain.User{
+						Email:           "user@email.com",
+						EmailVerifiedAt: &emailVerifiedAt,
+						Balance:         30,
 					}
 					userCart = domain.PaginatedResponse[domain.Game]{
 						Total: 2,
 						Results: []domain.Game{
 							{
-								Price: 5,
+								ID:     uuid.New(),
+								Price:  5,
+								Status: domain.GameStatusPublished,
 							},
 							{
-								Price: 15,
+								ID:     uuid.New(),
+								Price:  15,
+								Status: domain.GameStatusPublished,
 							},
 						},
 					}
@@ -294,6 +419,12 @@ func TestService_PurchaseUserCart(t *testing.T) {
 							},
 						}).
 						Return(userCart, nil),
+					s.mockDataStore.EXPECT().
+						GetGameByID(ctx, tx, userCart.Results[0].ID).
+						Return(userCart.Results[0], nil),
+					s.mockDataStore.EXPECT().
+						GetGameByID(ctx, tx, userCart.Results[1].ID).
+						Return(userCart.Results[1], nil),
 					s.mockDataStore.EXPECT().
 						PatchUser(ctx, tx, userID, domain.EditableUserPatch{
 							Balance: &newUserBalance,
@@ -311,18 +442,24 @@ func TestService_PurchaseUserCart(t *testing.T) {
 
 					userID = uuid.New()
 
-					user = domain.User{
-						Email:   "user@email.com",
-						Balance: 30,
+					emailVerifiedAt = time.Now().UTC()
+					user            = domain.User{
+						Email:           "user@email.com",
+						EmailVerifiedAt: &emailVerifiedAt,
+						Balance:         30,
 					}
 					userCart = domain.PaginatedResponse[domain.Game]{
 						Total: 2,
 						Results: []domain.Game{
 							{
-								Price: 5,
+								ID:     uuid.New(),
+								Price:  5,
+								Status: domain.GameStatusPublished,
 							},
 							{
-								Price: 15,
+								ID:     uuid.New(),
+								Price:  15,
+								Status: domain.GameStatusPublished,
 							},
 						},
 					}
@@ -355,6 +492,12 @@ func TestService_PurchaseUserCart(t *testing.T) {
 							},
 						}).
 						Return(userCart, nil),
+					s.mockDataStore.EXPECT().
+						GetGameByID(ctx, tx, userCart.Results[0].ID).
+						Return(userCart.Results[0], nil),
+					s.mockDataStore.EXPECT().
+						GetGameByID(ctx, tx, userCart.Results[1].ID).
+						Return(userCart.Results[1], nil),
 					s.mockDataStore.EXPECT().
 						PatchUser(ctx, tx, userID, domain.EditableUserPatch{
 							Balance: &newUserBalance,
@@ -375,18 +518,24 @@ func TestService_PurchaseUserCart(t *testing.T) {
 
 					userID = uuid.New()
 
-					user = domain.User{
-						Email:   "user@email.com",
-						Balance: 30,
+					emailVerifiedAt = time.Now().UTC()
+					user            = domain.User{
+						Email:           "user@email.com",
+						EmailVerifiedAt: &emailVerifiedAt,
+						Balance:         30,
 					}
 					userCart = domain.PaginatedResponse[domain.Game]{
 						Total: 2,
 						Results: []domain.Game{
 							{
-								Price: 5,
+								ID:     uuid.New(),
+								Price:  5,
+								Status: domain.GameStatusPublished,
 							},
 							{
-								Price: 15,
+								ID:     uuid.New(),
+								Price:  15,
+								Status: domain.GameStatusPublished,
 							},
 						},
 					}
@@ -419,6 +568,12 @@ func TestService_PurchaseUserCart(t *testing.T) {
 							},
 						}).
 						Return(userCart, nil),
+					s.mockDataStore.EXPECT().
+						GetGameByID(ctx, tx, userCart.Results[0].ID).
+						Return(userCart.Results[0], nil),
+					s.mockDataStore.EXPECT().
+						GetGameByID(ctx, tx, userCart.Results[1].ID).
+						Return(userCart.Results[1], nil),
 					s.mockDataStore.EXPECT().
 						PatchUser(ctx, tx, userID, domain.EditableUserPatch{
 							Balance: &newUserBalance,
@@ -13,6 +13,7 @@ import (
 	"go.uber.org/mock/gomock"
 	"golang.org/x/text/language"
 
+	"github.com/goofr-group/gaming-store/server/internal/authn"
 	"github.com/goofr-group/gaming-store/server/internal/domain"
 )
 
@@ -64,6 +65,9 @@ func TestService_CreateUser(t *testing.T) {
 			s.mockAuthnService.EXPECT().
 				ValidPassword([]byte(editableUser.Password)).
 				Return(true),
+			s.mockBreachStore.EXPECT().
+				Compromised([]byte(editableUser.Password)).
+				Return(false, nil),
 			s.mockAuthnService.EXPECT().
 				HashPassword([]byte(editableUser.Password)).
 				Return(expectedPassword, nil),
@@ -73,6 +77,12 @@ func TestService_CreateUser(t *testing.T) {
 			s.mockDataStore.EXPECT().
 				GetUserByID(ctx, tx, userID).
 				Return(expectedUser, nil),
+			s.mockAuthnService.EXPECT().
+				NewEmailVerificationToken(userID.String(), authn.SubjectRoleUser, string(expectedUser.Email)).
+				Return("email verification token", nil),
+			s.mockSMTP.EXPECT().
+				SendMailHTML([]string{string(expectedUser.Email)}, emailVerificationEmailSubject, gomock.Any()).
+				Return(nil),
 		)
 
 		user, err := s.service.CreateUser(ctx, editableUser)
@@ -109,6 +119,82 @@ func TestService_CreateUser(t *testing.T) {
 			assert.Equal(t, expectedError, err)
 		})
 
+		t.Run("when the password is compromised", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				editableUser = domain.EditableUserWithPassword{
+					EditableUser: domain.EditableUser{
+						Username:    "username",
+						Email:       "user@email.com",
+						DisplayName: "user name",
+						DateOfBirth: domain.UserDateOfBirth(time.Date(2000, 2, 1, 0, 0, 0, 0, time.UTC)),
+						Address:     "address",
+						Country:     domain.Country{Region: language.MustParseRegion("pt")},
+						Vatin:       "123456789",
+					},
+					Password: "password123",
+				}
+
+				expectedError = fmt.Errorf("%s: %w", descriptionPasswordCompromised, domain.ErrPasswordCompromised)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+
+			gomock.InOrder(
+				s.mockAuthnService.EXPECT().
+					ValidPassword([]byte(editableUser.Password)).
+					Return(true),
+				s.mockBreachStore.EXPECT().
+					Compromised([]byte(editableUser.Password)).
+					Return(true, nil),
+			)
+
+			_, err := s.service.CreateUser(ctx, editableUser)
+			assert.Equal(t, expectedError, err)
+		})
+
+		t.Run("when the breach store fails", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				editableUser = domain.EditableUserWithPassword{
+					EditableUser: domain.EditableUser{
+						Username:    "username",
+						Email:       "user@email.com",
+						DisplayName: "user name",
+						DateOfBirth: domain.UserDateOfBirth(time.Date(2000, 2, 1, 0, 0, 0, 0, time.UTC)),
+						Address:     "address",
+						Country:     domain.Country{Region: language.MustParseRegion("pt")},
+						Vatin:       "123456789",
+					},
+					Password: "password.secure123",
+				}
+
+				errBreachStore = errors.New("breach store error")
+
+				expectedError = fmt.Errorf("%s: %w", descriptionFailedCheckPasswordBreached, errBreachStore)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+
+			gomock.InOrder(
+				s.mockAuthnService.EXPECT().
+					ValidPassword([]byte(editableUser.Password)).
+					Return(true),
+				s.mockBreachStore.EXPECT().
+					Compromised([]byte(editableUser.Password)).
+					Return(false, errBreachStore),
+			)
+
+			_, err := s.service.CreateUser(ctx, editableUser)
+			assert.Equal(t, expectedError, err)
+		})
+
 		t.Run("when the authn service fails", func(t *testing.T) {
 			t.Run("when validating the password", func(t *testing.T) {
 				var (
@@ -173,6 +259,9 @@ func TestService_CreateUser(t *testing.T) {
 					s.mockAuthnService.EXPECT().
 						ValidPassword([]byte(editableUser.Password)).
 						Return(true),
+					s.mockBreachStore.EXPECT().
+						Compromised([]byte(editableUser.Password)).
+						Return(false, nil),
 					s.mockAuthnService.EXPECT().
 						HashPassword([]byte(editableUser.Password)).
 						Return(nil, errTest),
@@ -220,6 +309,9 @@ func TestService_CreateUser(t *testing.T) {
 					s.mockAuthnService.EXPECT().
 						ValidPassword([]byte(editableUser.Password)).
 						Return(true),
+					s.mockBreachStore.EXPECT().
+						Compromised([]byte(editableUser.Password)).
+						Return(false, nil),
 					s.mockAuthnService.EXPECT().
 						HashPassword([]byte(editableUser.Password)).
 						Return(expectedPassword, nil),
@@ -269,6 +361,9 @@ func TestService_CreateUser(t *testing.T) {
 					s.mockAuthnService.EXPECT().
 						ValidPassword([]byte(editableUser.Password)).
 						Return(true),
+					s.mockBreachStore.EXPECT().
+						Compromised([]byte(editableUser.Password)).
+						Return(false, nil),
 					s.mockAuthnService.EXPECT().
 						HashPassword([]byte(editableUser.Password)).
 						Return(expectedPassword, nil),
@@ -286,3 +381,283 @@ func TestService_CreateUser(t *testing.T) {
 		})
 	})
 }
+
+func TestService_ChangeUserPassword(t *testing.T) {
+	var (
+		id              = uuid.New()
+		currentPassword = domain.Password("password.current123")
+		newPassword     = domain.Password("password.secure123")
+		hashedPassword  = domain.Password("hashed password")
+		sessionID       = uuid.New()
+		client          = domain.SessionClient{IPAddress: "127.0.0.1"}
+		throttleKeys    = []string{"user:account:" + id.String(), "user:ip:127.0.0.1"}
+	)
+
+	t.Run("should successfully change user password", func(t *testing.T) {
+		ctx := context.Background()
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		lockingTx := s.newLockingTx(ctx, ctrl)
+		readWriteTx := s.newReadWriteTx(ctx, ctrl)
+
+		gomock.InOrder(
+			s.mockAuthnService.EXPECT().
+				ValidPassword([]byte(newPassword)).
+				Return(true),
+			s.mockBreachStore.EXPECT().
+				Compromised([]byte(newPassword)).
+				Return(false, nil),
+			s.mockDataStore.EXPECT().
+				GetUserPassword(ctx, lockingTx, id).
+				Return(hashedPassword, nil),
+			s.mockDataStore.EXPECT().
+				ListSignInThrottlesForUpdate(ctx, lockingTx, throttleKeys).
+				Return([]domain.SignInThrottle{}, nil),
+			s.mockAuthnService.EXPECT().
+				CheckPasswordHash([]byte(currentPassword), []byte(hashedPassword)).
+				Return(true, nil),
+			s.mockDataStore.EXPECT().
+				DeleteSignInThrottle(ctx, lockingTx, throttleKeys[0]).
+				Return(nil),
+			s.mockAuthnService.EXPECT().
+				HashPassword([]byte(newPassword)).
+				Return([]byte("new hashed password"), nil),
+			s.mockDataStore.EXPECT().
+				UpdateUserPassword(ctx, readWriteTx, id, domain.Password("new hashed password")).
+				Return(nil),
+			s.mockDataStore.EXPECT().
+				RevokeOtherSessions(ctx, readWriteTx, id, sessionID).
+				Return(nil),
+		)
+
+		err := s.service.ChangeUserPassword(ctx, id, sessionID, currentPassword, newPassword, client)
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the new password is invalid", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				expectedError = fmt.Errorf("%s: %w", descriptionInvalidFieldValue, &domain.FieldValueInvalidError{FieldName: domain.FieldPassword})
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+
+			s.mockAuthnService.EXPECT().
+				ValidPassword([]byte("password")).
+				Return(false)
+
+			err := s.service.ChangeUserPassword(ctx, id, sessionID, currentPassword, "password", client)
+			assert.Equal(t, expectedError, err)
+		})
+
+		t.Run("when the user is not found", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				expectedError = fmt.Errorf("%s: %w", descriptionFailedCheckCurrentPassword, domain.ErrUserNotFound)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			lockingTx := s.newLockingTx(ctx, ctrl)
+
+			gomock.InOrder(
+				s.mockAuthnService.EXPECT().
+					ValidPassword([]byte(newPassword)).
+					Return(true),
+				s.mockBreachStore.EXPECT().
+					Compromised([]byte(newPassword)).
+					Return(false, nil),
+				s.mockDataStore.EXPECT().
+					GetUserPassword(ctx, lockingTx, id).
+					Return(domain.Password(""), domain.ErrUserNotFound),
+			)
+
+			err := s.service.ChangeUserPassword(ctx, id, sessionID, currentPassword, newPassword, client)
+			assert.Equal(t, expectedError, err)
+		})
+
+		t.Run("when the current password is incorrect", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				expectedError = fmt.Errorf("%s: %w", descriptionFailedCheckPasswordHash, domain.ErrCredentialsIncorrect)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			lockingTx := s.newLockingTx(ctx, ctrl)
+
+			gomock.InOrder(
+				s.mockAuthnService.EXPECT().
+					ValidPassword([]byte(newPassword)).
+					Return(true),
+				s.mockBreachStore.EXPECT().
+					Compromised([]byte(newPassword)).
+					Return(false, nil),
+				s.mockDataStore.EXPECT().
+					GetUserPassword(ctx, lockingTx, id).
+					Return(hashedPassword, nil),
+				s.mockDataStore.EXPECT().
+					ListSignInThrottlesForUpdate(ctx, lockingTx, throttleKeys).
+					Return([]domain.SignInThrottle{}, nil),
+				s.mockAuthnService.EXPECT().
+					CheckPasswordHash([]byte(currentPassword), []byte(hashedPassword)).
+					Return(false, nil),
+				s.mockDataStore.EXPECT().
+					FailSignInThrottle(ctx, lockingTx, throttleKeys[0], gomock.Any(), gomock.Any()).
+					Return(domain.SignInThrottle{FailedAttempts: 1}, nil),
+				s.mockDataStore.EXPECT().
+					FailSignInThrottle(ctx, lockingTx, throttleKeys[1], gomock.Any(), gomock.Any()).
+					Return(domain.SignInThrottle{FailedAttempts: 1}, nil),
+			)
+
+			err := s.service.ChangeUserPassword(ctx, id, sessionID, currentPassword, newPassword, client)
+			assert.Equal(t, expectedError, err)
+		})
+
+		t.Run("when the current password checks are throttled", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				lockedUntil = time.Now().Add(time.Minute)
+
+				expectedError = fmt.Errorf("%s: %w", descriptionSignInThrottled, domain.ErrCredentialsIncorrect)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			lockingTx := s.newLockingTx(ctx, ctrl)
+
+			gomock.InOrder(
+				s.mockAuthnService.EXPECT().
+					ValidPassword([]byte(newPassword)).
+					Return(true),
+				s.mockBreachStore.EXPECT().
+					Compromised([]byte(newPassword)).
+					Return(false, nil),
+				s.mockDataStore.EXPECT().
+					GetUserPassword(ctx, lockingTx, id).
+					Return(hashedPassword, nil),
+				s.mockDataStore.EXPECT().
+					ListSignInThrottlesForUpdate(ctx, lockingTx, throttleKeys).
+					Return([]domain.SignInThrottle{{Key: throttleKeys[0], LastFailedAt: time.Now(), LockedUntil: &lockedUntil}}, nil),
+			)
+
+			err := s.service.ChangeUserPassword(ctx, id, sessionID, currentPassword, newPassword, client)
+			assert.Equal(t, expectedError, err)
+		})
+	})
+}
+
+func TestService_SignInUser(t *testing.T) {
+	t.Run("should successfully return a challenge token when totp is enabled", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			email     = domain.Email("user@email.com")
+			password  = domain.Password("password")
+			enabledAt = time.Now()
+
+			signIn = domain.SignInUser{
+				ID:       uuid.New(),
+				Username: "username",
+				Email:    email,
+				Password: "hashed password",
+			}
+
+			user = domain.User{
+				ID: uuid.New(),
+			}
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadOnlyTx(ctx, ctrl)
+		lockingTx := s.newLockingTx(ctx, ctrl)
+
+		gomock.InOrder(
+			s.mockDataStore.EXPECT().
+				GetUserSignIn(ctx, lockingTx, domain.Username(""), email).
+				Return(signIn, nil),
+			s.mockDataStore.EXPECT().
+				ListSignInThrottlesForUpdate(ctx, lockingTx, []string{"user:account:" + signIn.ID.String()}).
+				Return([]domain.SignInThrottle{}, nil),
+			s.mockAuthnService.EXPECT().
+				CheckPasswordHash([]byte(password), []byte(signIn.Password)).
+				Return(true, nil),
+			s.mockDataStore.EXPECT().
+				DeleteSignInThrottle(ctx, lockingTx, "user:account:"+signIn.ID.String()).
+				Return(nil),
+			s.mockAuthnService.EXPECT().
+				PasswordHashNeedsUpgrade([]byte(signIn.Password)).
+				Return(false),
+			s.mockDataStore.EXPECT().
+				GetUserByEmail(ctx, tx, email).
+				Return(user, nil),
+			s.mockDataStore.EXPECT().
+				GetTOTPBySubjectID(ctx, tx, user.ID).
+				Return(domain.TOTP{EnabledAt: &enabledAt}, nil),
+			s.mockAuthnService.EXPECT().
+				NewTwoFactorChallengeToken(user.ID.String(), authn.SubjectRoleUser).
+				Return("challenge token", nil),
+		)
+
+		expectedSignIn := domain.SignIn{
+			ChallengeToken: "challenge token",
+		}
+
+		actualSignIn, err := s.service.SignInUser(ctx, "", email, password, domain.SessionClient{})
+		require.NoError(t, err)
+		assert.Equal(t, expectedSignIn, actualSignIn)
+	})
+
+	t.Run("should fail when the user is locked out through another identifier", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			username    = domain.Username("username")
+			password    = domain.Password("password")
+			lockedUntil = time.Now().Add(time.Minute)
+
+			signIn = domain.SignInUser{
+				ID:       uuid.New(),
+				Username: username,
+				Email:    "user@email.com",
+				Password: "hashed password",
+			}
+			accountKey = "user:account:" + signIn.ID.String()
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newLockingTx(ctx, ctrl)
+
+		gomock.InOrder(
+			s.mockDataStore.EXPECT().
+				GetUserSignIn(ctx, tx, username, domain.Email("")).
+				Return(signIn, nil),
+			s.mockDataStore.EXPECT().
+				ListSignInThrottlesForUpdate(ctx, tx, []string{accountKey}).
+				Return([]domain.SignInThrottle{
+					{Key: accountKey, LastFailedAt: time.Now(), LockedUntil: &lockedUntil},
+				}, nil),
+			s.mockAuthnService.EXPECT().
+				CheckDummyPasswordHash([]byte(password)),
+		)
+
+		_, err := s.service.SignInUser(ctx, username, "", password, domain.SessionClient{})
+		require.ErrorIs(t, err, domain.ErrCredentialsIncorrect)
+	})
+}
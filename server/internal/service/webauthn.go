@@ -0,0 +1,440 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goofr-group/gaming-store/server/internal/authn"
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+	"github.com/goofr-group/gaming-store/server/internal/logging"
+)
+
+const (
+	descriptionFailedBeginWebAuthnRegistration  = "service: failed to begin webauthn registration"
+	descriptionFailedFinishWebAuthnRegistration = "service: failed to finish webauthn registration"
+	descriptionFailedBeginWebAuthnSignIn        = "service: failed to begin webauthn sign in"
+	descriptionFailedFinishWebAuthnSignIn       = "service: failed to finish webauthn sign in"
+)
+
+// BeginUserWebAuthnRegistration starts the registration of a passkey for the user with the specified identifier and
+// returns the options to be passed to the browser.
+func (s *service) BeginUserWebAuthnRegistration(ctx context.Context, userID uuid.UUID) (domain.WebAuthnOptions, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "BeginUserWebAuthnRegistration"),
+		slog.String(logging.UserID, userID.String()),
+	}
+
+	return s.beginWebAuthnRegistration(ctx, userID, authn.SubjectRoleUser, s.userWebAuthnNames(ctx, userID), logAttrs)
+}
+
+// FinishUserWebAuthnRegistration validates the response of the browser to the specified registration ceremony and
+// stores the new passkey of the user with the specified identifier.
+func (s *service) FinishUserWebAuthnRegistration(ctx context.Context, userID, ceremonyID uuid.UUID, response []byte) error {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "FinishUserWebAuthnRegistration"),
+		slog.String(logging.UserID, userID.String()),
+	}
+
+	return s.finishWebAuthnRegistration(ctx, userID, ceremonyID, response, authn.SubjectRoleUser, s.userWebAuthnNames(ctx, userID), logAttrs)
+}
+
+// BeginUserWebAuthnSignIn starts the passwordless sign in of a user from the specified client and returns the options
+// to be passed to the browser.
+func (s *service) BeginUserWebAuthnSignIn(ctx context.Context, client domain.SessionClient) (domain.WebAuthnOptions, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "BeginUserWebAuthnSignIn"),
+	}
+
+	return s.beginWebAuthnSignIn(ctx, authn.SubjectRoleUser, client, logAttrs)
+}
+
+// FinishUserWebAuthnSignIn validates the response of the browser to the specified sign in ceremony and returns the
+// tokens of the user who owns the passkey.
+func (s *service) FinishUserWebAuthnSignIn(ctx context.Context, ceremonyID uuid.UUID, response []byte, client domain.SessionClient) (domain.Tokens, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "FinishUserWebAuthnSignIn"),
+	}
+
+	return s.finishWebAuthnSignIn(ctx, ceremonyID, response, authn.SubjectRoleUser, client, logAttrs)
+}
+
+// BeginPublisherWebAuthnRegistration starts the registration of a passkey for the publisher with the specified
+// identifier and returns the options to be passed to the browser.
+func (s *service) BeginPublisherWebAuthnRegistration(ctx context.Context, publisherID uuid.UUID) (domain.WebAuthnOptions, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "BeginPublisherWebAuthnRegistration"),
+		slog.String(logging.PublisherID, publisherID.String()),
+	}
+
+	return s.beginWebAuthnRegistration(ctx, publisherID, authn.SubjectRolePublisher, s.publisherWebAuthnNames(ctx, publisherID), logAttrs)
+}
+
+// FinishPublisherWebAuthnRegistration validates the response of the browser to the specified registration ceremony
+// and stores the new passkey of the publisher with the specified identifier.
+func (s *service) FinishPublisherWebAuthnRegistration(ctx context.Context, publisherID, ceremonyID uuid.UUID, response []byte) error {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "FinishPublisherWebAuthnRegistration"),
+		slog.String(logging.PublisherID, publisherID.String()),
+	}
+
+	return s.finishWebAuthnRegistration(ctx, publisherID, ceremonyID, response, authn.SubjectRolePublisher, s.publisherWebAuthnNames(ctx, publisherID), logAttrs)
+}
+
+// BeginPublisherWebAuthnSignIn starts the passwordless sign in of a publisher from the specified client and returns
+// the options to be passed to the browser.
+func (s *service) BeginPublisherWebAuthnSignIn(ctx context.Context, client domain.SessionClient) (domain.WebAuthnOptions, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "BeginPublisherWebAuthnSignIn"),
+	}
+
+	return s.beginWebAuthnSignIn(ctx, authn.SubjectRolePublisher, client, logAttrs)
+}
+
+// FinishPublisherWebAuthnSignIn validates the response of the browser to the specified sign in ceremony and returns
+// the tokens of the publisher who owns the passkey.
+func (s *service) FinishPublisherWebAuthnSignIn(ctx context.Context, ceremonyID uuid.UUID, response []byte, client domain.SessionClient) (domain.Tokens, error) {
+	logAttrs := []any{
+		slog.String(logging.ServiceMethod, "FinishPublisherWebAuthnSignIn"),
+	}
+
+	return s.finishWebAuthnSignIn(ctx, ceremonyID, response, authn.SubjectRolePublisher, client, logAttrs)
+}
+
+// userWebAuthnNames returns a function which gets the name and the display name of the user with the specified
+// identifier, shown by authenticators to tell passkeys apart.
+func (s *service) userWebAuthnNames(ctx context.Context, userID uuid.UUID) func(tx pgx.Tx) (string, string, error) {
+	return func(tx pgx.Tx) (string, string, error) {
+		user, err := s.dataStore.GetUserByID(ctx, tx, userID)
+		return string(user.Username), string(user.DisplayName), err
+	}
+}
+
+// publisherWebAuthnNames returns a function which gets the name and the display name of the publisher with the
+// specified identifier, shown by authenticators to tell passkeys apart.
+func (s *service) publisherWebAuthnNames(ctx context.Context, publisherID uuid.UUID) func(tx pgx.Tx) (string, string, error) {
+	return func(tx pgx.Tx) (string, string, error) {
+		publisher, err := s.dataStore.GetPublisherByID(ctx, tx, publisherID)
+		return string(publisher.Email), string(publisher.Name), err
+	}
+}
+
+// beginWebAuthnRegistration stores a new registration ceremony for the specified subject, whose names are returned by
+// getNames, and returns its options. The credentials already registered by the subject are excluded.
+func (s *service) beginWebAuthnRegistration(ctx context.Context, subjectID uuid.UUID, role authn.SubjectRole, getNames func(tx pgx.Tx) (string, string, error), logAttrs []any) (domain.WebAuthnOptions, error) {
+	var webAuthnOptions domain.WebAuthnOptions
+
+	err := s.readWriteTx(ctx, func(tx pgx.Tx) error {
+		subject, err := s.webAuthnSubject(ctx, tx, subjectID, role, getNames)
+		if err != nil {
+			return err
+		}
+
+		options, session, err := s.authnService.BeginWebAuthnRegistration(subject)
+		if err != nil {
+			return err
+		}
+
+		ceremonyID, err := s.createWebAuthnCeremony(ctx, tx, &subjectID, role, session)
+		if err != nil {
+			return err
+		}
+
+		webAuthnOptions = domain.WebAuthnOptions{
+			CeremonyID: ceremonyID,
+			Options:    options,
+		}
+
+		return nil
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUserNotFound),
+			errors.Is(err, domain.ErrPublisherNotFound):
+			return domain.WebAuthnOptions{}, logInfoAndWrapError(ctx, err, descriptionFailedBeginWebAuthnRegistration, logAttrs...)
+		default:
+			return domain.WebAuthnOptions{}, logAndWrapError(ctx, err, descriptionFailedBeginWebAuthnRegistration, logAttrs...)
+		}
+	}
+
+	return webAuthnOptions, nil
+}
+
+// finishWebAuthnRegistration consumes the specified registration ceremony of the subject and stores the credential
+// of the response if it is valid.
+func (s *service) finishWebAuthnRegistration(ctx context.Context, subjectID, ceremonyID uuid.UUID, response []byte, role authn.SubjectRole, getNames func(tx pgx.Tx) (string, string, error), logAttrs []any) error {
+	var (
+		ceremony domain.WebAuthnCeremony
+		subject  authn.WebAuthnSubject
+		err      error
+	)
+
+	err = s.readWriteTx(ctx, func(tx pgx.Tx) error {
+		ceremony, err = s.dataStore.DeleteWebAuthnCeremony(ctx, tx, ceremonyID)
+		if err != nil {
+			return err
+		}
+
+		if ceremony.SubjectID == nil || *ceremony.SubjectID != subjectID || !validWebAuthnCeremony(ceremony, role) {
+			return domain.ErrWebAuthnCeremonyInvalid
+		}
+
+		subject, err = s.webAuthnSubject(ctx, tx, subjectID, role, getNames)
+
+		return err
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrWebAuthnCeremonyNotFound):
+			return logInfoAndWrapError(ctx, domain.ErrWebAuthnCeremonyInvalid, descriptionFailedFinishWebAuthnRegistration, logAttrs...)
+		case errors.Is(err, domain.ErrWebAuthnCeremonyInvalid),
+			errors.Is(err, domain.ErrUserNotFound),
+			errors.Is(err, domain.ErrPublisherNotFound):
+			return logInfoAndWrapError(ctx, err, descriptionFailedFinishWebAuthnRegistration, logAttrs...)
+		default:
+			return logAndWrapError(ctx, err, descriptionFailedFinishWebAuthnRegistration, logAttrs...)
+		}
+	}
+
+	credential, err := s.authnService.FinishWebAuthnRegistration(subject, ceremony.Session, response)
+	if err != nil {
+		return logInfoAndWrapError(ctx, domain.ErrWebAuthnCredentialInvalid, descriptionFailedFinishWebAuthnRegistration, append(logAttrs, logging.Error(err))...)
+	}
+
+	err = s.readWriteTx(ctx, func(tx pgx.Tx) error {
+		_, err := s.dataStore.CreateWebAuthnCredential(ctx, tx, domain.EditableWebAuthnCredential{
+			SubjectID:       subjectID,
+			SubjectRole:     string(role),
+			CredentialID:    credential.ID,
+			PublicKey:       credential.PublicKey,
+			AttestationType: credential.AttestationType,
+			Transports:      credential.Transports,
+			AAGUID:          credential.AAGUID,
+			SignCount:       int64(credential.SignCount),
+		})
+
+		return err
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrWebAuthnCredentialAlreadyExists):
+			return logInfoAndWrapError(ctx, err, descriptionFailedFinishWebAuthnRegistration, logAttrs...)
+		default:
+			return logAndWrapError(ctx, err, descriptionFailedFinishWebAuthnRegistration, logAttrs...)
+		}
+	}
+
+	return nil
+}
+
+// beginWebAuthnSignIn stores a new sign in ceremony for the specified role and returns its options. The subject is
+// only known once the browser responds with one of its discoverable credentials. The ceremonies started from the IP
+// address of the client are throttled, since anyone can start them.
+func (s *service) beginWebAuthnSignIn(ctx context.Context, role authn.SubjectRole, client domain.SessionClient, logAttrs []any) (domain.WebAuthnOptions, error) {
+	options, session, err := s.authnService.BeginWebAuthnLogin()
+	if err != nil {
+		return domain.WebAuthnOptions{}, logAndWrapError(ctx, err, descriptionFailedBeginWebAuthnSignIn, logAttrs...)
+	}
+
+	var ceremonyID uuid.UUID
+
+	err = s.readWriteTx(ctx, func(tx pgx.Tx) error {
+		if len(client.IPAddress) != 0 {
+			now := time.Now().UTC()
+			rules := []signInThrottleRule{{
+				key:    signInThrottleKey(role, signInThrottleKeyWebAuthnCeremony, client.IPAddress),
+				policy: domain.SignInThrottlePolicyWebAuthnCeremony,
+			}}
+
+			allowed, err := s.signInThrottlesAllowed(ctx, tx, rules, now)
+			if err != nil {
+				return err
+			}
+
+			if !allowed {
+				return domain.ErrWebAuthnCeremonyThrottled
+			}
+
+			// Every started ceremony counts as an attempt, since it is only known to fail once it expires.
+			_, err = s.failSignInThrottles(ctx, tx, rules, now)
+			if err != nil {
+				return err
+			}
+		}
+
+		ceremonyID, err = s.createWebAuthnCeremony(ctx, tx, nil, role, session)
+		return err
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrWebAuthnCeremonyThrottled):
+			return domain.WebAuthnOptions{}, logInfoAndWrapError(ctx, err, descriptionFailedBeginWebAuthnSignIn, logAttrs...)
+		default:
+			return domain.WebAuthnOptions{}, logAndWrapError(ctx, err, descriptionFailedBeginWebAuthnSignIn, logAttrs...)
+		}
+	}
+
+	return domain.WebAuthnOptions{
+		CeremonyID: ceremonyID,
+		Options:    options,
+	}, nil
+}
+
+// finishWebAuthnSignIn consumes the specified sign in ceremony and returns new tokens for the owner of the credential
+// of the response, if it is valid. Passkeys already prove possession and user verification, so no two-factor
+// challenge is issued.
+func (s *service) finishWebAuthnSignIn(ctx context.Context, ceremonyID uuid.UUID, response []byte, role authn.SubjectRole, client domain.SessionClient, logAttrs []any) (domain.Tokens, error) {
+	var (
+		ceremony domain.WebAuthnCeremony
+		err      error
+	)
+
+	err = s.readWriteTx(ctx, func(tx pgx.Tx) error {
+		ceremony, err = s.dataStore.DeleteWebAuthnCeremony(ctx, tx, ceremonyID)
+		if err != nil {
+			return err
+		}
+
+		if ceremony.SubjectID != nil || !validWebAuthnCeremony(ceremony, role) {
+			return domain.ErrWebAuthnCeremonyInvalid
+		}
+
+		return nil
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrWebAuthnCeremonyNotFound):
+			return domain.Tokens{}, logInfoAndWrapError(ctx, domain.ErrWebAuthnCeremonyInvalid, descriptionFailedFinishWebAuthnSignIn, logAttrs...)
+		case errors.Is(err, domain.ErrWebAuthnCeremonyInvalid):
+			return domain.Tokens{}, logInfoAndWrapError(ctx, err, descriptionFailedFinishWebAuthnSignIn, logAttrs...)
+		default:
+			return domain.Tokens{}, logAndWrapError(ctx, err, descriptionFailedFinishWebAuthnSignIn, logAttrs...)
+		}
+	}
+
+	var (
+		storedCredential domain.WebAuthnCredential
+		lookupErr        error // The webauthn library does not keep the error chain of the lookup.
+	)
+
+	credential, err := s.authnService.FinishWebAuthnLogin(ceremony.Session, response, func(userHandle, credentialID []byte) (authn.WebAuthnSubject, error) {
+		subjectID, err := uuid.FromBytes(userHandle)
+		if err != nil {
+			return authn.WebAuthnSubject{}, domain.ErrWebAuthnCredentialNotFound
+		}
+
+		var credentials []domain.WebAuthnCredential
+
+		lookupErr = s.readOnlyTx(ctx, func(tx pgx.Tx) error {
+			credentials, err = s.dataStore.ListWebAuthnCredentials(ctx, tx, subjectID)
+			return err
+		})
+		if lookupErr != nil {
+			return authn.WebAuthnSubject{}, lookupErr
+		}
+
+		for _, credential := range credentials {
+			if credential.SubjectRole == string(role) && bytes.Equal(credential.CredentialID, credentialID) {
+				storedCredential = credential
+			}
+		}
+
+		if storedCredential.ID == uuid.Nil {
+			return authn.WebAuthnSubject{}, domain.ErrWebAuthnCredentialNotFound
+		}
+
+		return authn.WebAuthnSubject{
+			ID:          userHandle,
+			Credentials: []authn.WebAuthnCredential{webAuthnCredentialToAuthn(storedCredential)},
+		}, nil
+	})
+	if err != nil {
+		if lookupErr != nil {
+			return domain.Tokens{}, logAndWrapError(ctx, lookupErr, descriptionFailedFinishWebAuthnSignIn, logAttrs...)
+		}
+
+		return domain.Tokens{}, logInfoAndWrapError(ctx, domain.ErrWebAuthnCredentialInvalid, descriptionFailedFinishWebAuthnSignIn, append(logAttrs, logging.Error(err))...)
+	}
+
+	err = s.readWriteTx(ctx, func(tx pgx.Tx) error {
+		return s.dataStore.UseWebAuthnCredential(ctx, tx, storedCredential.ID, int64(credential.SignCount))
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrWebAuthnCredentialInvalid),
+			errors.Is(err, domain.ErrWebAuthnCredentialNotFound):
+			return domain.Tokens{}, logInfoAndWrapError(ctx, domain.ErrWebAuthnCredentialInvalid, descriptionFailedFinishWebAuthnSignIn, logAttrs...)
+		default:
+			return domain.Tokens{}, logAndWrapError(ctx, err, descriptionFailedFinishWebAuthnSignIn, logAttrs...)
+		}
+	}
+
+	return s.newTokens(ctx, storedCredential.SubjectID, role, client, logAttrs)
+}
+
+// webAuthnSubject returns the webauthn subject with the specified identifier, along with its credentials.
+func (s *service) webAuthnSubject(ctx context.Context, tx pgx.Tx, subjectID uuid.UUID, role authn.SubjectRole, getNames func(tx pgx.Tx) (string, string, error)) (authn.WebAuthnSubject, error) {
+	name, displayName, err := getNames(tx)
+	if err != nil {
+		return authn.WebAuthnSubject{}, err
+	}
+
+	credentials, err := s.dataStore.ListWebAuthnCredentials(ctx, tx, subjectID)
+	if err != nil {
+		return authn.WebAuthnSubject{}, err
+	}
+
+	subject := authn.WebAuthnSubject{
+		ID:          subjectID[:],
+		Name:        name,
+		DisplayName: displayName,
+	}
+
+	for _, credential := range credentials {
+		if credential.SubjectRole == string(role) {
+			subject.Credentials = append(subject.Credentials, webAuthnCredentialToAuthn(credential))
+		}
+	}
+
+	return subject, nil
+}
+
+// createWebAuthnCeremony deletes the expired ceremonies and stores a new ceremony of the specified subject and role
+// with the specified session. The subject is nil for a sign in.
+func (s *service) createWebAuthnCeremony(ctx context.Context, tx pgx.Tx, subjectID *uuid.UUID, role authn.SubjectRole, session []byte) (uuid.UUID, error) {
+	now := time.Now()
+
+	err := s.dataStore.DeleteExpiredWebAuthnCeremonies(ctx, tx, now)
+	if err != nil {
+		return uuid.UUID{}, err
+	}
+
+	return s.dataStore.CreateWebAuthnCeremony(ctx, tx, domain.EditableWebAuthnCeremony{
+		SubjectID:   subjectID,
+		SubjectRole: string(role),
+		Session:     session,
+		ExpiresAt:   now.Add(domain.WebAuthnCeremonyExpirationTime),
+	})
+}
+
+// validWebAuthnCeremony reports whether the ceremony was started for the specified role and has not expired.
+func validWebAuthnCeremony(ceremony domain.WebAuthnCeremony, role authn.SubjectRole) bool {
+	return ceremony.SubjectRole == string(role) && time.Now().Before(ceremony.ExpiresAt)
+}
+
+// webAuthnCredentialToAuthn returns the authn representation of the stored webauthn credential.
+func webAuthnCredentialToAuthn(credential domain.WebAuthnCredential) authn.WebAuthnCredential {
+	return authn.WebAuthnCredential{
+		ID:              credential.CredentialID,
+		PublicKey:       credential.PublicKey,
+		AttestationType: credential.AttestationType,
+		Transports:      credential.Transports,
+		AAGUID:          credential.AAGUID,
+		SignCount:       uint32(credential.SignCount),
+	}
+}
@@ -0,0 +1,549 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/goofr-group/gaming-store/server/internal/authn"
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+func TestService_BeginUserWebAuthnRegistration(t *testing.T) {
+	t.Run("should successfully begin user webauthn registration", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			user = domain.User{
+				ID: uuid.New(),
+			}
+			credential = domain.WebAuthnCredential{
+				EditableWebAuthnCredential: domain.EditableWebAuthnCredential{
+					SubjectID:    user.ID,
+					SubjectRole:  string(authn.SubjectRoleUser),
+					CredentialID: []byte("credential id"),
+					SignCount:    4,
+				},
+				ID: uuid.New(),
+			}
+			ceremonyID = uuid.New()
+		)
+
+		user.Username = "username"
+		user.DisplayName = "display name"
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		expectedSubject := authn.WebAuthnSubject{
+			ID:          user.ID[:],
+			Name:        "username",
+			DisplayName: "display name",
+			Credentials: []authn.WebAuthnCredential{
+				{ID: credential.CredentialID, SignCount: 4},
+			},
+		}
+
+		gomock.InOrder(
+			s.mockDataStore.EXPECT().
+				GetUserByID(ctx, tx, user.ID).
+				Return(user, nil),
+			s.mockDataStore.EXPECT().
+				ListWebAuthnCredentials(ctx, tx, user.ID).
+				Return([]domain.WebAuthnCredential{credential}, nil),
+			s.mockAuthnService.EXPECT().
+				BeginWebAuthnRegistration(expectedSubject).
+				Return([]byte(`{"publicKey":{}}`), []byte(`{"challenge":""}`), nil),
+			s.mockDataStore.EXPECT().
+				DeleteExpiredWebAuthnCeremonies(ctx, tx, gomock.Any()).
+				Return(nil),
+			s.mockDataStore.EXPECT().
+				CreateWebAuthnCeremony(ctx, tx, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ pgx.Tx, ceremony domain.EditableWebAuthnCeremony) (uuid.UUID, error) {
+					assert.Equal(t, &user.ID, ceremony.SubjectID)
+					assert.Equal(t, string(authn.SubjectRoleUser), ceremony.SubjectRole)
+					assert.Equal(t, []byte(`{"challenge":""}`), ceremony.Session)
+					assert.WithinDuration(t, time.Now().Add(domain.WebAuthnCeremonyExpirationTime), ceremony.ExpiresAt, time.Second)
+
+					return ceremonyID, nil
+				}),
+		)
+
+		expectedOptions := domain.WebAuthnOptions{
+			CeremonyID: ceremonyID,
+			Options:    []byte(`{"publicKey":{}}`),
+		}
+
+		options, err := s.service.BeginUserWebAuthnRegistration(ctx, user.ID)
+		require.NoError(t, err)
+		assert.Equal(t, expectedOptions, options)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the user is not found", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				userID = uuid.New()
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			s.mockDataStore.EXPECT().
+				GetUserByID(ctx, tx, userID).
+				Return(domain.User{}, domain.ErrUserNotFound)
+
+			_, err := s.service.BeginUserWebAuthnRegistration(ctx, userID)
+			require.ErrorIs(t, err, domain.ErrUserNotFound)
+		})
+	})
+}
+
+func TestService_FinishUserWebAuthnRegistration(t *testing.T) {
+	newCeremony := func(subjectID *uuid.UUID, expiresAt time.Time) domain.WebAuthnCeremony {
+		return domain.WebAuthnCeremony{
+			EditableWebAuthnCeremony: domain.EditableWebAuthnCeremony{
+				SubjectID:   subjectID,
+				SubjectRole: string(authn.SubjectRoleUser),
+				Session:     []byte("session"),
+				ExpiresAt:   expiresAt,
+			},
+			ID: uuid.New(),
+		}
+	}
+
+	t.Run("should successfully finish user webauthn registration", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			user = domain.User{
+				ID: uuid.New(),
+			}
+			ceremony = newCeremony(&user.ID, time.Now().Add(time.Minute))
+			response = []byte("response")
+
+			credential = authn.WebAuthnCredential{
+				ID:              []byte("credential id"),
+				PublicKey:       []byte("public key"),
+				AttestationType: "none",
+				Transports:      []string{"internal"},
+				AAGUID:          make([]byte, 16),
+			}
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		gomock.InOrder(
+			s.mockDataStore.EXPECT().
+				DeleteWebAuthnCeremony(ctx, tx, ceremony.ID).
+				Return(ceremony, nil),
+			s.mockDataStore.EXPECT().
+				GetUserByID(ctx, tx, user.ID).
+				Return(user, nil),
+			s.mockDataStore.EXPECT().
+				ListWebAuthnCredentials(ctx, tx, user.ID).
+				Return(nil, nil),
+			s.mockAuthnService.EXPECT().
+				FinishWebAuthnRegistration(gomock.Any(), ceremony.Session, response).
+				Return(credential, nil),
+			s.mockDataStore.EXPECT().
+				CreateWebAuthnCredential(ctx, tx, domain.EditableWebAuthnCredential{
+					SubjectID:       user.ID,
+					SubjectRole:     string(authn.SubjectRoleUser),
+					CredentialID:    credential.ID,
+					PublicKey:       credential.PublicKey,
+					AttestationType: credential.AttestationType,
+					Transports:      credential.Transports,
+					AAGUID:          credential.AAGUID,
+				}).
+				Return(uuid.New(), nil),
+		)
+
+		err := s.service.FinishUserWebAuthnRegistration(ctx, user.ID, ceremony.ID, response)
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the ceremony was started by another user", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				otherUserID = uuid.New()
+				ceremony    = newCeremony(&otherUserID, time.Now().Add(time.Minute))
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			s.mockDataStore.EXPECT().
+				DeleteWebAuthnCeremony(ctx, tx, ceremony.ID).
+				Return(ceremony, nil)
+
+			err := s.service.FinishUserWebAuthnRegistration(ctx, uuid.New(), ceremony.ID, []byte("response"))
+			require.ErrorIs(t, err, domain.ErrWebAuthnCeremonyInvalid)
+		})
+
+		t.Run("when the ceremony is expired", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				userID   = uuid.New()
+				ceremony = newCeremony(&userID, time.Now().Add(-time.Minute))
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			s.mockDataStore.EXPECT().
+				DeleteWebAuthnCeremony(ctx, tx, ceremony.ID).
+				Return(ceremony, nil)
+
+			err := s.service.FinishUserWebAuthnRegistration(ctx, userID, ceremony.ID, []byte("response"))
+			require.ErrorIs(t, err, domain.ErrWebAuthnCeremonyInvalid)
+		})
+
+		t.Run("when the response is invalid", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				user = domain.User{
+					ID: uuid.New(),
+				}
+				ceremony = newCeremony(&user.ID, time.Now().Add(time.Minute))
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			gomock.InOrder(
+				s.mockDataStore.EXPECT().
+					DeleteWebAuthnCeremony(ctx, tx, ceremony.ID).
+					Return(ceremony, nil),
+				s.mockDataStore.EXPECT().
+					GetUserByID(ctx, tx, user.ID).
+					Return(user, nil),
+				s.mockDataStore.EXPECT().
+					ListWebAuthnCredentials(ctx, tx, user.ID).
+					Return(nil, nil),
+				s.mockAuthnService.EXPECT().
+					FinishWebAuthnRegistration(gomock.Any(), ceremony.Session, []byte("response")).
+					Return(authn.WebAuthnCredential{}, errors.New("invalid attestation")),
+			)
+
+			err := s.service.FinishUserWebAuthnRegistration(ctx, user.ID, ceremony.ID, []byte("response"))
+			require.ErrorIs(t, err, domain.ErrWebAuthnCredentialInvalid)
+		})
+	})
+}
+
+func TestService_BeginUserWebAuthnSignIn(t *testing.T) {
+	t.Run("should successfully begin user webauthn sign in", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			client     = domain.SessionClient{IPAddress: "127.0.0.1"}
+			ceremonyID = uuid.New()
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+
+		gomock.InOrder(
+			s.mockAuthnService.EXPECT().
+				BeginWebAuthnLogin().
+				Return([]byte(`{"publicKey":{}}`), []byte(`{"challenge":""}`), nil),
+			s.mockDataStore.EXPECT().
+				ListSignInThrottles(ctx, tx, []string{"user:webauthn-ceremony:127.0.0.1"}).
+				Return([]domain.SignInThrottle{}, nil),
+			s.mockDataStore.EXPECT().
+				FailSignInThrottle(ctx, tx, "user:webauthn-ceremony:127.0.0.1", gomock.Any(), gomock.Any()).
+				Return(domain.SignInThrottle{FailedAttempts: 1}, nil),
+			s.mockDataStore.EXPECT().
+				DeleteExpiredWebAuthnCeremonies(ctx, tx, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ pgx.Tx, expiredAt time.Time) error {
+					assert.WithinDuration(t, time.Now(), expiredAt, time.Second)
+
+					return nil
+				}),
+			s.mockDataStore.EXPECT().
+				CreateWebAuthnCeremony(ctx, tx, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ pgx.Tx, ceremony domain.EditableWebAuthnCeremony) (uuid.UUID, error) {
+					assert.Nil(t, ceremony.SubjectID)
+					assert.Equal(t, string(authn.SubjectRoleUser), ceremony.SubjectRole)
+
+					return ceremonyID, nil
+				}),
+		)
+
+		expectedOptions := domain.WebAuthnOptions{
+			CeremonyID: ceremonyID,
+			Options:    []byte(`{"publicKey":{}}`),
+		}
+
+		options, err := s.service.BeginUserWebAuthnSignIn(ctx, client)
+		require.NoError(t, err)
+		assert.Equal(t, expectedOptions, options)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when too many ceremonies were started from the client", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				client      = domain.SessionClient{IPAddress: "127.0.0.1"}
+				key         = "user:webauthn-ceremony:127.0.0.1"
+				lockedUntil = time.Now().Add(time.Minute)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			gomock.InOrder(
+				s.mockAuthnService.EXPECT().
+					BeginWebAuthnLogin().
+					Return([]byte(`{"publicKey":{}}`), []byte(`{"challenge":""}`), nil),
+				s.mockDataStore.EXPECT().
+					ListSignInThrottles(ctx, tx, []string{key}).
+					Return([]domain.SignInThrottle{{Key: key, LastFailedAt: time.Now(), LockedUntil: &lockedUntil}}, nil),
+			)
+
+			_, err := s.service.BeginUserWebAuthnSignIn(ctx, client)
+			require.ErrorIs(t, err, domain.ErrWebAuthnCeremonyThrottled)
+		})
+	})
+}
+
+func TestService_FinishUserWebAuthnSignIn(t *testing.T) {
+	newCeremony := func(expiresAt time.Time) domain.WebAuthnCeremony {
+		return domain.WebAuthnCeremony{
+			EditableWebAuthnCeremony: domain.EditableWebAuthnCeremony{
+				SubjectRole: string(authn.SubjectRoleUser),
+				Session:     []byte("session"),
+				ExpiresAt:   expiresAt,
+			},
+			ID: uuid.New(),
+		}
+	}
+
+	newCredential := func(subjectID uuid.UUID, role authn.SubjectRole) domain.WebAuthnCredential {
+		return domain.WebAuthnCredential{
+			EditableWebAuthnCredential: domain.EditableWebAuthnCredential{
+				SubjectID:    subjectID,
+				SubjectRole:  string(role),
+				CredentialID: []byte("credential id"),
+				SignCount:    4,
+			},
+			ID: uuid.New(),
+		}
+	}
+
+	// finishWebAuthnLogin returns a mock implementation of the webauthn login which looks up the subject of the
+	// credential and validates the assertion.
+	finishWebAuthnLogin := func(userHandle []byte, signCount uint32) func([]byte, []byte, func([]byte, []byte) (authn.WebAuthnSubject, error)) (authn.WebAuthnCredential, error) {
+		return func(_, _ []byte, getSubject func([]byte, []byte) (authn.WebAuthnSubject, error)) (authn.WebAuthnCredential, error) {
+			subject, err := getSubject(userHandle, []byte("credential id"))
+			if err != nil {
+				return authn.WebAuthnCredential{}, errors.New("failed to get subject")
+			}
+
+			credential := subject.Credentials[0]
+			credential.SignCount = signCount
+
+			return credential, nil
+		}
+	}
+
+	t.Run("should successfully sign in user with a passkey", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+
+			userID     = uuid.New()
+			ceremony   = newCeremony(time.Now().Add(time.Minute))
+			credential = newCredential(userID, authn.SubjectRoleUser)
+			response   = []byte("response")
+			client     = domain.SessionClient{UserAgent: "user agent", IPAddress: "127.0.0.1"}
+		)
+
+		ctrl := gomock.NewController(t)
+
+		s := newServiceTest(ctrl)
+		tx := s.newReadWriteTx(ctx, ctrl)
+		readOnlyTx := s.newReadOnlyTx(ctx, ctrl)
+
+		sessionID := uuid.New()
+
+		gomock.InOrder(
+			s.mockDataStore.EXPECT().
+				DeleteWebAuthnCeremony(ctx, tx, ceremony.ID).
+				Return(ceremony, nil),
+			s.mockAuthnService.EXPECT().
+				FinishWebAuthnLogin(ceremony.Session, response, gomock.Any()).
+				DoAndReturn(finishWebAuthnLogin(userID[:], 5)),
+			s.mockDataStore.EXPECT().
+				ListWebAuthnCredentials(ctx, readOnlyTx, userID).
+				Return([]domain.WebAuthnCredential{credential}, nil),
+			s.mockDataStore.EXPECT().
+				UseWebAuthnCredential(ctx, tx, credential.ID, int64(5)).
+				Return(nil),
+			s.mockAuthnService.EXPECT().
+				NewRefreshToken().
+				Return("refresh token", []byte("refresh token hash"), nil),
+			s.mockDataStore.EXPECT().
+				CreateSession(ctx, tx, gomock.Any()).
+				Return(sessionID, nil),
+			s.mockDataStore.EXPECT().
+				CreateRefreshToken(ctx, tx, gomock.Any()).
+				Return(uuid.New(), nil),
+			s.mockDataStore.EXPECT().
+				GetUserAdmin(ctx, tx, userID).
+				Return(false, nil),
+			s.mockDataStore.EXPECT().
+				ListUserPublisherMembers(ctx, tx, userID).
+				Return(nil, nil),
+			s.mockAuthnService.EXPECT().
+				NewJWT(userID.String(), sessionID.String(), []authn.SubjectRole{authn.SubjectRoleUser}, nil).
+				Return("access token", nil),
+		)
+
+		expectedTokens := domain.Tokens{
+			AccessToken:  "access token",
+			RefreshToken: "refresh token",
+		}
+
+		tokens, err := s.service.FinishUserWebAuthnSignIn(ctx, ceremony.ID, response, client)
+		require.NoError(t, err)
+		assert.Equal(t, expectedTokens, tokens)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the ceremony is not found", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				ceremonyID = uuid.New()
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			s.mockDataStore.EXPECT().
+				DeleteWebAuthnCeremony(ctx, tx, ceremonyID).
+				Return(domain.WebAuthnCeremony{}, domain.ErrWebAuthnCeremonyNotFound)
+
+			_, err := s.service.FinishUserWebAuthnSignIn(ctx, ceremonyID, []byte("response"), domain.SessionClient{})
+			require.ErrorIs(t, err, domain.ErrWebAuthnCeremonyInvalid)
+		})
+
+		t.Run("when the ceremony is expired", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				ceremony = newCeremony(time.Now().Add(-time.Minute))
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+
+			s.mockDataStore.EXPECT().
+				DeleteWebAuthnCeremony(ctx, tx, ceremony.ID).
+				Return(ceremony, nil)
+
+			_, err := s.service.FinishUserWebAuthnSignIn(ctx, ceremony.ID, []byte("response"), domain.SessionClient{})
+			require.ErrorIs(t, err, domain.ErrWebAuthnCeremonyInvalid)
+		})
+
+		t.Run("when the credential belongs to a publisher", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				publisherID = uuid.New()
+				ceremony    = newCeremony(time.Now().Add(time.Minute))
+				credential  = newCredential(publisherID, authn.SubjectRolePublisher)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+			readOnlyTx := s.newReadOnlyTx(ctx, ctrl)
+
+			gomock.InOrder(
+				s.mockDataStore.EXPECT().
+					DeleteWebAuthnCeremony(ctx, tx, ceremony.ID).
+					Return(ceremony, nil),
+				s.mockAuthnService.EXPECT().
+					FinishWebAuthnLogin(ceremony.Session, []byte("response"), gomock.Any()).
+					DoAndReturn(finishWebAuthnLogin(publisherID[:], 5)),
+				s.mockDataStore.EXPECT().
+					ListWebAuthnCredentials(ctx, readOnlyTx, publisherID).
+					Return([]domain.WebAuthnCredential{credential}, nil),
+			)
+
+			_, err := s.service.FinishUserWebAuthnSignIn(ctx, ceremony.ID, []byte("response"), domain.SessionClient{})
+			require.ErrorIs(t, err, domain.ErrWebAuthnCredentialInvalid)
+		})
+
+		t.Run("when the sign count did not increase", func(t *testing.T) {
+			var (
+				ctx = context.Background()
+
+				userID     = uuid.New()
+				ceremony   = newCeremony(time.Now().Add(time.Minute))
+				credential = newCredential(userID, authn.SubjectRoleUser)
+			)
+
+			ctrl := gomock.NewController(t)
+
+			s := newServiceTest(ctrl)
+			tx := s.newReadWriteTx(ctx, ctrl)
+			readOnlyTx := s.newReadOnlyTx(ctx, ctrl)
+
+			gomock.InOrder(
+				s.mockDataStore.EXPECT().
+					DeleteWebAuthnCeremony(ctx, tx, ceremony.ID).
+					Return(ceremony, nil),
+				s.mockAuthnService.EXPECT().
+					FinishWebAuthnLogin(ceremony.Session, []byte("response"), gomock.Any()).
+					DoAndReturn(finishWebAuthnLogin(userID[:], 5)),
+				s.mockDataStore.EXPECT().
+					ListWebAuthnCredentials(ctx, readOnlyTx, userID).
+					Return([]domain.WebAuthnCredential{credential}, nil),
+				s.mockDataStore.EXPECT().
+					UseWebAuthnCredential(ctx, tx, credential.ID, int64(5)).
+					Return(domain.ErrWebAuthnCredentialInvalid),
+			)
+
+			_, err := s.service.FinishUserWebAuthnSignIn(ctx, ceremony.ID, []byte("response"), domain.SessionClient{})
+			require.ErrorIs(t, err, domain.ErrWebAuthnCredentialInvalid)
+		})
+	})
+}
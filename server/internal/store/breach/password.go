@@ -0,0 +1,64 @@
+package breach
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+const (
+	hashPrefixLength = 5
+	rangeFileExt     = ".txt"
+	countSeparator   = ':'
+)
+
+// Compromised returns whether the password is found in the breached password corpus. Hashes with a zero count, which
+// are added to the range files as padding, are not considered breached.
+func (s *store) Compromised(password []byte) (bool, error) {
+	sum := sha1.Sum(password)
+	hash := bytes.ToUpper([]byte(hex.EncodeToString(sum[:])))
+	prefix, suffix := hash[:hashPrefixLength], hash[hashPrefixLength:]
+
+	file, err := os.Open(filepath.Join(s.directory, string(prefix)+rangeFileExt))
+	if err != nil {
+		// A missing range file means that no breached password has a hash with that prefix.
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("store: failed to open range file: %w", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+
+		lineSuffix, count, _ := bytes.Cut(line, []byte{countSeparator})
+		if !bytes.EqualFold(lineSuffix, suffix) {
+			continue
+		}
+
+		n, err := strconv.Atoi(string(count))
+		if err != nil {
+			return false, fmt.Errorf("store: failed to parse range file count: %w", err)
+		}
+
+		return n > 0, nil
+	}
+
+	err = scanner.Err()
+	if err != nil {
+		return false, fmt.Errorf("store: failed to read range file: %w", err)
+	}
+
+	return false, nil
+}
@@ -0,0 +1,69 @@
+package breach
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goofr-group/gaming-store/server/internal/config"
+)
+
+// newRangeFileTest writes the range file with the hash of each password and its count to the directory.
+func newRangeFileTest(t *testing.T, directory string, counts map[string]int) {
+	t.Helper()
+
+	lines := make(map[string][]string)
+
+	for password, count := range counts {
+		sum := sha1.Sum([]byte(password))
+		hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+		prefix, suffix := hash[:hashPrefixLength], hash[hashPrefixLength:]
+
+		lines[prefix] = append(lines[prefix], suffix+string(countSeparator)+strconv.Itoa(count))
+	}
+
+	for prefix, suffixes := range lines {
+		err := os.WriteFile(filepath.Join(directory, prefix+rangeFileExt), []byte(strings.Join(suffixes, "\r\n")), 0o600)
+		require.NoError(t, err)
+	}
+}
+
+func TestStore_Compromised(t *testing.T) {
+	directory := t.TempDir()
+
+	newRangeFileTest(t, directory, map[string]int{
+		"password123": 251682,
+		"padding":     0,
+	})
+
+	s, err := New(config.BreachedPasswords{Enabled: true, Directory: directory})
+	require.NoError(t, err)
+
+	t.Run("should successfully find breached password", func(t *testing.T) {
+		compromised, err := s.Compromised([]byte("password123"))
+		require.NoError(t, err)
+		assert.True(t, compromised)
+	})
+
+	t.Run("should successfully not find password", func(t *testing.T) {
+		for _, password := range []string{"ReallySecurePassword$123", "padding"} {
+			compromised, err := s.Compromised([]byte(password))
+			require.NoError(t, err)
+			assert.False(t, compromised)
+		}
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when the directory does not exist", func(t *testing.T) {
+			_, err := New(config.BreachedPasswords{Enabled: true, Directory: filepath.Join(directory, "missing")})
+			require.Error(t, err)
+		})
+	})
+}
@@ -0,0 +1,35 @@
+package breach
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/goofr-group/gaming-store/server/internal/config"
+)
+
+// store defines the breached password store structure.
+//
+// The corpus is a directory of SHA-1 hashes of breached passwords partitioned by prefix, as served by the k-anonymity
+// range API of Have I Been Pwned. Each file is named after the first 5 hexadecimal characters of the hashes it holds,
+// and contains one "SUFFIX:COUNT" line per hash, so that a lookup only reads a small file and passwords never leave the
+// server.
+type store struct {
+	directory string
+}
+
+// New returns a new breached password store.
+func New(config config.BreachedPasswords) (*store, error) {
+	info, err := os.Stat(config.Directory)
+	if err != nil {
+		return nil, fmt.Errorf("store: failed to open breached passwords directory: %w", err)
+	}
+
+	if !info.IsDir() {
+		return nil, fmt.Errorf("store: failed to open breached passwords directory: %w", errors.New("not a directory"))
+	}
+
+	return &store{
+		directory: config.Directory,
+	}, nil
+}
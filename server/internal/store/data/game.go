@@ -16,19 +16,19 @@ const (
 	constraintGamesPublisherIDFkey          = "games_publisher_id_fkey"
 	constraintGamesPreviewMultimediaIDFkey  = "games_preview_multimedia_id_fkey"
 	constraintGamesDownloadMultimediaIDFkey = "games_download_multimedia_id_fkey"
+	constraintInvoicesGamesGameIDFkey       = "invoices_games_game_id_fkey"
 )
 
 // CreateGame executes a query to create a game with the specified data.
 func (s *store) CreateGame(ctx context.Context, tx pgx.Tx, publisherID uuid.UUID, editableGame domain.EditableGame) (uuid.UUID, error) {
 	row := tx.QueryRow(ctx, `
-		INSERT INTO games (publisher_id, title, price, is_active, release_date, description, age_rating, features, languages, requirements, preview_multimedia_id, download_multimedia_id)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		INSERT INTO games (publisher_id, title, price, release_date, description, age_rating, features, languages, requirements, preview_multimedia_id, download_multimedia_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 		RETURNING id
 	`,
 		publisherID,
 		editableGame.Title,
 		editableGame.Price,
-		editableGame.IsActive,
 		editableGame.ReleaseDate,
 		editableGame.Description,
 		editableGame.AgeRating,
@@ -60,52 +60,7 @@ func (s *store) CreateGame(ctx context.Context, tx pgx.Tx, publisherID uuid.UUID
 
 // ListGames executes a query to return the games for the specified filter.
 func (s *store) ListGames(ctx context.Context, tx pgx.Tx, filter domain.GamesPaginatedFilter) (domain.PaginatedResponse[domain.Game], error) {
-	var (
-		filterFields []listSQLWhereOperator
-		argsWhere    []any
-	)
-
-	if filter.PublisherID != nil {
-		filterFields = append(filterFields, listSQLWhereOperatorEqual("g.publisher_id"))
-		argsWhere = append(argsWhere, *filter.PublisherID)
-	}
-
-	if filter.Title != nil {
-		filterFields = append(filterFields, listSQLWhereOperatorILike("g.title"))
-		argsWhere = append(argsWhere, *filter.Title)
-	}
-
-	if filter.PriceUnder != nil {
-		filterFields = append(filterFields, listSQLWhereOperatorLessThanEqual("g.price"))
-		argsWhere = append(argsWhere, *filter.PriceUnder)
-	}
-
-	if filter.PriceAbove != nil {
-		filterFields = append(filterFields, listSQLWhereOperatorGreaterThanEqual("g.price"))
-		argsWhere = append(argsWhere, *filter.PriceAbove)
-	}
-
-	if filter.IsActive != nil {
-		filterFields = append(filterFields, listSQLWhereOperatorEqual("g.is_active"))
-		argsWhere = append(argsWhere, *filter.IsActive)
-	}
-
-	if filter.ReleaseDateBefore != nil {
-		filterFields = append(filterFields, listSQLWhereOperatorLessThanEqual("g.release_date"))
-		argsWhere = append(argsWhere, *filter.ReleaseDateBefore)
-	}
-
-	if filter.ReleaseDateAfter != nil {
-		filterFields = append(filterFields, listSQLWhereOperatorGreaterThanEqual("g.release_date"))
-		argsWhere = append(argsWhere, *filter.ReleaseDateAfter)
-	}
-
-	if filter.TagIDs != nil {
-		filterFields = append(filterFields, listSQLWhereOperatorArrayContainsArray("(SELECT array_agg(tag_id) FROM games_tags WHERE game_id = g.id)"))
-		argsWhere = append(argsWhere, *filter.TagIDs)
-	}
-
-	sqlWhere := listSQLWhere(filterFields)
+	sqlWhere, argsWhere, titlePosition := listGamesSQLWhere(filter)
 
 	row := tx.QueryRow(ctx, `
 		SELECT count(g.id) 
@@ -140,11 +95,16 @@ func (s *store) ListGames(ctx context.Context, tx pgx.Tx, filter domain.GamesPag
 		sortField = "g.release_date"
 	case domain.GamePaginatedSortUserCount:
 		sortField = "count(ul.user_id)"
+	case domain.GamePaginatedSortRelevance:
+		// Without a search there is nothing to rank, so the default sort field is kept.
+		if titlePosition != 0 {
+			sortField = listSQLRelevance("g.search_vector", "g.title", titlePosition)
+		}
 	}
 
 	rows, err := tx.Query(ctx, `
-		SELECT g.id, g.title, g.price, g.is_active, g.release_date, g.description, g.age_rating, g.features, g.languages, g.requirements, g.created_at, g.modified_at,
-			p.id, p.email, p.name, p.address, p.country, p.vatin, p.created_at, p.modified_at,
+		SELECT g.id, g.title, g.price, g.status, g.review_notes, g.release_date, g.description, g.age_rating, g.features, g.languages, g.requirements, g.created_at, g.modified_at,
+			p.id, p.email, p.name, p.address, p.country, p.vatin, p.verification_status, p.verification_reason, p.created_at, p.modified_at,
 			pm.id, pm.checksum, pm.media_type, pm.url, pm.created_at,
 			gpm.id, gpm.checksum, gpm.media_type, gpm.url, gpm.created_at,
 			gdm.id, gdm.checksum, gdm.media_type, gdm.url, gdm.created_at
@@ -194,6 +154,59 @@ func (s *store) ListGames(ctx context.Context, tx pgx.Tx, filter domain.GamesPag
 	}, nil
 }
 
+// listGamesSQLWhere returns the SQL WHERE clause and its arguments for the specified filter.
+// It also returns the position of the title argument, or zero if the title is not filtered.
+func listGamesSQLWhere(filter domain.GamesPaginatedFilter) (string, []any, int) {
+	var (
+		filterFields  []listSQLWhereOperator
+		argsWhere     []any
+		titlePosition int
+	)
+
+	if filter.PublisherID != nil {
+		filterFields = append(filterFields, listSQLWhereOperatorEqual("g.publisher_id"))
+		argsWhere = append(argsWhere, *filter.PublisherID)
+	}
+
+	if filter.Title != nil {
+		filterFields = append(filterFields, listSQLWhereOperatorFullTextSearch("g.search_vector", "g.title"))
+		argsWhere = append(argsWhere, *filter.Title)
+		titlePosition = len(argsWhere)
+	}
+
+	if filter.PriceUnder != nil {
+		filterFields = append(filterFields, listSQLWhereOperatorLessThanEqual("g.price"))
+		argsWhere = append(argsWhere, *filter.PriceUnder)
+	}
+
+	if filter.PriceAbove != nil {
+		filterFields = append(filterFields, listSQLWhereOperatorGreaterThanEqual("g.price"))
+		argsWhere = append(argsWhere, *filter.PriceAbove)
+	}
+
+	if filter.Status != nil {
+		filterFields = append(filterFields, listSQLWhereOperatorEqual("g.status"))
+		argsWhere = append(argsWhere, *filter.Status)
+	}
+
+	if filter.ReleaseDateBefore != nil {
+		filterFields = append(filterFields, listSQLWhereOperatorLessThanEqual("g.release_date"))
+		argsWhere = append(argsWhere, *filter.ReleaseDateBefore)
+	}
+
+	if filter.ReleaseDateAfter != nil {
+		filterFields = append(filterFields, listSQLWhereOperatorGreaterThanEqual("g.release_date"))
+		argsWhere = append(argsWhere, *filter.ReleaseDateAfter)
+	}
+
+	if filter.TagIDs != nil {
+		filterFields = append(filterFields, listSQLWhereOperatorArrayContainsArray("(SELECT array_agg(tag_id) FROM games_tags WHERE game_id = g.id)"))
+		argsWhere = append(argsWhere, *filter.TagIDs)
+	}
+
+	return listSQLWhere(filterFields), argsWhere, titlePosition
+}
+
 // ListGamesRecommended executes a query to return the recommended games for the specified filter.
 func (s *store) ListGamesRecommended(ctx context.Context, tx pgx.Tx, filter domain.GamesRecommendedPaginatedFilter) (domain.PaginatedResponse[domain.Game], error) {
 	var (
@@ -201,8 +214,8 @@ func (s *store) ListGamesRecommended(ctx context.Context, tx pgx.Tx, filter doma
 		argsWhere    []any
 	)
 
-	filterFields = append(filterFields, listSQLWhereOperatorEqual("g.is_active"))
-	argsWhere = append(argsWhere, true)
+	filterFields = append(filterFields, listSQLWhereOperatorEqual("g.status"))
+	argsWhere = append(argsWhere, domain.GameStatusPublished)
 
 	filterFields = append(filterFields, listSQLWhereOperatorGreaterThanEqual("g.release_date"))
 	argsWhere = append(argsWhere, time.Now().AddDate(-1, 0, 0).UTC())
@@ -259,8 +272,8 @@ func (s *store) ListGamesRecommended(ctx context.Context, tx pgx.Tx, filter doma
 
 	rows, err := tx.Query(ctx, `
 		SELECT DISTINCT ON (p.id) count(ul.user_id) OVER (PARTITION BY g.id) AS user_count,
-			g.id, g.title, g.price, g.is_active, g.release_date, g.description, g.age_rating, g.features, g.languages, g.requirements, g.created_at, g.modified_at,
-			p.id, p.email, p.name, p.address, p.country, p.vatin, p.created_at, p.modified_at,
+			g.id, g.title, g.price, g.status, g.review_notes, g.release_date, g.description, g.age_rating, g.features, g.languages, g.requirements, g.created_at, g.modified_at,
+			p.id, p.email, p.name, p.address, p.country, p.vatin, p.verification_status, p.verification_reason, p.created_at, p.modified_at,
 			pm.id, pm.checksum, pm.media_type, pm.url, pm.created_at,
 			gpm.id, gpm.checksum, gpm.media_type, gpm.url, gpm.created_at,
 			gdm.id, gdm.checksum, gdm.media_type, gdm.url, gdm.created_at
@@ -310,11 +323,83 @@ func (s *store) ListGamesRecommended(ctx context.Context, tx pgx.Tx, filter doma
 	}, nil
 }
 
+// UpdateGameStatus executes a query to update the status and review notes of the game with the specified identifier.
+func (s *store) UpdateGameStatus(ctx context.Context, tx pgx.Tx, id uuid.UUID, status domain.GameStatus, reviewNotes *domain.GameReviewNotes) error {
+	commandTag, err := tx.Exec(ctx, `
+		UPDATE games
+		SET status = $2, review_notes = $3
+		WHERE id = $1
+	`,
+		id,
+		status,
+		reviewNotes,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, domain.ErrGameNotFound)
+	}
+
+	return nil
+}
+
+// DeleteGame executes a query to delete the game with the specified identifier. Games that were purchased by users
+// can not be deleted.
+func (s *store) DeleteGame(ctx context.Context, tx pgx.Tx, id uuid.UUID) error {
+	commandTag, err := tx.Exec(ctx, `
+		DELETE FROM games
+		WHERE id = $1
+	`,
+		id,
+	)
+	if err != nil {
+		switch constraintNameFromError(err) {
+		case constraintUsersLibrariesGameIDFkey, constraintInvoicesGamesGameIDFkey:
+			return fmt.Errorf("%s: %w", descriptionFailedExec, domain.ErrGameHasOwners)
+		default:
+			return fmt.Errorf("%s: %w", descriptionFailedExec, err)
+		}
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, domain.ErrGameNotFound)
+	}
+
+	return nil
+}
+
+// GetGamePublisherID executes a query to return the identifier of the publisher of the game with the specified
+// identifier.
+func (s *store) GetGamePublisherID(ctx context.Context, tx pgx.Tx, id uuid.UUID) (uuid.UUID, error) {
+	row := tx.QueryRow(ctx, `
+		SELECT publisher_id
+		FROM games
+		WHERE id = $1
+	`,
+		id,
+	)
+
+	var publisherID uuid.UUID
+
+	err := row.Scan(&publisherID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.UUID{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, domain.ErrGameNotFound)
+		}
+
+		return uuid.UUID{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, err)
+	}
+
+	return publisherID, nil
+}
+
 // GetGameByID executes a query to return the game with the specified identifier.
 func (s *store) GetGameByID(ctx context.Context, tx pgx.Tx, id uuid.UUID) (domain.Game, error) {
 	row := tx.QueryRow(ctx, `
-		SELECT g.id, g.title, g.price, g.is_active, g.release_date, g.description, g.age_rating, g.features, g.languages, g.requirements, g.created_at, g.modified_at,
-			p.id, p.email, p.name, p.address, p.country, p.vatin, p.created_at, p.modified_at,
+		SELECT g.id, g.title, g.price, g.status, g.review_notes, g.release_date, g.description, g.age_rating, g.features, g.languages, g.requirements, g.created_at, g.modified_at,
+			p.id, p.email, p.name, p.address, p.country, p.vatin, p.verification_status, p.verification_reason, p.created_at, p.modified_at,
 			pm.id, pm.checksum, pm.media_type, pm.url, pm.created_at,
 			gpm.id, gpm.checksum, gpm.media_type, gpm.url, gpm.created_at,
 			gdm.id, gdm.checksum, gdm.media_type, gdm.url, gdm.created_at
@@ -360,21 +445,19 @@ func (s *store) PatchGame(ctx context.Context, tx pgx.Tx, id uuid.UUID, editable
 		UPDATE games SET
 			title = coalesce($2, title),
 			price = coalesce($3, price),
-			is_active = coalesce($4, is_active),
-			release_date = coalesce($5, release_date),
-			description = coalesce($6, description),
-			age_rating = coalesce($7, age_rating),
-			features = coalesce($8, features),
-			languages = coalesce($9, languages),
-			requirements = coalesce($10, requirements),
-			preview_multimedia_id = coalesce($11, preview_multimedia_id),
-			download_multimedia_id = coalesce($12, download_multimedia_id)
+			release_date = coalesce($4, release_date),
+			description = coalesce($5, description),
+			age_rating = coalesce($6, age_rating),
+			features = coalesce($7, features),
+			languages = coalesce($8, languages),
+			requirements = coalesce($9, requirements),
+			preview_multimedia_id = coalesce($10, preview_multimedia_id),
+			download_multimedia_id = coalesce($11, download_multimedia_id)
 		WHERE id = $1
 	`,
 		id,
 		editableGame.Title,
 		editableGame.Price,
-		editableGame.IsActive,
 		editableGame.ReleaseDate,
 		editableGame.Description,
 		editableGame.AgeRating,
@@ -424,7 +507,8 @@ func getGameFromRow(row pgx.Row) (domain.Game, error) {
 		&game.ID,
 		&game.Title,
 		&game.Price,
-		&game.IsActive,
+		&game.Status,
+		&game.ReviewNotes,
 		&game.ReleaseDate,
 		&game.Description,
 		&game.AgeRating,
@@ -440,6 +524,8 @@ func getGameFromRow(row pgx.Row) (domain.Game, error) {
 		&game.Publisher.Address,
 		&game.Publisher.Country,
 		&game.Publisher.Vatin,
+		&game.Publisher.VerificationStatus,
+		&game.Publisher.VerificationReason,
 		&game.Publisher.CreatedAt,
 		&game.Publisher.ModifiedAt,
 
@@ -532,7 +618,8 @@ func getGameRecommendedFromRow(row pgx.Row) (domain.Game, error) {
 		&game.ID,
 		&game.Title,
 		&game.Price,
-		&game.IsActive,
+		&game.Status,
+		&game.ReviewNotes,
 		&game.ReleaseDate,
 		&game.Description,
 		&game.AgeRating,
@@ -548,6 +635,8 @@ func getGameRecommendedFromRow(row pgx.Row) (domain.Game, error) {
 		&game.Publisher.Address,
 		&game.Publisher.Country,
 		&game.Publisher.Vatin,
+		&game.Publisher.VerificationStatus,
+		&game.Publisher.VerificationReason,
 		&game.Publisher.CreatedAt,
 		&game.Publisher.ModifiedAt,
 
@@ -0,0 +1,179 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+// ListGamesChart executes a query to return the games of the chart with the specified filter, ordered by their
+// score. The charts are read from the table filled by RefreshGamesCharts.
+func (s *store) ListGamesChart(ctx context.Context, tx pgx.Tx, filter domain.GamesChartPaginatedFilter) (domain.PaginatedResponse[domain.Game], error) {
+	var (
+		filterFields []listSQLWhereOperator
+		argsWhere    []any
+	)
+
+	filterFields = append(filterFields, listSQLWhereOperatorEqual("gc.type"))
+	argsWhere = append(argsWhere, filter.Type)
+
+	filterFields = append(filterFields, listSQLWhereOperatorEqual("gc.time_window"))
+	argsWhere = append(argsWhere, filter.Window)
+
+	filterFields = append(filterFields, listSQLWhereOperatorEqual("g.status"))
+	argsWhere = append(argsWhere, domain.GameStatusPublished)
+
+	sqlWhere := listSQLWhere(filterFields)
+
+	row := tx.QueryRow(ctx, `
+		SELECT count(gc.game_id)
+		FROM games_charts gc
+		INNER JOIN games g
+			ON g.id = gc.game_id
+	`+sqlWhere,
+		argsWhere...,
+	)
+
+	var total int
+
+	err := row.Scan(&total)
+	if err != nil {
+		return domain.PaginatedResponse[domain.Game]{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, err)
+	}
+
+	rows, err := tx.Query(ctx, `
+		SELECT g.id, g.title, g.price, g.status, g.review_notes, g.release_date, g.description, g.age_rating, g.features, g.languages, g.requirements, g.created_at, g.modified_at,
+			p.id, p.email, p.name, p.address, p.country, p.vatin, p.verification_status, p.verification_reason, p.created_at, p.modified_at,
+			pm.id, pm.checksum, pm.media_type, pm.url, pm.created_at,
+			gpm.id, gpm.checksum, gpm.media_type, gpm.url, gpm.created_at,
+			gdm.id, gdm.checksum, gdm.media_type, gdm.url, gdm.created_at
+		FROM games_charts gc
+		INNER JOIN games g
+			ON g.id = gc.game_id
+		INNER JOIN publishers p
+			ON p.id = g.publisher_id
+		LEFT JOIN multimedia pm
+			ON pm.id = p.picture_multimedia_id
+		INNER JOIN multimedia gpm
+			ON gpm.id = g.preview_multimedia_id
+		LEFT JOIN multimedia gdm
+			ON gdm.id = g.download_multimedia_id
+	`+sqlWhere+`
+		ORDER BY gc.score DESC, g.created_at DESC
+	`+listSQLLimitOffset(filter.Limit, filter.Offset),
+		argsWhere...,
+	)
+	if err != nil {
+		return domain.PaginatedResponse[domain.Game]{}, fmt.Errorf("%s: %w", descriptionFailedQuery, err)
+	}
+	defer rows.Close()
+
+	games, err := getGamesFromRows(rows)
+	if err != nil {
+		return domain.PaginatedResponse[domain.Game]{}, fmt.Errorf("%s: %w", descriptionFailedScanRows, err)
+	}
+
+	for i, game := range games {
+		game.Multimedia, err = s.GetGameMultimedia(ctx, tx, game.ID)
+		if err != nil {
+			return domain.PaginatedResponse[domain.Game]{}, err
+		}
+
+		game.Tags, err = s.GetGameTags(ctx, tx, game.ID)
+		if err != nil {
+			return domain.PaginatedResponse[domain.Game]{}, err
+		}
+
+		games[i] = game
+	}
+
+	return domain.PaginatedResponse[domain.Game]{
+		Total:   total,
+		Results: games,
+	}, nil
+}
+
+// RefreshGamesCharts executes the queries to recompute the charts of every type and window.
+// The top sellers are scored by their purchases in the window, and the trending games by their library additions in
+// the window, multiplied by their growth over the previous window. Every event is weighted with an exponential time
+// decay, and only the best scored active and released games are kept for each chart.
+func (s *store) RefreshGamesCharts(ctx context.Context, tx pgx.Tx) error {
+	_, err := tx.Exec(ctx, `
+		DELETE FROM games_charts
+	`)
+	if err != nil {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, err)
+	}
+
+	for _, window := range domain.GameChartWindows {
+		duration := window.Duration()
+		halfLife := time.Duration(float64(duration) * domain.GameChartHalfLifeRatio)
+
+		_, err = tx.Exec(ctx, `
+			WITH sales AS (
+				SELECT ig.game_id, sum(exp(-ln(2) * extract(epoch FROM LOCALTIMESTAMP - ig.created_at)::float8 / $2::float8)) AS score
+				FROM invoices_games ig
+				WHERE ig.created_at >= LOCALTIMESTAMP - make_interval(secs => $1::float8)
+				GROUP BY ig.game_id
+			), additions_recent AS (
+				SELECT ul.game_id, count(ul.user_id) AS total,
+					sum(exp(-ln(2) * extract(epoch FROM LOCALTIMESTAMP - ul.created_at)::float8 / $2::float8)) AS score
+				FROM users_libraries ul
+				WHERE ul.created_at >= LOCALTIMESTAMP - make_interval(secs => $1::float8)
+				GROUP BY ul.game_id
+			), additions_previous AS (
+				SELECT ul.game_id, count(ul.user_id) AS total
+				FROM users_libraries ul
+				WHERE ul.created_at >= LOCALTIMESTAMP - 2 * make_interval(secs => $1::float8)
+					AND ul.created_at < LOCALTIMESTAMP - make_interval(secs => $1::float8)
+				GROUP BY ul.game_id
+			), trending AS (
+				SELECT ar.game_id, ar.score * (ar.total + 1)::float8 / (coalesce(ap.total, 0) + 1) AS score
+				FROM additions_recent ar
+				LEFT JOIN additions_previous ap
+					ON ap.game_id = ar.game_id
+			), charts AS (
+				SELECT $6::varchar AS type, s.game_id, s.score
+				FROM sales s
+				UNION ALL
+				SELECT $7::varchar, t.game_id, t.score
+				FROM trending t
+				UNION ALL
+				SELECT $8::varchar, t.game_id, t.score
+				FROM trending t
+				INNER JOIN games g
+					ON g.id = t.game_id
+				WHERE g.release_date >= CURRENT_DATE - $3::int
+			), charts_ranked AS (
+				SELECT c.type, c.game_id, c.score,
+					row_number() OVER (PARTITION BY c.type ORDER BY c.score DESC) AS position
+				FROM charts c
+				INNER JOIN games g
+					ON g.id = c.game_id
+				WHERE g.status = 'published' AND g.release_date <= CURRENT_DATE
+			)
+			INSERT INTO games_charts (type, time_window, game_id, score)
+			SELECT type, $5, game_id, score
+			FROM charts_ranked
+			WHERE position <= $4
+		`,
+			duration.Seconds(),
+			halfLife.Seconds(),
+			int(domain.GameChartNewPeriod.Hours()/24),
+			domain.GameChartMaxPerChart,
+			window,
+			domain.GameChartTypeTopSellers,
+			domain.GameChartTypeTrending,
+			domain.GameChartTypeNewAndTrending,
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", descriptionFailedExec, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,162 @@
+//go:build integration
+
+package data
+
+import (
+	"context"
+	"hash/crc32"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+func TestStore_ListGamesChart(t *testing.T) {
+	t.Run("should successfully list games charts after refreshing them", func(t *testing.T) {
+		ctx := context.Background()
+
+		tx := newTx(t, ctx)
+
+		multimediaID, err := s.CreateMultimedia(ctx, tx, domain.MultimediaObject{
+			Checksum:  crc32.ChecksumIEEE([]byte("test")),
+			MediaType: "png",
+			URL:       "url",
+		})
+		require.NoError(t, err)
+
+		publisherID, err := s.CreatePublisher(ctx, tx, domain.EditablePublisherWithPassword{
+			EditablePublisher: domain.EditablePublisher{
+				Email:   "publisher@email.com",
+				Name:    "publisher name",
+				Address: "address",
+				Country: domain.Country{Region: language.MustParseRegion("pt")},
+				Vatin:   "123456789",
+			},
+			Password: "password",
+		})
+		require.NoError(t, err)
+
+		releaseDate := time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC)
+
+		editableGame := domain.EditableGame{
+			Title:       "title",
+			Price:       10,
+			ReleaseDate: &releaseDate,
+			Description: "description",
+			AgeRating:   "18",
+			Features:    "features",
+			Languages: domain.GameLanguages{
+				{Tag: language.English},
+			},
+			Requirements: domain.GameRequirements{
+				Minimum:     "minimum requirements",
+				Recommended: "recommended requirements",
+			},
+			PreviewMultimediaID:  multimediaID,
+			DownloadMultimediaID: &multimediaID,
+		}
+
+		game1, err := s.CreateGame(ctx, tx, publisherID, editableGame)
+		require.NoError(t, err)
+
+		err = s.UpdateGameStatus(ctx, tx, game1, domain.GameStatusPublished, nil)
+		require.NoError(t, err)
+
+		// Game 2 is considered new.
+		newReleaseDate := time.Now().AddDate(0, 0, -10)
+		editableGame.ReleaseDate = &newReleaseDate
+
+		game2, err := s.CreateGame(ctx, tx, publisherID, editableGame)
+		require.NoError(t, err)
+
+		err = s.UpdateGameStatus(ctx, tx, game2, domain.GameStatusPublished, nil)
+		require.NoError(t, err)
+
+		editableUser := domain.EditableUserWithPassword{
+			EditableUser: domain.EditableUser{
+				Username:    "username1",
+				Email:       "user1@email.com",
+				DisplayName: "user name",
+				DateOfBirth: domain.UserDateOfBirth(time.Date(2000, 02, 01, 0, 0, 0, 0, time.UTC)),
+				Address:     "address",
+				Country:     domain.Country{Region: language.MustParseRegion("pt")},
+				Vatin:       "123456781",
+			},
+			Password: "password",
+		}
+
+		user1, err := s.CreateUser(ctx, tx, editableUser)
+		require.NoError(t, err)
+
+		editableUser.Username = "username2"
+		editableUser.Email = "user2@email.com"
+		editableUser.Vatin = "123456782"
+
+		user2, err := s.CreateUser(ctx, tx, editableUser)
+		require.NoError(t, err)
+
+		// Game 1 was recently purchased by both users, while game 2 was added by one user in the previous window and by
+		// the other user recently.
+		_, err = tx.Exec(ctx, `
+			INSERT INTO users_libraries (user_id, game_id, created_at)
+			VALUES ($1, $3, LOCALTIMESTAMP - interval '30 hours'), ($2, $3, LOCALTIMESTAMP - interval '2 days'),
+				($1, $4, LOCALTIMESTAMP - interval '10 days'), ($2, $4, LOCALTIMESTAMP - interval '1 hour')
+		`, user1, user2, game1, game2)
+		require.NoError(t, err)
+
+		_, err = tx.Exec(ctx, `
+			WITH invoices_users AS (
+				INSERT INTO invoices (user_id, user_vatin, created_at)
+				VALUES ($1, '123456781', LOCALTIMESTAMP - interval '30 hours'), ($2, '123456782', LOCALTIMESTAMP - interval '2 days')
+				RETURNING id, created_at
+			)
+			INSERT INTO invoices_games (invoice_id, game_id, price, tax, publisher_vatin, created_at)
+			SELECT id, $3, 10, 0.23, '123456789', created_at
+			FROM invoices_users
+		`, user1, user2, game1)
+		require.NoError(t, err)
+
+		err = s.RefreshGamesCharts(ctx, tx)
+		require.NoError(t, err)
+
+		filter := domain.GamesChartPaginatedFilter{
+			PaginatedRequestBase: domain.PaginatedRequestBase{
+				Limit: 10,
+			},
+			Type:   domain.GameChartTypeTopSellers,
+			Window: domain.GameChartWindow7d,
+		}
+
+		games, err := s.ListGamesChart(ctx, tx, filter)
+		require.NoError(t, err)
+		require.Equal(t, 1, games.Total)
+		assert.Equal(t, game1, games.Results[0].ID)
+
+		filter.Type = domain.GameChartTypeTrending
+
+		games, err = s.ListGamesChart(ctx, tx, filter)
+		require.NoError(t, err)
+		require.Equal(t, 2, games.Total)
+		assert.Equal(t, game1, games.Results[0].ID)
+		assert.Equal(t, game2, games.Results[1].ID)
+
+		filter.Type = domain.GameChartTypeNewAndTrending
+
+		games, err = s.ListGamesChart(ctx, tx, filter)
+		require.NoError(t, err)
+		require.Equal(t, 1, games.Total)
+		assert.Equal(t, game2, games.Results[0].ID)
+
+		// Only the game added in the last day is in the smaller window.
+		filter.Window = domain.GameChartWindow1d
+
+		games, err = s.ListGamesChart(ctx, tx, filter)
+		require.NoError(t, err)
+		require.Equal(t, 1, games.Total)
+		assert.Equal(t, game2, games.Results[0].ID)
+	})
+}
@@ -0,0 +1,198 @@
+package data
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+// ListGamesFacets executes a query to return the facets of the games for the specified filter.
+func (s *store) ListGamesFacets(ctx context.Context, tx pgx.Tx, filter domain.GamesPaginatedFilter) (domain.GamesFacets, error) {
+	sqlWhere, argsWhere, _ := listGamesSQLWhere(filter)
+
+	var facets domain.GamesFacets
+
+	for _, facet := range filter.Facets {
+		var err error
+
+		switch facet {
+		case domain.GameFacetTags:
+			facets.Tags, err = listGamesFacetTags(ctx, tx, sqlWhere, argsWhere)
+		case domain.GameFacetPrices:
+			facets.Prices, err = listGamesFacetPrices(ctx, tx, sqlWhere, argsWhere)
+		case domain.GameFacetLanguages:
+			facets.Languages, err = listGamesFacetLanguages(ctx, tx, sqlWhere, argsWhere)
+		case domain.GameFacetAgeRatings:
+			facets.AgeRatings, err = listGamesFacetAgeRatings(ctx, tx, sqlWhere, argsWhere)
+		}
+
+		if err != nil {
+			return domain.GamesFacets{}, err
+		}
+	}
+
+	return facets, nil
+}
+
+// listGamesFacetTags executes a query to return the tag facet of the games matching the specified SQL WHERE clause.
+func listGamesFacetTags(ctx context.Context, tx pgx.Tx, sqlWhere string, argsWhere []any) ([]domain.GameFacetTag, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT t.id, t.name, t.description, t.created_at, t.modified_at, count(g.id)
+		FROM games g
+		INNER JOIN games_tags gt
+			ON gt.game_id = g.id
+		INNER JOIN tags t
+			ON t.id = gt.tag_id
+	`+sqlWhere+`
+		GROUP BY t.id
+		ORDER BY count(g.id) DESC, t.name
+	`,
+		argsWhere...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", descriptionFailedQuery, err)
+	}
+	defer rows.Close()
+
+	facetTags := make([]domain.GameFacetTag, 0)
+
+	for rows.Next() {
+		var facetTag domain.GameFacetTag
+
+		err := rows.Scan(
+			&facetTag.Tag.ID,
+			&facetTag.Tag.Name,
+			&facetTag.Tag.Description,
+			&facetTag.Tag.CreatedAt,
+			&facetTag.Tag.ModifiedAt,
+			&facetTag.Count,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", descriptionFailedScanRows, err)
+		}
+
+		facetTags = append(facetTags, facetTag)
+	}
+
+	return facetTags, nil
+}
+
+// listGamesFacetPrices executes a query to return the price facet of the games matching the specified SQL WHERE
+// clause. Every bucket defined by domain.GameFacetPriceBounds is returned, even if no game matches it.
+func listGamesFacetPrices(ctx context.Context, tx pgx.Tx, sqlWhere string, argsWhere []any) ([]domain.GameFacetPrice, error) {
+	var (
+		facetPrices = make([]domain.GameFacetPrice, len(domain.GameFacetPriceBounds))
+		bounds      = make([]float64, len(domain.GameFacetPriceBounds))
+	)
+
+	for i, bound := range domain.GameFacetPriceBounds {
+		facetPrices[i].Min = bound
+		bounds[i] = float64(bound)
+
+		if i+1 < len(domain.GameFacetPriceBounds) {
+			maxPrice := domain.GameFacetPriceBounds[i+1]
+			facetPrices[i].Max = &maxPrice
+		}
+	}
+
+	// The bucket number returned by width_bucket is zero for prices below the first bound, and one-based otherwise.
+	rows, err := tx.Query(ctx, `
+		SELECT `+fmt.Sprintf("width_bucket(g.price, $%d::float8[])", len(argsWhere)+1)+` AS bucket, count(g.id)
+		FROM games g
+	`+sqlWhere+`
+		GROUP BY bucket
+	`,
+		append(argsWhere, bounds)...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", descriptionFailedQuery, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var bucket, count int
+
+		err := rows.Scan(&bucket, &count)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", descriptionFailedScanRows, err)
+		}
+
+		if bucket < 1 || bucket > len(facetPrices) {
+			continue
+		}
+
+		facetPrices[bucket-1].Count = count
+	}
+
+	return facetPrices, nil
+}
+
+// listGamesFacetLanguages executes a query to return the language facet of the games matching the specified SQL WHERE
+// clause.
+func listGamesFacetLanguages(ctx context.Context, tx pgx.Tx, sqlWhere string, argsWhere []any) ([]domain.GameFacetLanguage, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT l.language, count(g.id)
+		FROM games g
+		CROSS JOIN LATERAL unnest(g.languages) AS l(language)
+	`+sqlWhere+`
+		GROUP BY l.language
+		ORDER BY count(g.id) DESC, l.language
+	`,
+		argsWhere...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", descriptionFailedQuery, err)
+	}
+	defer rows.Close()
+
+	facetLanguages := make([]domain.GameFacetLanguage, 0)
+
+	for rows.Next() {
+		var facetLanguage domain.GameFacetLanguage
+
+		err := rows.Scan(&facetLanguage.Language, &facetLanguage.Count)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", descriptionFailedScanRows, err)
+		}
+
+		facetLanguages = append(facetLanguages, facetLanguage)
+	}
+
+	return facetLanguages, nil
+}
+
+// listGamesFacetAgeRatings executes a query to return the age rating facet of the games matching the specified SQL
+// WHERE clause.
+func listGamesFacetAgeRatings(ctx context.Context, tx pgx.Tx, sqlWhere string, argsWhere []any) ([]domain.GameFacetAgeRating, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT g.age_rating, count(g.id)
+		FROM games g
+	`+sqlWhere+`
+		GROUP BY g.age_rating
+		ORDER BY g.age_rating
+	`,
+		argsWhere...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", descriptionFailedQuery, err)
+	}
+	defer rows.Close()
+
+	facetAgeRatings := make([]domain.GameFacetAgeRating, 0)
+
+	for rows.Next() {
+		var facetAgeRating domain.GameFacetAgeRating
+
+		err := rows.Scan(&facetAgeRating.AgeRating, &facetAgeRating.Count)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", descriptionFailedScanRows, err)
+		}
+
+		facetAgeRatings = append(facetAgeRatings, facetAgeRating)
+	}
+
+	return facetAgeRatings, nil
+}
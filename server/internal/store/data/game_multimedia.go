@@ -72,6 +72,64 @@ func (s *store) GetGameMultimedia(ctx context.Context, tx pgx.Tx, id uuid.UUID)
 	return multimedia, nil
 }
 
+// GetGameMultimediaPositions executes a query to return the multimedia identifiers and positions of the game with the
+// specified identifier, ordered by position.
+func (s *store) GetGameMultimediaPositions(ctx context.Context, tx pgx.Tx, gameID uuid.UUID) ([]domain.GameRevisionMultimedia, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT multimedia_id, position
+		FROM games_multimedia
+		WHERE game_id = $1
+		ORDER BY position ASC
+	`,
+		gameID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", descriptionFailedQuery, err)
+	}
+	defer rows.Close()
+
+	var multimedia []domain.GameRevisionMultimedia
+
+	for rows.Next() {
+		var gameMultimedia domain.GameRevisionMultimedia
+
+		err := rows.Scan(
+			&gameMultimedia.MultimediaID,
+			&gameMultimedia.Position,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", descriptionFailedScanRows, err)
+		}
+
+		multimedia = append(multimedia, gameMultimedia)
+	}
+
+	return multimedia, nil
+}
+
+// ReplaceGameMultimedia executes a query to replace the multimedia associated with the game with the specified
+// identifier with the specified ones.
+func (s *store) ReplaceGameMultimedia(ctx context.Context, tx pgx.Tx, gameID uuid.UUID, multimedia []domain.GameRevisionMultimedia) error {
+	_, err := tx.Exec(ctx, `
+		DELETE FROM games_multimedia
+		WHERE game_id = $1
+	`,
+		gameID,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, err)
+	}
+
+	for _, gameMultimedia := range multimedia {
+		err = s.CreateGameMultimedia(ctx, tx, gameID, gameMultimedia.MultimediaID, domain.EditableGameMultimedia{Position: gameMultimedia.Position})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // DeleteGameMultimedia executes a query to delete the game multimedia association with the specified identifiers.
 func (s *store) DeleteGameMultimedia(ctx context.Context, tx pgx.Tx, gameID, multimediaID uuid.UUID) error {
 	commandTag, err := tx.Exec(ctx, `
@@ -0,0 +1,167 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+const (
+	constraintGamesRevisionsGameIDFkey = "games_revisions_game_id_fkey"
+)
+
+// CreateGameRevision executes a query to create a revision of the game with the specified identifier.
+func (s *store) CreateGameRevision(ctx context.Context, tx pgx.Tx, gameID uuid.UUID, editableGameRevision domain.EditableGameRevision) error {
+	_, err := tx.Exec(ctx, `
+		INSERT INTO games_revisions (game_id, number, author_id, author_role, changed_fields, snapshot)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`,
+		gameID,
+		editableGameRevision.Number,
+		editableGameRevision.Author.ID,
+		editableGameRevision.Author.Role,
+		editableGameRevision.ChangedFields,
+		editableGameRevision.Snapshot,
+	)
+	if err != nil {
+		switch constraintNameFromError(err) {
+		case constraintGamesRevisionsGameIDFkey:
+			return fmt.Errorf("%s: %w", descriptionFailedExec, domain.ErrGameNotFound)
+		}
+
+		return fmt.Errorf("%s: %w", descriptionFailedExec, err)
+	}
+
+	return nil
+}
+
+// ListGameRevisions executes a query to return the revisions of the game with the specified identifier, newest first.
+func (s *store) ListGameRevisions(ctx context.Context, tx pgx.Tx, gameID uuid.UUID, filter domain.GameRevisionsPaginatedFilter) (domain.PaginatedResponse[domain.GameRevision], error) {
+	row := tx.QueryRow(ctx, `
+		SELECT count(id)
+		FROM games_revisions
+		WHERE game_id = $1
+	`,
+		gameID,
+	)
+
+	var total int
+
+	err := row.Scan(&total)
+	if err != nil {
+		return domain.PaginatedResponse[domain.GameRevision]{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, err)
+	}
+
+	rows, err := tx.Query(ctx, `
+		SELECT id, game_id, number, author_id, author_role, changed_fields, snapshot, created_at
+		FROM games_revisions
+		WHERE game_id = $1
+		ORDER BY number DESC
+	`+listSQLLimitOffset(filter.Limit, filter.Offset),
+		gameID,
+	)
+	if err != nil {
+		return domain.PaginatedResponse[domain.GameRevision]{}, fmt.Errorf("%s: %w", descriptionFailedQuery, err)
+	}
+	defer rows.Close()
+
+	gameRevisions, err := getGameRevisionsFromRows(rows)
+	if err != nil {
+		return domain.PaginatedResponse[domain.GameRevision]{}, fmt.Errorf("%s: %w", descriptionFailedScanRows, err)
+	}
+
+	return domain.PaginatedResponse[domain.GameRevision]{
+		Total:   total,
+		Results: gameRevisions,
+	}, nil
+}
+
+// GetGameRevision executes a query to return the revision with the specified number of the game with the specified
+// identifier.
+func (s *store) GetGameRevision(ctx context.Context, tx pgx.Tx, gameID uuid.UUID, number int) (domain.GameRevision, error) {
+	row := tx.QueryRow(ctx, `
+		SELECT id, game_id, number, author_id, author_role, changed_fields, snapshot, created_at
+		FROM games_revisions
+		WHERE game_id = $1 AND number = $2
+	`,
+		gameID,
+		number,
+	)
+
+	gameRevision, err := getGameRevisionFromRow(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.GameRevision{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, domain.ErrGameRevisionNotFound)
+		}
+
+		return domain.GameRevision{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, err)
+	}
+
+	return gameRevision, nil
+}
+
+// GetLatestGameRevision executes a query to return the latest revision of the game with the specified identifier.
+func (s *store) GetLatestGameRevision(ctx context.Context, tx pgx.Tx, gameID uuid.UUID) (domain.GameRevision, error) {
+	row := tx.QueryRow(ctx, `
+		SELECT id, game_id, number, author_id, author_role, changed_fields, snapshot, created_at
+		FROM games_revisions
+		WHERE game_id = $1
+		ORDER BY number DESC
+		LIMIT 1
+	`,
+		gameID,
+	)
+
+	gameRevision, err := getGameRevisionFromRow(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.GameRevision{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, domain.ErrGameRevisionNotFound)
+		}
+
+		return domain.GameRevision{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, err)
+	}
+
+	return gameRevision, nil
+}
+
+// getGameRevisionFromRow returns the game revision by scanning the given row.
+func getGameRevisionFromRow(row pgx.Row) (domain.GameRevision, error) {
+	var gameRevision domain.GameRevision
+
+	err := row.Scan(
+		&gameRevision.ID,
+		&gameRevision.GameID,
+		&gameRevision.Number,
+		&gameRevision.Author.ID,
+		&gameRevision.Author.Role,
+		&gameRevision.ChangedFields,
+		&gameRevision.Snapshot,
+		&gameRevision.CreatedAt,
+	)
+	if err != nil {
+		return domain.GameRevision{}, err
+	}
+
+	return gameRevision, nil
+}
+
+// getGameRevisionsFromRows returns the game revisions by scanning the given rows.
+func getGameRevisionsFromRows(rows pgx.Rows) ([]domain.GameRevision, error) {
+	var gameRevisions []domain.GameRevision
+
+	for rows.Next() {
+		gameRevision, err := getGameRevisionFromRow(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		gameRevisions = append(gameRevisions, gameRevision)
+	}
+
+	return gameRevisions, nil
+}
@@ -0,0 +1,118 @@
+//go:build integration
+
+package data
+
+import (
+	"context"
+	"hash/crc32"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+func TestStore_GameRevisions(t *testing.T) {
+	t.Run("should successfully create, list and get game revisions", func(t *testing.T) {
+		ctx := context.Background()
+
+		tx := newTx(t, ctx)
+
+		multimediaID, err := s.CreateMultimedia(ctx, tx, domain.MultimediaObject{
+			Checksum:  crc32.ChecksumIEEE([]byte("test")),
+			MediaType: "png",
+			URL:       "url",
+		})
+		require.NoError(t, err)
+
+		publisherID, err := s.CreatePublisher(ctx, tx, domain.EditablePublisherWithPassword{
+			EditablePublisher: domain.EditablePublisher{
+				Email:   "publisher@email.com",
+				Name:    "publisher name",
+				Address: "address",
+				Country: domain.Country{Region: language.MustParseRegion("pt")},
+				Vatin:   "123456789",
+			},
+			Password: "password",
+		})
+		require.NoError(t, err)
+
+		gameID, err := s.CreateGame(ctx, tx, publisherID, domain.EditableGame{
+			Title:       "title",
+			Price:       10,
+			Description: "description",
+			AgeRating:   "18",
+			Features:    "features",
+			Languages: domain.GameLanguages{
+				{Tag: language.English},
+			},
+			Requirements: domain.GameRequirements{
+				Minimum:     "minimum requirements",
+				Recommended: "recommended requirements",
+			},
+			PreviewMultimediaID: multimediaID,
+		})
+		require.NoError(t, err)
+
+		_, err = s.GetLatestGameRevision(ctx, tx, gameID)
+		require.ErrorIs(t, err, domain.ErrGameRevisionNotFound)
+
+		err = s.ReplaceGameMultimedia(ctx, tx, gameID, []domain.GameRevisionMultimedia{{MultimediaID: multimediaID, Position: 3}})
+		require.NoError(t, err)
+
+		multimedia, err := s.GetGameMultimediaPositions(ctx, tx, gameID)
+		require.NoError(t, err)
+		assert.Equal(t, []domain.GameRevisionMultimedia{{MultimediaID: multimediaID, Position: 3}}, multimedia)
+
+		game, err := s.GetGameByID(ctx, tx, gameID)
+		require.NoError(t, err)
+
+		releaseDate := time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC)
+		game.ReleaseDate = &releaseDate
+
+		editableGameRevision := domain.EditableGameRevision{
+			Number:        1,
+			Author:        domain.GameRevisionAuthor{ID: publisherID, Role: domain.GameRevisionAuthorRolePublisher},
+			ChangedFields: []domain.GameRevisionField{domain.GameRevisionFieldTitle, domain.GameRevisionFieldMultimedia},
+			Snapshot:      domain.NewGameRevisionSnapshot(game, multimedia),
+		}
+
+		err = s.CreateGameRevision(ctx, tx, gameID, editableGameRevision)
+		require.NoError(t, err)
+
+		editableGameRevision.Number = 2
+
+		err = s.CreateGameRevision(ctx, tx, gameID, editableGameRevision)
+		require.NoError(t, err)
+
+		err = s.CreateGameRevision(ctx, tx, uuid.New(), editableGameRevision)
+		require.ErrorIs(t, err, domain.ErrGameNotFound)
+
+		gameRevision, err := s.GetGameRevision(ctx, tx, gameID, 1)
+		require.NoError(t, err)
+		assert.Equal(t, gameID, gameRevision.GameID)
+		assert.Equal(t, 1, gameRevision.Number)
+		assert.Equal(t, editableGameRevision.Author, gameRevision.Author)
+		assert.Equal(t, editableGameRevision.ChangedFields, gameRevision.ChangedFields)
+		assert.Empty(t, gameRevision.Snapshot.Diff(editableGameRevision.Snapshot))
+
+		latestGameRevision, err := s.GetLatestGameRevision(ctx, tx, gameID)
+		require.NoError(t, err)
+		assert.Equal(t, 2, latestGameRevision.Number)
+
+		_, err = s.GetGameRevision(ctx, tx, gameID, 3)
+		require.ErrorIs(t, err, domain.ErrGameRevisionNotFound)
+
+		paginatedGameRevisions, err := s.ListGameRevisions(ctx, tx, gameID, domain.GameRevisionsPaginatedFilter{
+			PaginatedRequestBase: domain.PaginatedRequestBase{Limit: 1, Offset: 0},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, paginatedGameRevisions.Total)
+		require.Len(t, paginatedGameRevisions.Results, 1)
+		assert.Equal(t, 2, paginatedGameRevisions.Results[0].Number)
+	})
+}
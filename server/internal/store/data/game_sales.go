@@ -0,0 +1,49 @@
+package data
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+// ListPublisherGameSales executes a query to return the sales of every game of the publisher with the specified
+// identifier, ordered by their revenue.
+func (s *store) ListPublisherGameSales(ctx context.Context, tx pgx.Tx, publisherID uuid.UUID) ([]domain.GameSales, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT g.id, g.title, count(ig.game_id), coalesce(sum(ig.price), 0)
+		FROM games g
+		LEFT JOIN invoices_games ig
+			ON ig.game_id = g.id
+		WHERE g.publisher_id = $1
+		GROUP BY g.id
+		ORDER BY coalesce(sum(ig.price), 0) DESC, g.title, g.id
+	`, publisherID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", descriptionFailedQuery, err)
+	}
+	defer rows.Close()
+
+	gamesSales := make([]domain.GameSales, 0)
+
+	for rows.Next() {
+		var gameSales domain.GameSales
+
+		err := rows.Scan(
+			&gameSales.GameID,
+			&gameSales.Title,
+			&gameSales.Units,
+			&gameSales.Revenue,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", descriptionFailedScanRows, err)
+		}
+
+		gamesSales = append(gamesSales, gameSales)
+	}
+
+	return gamesSales, nil
+}
@@ -0,0 +1,125 @@
+//go:build integration
+
+package data
+
+import (
+	"context"
+	"hash/crc32"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+func TestStore_ListPublisherGameSales(t *testing.T) {
+	t.Run("should successfully list the sales of the publisher games", func(t *testing.T) {
+		ctx := context.Background()
+
+		tx := newTx(t, ctx)
+
+		multimediaID, err := s.CreateMultimedia(ctx, tx, domain.MultimediaObject{
+			Checksum:  crc32.ChecksumIEEE([]byte("test")),
+			MediaType: "png",
+			URL:       "url",
+		})
+		require.NoError(t, err)
+
+		editablePublisher := domain.EditablePublisherWithPassword{
+			EditablePublisher: domain.EditablePublisher{
+				Email:   "publisher1@email.com",
+				Name:    "publisher name",
+				Address: "address",
+				Country: domain.Country{Region: language.MustParseRegion("pt")},
+				Vatin:   "123456789",
+			},
+			Password: "password",
+		}
+
+		publisher1, err := s.CreatePublisher(ctx, tx, editablePublisher)
+		require.NoError(t, err)
+
+		editablePublisher.Email = "publisher2@email.com"
+		editablePublisher.Vatin = "123456788"
+
+		publisher2, err := s.CreatePublisher(ctx, tx, editablePublisher)
+		require.NoError(t, err)
+
+		editableGame := domain.EditableGame{
+			Title:       "Jump Master",
+			Price:       10,
+			Description: "description",
+			AgeRating:   "18",
+			Features:    "features",
+			Languages: domain.GameLanguages{
+				{Tag: language.English},
+			},
+			Requirements: domain.GameRequirements{
+				Minimum:     "minimum requirements",
+				Recommended: "recommended requirements",
+			},
+			PreviewMultimediaID: multimediaID,
+		}
+
+		game1, err := s.CreateGame(ctx, tx, publisher1, editableGame)
+		require.NoError(t, err)
+
+		editableGame.Title = "Racing Legends"
+
+		game2, err := s.CreateGame(ctx, tx, publisher1, editableGame)
+		require.NoError(t, err)
+
+		game3, err := s.CreateGame(ctx, tx, publisher2, editableGame)
+		require.NoError(t, err)
+
+		editableUser := domain.EditableUserWithPassword{
+			EditableUser: domain.EditableUser{
+				Username:    "username1",
+				Email:       "user1@email.com",
+				DisplayName: "user name",
+				DateOfBirth: domain.UserDateOfBirth(time.Date(2000, 02, 01, 0, 0, 0, 0, time.UTC)),
+				Address:     "address",
+				Country:     domain.Country{Region: language.MustParseRegion("pt")},
+				Vatin:       "123456781",
+			},
+			Password: "password",
+		}
+
+		user1, err := s.CreateUser(ctx, tx, editableUser)
+		require.NoError(t, err)
+
+		editableUser.Username = "username2"
+		editableUser.Email = "user2@email.com"
+		editableUser.Vatin = "123456782"
+
+		user2, err := s.CreateUser(ctx, tx, editableUser)
+		require.NoError(t, err)
+
+		// Game 2 was purchased by both users, at different prices, and game 3 of the other publisher by one of them.
+		_, err = tx.Exec(ctx, `
+			WITH invoices_users AS (
+				INSERT INTO invoices (user_id, user_vatin)
+				VALUES ($1, '123456781'), ($2, '123456782')
+				RETURNING id, user_id
+			)
+			INSERT INTO invoices_games (invoice_id, game_id, price, tax, publisher_vatin)
+			SELECT id, $3, CASE WHEN user_id = $1 THEN 10 ELSE 5 END, 0.23, '123456789'
+			FROM invoices_users
+			UNION ALL
+			SELECT id, $4, 10, 0.23, '123456789'
+			FROM invoices_users
+			WHERE user_id = $1
+		`, user1, user2, game2, game3)
+		require.NoError(t, err)
+
+		gamesSales, err := s.ListPublisherGameSales(ctx, tx, publisher1)
+		require.NoError(t, err)
+		require.Len(t, gamesSales, 2)
+
+		assert.Equal(t, domain.GameSales{GameID: game2, Title: "Racing Legends", Units: 2, Revenue: 15}, gamesSales[0])
+		assert.Equal(t, domain.GameSales{GameID: game1, Title: "Jump Master", Units: 0, Revenue: 0}, gamesSales[1])
+	})
+}
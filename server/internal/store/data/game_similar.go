@@ -0,0 +1,171 @@
+package data
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+// ListGamesSimilar executes a query to return the games similar to the game with the specified identifier, for the
+// specified filter. The similar games are read from the table filled by RefreshGamesSimilar.
+func (s *store) ListGamesSimilar(ctx context.Context, tx pgx.Tx, gameID uuid.UUID, filter domain.GamesSimilarPaginatedFilter) (domain.PaginatedResponse[domain.Game], error) {
+	var (
+		filterFields []listSQLWhereOperator
+		argsWhere    []any
+	)
+
+	filterFields = append(filterFields, listSQLWhereOperatorEqual("gs.game_id"))
+	argsWhere = append(argsWhere, gameID)
+
+	filterFields = append(filterFields, listSQLWhereOperatorEqual("g.status"))
+	argsWhere = append(argsWhere, domain.GameStatusPublished)
+
+	if filter.UserID != nil {
+		filterFields = append(filterFields, func(position int) string {
+			return fmt.Sprintf("NOT EXISTS (SELECT 1 FROM users_libraries ul WHERE ul.game_id = g.id AND ul.user_id = $%d)", position)
+		})
+		argsWhere = append(argsWhere, *filter.UserID)
+	}
+
+	sqlWhere := listSQLWhere(filterFields)
+
+	row := tx.QueryRow(ctx, `
+		SELECT count(gs.similar_game_id)
+		FROM games_similar gs
+		INNER JOIN games g
+			ON g.id = gs.similar_game_id
+	`+sqlWhere,
+		argsWhere...,
+	)
+
+	var total int
+
+	err := row.Scan(&total)
+	if err != nil {
+		return domain.PaginatedResponse[domain.Game]{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, err)
+	}
+
+	rows, err := tx.Query(ctx, `
+		SELECT g.id, g.title, g.price, g.status, g.review_notes, g.release_date, g.description, g.age_rating, g.features, g.languages, g.requirements, g.created_at, g.modified_at,
+			p.id, p.email, p.name, p.address, p.country, p.vatin, p.verification_status, p.verification_reason, p.created_at, p.modified_at,
+			pm.id, pm.checksum, pm.media_type, pm.url, pm.created_at,
+			gpm.id, gpm.checksum, gpm.media_type, gpm.url, gpm.created_at,
+			gdm.id, gdm.checksum, gdm.media_type, gdm.url, gdm.created_at
+		FROM games_similar gs
+		INNER JOIN games g
+			ON g.id = gs.similar_game_id
+		INNER JOIN publishers p
+			ON p.id = g.publisher_id
+		LEFT JOIN multimedia pm
+			ON pm.id = p.picture_multimedia_id
+		INNER JOIN multimedia gpm
+			ON gpm.id = g.preview_multimedia_id
+		LEFT JOIN multimedia gdm
+			ON gdm.id = g.download_multimedia_id
+	`+sqlWhere+`
+		ORDER BY gs.score DESC, g.created_at DESC
+	`+listSQLLimitOffset(filter.Limit, filter.Offset),
+		argsWhere...,
+	)
+	if err != nil {
+		return domain.PaginatedResponse[domain.Game]{}, fmt.Errorf("%s: %w", descriptionFailedQuery, err)
+	}
+	defer rows.Close()
+
+	games, err := getGamesFromRows(rows)
+	if err != nil {
+		return domain.PaginatedResponse[domain.Game]{}, fmt.Errorf("%s: %w", descriptionFailedScanRows, err)
+	}
+
+	for i, game := range games {
+		game.Multimedia, err = s.GetGameMultimedia(ctx, tx, game.ID)
+		if err != nil {
+			return domain.PaginatedResponse[domain.Game]{}, err
+		}
+
+		game.Tags, err = s.GetGameTags(ctx, tx, game.ID)
+		if err != nil {
+			return domain.PaginatedResponse[domain.Game]{}, err
+		}
+
+		games[i] = game
+	}
+
+	return domain.PaginatedResponse[domain.Game]{
+		Total:   total,
+		Results: games,
+	}, nil
+}
+
+// RefreshGamesSimilar executes a query to recompute the similar games of every game.
+// The score combines the Jaccard similarity of the tags and the cosine similarity of the owners of both games, and
+// only the best scored games are kept for each game.
+func (s *store) RefreshGamesSimilar(ctx context.Context, tx pgx.Tx) error {
+	_, err := tx.Exec(ctx, `
+		DELETE FROM games_similar
+	`)
+	if err != nil {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		WITH games_tags_count AS (
+			SELECT game_id, count(tag_id) AS total
+			FROM games_tags
+			GROUP BY game_id
+		), games_tags_shared AS (
+			SELECT a.game_id, b.game_id AS similar_game_id, count(a.tag_id) AS total
+			FROM games_tags a
+			INNER JOIN games_tags b
+				ON b.tag_id = a.tag_id AND b.game_id <> a.game_id
+			GROUP BY a.game_id, b.game_id
+		), games_owners_count AS (
+			SELECT game_id, count(user_id) AS total
+			FROM users_libraries
+			GROUP BY game_id
+		), games_owners_shared AS (
+			SELECT a.game_id, b.game_id AS similar_game_id, count(a.user_id) AS total
+			FROM users_libraries a
+			INNER JOIN users_libraries b
+				ON b.user_id = a.user_id AND b.game_id <> a.game_id
+			GROUP BY a.game_id, b.game_id
+		), games_scores AS (
+			SELECT coalesce(ts.game_id, os.game_id) AS game_id,
+				coalesce(ts.similar_game_id, os.similar_game_id) AS similar_game_id,
+				$1 * coalesce(ts.total::float8 / (tca.total + tcb.total - ts.total), 0) +
+					$2 * coalesce(os.total::float8 / sqrt(oca.total * ocb.total), 0) AS score
+			FROM games_tags_shared ts
+			FULL JOIN games_owners_shared os
+				ON os.game_id = ts.game_id AND os.similar_game_id = ts.similar_game_id
+			LEFT JOIN games_tags_count tca
+				ON tca.game_id = ts.game_id
+			LEFT JOIN games_tags_count tcb
+				ON tcb.game_id = ts.similar_game_id
+			LEFT JOIN games_owners_count oca
+				ON oca.game_id = os.game_id
+			LEFT JOIN games_owners_count ocb
+				ON ocb.game_id = os.similar_game_id
+		), games_scores_ranked AS (
+			SELECT game_id, similar_game_id, score,
+				row_number() OVER (PARTITION BY game_id ORDER BY score DESC) AS position
+			FROM games_scores
+		)
+		INSERT INTO games_similar (game_id, similar_game_id, score)
+		SELECT game_id, similar_game_id, score
+		FROM games_scores_ranked
+		WHERE position <= $3
+	`,
+		domain.GameSimilarTagsWeight,
+		domain.GameSimilarOwnersWeight,
+		domain.GameSimilarMaxPerGame,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,156 @@
+//go:build integration
+
+package data
+
+import (
+	"context"
+	"hash/crc32"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+func TestStore_ListGamesSimilar(t *testing.T) {
+	t.Run("should successfully list similar games after refreshing them", func(t *testing.T) {
+		ctx := context.Background()
+
+		tx := newTx(t, ctx)
+
+		multimediaID, err := s.CreateMultimedia(ctx, tx, domain.MultimediaObject{
+			Checksum:  crc32.ChecksumIEEE([]byte("test")),
+			MediaType: "png",
+			URL:       "url",
+		})
+		require.NoError(t, err)
+
+		publisherID, err := s.CreatePublisher(ctx, tx, domain.EditablePublisherWithPassword{
+			EditablePublisher: domain.EditablePublisher{
+				Email:   "publisher@email.com",
+				Name:    "publisher name",
+				Address: "address",
+				Country: domain.Country{Region: language.MustParseRegion("pt")},
+				Vatin:   "123456789",
+			},
+			Password: "password",
+		})
+		require.NoError(t, err)
+
+		releaseDate := time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC)
+
+		editableGame := domain.EditableGame{
+			Title:       "title",
+			Price:       10,
+			ReleaseDate: &releaseDate,
+			Description: "description",
+			AgeRating:   "18",
+			Features:    "features",
+			Languages: domain.GameLanguages{
+				{Tag: language.English},
+			},
+			Requirements: domain.GameRequirements{
+				Minimum:     "minimum requirements",
+				Recommended: "recommended requirements",
+			},
+			PreviewMultimediaID:  multimediaID,
+			DownloadMultimediaID: &multimediaID,
+		}
+
+		game1, err := s.CreateGame(ctx, tx, publisherID, editableGame)
+		require.NoError(t, err)
+
+		err = s.UpdateGameStatus(ctx, tx, game1, domain.GameStatusPublished, nil)
+		require.NoError(t, err)
+
+		game2, err := s.CreateGame(ctx, tx, publisherID, editableGame)
+		require.NoError(t, err)
+
+		err = s.UpdateGameStatus(ctx, tx, game2, domain.GameStatusPublished, nil)
+		require.NoError(t, err)
+
+		game3, err := s.CreateGame(ctx, tx, publisherID, editableGame)
+		require.NoError(t, err)
+
+		err = s.UpdateGameStatus(ctx, tx, game3, domain.GameStatusPublished, nil)
+		require.NoError(t, err)
+
+		tags, err := s.ListTags(ctx, tx, domain.TagsPaginatedFilter{
+			PaginatedRequest: domain.PaginatedRequest[domain.TagPaginatedSort]{
+				PaginatedRequestBase: domain.PaginatedRequestBase{
+					Limit: 3,
+				},
+				Sort: domain.TagPaginatedSortName,
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, tags.Results, 3)
+
+		// Games 1 and 2 share all their tags, while game 3 only shares owners with game 1.
+		for _, tag := range tags.Results[:2] {
+			err = s.CreateGameTag(ctx, tx, game1, tag.ID)
+			require.NoError(t, err)
+
+			err = s.CreateGameTag(ctx, tx, game2, tag.ID)
+			require.NoError(t, err)
+		}
+
+		err = s.CreateGameTag(ctx, tx, game3, tags.Results[2].ID)
+		require.NoError(t, err)
+
+		editableUser := domain.EditableUserWithPassword{
+			EditableUser: domain.EditableUser{
+				Username:    "username1",
+				Email:       "user1@email.com",
+				DisplayName: "user name",
+				DateOfBirth: domain.UserDateOfBirth(time.Date(2000, 02, 01, 0, 0, 0, 0, time.UTC)),
+				Address:     "address",
+				Country:     domain.Country{Region: language.MustParseRegion("pt")},
+				Vatin:       "123456781",
+			},
+			Password: "password",
+		}
+
+		user1, err := s.CreateUser(ctx, tx, editableUser)
+		require.NoError(t, err)
+
+		editableUser.Username = "username2"
+		editableUser.Email = "user2@email.com"
+		editableUser.Vatin = "123456782"
+
+		user2, err := s.CreateUser(ctx, tx, editableUser)
+		require.NoError(t, err)
+
+		_, err = tx.Exec(ctx, `
+			INSERT INTO users_libraries (user_id, game_id)
+			VALUES ($1, $3), ($1, $4), ($2, $3)
+		`, user1, user2, game1, game3)
+		require.NoError(t, err)
+
+		err = s.RefreshGamesSimilar(ctx, tx)
+		require.NoError(t, err)
+
+		filter := domain.GamesSimilarPaginatedFilter{
+			PaginatedRequestBase: domain.PaginatedRequestBase{
+				Limit: 10,
+			},
+		}
+
+		games, err := s.ListGamesSimilar(ctx, tx, game1, filter)
+		require.NoError(t, err)
+		require.Equal(t, 2, games.Total)
+		assert.Equal(t, game2, games.Results[0].ID)
+		assert.Equal(t, game3, games.Results[1].ID)
+
+		// Games owned by the user are excluded.
+		filter.UserID = &user1
+
+		games, err = s.ListGamesSimilar(ctx, tx, game1, filter)
+		require.NoError(t, err)
+		require.Equal(t, 1, games.Total)
+		assert.Equal(t, game2, games.Results[0].ID)
+	})
+}
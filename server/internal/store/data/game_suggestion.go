@@ -0,0 +1,79 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+// likePatternEscaper escapes the LIKE wildcards, using the default escape character.
+var likePatternEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// ListGameSuggestions executes a query to return the game title, tag and publisher name suggestions for the specified
+// filter, ordered by popularity.
+func (s *store) ListGameSuggestions(ctx context.Context, tx pgx.Tx, filter domain.GameSuggestionsFilter) ([]domain.GameSuggestion, error) {
+	prefix := likePatternEscaper.Replace(strings.ToLower(string(filter.Query))) + "%"
+
+	rows, err := tx.Query(ctx, `
+		SELECT $2::text, g.id, g.title, count(ul.user_id)
+		FROM games g
+		LEFT JOIN users_libraries ul
+			ON ul.game_id = g.id
+		WHERE LOWER(g.title) LIKE $1 AND g.status = 'published' AND g.release_date <= CURRENT_DATE
+		GROUP BY g.id
+		UNION ALL
+		SELECT $3::text, t.id, t.name, count(ul.user_id)
+		FROM tags t
+		INNER JOIN games_tags gt
+			ON gt.tag_id = t.id
+		INNER JOIN games g
+			ON g.id = gt.game_id
+		LEFT JOIN users_libraries ul
+			ON ul.game_id = g.id
+		WHERE LOWER(t.name) LIKE $1 AND g.status = 'published' AND g.release_date <= CURRENT_DATE
+		GROUP BY t.id
+		UNION ALL
+		SELECT $4::text, p.id, p.name, count(ul.user_id)
+		FROM publishers p
+		INNER JOIN games g
+			ON g.publisher_id = p.id
+		LEFT JOIN users_libraries ul
+			ON ul.game_id = g.id
+		WHERE LOWER(p.name) LIKE $1 AND g.status = 'published' AND g.release_date <= CURRENT_DATE
+		GROUP BY p.id
+		ORDER BY 4 DESC, 3
+	`+fmt.Sprintf(" LIMIT %d", filter.Limit),
+		prefix,
+		domain.GameSuggestionTypeTitle,
+		domain.GameSuggestionTypeTag,
+		domain.GameSuggestionTypePublisher,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", descriptionFailedQuery, err)
+	}
+	defer rows.Close()
+
+	suggestions := make([]domain.GameSuggestion, 0)
+
+	for rows.Next() {
+		var suggestion domain.GameSuggestion
+
+		err := rows.Scan(
+			&suggestion.Type,
+			&suggestion.ID,
+			&suggestion.Text,
+			&suggestion.Popularity,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", descriptionFailedScanRows, err)
+		}
+
+		suggestions = append(suggestions, suggestion)
+	}
+
+	return suggestions, nil
+}
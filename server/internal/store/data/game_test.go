@@ -78,7 +78,6 @@ func TestStore_CreateGame(t *testing.T) {
 		editableGame := domain.EditableGame{
 			Title:       "title",
 			Price:       9.99,
-			IsActive:    true,
 			ReleaseDate: &releaseDate,
 			Description: "description",
 			AgeRating:   "18",
@@ -135,7 +134,8 @@ func TestStore_CreateGame(t *testing.T) {
 		assert.Equal(t, publisher, game.Publisher)
 		assert.Equal(t, editableGame.Title, game.Title)
 		assert.Equal(t, editableGame.Price, game.Price)
-		assert.Equal(t, editableGame.IsActive, game.IsActive)
+		assert.Equal(t, domain.GameStatusDraft, game.Status)
+		assert.Nil(t, game.ReviewNotes)
 		assert.Equal(t, editableGame.ReleaseDate, game.ReleaseDate)
 		assert.Equal(t, editableGame.Description, game.Description)
 		assert.Equal(t, editableGame.AgeRating, game.AgeRating)
@@ -163,6 +163,13 @@ func TestStore_CreateGame(t *testing.T) {
 
 		assert.Equal(t, tags.Results[0], game.Tags[0])
 		assert.Equal(t, tags.Results[1], game.Tags[1])
+
+		gamePublisherID, err := s.GetGamePublisherID(ctx, tx, gameID)
+		require.NoError(t, err)
+		assert.Equal(t, publisherID, gamePublisherID)
+
+		_, err = s.GetGamePublisherID(ctx, tx, uuid.New())
+		require.ErrorIs(t, err, domain.ErrGameNotFound)
 	})
 
 	t.Run("should fail", func(t *testing.T) {
@@ -185,7 +192,6 @@ func TestStore_CreateGame(t *testing.T) {
 			editableGame := domain.EditableGame{
 				Title:       "title",
 				Price:       9.99,
-				IsActive:    true,
 				ReleaseDate: &releaseDate,
 				Description: "description",
 				AgeRating:   "18",
@@ -243,7 +249,6 @@ func TestStore_CreateGame(t *testing.T) {
 			editableGame := domain.EditableGame{
 				Title:       "title",
 				Price:       9.99,
-				IsActive:    true,
 				ReleaseDate: &releaseDate,
 				Description: "description",
 				AgeRating:   "18",
@@ -302,7 +307,6 @@ func TestStore_CreateGame(t *testing.T) {
 			editableGame := domain.EditableGame{
 				Title:       "title",
 				Price:       9.99,
-				IsActive:    true,
 				ReleaseDate: &releaseDate,
 				Description: "description",
 				AgeRating:   "18",
@@ -326,3 +330,391 @@ func TestStore_CreateGame(t *testing.T) {
 		})
 	})
 }
+
+func TestStore_ListGames(t *testing.T) {
+	t.Run("should successfully search games by relevance", func(t *testing.T) {
+		ctx := context.Background()
+
+		tx := newTx(t, ctx)
+
+		multimediaID, err := s.CreateMultimedia(ctx, tx, domain.MultimediaObject{
+			Checksum:  crc32.ChecksumIEEE([]byte("test")),
+			MediaType: "png",
+			URL:       "url",
+		})
+		require.NoError(t, err)
+
+		publisherID, err := s.CreatePublisher(ctx, tx, domain.EditablePublisherWithPassword{
+			EditablePublisher: domain.EditablePublisher{
+				Email:   "publisher@email.com",
+				Name:    "publisher name",
+				Address: "address",
+				Country: domain.Country{Region: language.MustParseRegion("pt")},
+				Vatin:   "123456789",
+			},
+			Password: "password",
+		})
+		require.NoError(t, err)
+
+		editableGame := domain.EditableGame{
+			Title:       "Jump Master",
+			Price:       10,
+			Description: "An adventure where you race against time",
+			AgeRating:   "18",
+			Features:    "features",
+			Languages: domain.GameLanguages{
+				{Tag: language.English},
+			},
+			Requirements: domain.GameRequirements{
+				Minimum:     "minimum requirements",
+				Recommended: "recommended requirements",
+			},
+			PreviewMultimediaID: multimediaID,
+		}
+
+		game1, err := s.CreateGame(ctx, tx, publisherID, editableGame)
+		require.NoError(t, err)
+
+		editableGame.Title = "Racing Legends"
+		editableGame.Description = "description"
+
+		game2, err := s.CreateGame(ctx, tx, publisherID, editableGame)
+		require.NoError(t, err)
+
+		sortRelevance := domain.GamePaginatedSortRelevance
+
+		newFilter := func(title domain.GameTitle) domain.GamesPaginatedFilter {
+			return domain.GamesPaginatedFilter{
+				PaginatedRequest: domain.PaginatedRequest[domain.GamePaginatedSort]{
+					PaginatedRequestBase: domain.PaginatedRequestBase{
+						Limit: 10,
+					},
+					Sort:  &sortRelevance,
+					Order: domain.PaginationOrderDesc,
+				},
+				Title: &title,
+			}
+		}
+
+		// Stemming.
+		games, err := s.ListGames(ctx, tx, newFilter("jumping"))
+		require.NoError(t, err)
+		require.Equal(t, 1, games.Total)
+		assert.Equal(t, game1, games.Results[0].ID)
+
+		// Typo tolerance.
+		games, err = s.ListGames(ctx, tx, newFilter("Jump Mastr"))
+		require.NoError(t, err)
+		require.Equal(t, 1, games.Total)
+		assert.Equal(t, game1, games.Results[0].ID)
+
+		// Title weighted above description.
+		games, err = s.ListGames(ctx, tx, newFilter("racing"))
+		require.NoError(t, err)
+		require.Equal(t, 2, games.Total)
+		assert.Equal(t, game2, games.Results[0].ID)
+		assert.Equal(t, game1, games.Results[1].ID)
+
+		// Best match last in ascending order.
+		filter := newFilter("racing")
+		filter.Order = domain.PaginationOrderAsc
+
+		games, err = s.ListGames(ctx, tx, filter)
+		require.NoError(t, err)
+		require.Equal(t, 2, games.Total)
+		assert.Equal(t, game1, games.Results[0].ID)
+		assert.Equal(t, game2, games.Results[1].ID)
+	})
+
+	t.Run("should successfully search games by their current tags", func(t *testing.T) {
+		ctx := context.Background()
+
+		tx := newTx(t, ctx)
+
+		multimediaID, err := s.CreateMultimedia(ctx, tx, domain.MultimediaObject{
+			Checksum:  crc32.ChecksumIEEE([]byte("test")),
+			MediaType: "png",
+			URL:       "url",
+		})
+		require.NoError(t, err)
+
+		publisherID, err := s.CreatePublisher(ctx, tx, domain.EditablePublisherWithPassword{
+			EditablePublisher: domain.EditablePublisher{
+				Email:   "publisher@email.com",
+				Name:    "publisher name",
+				Address: "address",
+				Country: domain.Country{Region: language.MustParseRegion("pt")},
+				Vatin:   "123456789",
+			},
+			Password: "password",
+		})
+		require.NoError(t, err)
+
+		editableGame := domain.EditableGame{
+			Title:       "Jump Master",
+			Price:       10,
+			Description: "description",
+			AgeRating:   "18",
+			Features:    "features",
+			Languages: domain.GameLanguages{
+				{Tag: language.English},
+			},
+			Requirements: domain.GameRequirements{
+				Minimum:     "minimum requirements",
+				Recommended: "recommended requirements",
+			},
+			PreviewMultimediaID: multimediaID,
+		}
+
+		game1, err := s.CreateGame(ctx, tx, publisherID, editableGame)
+		require.NoError(t, err)
+
+		editableGame.Title = "Racing Legends"
+
+		game2, err := s.CreateGame(ctx, tx, publisherID, editableGame)
+		require.NoError(t, err)
+
+		tags, err := s.ListTags(ctx, tx, domain.TagsPaginatedFilter{
+			PaginatedRequest: domain.PaginatedRequest[domain.TagPaginatedSort]{
+				PaginatedRequestBase: domain.PaginatedRequestBase{
+					Limit: 1,
+				},
+				Sort: domain.TagPaginatedSortName,
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, tags.Results, 1)
+
+		err = s.CreateGameTag(ctx, tx, game1, tags.Results[0].ID)
+		require.NoError(t, err)
+
+		sortRelevance := domain.GamePaginatedSortRelevance
+		title := domain.GameTitle("Zeppelin")
+
+		filter := domain.GamesPaginatedFilter{
+			PaginatedRequest: domain.PaginatedRequest[domain.GamePaginatedSort]{
+				PaginatedRequestBase: domain.PaginatedRequestBase{
+					Limit: 10,
+				},
+				Sort:  &sortRelevance,
+				Order: domain.PaginationOrderDesc,
+			},
+			Title: &title,
+		}
+
+		// Renamed tag.
+		_, err = tx.Exec(ctx, `
+			UPDATE tags
+			SET name = $2
+			WHERE id = $1
+		`, tags.Results[0].ID, title)
+		require.NoError(t, err)
+
+		games, err := s.ListGames(ctx, tx, filter)
+		require.NoError(t, err)
+		require.Equal(t, 1, games.Total)
+		assert.Equal(t, game1, games.Results[0].ID)
+
+		// Tag moved to another game.
+		_, err = tx.Exec(ctx, `
+			UPDATE games_tags
+			SET game_id = $2
+			WHERE game_id = $1
+		`, game1, game2)
+		require.NoError(t, err)
+
+		games, err = s.ListGames(ctx, tx, filter)
+		require.NoError(t, err)
+		require.Equal(t, 1, games.Total)
+		assert.Equal(t, game2, games.Results[0].ID)
+	})
+}
+
+func TestStore_ListGamesFacets(t *testing.T) {
+	t.Run("should successfully count games facets under the filter", func(t *testing.T) {
+		ctx := context.Background()
+
+		tx := newTx(t, ctx)
+
+		multimediaID, err := s.CreateMultimedia(ctx, tx, domain.MultimediaObject{
+			Checksum:  crc32.ChecksumIEEE([]byte("test")),
+			MediaType: "png",
+			URL:       "url",
+		})
+		require.NoError(t, err)
+
+		publisherID, err := s.CreatePublisher(ctx, tx, domain.EditablePublisherWithPassword{
+			EditablePublisher: domain.EditablePublisher{
+				Email:   "publisher@email.com",
+				Name:    "publisher name",
+				Address: "address",
+				Country: domain.Country{Region: language.MustParseRegion("pt")},
+				Vatin:   "123456789",
+			},
+			Password: "password",
+		})
+		require.NoError(t, err)
+
+		editableGame := domain.EditableGame{
+			Title:       "title 1",
+			Price:       4.99,
+			Description: "description",
+			AgeRating:   "12",
+			Features:    "features",
+			Languages: domain.GameLanguages{
+				{Tag: language.Portuguese},
+				{Tag: language.English},
+			},
+			Requirements: domain.GameRequirements{
+				Minimum:     "minimum requirements",
+				Recommended: "recommended requirements",
+			},
+			PreviewMultimediaID: multimediaID,
+		}
+
+		game1, err := s.CreateGame(ctx, tx, publisherID, editableGame)
+		require.NoError(t, err)
+
+		err = s.UpdateGameStatus(ctx, tx, game1, domain.GameStatusPublished, nil)
+		require.NoError(t, err)
+
+		editableGame.Title = "title 2"
+		editableGame.Price = 59.99
+		editableGame.AgeRating = "18"
+		editableGame.Languages = domain.GameLanguages{{Tag: language.English}}
+
+		game2, err := s.CreateGame(ctx, tx, publisherID, editableGame)
+		require.NoError(t, err)
+
+		err = s.UpdateGameStatus(ctx, tx, game2, domain.GameStatusPublished, nil)
+		require.NoError(t, err)
+
+		editableGame.Title = "title 3"
+
+		// Game 3 stays a draft.
+		_, err = s.CreateGame(ctx, tx, publisherID, editableGame)
+		require.NoError(t, err)
+
+		status := domain.GameStatusPublished
+
+		facets, err := s.ListGamesFacets(ctx, tx, domain.GamesPaginatedFilter{
+			Status: &status,
+			Facets: []domain.GameFacet{
+				domain.GameFacetPrices,
+				domain.GameFacetLanguages,
+				domain.GameFacetAgeRatings,
+			},
+		})
+		require.NoError(t, err)
+		assert.Nil(t, facets.Tags)
+
+		require.Len(t, facets.Prices, len(domain.GameFacetPriceBounds))
+		assert.Equal(t, 1, facets.Prices[0].Count)
+		assert.Equal(t, 1, facets.Prices[len(facets.Prices)-1].Count)
+		assert.Nil(t, facets.Prices[len(facets.Prices)-1].Max)
+
+		assert.Equal(t, []domain.GameFacetLanguage{
+			{Language: domain.Language{Tag: language.English}, Count: 2},
+			{Language: domain.Language{Tag: language.Portuguese}, Count: 1},
+		}, facets.Languages)
+
+		assert.Equal(t, []domain.GameFacetAgeRating{
+			{AgeRating: "12", Count: 1},
+			{AgeRating: "18", Count: 1},
+		}, facets.AgeRatings)
+	})
+}
+
+func TestStore_GameStatus(t *testing.T) {
+	t.Run("should successfully update game status and delete games without owners", func(t *testing.T) {
+		ctx := context.Background()
+
+		tx := newTx(t, ctx)
+
+		multimediaID, err := s.CreateMultimedia(ctx, tx, domain.MultimediaObject{
+			Checksum:  crc32.ChecksumIEEE([]byte("test")),
+			MediaType: "png",
+			URL:       "url",
+		})
+		require.NoError(t, err)
+
+		publisherID, err := s.CreatePublisher(ctx, tx, domain.EditablePublisherWithPassword{
+			EditablePublisher: domain.EditablePublisher{
+				Email:   "publisher@email.com",
+				Name:    "publisher name",
+				Address: "address",
+				Country: domain.Country{Region: language.MustParseRegion("pt")},
+				Vatin:   "123456789",
+			},
+			Password: "password",
+		})
+		require.NoError(t, err)
+
+		editableGame := domain.EditableGame{
+			Title:       "title",
+			Price:       10,
+			Description: "description",
+			AgeRating:   "18",
+			Features:    "features",
+			Languages: domain.GameLanguages{
+				{Tag: language.English},
+			},
+			Requirements: domain.GameRequirements{
+				Minimum:     "minimum requirements",
+				Recommended: "recommended requirements",
+			},
+			PreviewMultimediaID: multimediaID,
+		}
+
+		game1, err := s.CreateGame(ctx, tx, publisherID, editableGame)
+		require.NoError(t, err)
+
+		game2, err := s.CreateGame(ctx, tx, publisherID, editableGame)
+		require.NoError(t, err)
+
+		reviewNotes := domain.GameReviewNotes("missing screenshots")
+
+		err = s.UpdateGameStatus(ctx, tx, game1, domain.GameStatusDraft, &reviewNotes)
+		require.NoError(t, err)
+
+		game, err := s.GetGameByID(ctx, tx, game1)
+		require.NoError(t, err)
+		assert.Equal(t, domain.GameStatusDraft, game.Status)
+		assert.Equal(t, &reviewNotes, game.ReviewNotes)
+
+		err = s.UpdateGameStatus(ctx, tx, uuid.New(), domain.GameStatusPublished, nil)
+		require.ErrorIs(t, err, domain.ErrGameNotFound)
+
+		userID, err := s.CreateUser(ctx, tx, domain.EditableUserWithPassword{
+			EditableUser: domain.EditableUser{
+				Username:    "username",
+				Email:       "user@email.com",
+				DisplayName: "user name",
+				DateOfBirth: domain.UserDateOfBirth(time.Date(2000, 02, 01, 0, 0, 0, 0, time.UTC)),
+				Address:     "address",
+				Country:     domain.Country{Region: language.MustParseRegion("pt")},
+				Vatin:       "123456789",
+			},
+			Password: "password",
+		})
+		require.NoError(t, err)
+
+		_, err = tx.Exec(ctx, `
+			INSERT INTO users_libraries (user_id, game_id)
+			VALUES ($1, $2)
+		`, userID, game2)
+		require.NoError(t, err)
+
+		err = s.DeleteGame(ctx, tx, game1)
+		require.NoError(t, err)
+
+		_, err = s.GetGameByID(ctx, tx, game1)
+		require.ErrorIs(t, err, domain.ErrGameNotFound)
+
+		err = s.DeleteGame(ctx, tx, game1)
+		require.ErrorIs(t, err, domain.ErrGameNotFound)
+
+		err = s.DeleteGame(ctx, tx, game2)
+		require.ErrorIs(t, err, domain.ErrGameHasOwners)
+	})
+}
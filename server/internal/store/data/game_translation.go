@@ -0,0 +1,150 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+const (
+	constraintGamesTranslationsGameIDFkey = "games_translations_game_id_fkey"
+)
+
+// PutGameTranslation executes a query to create or replace the translation of the game with the specified identifier
+// in the specified language.
+func (s *store) PutGameTranslation(ctx context.Context, tx pgx.Tx, gameID uuid.UUID, lang domain.Language, editableGameTranslation domain.EditableGameTranslation) error {
+	_, err := tx.Exec(ctx, `
+		INSERT INTO games_translations (game_id, language, title, description, features)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (game_id, language) DO UPDATE
+		SET title = excluded.title,
+			description = excluded.description,
+			features = excluded.features
+	`,
+		gameID,
+		lang,
+		editableGameTranslation.Title,
+		editableGameTranslation.Description,
+		editableGameTranslation.Features,
+	)
+	if err != nil {
+		switch constraintNameFromError(err) {
+		case constraintGamesTranslationsGameIDFkey:
+			return fmt.Errorf("%s: %w", descriptionFailedExec, domain.ErrGameNotFound)
+		}
+
+		return fmt.Errorf("%s: %w", descriptionFailedExec, err)
+	}
+
+	return nil
+}
+
+// GetGameTranslation executes a query to return the translation of the game with the specified identifier in the
+// specified language.
+func (s *store) GetGameTranslation(ctx context.Context, tx pgx.Tx, gameID uuid.UUID, lang domain.Language) (domain.GameTranslation, error) {
+	row := tx.QueryRow(ctx, `
+		SELECT game_id, language, title, description, features, created_at, modified_at
+		FROM games_translations
+		WHERE game_id = $1 AND language = $2
+	`,
+		gameID,
+		lang,
+	)
+
+	gameTranslation, err := getGameTranslationFromRow(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.GameTranslation{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, domain.ErrGameTranslationNotFound)
+		}
+
+		return domain.GameTranslation{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, err)
+	}
+
+	return gameTranslation, nil
+}
+
+// ListGameTranslations executes a query to return the translations of the games with the specified identifiers,
+// ordered by game and language.
+func (s *store) ListGameTranslations(ctx context.Context, tx pgx.Tx, gameIDs []uuid.UUID) ([]domain.GameTranslation, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT game_id, language, title, description, features, created_at, modified_at
+		FROM games_translations
+		WHERE game_id = ANY($1)
+		ORDER BY game_id, language
+	`,
+		gameIDs,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", descriptionFailedQuery, err)
+	}
+	defer rows.Close()
+
+	gameTranslations, err := getGameTranslationsFromRows(rows)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", descriptionFailedScanRows, err)
+	}
+
+	return gameTranslations, nil
+}
+
+// DeleteGameTranslation executes a query to delete the translation of the game with the specified identifier in the
+// specified language.
+func (s *store) DeleteGameTranslation(ctx context.Context, tx pgx.Tx, gameID uuid.UUID, lang domain.Language) error {
+	commandTag, err := tx.Exec(ctx, `
+		DELETE FROM games_translations
+		WHERE game_id = $1 AND language = $2
+	`,
+		gameID,
+		lang,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, domain.ErrGameTranslationNotFound)
+	}
+
+	return nil
+}
+
+// getGameTranslationFromRow returns the game translation by scanning the given row.
+func getGameTranslationFromRow(row pgx.Row) (domain.GameTranslation, error) {
+	var gameTranslation domain.GameTranslation
+
+	err := row.Scan(
+		&gameTranslation.GameID,
+		&gameTranslation.Language,
+		&gameTranslation.Title,
+		&gameTranslation.Description,
+		&gameTranslation.Features,
+		&gameTranslation.CreatedAt,
+		&gameTranslation.ModifiedAt,
+	)
+	if err != nil {
+		return domain.GameTranslation{}, err
+	}
+
+	return gameTranslation, nil
+}
+
+// getGameTranslationsFromRows returns the game translations by scanning the given rows.
+func getGameTranslationsFromRows(rows pgx.Rows) ([]domain.GameTranslation, error) {
+	var gameTranslations []domain.GameTranslation
+
+	for rows.Next() {
+		gameTranslation, err := getGameTranslationFromRow(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		gameTranslations = append(gameTranslations, gameTranslation)
+	}
+
+	return gameTranslations, nil
+}
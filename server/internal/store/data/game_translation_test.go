@@ -0,0 +1,99 @@
+//go:build integration
+
+package data
+
+import (
+	"context"
+	"hash/crc32"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+func TestStore_GameTranslations(t *testing.T) {
+	t.Run("should successfully put, get, list and delete game translations", func(t *testing.T) {
+		ctx := context.Background()
+
+		tx := newTx(t, ctx)
+
+		multimediaID, err := s.CreateMultimedia(ctx, tx, domain.MultimediaObject{
+			Checksum:  crc32.ChecksumIEEE([]byte("test")),
+			MediaType: "png",
+			URL:       "url",
+		})
+		require.NoError(t, err)
+
+		publisherID, err := s.CreatePublisher(ctx, tx, domain.EditablePublisherWithPassword{
+			EditablePublisher: domain.EditablePublisher{
+				Email:   "publisher@email.com",
+				Name:    "publisher name",
+				Address: "address",
+				Country: domain.Country{Region: language.MustParseRegion("pt")},
+				Vatin:   "123456789",
+			},
+			Password: "password",
+		})
+		require.NoError(t, err)
+
+		gameID, err := s.CreateGame(ctx, tx, publisherID, domain.EditableGame{
+			Title:       "title",
+			Price:       10,
+			Description: "description",
+			AgeRating:   "18",
+			Features:    "features",
+			Languages: domain.GameLanguages{
+				{Tag: language.English},
+				{Tag: language.Portuguese},
+			},
+			Requirements: domain.GameRequirements{
+				Minimum:     "minimum requirements",
+				Recommended: "recommended requirements",
+			},
+			PreviewMultimediaID: multimediaID,
+		})
+		require.NoError(t, err)
+
+		portuguese := domain.Language{Tag: language.Portuguese}
+
+		_, err = s.GetGameTranslation(ctx, tx, gameID, portuguese)
+		require.ErrorIs(t, err, domain.ErrGameTranslationNotFound)
+
+		editableGameTranslation := domain.EditableGameTranslation{
+			Title:       "translated title",
+			Description: "translated description",
+			Features:    "translated features",
+		}
+
+		err = s.PutGameTranslation(ctx, tx, gameID, portuguese, editableGameTranslation)
+		require.NoError(t, err)
+
+		editableGameTranslation.Title = "other translated title"
+
+		err = s.PutGameTranslation(ctx, tx, gameID, portuguese, editableGameTranslation)
+		require.NoError(t, err)
+
+		err = s.PutGameTranslation(ctx, tx, uuid.New(), portuguese, editableGameTranslation)
+		require.ErrorIs(t, err, domain.ErrGameNotFound)
+
+		gameTranslation, err := s.GetGameTranslation(ctx, tx, gameID, portuguese)
+		require.NoError(t, err)
+		assert.Equal(t, gameID, gameTranslation.GameID)
+		assert.Equal(t, portuguese, gameTranslation.Language)
+		assert.Equal(t, editableGameTranslation, gameTranslation.EditableGameTranslation)
+
+		gameTranslations, err := s.ListGameTranslations(ctx, tx, []uuid.UUID{gameID, uuid.New()})
+		require.NoError(t, err)
+		assert.Equal(t, []domain.GameTranslation{gameTranslation}, gameTranslations)
+
+		err = s.DeleteGameTranslation(ctx, tx, gameID, portuguese)
+		require.NoError(t, err)
+
+		err = s.DeleteGameTranslation(ctx, tx, gameID, portuguese)
+		require.ErrorIs(t, err, domain.ErrGameTranslationNotFound)
+	})
+}
@@ -11,9 +11,11 @@ import (
 // given positional parameter.
 type listSQLWhereOperator func(position int) string
 
-func listSQLWhereOperatorILike(field string) listSQLWhereOperator {
+// listSQLWhereOperatorFullTextSearch matches the positional parameter against the search vector field using the
+// search_query database function, or against the fuzzy field using trigram word similarity to tolerate typos.
+func listSQLWhereOperatorFullTextSearch(vectorField, fuzzyField string) listSQLWhereOperator {
 	return func(position int) string {
-		return fmt.Sprintf("%s ILIKE '%%' || $%d || '%%'", field, position)
+		return fmt.Sprintf("(%s @@ search_query($%d) OR $%d <%% %s)", vectorField, position, position, fuzzyField)
 	}
 }
 
@@ -62,6 +64,12 @@ func listSQLWhere(fields []listSQLWhereOperator) string {
 	return " WHERE " + strings.Join(operators, " AND ")
 }
 
+// listSQLRelevance returns an SQL expression that ranks the search vector field and the fuzzy field against the
+// positional parameter used by listSQLWhereOperatorFullTextSearch.
+func listSQLRelevance(vectorField, fuzzyField string, position int) string {
+	return fmt.Sprintf("(ts_rank(%s, search_query($%d)) + word_similarity($%d, %s))", vectorField, position, position, fuzzyField)
+}
+
 // listSQLOrder returns an SQL ORDER keyword for the specified field and order.
 func listSQLOrder(field string, order domain.PaginationOrder, secondaryField *string) string {
 	o := " ASC"
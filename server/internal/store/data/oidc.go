@@ -0,0 +1,125 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+const (
+	constraintUsersIdentitiesProviderSubjectKey = "users_identities_provider_subject_key"
+	constraintUsersIdentitiesUserIDProviderKey  = "users_identities_user_id_provider_key"
+	constraintUsersIdentitiesUserIDFkey         = "users_identities_user_id_fkey"
+)
+
+// CreateOIDCAuthorization executes a query to create an oidc authorization with the specified data.
+func (s *store) CreateOIDCAuthorization(ctx context.Context, tx pgx.Tx, editableOIDCAuthorization domain.EditableOIDCAuthorization) (uuid.UUID, error) {
+	var id uuid.UUID
+
+	err := tx.QueryRow(ctx, `
+		INSERT INTO oidc_authorizations (provider, nonce, code_verifier, binding_hash, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id
+	`,
+		editableOIDCAuthorization.Provider,
+		editableOIDCAuthorization.Nonce,
+		editableOIDCAuthorization.CodeVerifier,
+		editableOIDCAuthorization.BindingHash,
+		editableOIDCAuthorization.ExpiresAt,
+	).Scan(&id)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, err)
+	}
+
+	return id, nil
+}
+
+// DeleteOIDCAuthorization executes a query to delete the oidc authorization with the specified identifier and returns
+// it, so that an authorization can only be completed once.
+func (s *store) DeleteOIDCAuthorization(ctx context.Context, tx pgx.Tx, id uuid.UUID) (domain.OIDCAuthorization, error) {
+	var oidcAuthorization domain.OIDCAuthorization
+
+	err := tx.QueryRow(ctx, `
+		DELETE FROM oidc_authorizations
+		WHERE id = $1
+		RETURNING id, provider, nonce, code_verifier, binding_hash, expires_at, created_at
+	`, id).Scan(
+		&oidcAuthorization.ID,
+		&oidcAuthorization.Provider,
+		&oidcAuthorization.Nonce,
+		&oidcAuthorization.CodeVerifier,
+		&oidcAuthorization.BindingHash,
+		&oidcAuthorization.ExpiresAt,
+		&oidcAuthorization.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.OIDCAuthorization{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, domain.ErrOIDCAuthorizationNotFound)
+		}
+
+		return domain.OIDCAuthorization{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, err)
+	}
+
+	return oidcAuthorization, nil
+}
+
+// CreateUserIdentity executes a query to link an external identity to a user.
+func (s *store) CreateUserIdentity(ctx context.Context, tx pgx.Tx, editableUserIdentity domain.EditableUserIdentity) (uuid.UUID, error) {
+	var id uuid.UUID
+
+	err := tx.QueryRow(ctx, `
+		INSERT INTO users_identities (user_id, provider, subject, email)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id
+	`,
+		editableUserIdentity.UserID,
+		editableUserIdentity.Provider,
+		editableUserIdentity.Subject,
+		editableUserIdentity.Email,
+	).Scan(&id)
+	if err != nil {
+		switch constraintNameFromError(err) {
+		case constraintUsersIdentitiesProviderSubjectKey,
+			constraintUsersIdentitiesUserIDProviderKey:
+			return uuid.UUID{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, domain.ErrUserIdentityAlreadyExists)
+		case constraintUsersIdentitiesUserIDFkey:
+			return uuid.UUID{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, domain.ErrUserNotFound)
+		default:
+			return uuid.UUID{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, err)
+		}
+	}
+
+	return id, nil
+}
+
+// GetUserIdentity executes a query to return the user identity of the specified provider and external subject.
+func (s *store) GetUserIdentity(ctx context.Context, tx pgx.Tx, provider, subject string) (domain.UserIdentity, error) {
+	var userIdentity domain.UserIdentity
+
+	err := tx.QueryRow(ctx, `
+		SELECT id, user_id, provider, subject, email, created_at
+		FROM users_identities
+		WHERE provider = $1 AND subject = $2
+	`, provider, subject).Scan(
+		&userIdentity.ID,
+		&userIdentity.UserID,
+		&userIdentity.Provider,
+		&userIdentity.Subject,
+		&userIdentity.Email,
+		&userIdentity.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.UserIdentity{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, domain.ErrUserIdentityNotFound)
+		}
+
+		return domain.UserIdentity{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, err)
+	}
+
+	return userIdentity, nil
+}
@@ -0,0 +1,95 @@
+//go:build integration
+
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+func TestStore_OIDC(t *testing.T) {
+	t.Run("should successfully create and delete oidc authorizations", func(t *testing.T) {
+		ctx := context.Background()
+
+		tx := newTx(t, ctx)
+
+		editableOIDCAuthorization := domain.EditableOIDCAuthorization{
+			Provider:     "provider",
+			Nonce:        "nonce",
+			CodeVerifier: "code verifier",
+			ExpiresAt:    time.Now().UTC().Add(domain.OIDCAuthorizationExpirationTime).Truncate(time.Microsecond),
+		}
+
+		id, err := s.CreateOIDCAuthorization(ctx, tx, editableOIDCAuthorization)
+		require.NoError(t, err)
+
+		oidcAuthorization, err := s.DeleteOIDCAuthorization(ctx, tx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id, oidcAuthorization.ID)
+		assert.Equal(t, editableOIDCAuthorization.Provider, oidcAuthorization.Provider)
+		assert.Equal(t, editableOIDCAuthorization.Nonce, oidcAuthorization.Nonce)
+		assert.Equal(t, editableOIDCAuthorization.CodeVerifier, oidcAuthorization.CodeVerifier)
+		assert.True(t, editableOIDCAuthorization.ExpiresAt.Equal(oidcAuthorization.ExpiresAt))
+
+		_, err = s.DeleteOIDCAuthorization(ctx, tx, id)
+		require.ErrorIs(t, err, domain.ErrOIDCAuthorizationNotFound)
+	})
+
+	t.Run("should successfully create and get user identities", func(t *testing.T) {
+		ctx := context.Background()
+
+		tx := newTx(t, ctx)
+
+		userID, err := s.CreateUser(ctx, tx, domain.EditableUserWithPassword{
+			EditableUser: domain.EditableUser{
+				Username:    "username",
+				Email:       "user@email.com",
+				DisplayName: "user name",
+				DateOfBirth: domain.UserDateOfBirth(time.Date(2000, 02, 01, 0, 0, 0, 0, time.UTC)),
+				Address:     "address",
+				Country:     domain.Country{Region: language.MustParseRegion("pt")},
+				Vatin:       "123456789",
+			},
+			Password: "password",
+		})
+		require.NoError(t, err)
+
+		email := domain.Email("user@provider.com")
+
+		editableUserIdentity := domain.EditableUserIdentity{
+			UserID:   userID,
+			Provider: "provider",
+			Subject:  "subject",
+			Email:    &email,
+		}
+
+		id, err := s.CreateUserIdentity(ctx, tx, editableUserIdentity)
+		require.NoError(t, err)
+
+		userIdentity, err := s.GetUserIdentity(ctx, tx, "provider", "subject")
+		require.NoError(t, err)
+		assert.Equal(t, id, userIdentity.ID)
+		assert.Equal(t, editableUserIdentity, userIdentity.EditableUserIdentity)
+
+		editableUserIdentity.Subject = "other subject"
+
+		_, err = s.CreateUserIdentity(ctx, tx, editableUserIdentity)
+		require.ErrorIs(t, err, domain.ErrUserIdentityAlreadyExists)
+
+		editableUserIdentity.UserID = uuid.New()
+
+		_, err = s.CreateUserIdentity(ctx, tx, editableUserIdentity)
+		require.ErrorIs(t, err, domain.ErrUserNotFound)
+
+		_, err = s.GetUserIdentity(ctx, tx, "provider", "other subject")
+		require.ErrorIs(t, err, domain.ErrUserIdentityNotFound)
+	})
+}
@@ -0,0 +1,81 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+// CreatePasswordResetToken executes a query to create a password reset token with the specified data.
+func (s *store) CreatePasswordResetToken(ctx context.Context, tx pgx.Tx, editablePasswordResetToken domain.EditablePasswordResetToken) (uuid.UUID, error) {
+	var id uuid.UUID
+
+	err := tx.QueryRow(ctx, `
+		INSERT INTO password_reset_tokens (subject_id, subject_role, hash, expires_at)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id
+	`,
+		editablePasswordResetToken.SubjectID,
+		editablePasswordResetToken.SubjectRole,
+		editablePasswordResetToken.Hash,
+		editablePasswordResetToken.ExpiresAt,
+	).Scan(&id)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, err)
+	}
+
+	return id, nil
+}
+
+// GetPasswordResetTokenByHash executes a query to return the password reset token with the specified hash.
+// The password reset token is locked until the end of the transaction, so that it can only be used once.
+func (s *store) GetPasswordResetTokenByHash(ctx context.Context, tx pgx.Tx, hash []byte) (domain.PasswordResetToken, error) {
+	var passwordResetToken domain.PasswordResetToken
+
+	err := tx.QueryRow(ctx, `
+		SELECT id, subject_id, subject_role, hash, expires_at, used_at, created_at
+		FROM password_reset_tokens
+		WHERE hash = $1
+		FOR UPDATE
+	`, hash).Scan(
+		&passwordResetToken.ID,
+		&passwordResetToken.SubjectID,
+		&passwordResetToken.SubjectRole,
+		&passwordResetToken.Hash,
+		&passwordResetToken.ExpiresAt,
+		&passwordResetToken.UsedAt,
+		&passwordResetToken.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.PasswordResetToken{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, domain.ErrPasswordResetTokenNotFound)
+		}
+
+		return domain.PasswordResetToken{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, err)
+	}
+
+	return passwordResetToken, nil
+}
+
+// UsePasswordResetToken executes a query to mark the password reset token with the specified identifier as used.
+func (s *store) UsePasswordResetToken(ctx context.Context, tx pgx.Tx, id uuid.UUID) error {
+	commandTag, err := tx.Exec(ctx, `
+		UPDATE password_reset_tokens
+		SET used_at = CURRENT_TIMESTAMP
+		WHERE id = $1
+	`, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, domain.ErrPasswordResetTokenNotFound)
+	}
+
+	return nil
+}
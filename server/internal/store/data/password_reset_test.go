@@ -0,0 +1,52 @@
+//go:build integration
+
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+func TestStore_PasswordResetToken(t *testing.T) {
+	t.Run("should successfully create and use password reset tokens", func(t *testing.T) {
+		ctx := context.Background()
+
+		tx := newTx(t, ctx)
+
+		editablePasswordResetToken := domain.EditablePasswordResetToken{
+			SubjectID:   uuid.New(),
+			SubjectRole: "user",
+			Hash:        []byte("hash 1"),
+			ExpiresAt:   time.Now().UTC().Add(time.Hour).Truncate(time.Microsecond),
+		}
+
+		id, err := s.CreatePasswordResetToken(ctx, tx, editablePasswordResetToken)
+		require.NoError(t, err)
+
+		passwordResetToken, err := s.GetPasswordResetTokenByHash(ctx, tx, editablePasswordResetToken.Hash)
+		require.NoError(t, err)
+		assert.Equal(t, id, passwordResetToken.ID)
+		assert.Equal(t, editablePasswordResetToken, passwordResetToken.EditablePasswordResetToken)
+		assert.Nil(t, passwordResetToken.UsedAt)
+
+		err = s.UsePasswordResetToken(ctx, tx, id)
+		require.NoError(t, err)
+
+		passwordResetToken, err = s.GetPasswordResetTokenByHash(ctx, tx, editablePasswordResetToken.Hash)
+		require.NoError(t, err)
+		assert.NotNil(t, passwordResetToken.UsedAt)
+
+		_, err = s.GetPasswordResetTokenByHash(ctx, tx, []byte("hash 2"))
+		require.ErrorIs(t, err, domain.ErrPasswordResetTokenNotFound)
+
+		err = s.UsePasswordResetToken(ctx, tx, uuid.New())
+		require.ErrorIs(t, err, domain.ErrPasswordResetTokenNotFound)
+	})
+}
@@ -0,0 +1,46 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+// advisoryLockKeys defines the keys of the advisory locks held by the instance that runs each periodic job.
+var advisoryLockKeys = map[domain.PeriodicJob]int64{
+	domain.PeriodicJobScheduledJobs:    0x5343484544, // "SCHED" in hexadecimal.
+	domain.PeriodicJobGamesSimilar:     0x53494D494C, // "SIMIL" in hexadecimal.
+	domain.PeriodicJobGamesCharts:      0x4348415254, // "CHART" in hexadecimal.
+	domain.PeriodicJobRecommenderTrain: 0x5245434F4D, // "RECOM" in hexadecimal.
+}
+
+// errPeriodicJobUnknown is returned when a lock is requested for a periodic job without an advisory lock key.
+var errPeriodicJobUnknown = errors.New("unknown periodic job")
+
+// AcquirePeriodicJobLock executes a query to try to acquire the advisory lock of the specified periodic job until the
+// end of the specified transaction. Returns true if the lock was acquired, false if another transaction holds it.
+func (s *store) AcquirePeriodicJobLock(ctx context.Context, tx pgx.Tx, job domain.PeriodicJob) (bool, error) {
+	key, ok := advisoryLockKeys[job]
+	if !ok {
+		return false, fmt.Errorf("%s: %w", descriptionFailedQuery, errPeriodicJobUnknown)
+	}
+
+	row := tx.QueryRow(ctx, `
+		SELECT pg_try_advisory_xact_lock($1)
+	`,
+		key,
+	)
+
+	var acquired bool
+
+	err := row.Scan(&acquired)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", descriptionFailedScanRow, err)
+	}
+
+	return acquired, nil
+}
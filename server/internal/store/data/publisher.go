@@ -56,7 +56,7 @@ func (s *store) CreatePublisher(ctx context.Context, tx pgx.Tx, editablePublishe
 // GetPublisherByID executes a query to return the publisher with the specified identifier.
 func (s *store) GetPublisherByID(ctx context.Context, tx pgx.Tx, id uuid.UUID) (domain.Publisher, error) {
 	row := tx.QueryRow(ctx, `
-		SELECT p.id, p.email, p.name, p.address, p.country, p.vatin, p.created_at, p.modified_at,
+		SELECT p.id, p.email, p.name, p.address, p.country, p.vatin, p.email_verified_at, p.verification_status, p.verification_reason, p.created_at, p.modified_at,
 			m.id, m.checksum, m.media_type, m.url, m.created_at 
 		FROM publishers p
 		LEFT JOIN multimedia m
@@ -81,7 +81,7 @@ func (s *store) GetPublisherByID(ctx context.Context, tx pgx.Tx, id uuid.UUID) (
 // GetPublisherByEmail executes a query to return the publisher with the specified email.
 func (s *store) GetPublisherByEmail(ctx context.Context, tx pgx.Tx, email domain.Email) (domain.Publisher, error) {
 	row := tx.QueryRow(ctx, `
-		SELECT p.id, p.email, p.name, p.address, p.country, p.vatin, p.created_at, p.modified_at,
+		SELECT p.id, p.email, p.name, p.address, p.country, p.vatin, p.email_verified_at, p.verification_status, p.verification_reason, p.created_at, p.modified_at,
 			m.id, m.checksum, m.media_type, m.url, m.created_at
 		FROM publishers p
 		LEFT JOIN multimedia m
@@ -106,7 +106,7 @@ func (s *store) GetPublisherByEmail(ctx context.Context, tx pgx.Tx, email domain
 // GetPublisherSignIn executes a query to return the sign-in of the publisher with the specified email.
 func (s *store) GetPublisherSignIn(ctx context.Context, tx pgx.Tx, email domain.Email) (domain.SignInPublisher, error) {
 	row := tx.QueryRow(ctx, `
-		SELECT email, password 
+		SELECT id, email, name, password 
 		FROM publishers 
 		WHERE email = $1
 		LIMIT 1
@@ -117,7 +117,9 @@ func (s *store) GetPublisherSignIn(ctx context.Context, tx pgx.Tx, email domain.
 	var signIn domain.SignInPublisher
 
 	err := row.Scan(
+		&signIn.ID,
 		&signIn.Email,
+		&signIn.Name,
 		&signIn.Password,
 	)
 	if err != nil {
@@ -171,6 +173,64 @@ func (s *store) PatchPublisher(ctx context.Context, tx pgx.Tx, id uuid.UUID, edi
 	return nil
 }
 
+// GetPublisherPassword executes a query to return the hashed password of the publisher with the specified identifier.
+func (s *store) GetPublisherPassword(ctx context.Context, tx pgx.Tx, id uuid.UUID) (domain.Password, error) {
+	row := tx.QueryRow(ctx, `
+		SELECT password
+		FROM publishers
+		WHERE id = $1
+	`, id)
+
+	var password domain.Password
+
+	err := row.Scan(&password)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", fmt.Errorf("%s: %w", descriptionFailedScanRow, domain.ErrPublisherNotFound)
+		}
+
+		return "", fmt.Errorf("%s: %w", descriptionFailedScanRow, err)
+	}
+
+	return password, nil
+}
+
+// UpdatePublisherPassword executes a query to update the password of the publisher with the specified identifier.
+func (s *store) UpdatePublisherPassword(ctx context.Context, tx pgx.Tx, id uuid.UUID, password domain.Password) error {
+	commandTag, err := tx.Exec(ctx, `
+		UPDATE publishers
+		SET password = $2
+		WHERE id = $1
+	`, id, password)
+	if err != nil {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, domain.ErrPublisherNotFound)
+	}
+
+	return nil
+}
+
+// VerifyPublisherEmail executes a query to mark the email of the publisher with the specified identifier as verified.
+func (s *store) VerifyPublisherEmail(ctx context.Context, tx pgx.Tx, id uuid.UUID) error {
+	commandTag, err := tx.Exec(ctx, `
+		UPDATE publishers
+		SET email_verified_at = CURRENT_TIMESTAMP
+		WHERE id = $1
+	`, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, domain.ErrPublisherNotFound)
+	}
+
+	return nil
+}
+
 // getPublisherFromRow returns the publisher by scanning the given row.
 func getPublisherFromRow(row pgx.Row) (domain.Publisher, error) {
 	var (
@@ -190,6 +250,9 @@ func getPublisherFromRow(row pgx.Row) (domain.Publisher, error) {
 		&publisher.Address,
 		&publisher.Country,
 		&publisher.Vatin,
+		&publisher.EmailVerifiedAt,
+		&publisher.VerificationStatus,
+		&publisher.VerificationReason,
 		&publisher.CreatedAt,
 		&publisher.ModifiedAt,
 
@@ -217,3 +280,19 @@ func getPublisherFromRow(row pgx.Row) (domain.Publisher, error) {
 
 	return publisher, nil
 }
+
+// getPublishersFromRows returns the publishers by scanning the given rows.
+func getPublishersFromRows(rows pgx.Rows) ([]domain.Publisher, error) {
+	var publishers []domain.Publisher
+
+	for rows.Next() {
+		publisher, err := getPublisherFromRow(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		publishers = append(publishers, publisher)
+	}
+
+	return publishers, nil
+}
@@ -0,0 +1,239 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+const (
+	constraintPublishersMembersPkey                = "publishers_members_pkey"
+	constraintPublishersMembersPublisherIDFkey     = "publishers_members_publisher_id_fkey"
+	constraintPublishersMembersUserIDFkey          = "publishers_members_user_id_fkey"
+	constraintPublishersInvitationsPublisherIDFkey = "publishers_invitations_publisher_id_fkey"
+)
+
+// CreatePublisherMember executes a query to add a user as a member of a publisher with the specified data.
+func (s *store) CreatePublisherMember(ctx context.Context, tx pgx.Tx, editablePublisherMember domain.EditablePublisherMember) error {
+	_, err := tx.Exec(ctx, `
+		INSERT INTO publishers_members (publisher_id, user_id, role)
+		VALUES ($1, $2, $3)
+	`,
+		editablePublisherMember.PublisherID,
+		editablePublisherMember.UserID,
+		editablePublisherMember.Role,
+	)
+	if err != nil {
+		switch constraintNameFromError(err) {
+		case constraintPublishersMembersPkey:
+			return fmt.Errorf("%s: %w", descriptionFailedExec, domain.ErrPublisherMemberAlreadyExists)
+		case constraintPublishersMembersPublisherIDFkey:
+			return fmt.Errorf("%s: %w", descriptionFailedExec, domain.ErrPublisherNotFound)
+		case constraintPublishersMembersUserIDFkey:
+			return fmt.Errorf("%s: %w", descriptionFailedExec, domain.ErrUserNotFound)
+		default:
+			return fmt.Errorf("%s: %w", descriptionFailedExec, err)
+		}
+	}
+
+	return nil
+}
+
+// ListPublisherMembers executes a query to return the members of the publisher with the specified identifier.
+func (s *store) ListPublisherMembers(ctx context.Context, tx pgx.Tx, publisherID uuid.UUID) ([]domain.PublisherMember, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT publisher_id, user_id, role, created_at, modified_at
+		FROM publishers_members
+		WHERE publisher_id = $1
+		ORDER BY created_at, user_id
+	`, publisherID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", descriptionFailedQuery, err)
+	}
+	defer rows.Close()
+
+	return scanPublisherMembers(rows)
+}
+
+// ListUserPublisherMembers executes a query to return the memberships of the user with the specified identifier.
+func (s *store) ListUserPublisherMembers(ctx context.Context, tx pgx.Tx, userID uuid.UUID) ([]domain.PublisherMember, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT publisher_id, user_id, role, created_at, modified_at
+		FROM publishers_members
+		WHERE user_id = $1
+		ORDER BY created_at, publisher_id
+	`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", descriptionFailedQuery, err)
+	}
+	defer rows.Close()
+
+	return scanPublisherMembers(rows)
+}
+
+// GetPublisherMember executes a query to return the membership of the specified user in the specified publisher.
+func (s *store) GetPublisherMember(ctx context.Context, tx pgx.Tx, publisherID, userID uuid.UUID) (domain.PublisherMember, error) {
+	var publisherMember domain.PublisherMember
+
+	err := tx.QueryRow(ctx, `
+		SELECT publisher_id, user_id, role, created_at, modified_at
+		FROM publishers_members
+		WHERE publisher_id = $1 AND user_id = $2
+	`, publisherID, userID).Scan(
+		&publisherMember.PublisherID,
+		&publisherMember.UserID,
+		&publisherMember.Role,
+		&publisherMember.CreatedAt,
+		&publisherMember.ModifiedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.PublisherMember{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, domain.ErrPublisherMemberNotFound)
+		}
+
+		return domain.PublisherMember{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, err)
+	}
+
+	return publisherMember, nil
+}
+
+// UpdatePublisherMemberRole executes a query to change the role of the specified user in the specified publisher.
+func (s *store) UpdatePublisherMemberRole(ctx context.Context, tx pgx.Tx, publisherID, userID uuid.UUID, role domain.PublisherMemberRole) error {
+	commandTag, err := tx.Exec(ctx, `
+		UPDATE publishers_members
+		SET role = $3
+		WHERE publisher_id = $1 AND user_id = $2
+	`, publisherID, userID, role)
+	if err != nil {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, domain.ErrPublisherMemberNotFound)
+	}
+
+	return nil
+}
+
+// DeletePublisherMember executes a query to remove the specified user from the members of the specified publisher.
+func (s *store) DeletePublisherMember(ctx context.Context, tx pgx.Tx, publisherID, userID uuid.UUID) error {
+	commandTag, err := tx.Exec(ctx, `
+		DELETE FROM publishers_members
+		WHERE publisher_id = $1 AND user_id = $2
+	`, publisherID, userID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, domain.ErrPublisherMemberNotFound)
+	}
+
+	return nil
+}
+
+// CreatePublisherInvitation executes a query to create a publisher invitation with the specified data.
+func (s *store) CreatePublisherInvitation(ctx context.Context, tx pgx.Tx, editablePublisherInvitation domain.EditablePublisherInvitation) (uuid.UUID, error) {
+	var id uuid.UUID
+
+	err := tx.QueryRow(ctx, `
+		INSERT INTO publishers_invitations (publisher_id, email, role, hash, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id
+	`,
+		editablePublisherInvitation.PublisherID,
+		editablePublisherInvitation.Email,
+		editablePublisherInvitation.Role,
+		editablePublisherInvitation.Hash,
+		editablePublisherInvitation.ExpiresAt,
+	).Scan(&id)
+	if err != nil {
+		switch constraintNameFromError(err) {
+		case constraintPublishersInvitationsPublisherIDFkey:
+			return uuid.UUID{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, domain.ErrPublisherNotFound)
+		default:
+			return uuid.UUID{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, err)
+		}
+	}
+
+	return id, nil
+}
+
+// GetPublisherInvitationByHash executes a query to return the publisher invitation with the specified hash.
+// The publisher invitation is locked until the end of the transaction, so that it can only be accepted once.
+func (s *store) GetPublisherInvitationByHash(ctx context.Context, tx pgx.Tx, hash []byte) (domain.PublisherInvitation, error) {
+	var publisherInvitation domain.PublisherInvitation
+
+	err := tx.QueryRow(ctx, `
+		SELECT id, publisher_id, email, role, hash, expires_at, accepted_at, created_at
+		FROM publishers_invitations
+		WHERE hash = $1
+		FOR UPDATE
+	`, hash).Scan(
+		&publisherInvitation.ID,
+		&publisherInvitation.PublisherID,
+		&publisherInvitation.Email,
+		&publisherInvitation.Role,
+		&publisherInvitation.Hash,
+		&publisherInvitation.ExpiresAt,
+		&publisherInvitation.AcceptedAt,
+		&publisherInvitation.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.PublisherInvitation{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, domain.ErrPublisherInvitationNotFound)
+		}
+
+		return domain.PublisherInvitation{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, err)
+	}
+
+	return publisherInvitation, nil
+}
+
+// AcceptPublisherInvitation executes a query to mark the publisher invitation with the specified identifier as
+// accepted.
+func (s *store) AcceptPublisherInvitation(ctx context.Context, tx pgx.Tx, id uuid.UUID) error {
+	commandTag, err := tx.Exec(ctx, `
+		UPDATE publishers_invitations
+		SET accepted_at = CURRENT_TIMESTAMP
+		WHERE id = $1
+	`, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, domain.ErrPublisherInvitationNotFound)
+	}
+
+	return nil
+}
+
+// scanPublisherMembers returns the publisher members of the rows.
+func scanPublisherMembers(rows pgx.Rows) ([]domain.PublisherMember, error) {
+	publisherMembers := make([]domain.PublisherMember, 0)
+
+	for rows.Next() {
+		var publisherMember domain.PublisherMember
+
+		err := rows.Scan(
+			&publisherMember.PublisherID,
+			&publisherMember.UserID,
+			&publisherMember.Role,
+			&publisherMember.CreatedAt,
+			&publisherMember.ModifiedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", descriptionFailedScanRows, err)
+		}
+
+		publisherMembers = append(publisherMembers, publisherMember)
+	}
+
+	return publisherMembers, nil
+}
@@ -0,0 +1,136 @@
+//go:build integration
+
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+func TestStore_PublisherMember(t *testing.T) {
+	t.Run("should successfully create, update and delete publisher members", func(t *testing.T) {
+		ctx := context.Background()
+
+		tx := newTx(t, ctx)
+
+		publisherID, err := s.CreatePublisher(ctx, tx, domain.EditablePublisherWithPassword{
+			EditablePublisher: domain.EditablePublisher{
+				Email:   "publisher@email.com",
+				Name:    "publisher name",
+				Address: "address",
+				Country: domain.Country{Region: language.MustParseRegion("pt")},
+				Vatin:   "123456789",
+			},
+			Password: "password",
+		})
+		require.NoError(t, err)
+
+		userID, err := s.CreateUser(ctx, tx, domain.EditableUserWithPassword{
+			EditableUser: domain.EditableUser{
+				Username:    "username",
+				Email:       "user@email.com",
+				DisplayName: "user name",
+				DateOfBirth: domain.UserDateOfBirth(time.Date(2000, 02, 01, 0, 0, 0, 0, time.UTC)),
+				Address:     "address",
+				Country:     domain.Country{Region: language.MustParseRegion("pt")},
+				Vatin:       "123456789",
+			},
+			Password: "password",
+		})
+		require.NoError(t, err)
+
+		editablePublisherMember := domain.EditablePublisherMember{
+			PublisherID: publisherID,
+			UserID:      userID,
+			Role:        domain.PublisherMemberRoleDeveloper,
+		}
+
+		err = s.CreatePublisherMember(ctx, tx, editablePublisherMember)
+		require.NoError(t, err)
+
+		err = s.CreatePublisherMember(ctx, tx, editablePublisherMember)
+		require.ErrorIs(t, err, domain.ErrPublisherMemberAlreadyExists)
+
+		publisherMembers, err := s.ListPublisherMembers(ctx, tx, publisherID)
+		require.NoError(t, err)
+		require.Len(t, publisherMembers, 1)
+		assert.Equal(t, editablePublisherMember, publisherMembers[0].EditablePublisherMember)
+
+		err = s.UpdatePublisherMemberRole(ctx, tx, publisherID, userID, domain.PublisherMemberRoleFinance)
+		require.NoError(t, err)
+
+		publisherMembers, err = s.ListUserPublisherMembers(ctx, tx, userID)
+		require.NoError(t, err)
+		require.Len(t, publisherMembers, 1)
+		assert.Equal(t, domain.PublisherMemberRoleFinance, publisherMembers[0].Role)
+
+		err = s.DeletePublisherMember(ctx, tx, publisherID, userID)
+		require.NoError(t, err)
+
+		_, err = s.GetPublisherMember(ctx, tx, publisherID, userID)
+		require.ErrorIs(t, err, domain.ErrPublisherMemberNotFound)
+
+		err = s.DeletePublisherMember(ctx, tx, publisherID, userID)
+		require.ErrorIs(t, err, domain.ErrPublisherMemberNotFound)
+
+		err = s.CreatePublisherMember(ctx, tx, domain.EditablePublisherMember{
+			PublisherID: uuid.New(),
+			UserID:      userID,
+			Role:        domain.PublisherMemberRoleViewer,
+		})
+		require.ErrorIs(t, err, domain.ErrPublisherNotFound)
+	})
+
+	t.Run("should successfully create and accept publisher invitations", func(t *testing.T) {
+		ctx := context.Background()
+
+		tx := newTx(t, ctx)
+
+		publisherID, err := s.CreatePublisher(ctx, tx, domain.EditablePublisherWithPassword{
+			EditablePublisher: domain.EditablePublisher{
+				Email:   "publisher@email.com",
+				Name:    "publisher name",
+				Address: "address",
+				Country: domain.Country{Region: language.MustParseRegion("pt")},
+				Vatin:   "123456789",
+			},
+			Password: "password",
+		})
+		require.NoError(t, err)
+
+		editablePublisherInvitation := domain.EditablePublisherInvitation{
+			PublisherID: publisherID,
+			Email:       "user@email.com",
+			Role:        domain.PublisherMemberRoleOwner,
+			Hash:        []byte("publisher invitation token hash"),
+			ExpiresAt:   time.Now().UTC().Add(domain.PublisherInvitationExpirationTime).Truncate(time.Microsecond),
+		}
+
+		id, err := s.CreatePublisherInvitation(ctx, tx, editablePublisherInvitation)
+		require.NoError(t, err)
+
+		publisherInvitation, err := s.GetPublisherInvitationByHash(ctx, tx, editablePublisherInvitation.Hash)
+		require.NoError(t, err)
+		assert.Equal(t, id, publisherInvitation.ID)
+		assert.Equal(t, editablePublisherInvitation.Role, publisherInvitation.Role)
+		assert.Nil(t, publisherInvitation.AcceptedAt)
+
+		err = s.AcceptPublisherInvitation(ctx, tx, id)
+		require.NoError(t, err)
+
+		publisherInvitation, err = s.GetPublisherInvitationByHash(ctx, tx, editablePublisherInvitation.Hash)
+		require.NoError(t, err)
+		assert.NotNil(t, publisherInvitation.AcceptedAt)
+
+		_, err = s.GetPublisherInvitationByHash(ctx, tx, []byte("unknown hash"))
+		require.ErrorIs(t, err, domain.ErrPublisherInvitationNotFound)
+	})
+}
@@ -165,3 +165,56 @@ func TestStore_CreatePublisher(t *testing.T) {
 		})
 	})
 }
+
+func TestStore_VerifyPublisherEmail(t *testing.T) {
+	t.Run("should successfully verify publisher email and reset it when the email changes", func(t *testing.T) {
+		ctx := context.Background()
+
+		tx := newTx(t, ctx)
+
+		editablePublisher := domain.EditablePublisherWithPassword{
+			EditablePublisher: domain.EditablePublisher{
+				Email:   "publisher@email.com",
+				Name:    "publisher name",
+				Address: "address",
+				Country: domain.Country{Region: language.MustParseRegion("pt")},
+				Vatin:   "123456789",
+			},
+			Password: "password",
+		}
+
+		publisherID, err := s.CreatePublisher(ctx, tx, editablePublisher)
+		require.NoError(t, err)
+
+		publisher, err := s.GetPublisherByID(ctx, tx, publisherID)
+		require.NoError(t, err)
+		assert.Nil(t, publisher.EmailVerifiedAt)
+
+		err = s.VerifyPublisherEmail(ctx, tx, publisherID)
+		require.NoError(t, err)
+
+		publisher, err = s.GetPublisherByID(ctx, tx, publisherID)
+		require.NoError(t, err)
+		assert.NotNil(t, publisher.EmailVerifiedAt)
+
+		newEmail := domain.Email("new.publisher@email.com")
+
+		err = s.PatchPublisher(ctx, tx, publisherID, domain.EditablePublisherPatch{Email: &newEmail})
+		require.NoError(t, err)
+
+		publisher, err = s.GetPublisherByID(ctx, tx, publisherID)
+		require.NoError(t, err)
+		assert.Nil(t, publisher.EmailVerifiedAt)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when publisher is not found", func(t *testing.T) {
+			ctx := context.Background()
+
+			tx := newTx(t, ctx)
+
+			err := s.VerifyPublisherEmail(ctx, tx, uuid.New())
+			require.ErrorIs(t, err, domain.ErrPublisherNotFound)
+		})
+	})
+}
@@ -0,0 +1,164 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+const (
+	constraintPublishersDocumentsPublisherIDFkey = "publishers_documents_publisher_id_fkey"
+)
+
+// CreatePublisherDocument executes a query to create a business document of the publisher with the specified
+// identifier with the specified data.
+func (s *store) CreatePublisherDocument(ctx context.Context, tx pgx.Tx, publisherID uuid.UUID, editablePublisherDocument domain.EditablePublisherDocument) (uuid.UUID, error) {
+	var id uuid.UUID
+
+	err := tx.QueryRow(ctx, `
+		INSERT INTO publishers_documents (publisher_id, object_name, media_type)
+		VALUES ($1, $2, $3)
+		RETURNING id
+	`,
+		publisherID,
+		editablePublisherDocument.ObjectName,
+		editablePublisherDocument.MediaType,
+	).Scan(&id)
+	if err != nil {
+		switch constraintNameFromError(err) {
+		case constraintPublishersDocumentsPublisherIDFkey:
+			return uuid.UUID{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, domain.ErrPublisherNotFound)
+		}
+
+		return uuid.UUID{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, err)
+	}
+
+	return id, nil
+}
+
+// GetPublisherDocument executes a query to return the business document with the specified identifier of the
+// publisher with the specified identifier.
+func (s *store) GetPublisherDocument(ctx context.Context, tx pgx.Tx, publisherID, id uuid.UUID) (domain.PublisherDocument, error) {
+	row := tx.QueryRow(ctx, `
+		SELECT id, publisher_id, object_name, media_type, created_at
+		FROM publishers_documents
+		WHERE publisher_id = $1 AND id = $2
+	`,
+		publisherID,
+		id,
+	)
+
+	publisherDocument, err := getPublisherDocumentFromRow(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.PublisherDocument{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, domain.ErrPublisherDocumentNotFound)
+		}
+
+		return domain.PublisherDocument{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, err)
+	}
+
+	return publisherDocument, nil
+}
+
+// ListPublisherDocuments executes a query to return the business documents of the publisher with the specified
+// identifier, oldest first.
+func (s *store) ListPublisherDocuments(ctx context.Context, tx pgx.Tx, publisherID uuid.UUID) ([]domain.PublisherDocument, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT id, publisher_id, object_name, media_type, created_at
+		FROM publishers_documents
+		WHERE publisher_id = $1
+		ORDER BY created_at ASC
+	`,
+		publisherID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", descriptionFailedQuery, err)
+	}
+	defer rows.Close()
+
+	var publisherDocuments []domain.PublisherDocument
+
+	for rows.Next() {
+		publisherDocument, err := getPublisherDocumentFromRow(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", descriptionFailedScanRows, err)
+		}
+
+		publisherDocuments = append(publisherDocuments, publisherDocument)
+	}
+
+	return publisherDocuments, nil
+}
+
+// ListPublishersByVerificationStatus executes a query to return the publishers with the specified verification
+// status, oldest first.
+func (s *store) ListPublishersByVerificationStatus(ctx context.Context, tx pgx.Tx, status domain.PublisherVerificationStatus) ([]domain.Publisher, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT p.id, p.email, p.name, p.address, p.country, p.vatin, p.email_verified_at, p.verification_status, p.verification_reason, p.created_at, p.modified_at,
+			m.id, m.checksum, m.media_type, m.url, m.created_at
+		FROM publishers p
+		LEFT JOIN multimedia m
+			ON m.id = p.picture_multimedia_id
+		WHERE p.verification_status = $1
+		ORDER BY p.created_at ASC
+	`,
+		status,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", descriptionFailedQuery, err)
+	}
+	defer rows.Close()
+
+	publishers, err := getPublishersFromRows(rows)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", descriptionFailedScanRows, err)
+	}
+
+	return publishers, nil
+}
+
+// UpdatePublisherVerification executes a query to update the verification status and reason of the publisher with
+// the specified identifier.
+func (s *store) UpdatePublisherVerification(ctx context.Context, tx pgx.Tx, id uuid.UUID, status domain.PublisherVerificationStatus, reason *domain.PublisherVerificationReason) error {
+	commandTag, err := tx.Exec(ctx, `
+		UPDATE publishers
+		SET verification_status = $2, verification_reason = $3
+		WHERE id = $1
+	`,
+		id,
+		status,
+		reason,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, domain.ErrPublisherNotFound)
+	}
+
+	return nil
+}
+
+// getPublisherDocumentFromRow returns the publisher document by scanning the given row.
+func getPublisherDocumentFromRow(row pgx.Row) (domain.PublisherDocument, error) {
+	var publisherDocument domain.PublisherDocument
+
+	err := row.Scan(
+		&publisherDocument.ID,
+		&publisherDocument.PublisherID,
+		&publisherDocument.ObjectName,
+		&publisherDocument.MediaType,
+		&publisherDocument.CreatedAt,
+	)
+	if err != nil {
+		return domain.PublisherDocument{}, err
+	}
+
+	return publisherDocument, nil
+}
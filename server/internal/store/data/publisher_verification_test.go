@@ -0,0 +1,87 @@
+//go:build integration
+
+package data
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+func TestStore_PublisherVerification(t *testing.T) {
+	t.Run("should successfully submit documents and review publisher", func(t *testing.T) {
+		ctx := context.Background()
+
+		tx := newTx(t, ctx)
+
+		publisherID, err := s.CreatePublisher(ctx, tx, domain.EditablePublisherWithPassword{
+			EditablePublisher: domain.EditablePublisher{
+				Email:   "publisher@email.com",
+				Name:    "publisher name",
+				Address: "address",
+				Country: domain.Country{Region: language.MustParseRegion("pt")},
+				Vatin:   "123456789",
+			},
+			Password: "password",
+		})
+		require.NoError(t, err)
+
+		publisher, err := s.GetPublisherByID(ctx, tx, publisherID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.PublisherVerificationStatusPending, publisher.VerificationStatus)
+		assert.Nil(t, publisher.VerificationReason)
+
+		editablePublisherDocument := domain.EditablePublisherDocument{
+			ObjectName: publisherID.String() + "/document",
+			MediaType:  "application/pdf",
+		}
+
+		documentID, err := s.CreatePublisherDocument(ctx, tx, publisherID, editablePublisherDocument)
+		require.NoError(t, err)
+
+		_, err = s.CreatePublisherDocument(ctx, tx, uuid.New(), editablePublisherDocument)
+		require.ErrorIs(t, err, domain.ErrPublisherNotFound)
+
+		document, err := s.GetPublisherDocument(ctx, tx, publisherID, documentID)
+		require.NoError(t, err)
+		assert.Equal(t, documentID, document.ID)
+		assert.Equal(t, publisherID, document.PublisherID)
+		assert.Equal(t, editablePublisherDocument, document.EditablePublisherDocument)
+
+		_, err = s.GetPublisherDocument(ctx, tx, uuid.New(), documentID)
+		require.ErrorIs(t, err, domain.ErrPublisherDocumentNotFound)
+
+		documents, err := s.ListPublisherDocuments(ctx, tx, publisherID)
+		require.NoError(t, err)
+		require.Len(t, documents, 1)
+		assert.Equal(t, documentID, documents[0].ID)
+
+		publishers, err := s.ListPublishersByVerificationStatus(ctx, tx, domain.PublisherVerificationStatusPending)
+		require.NoError(t, err)
+		require.Len(t, publishers, 1)
+		assert.Equal(t, publisherID, publishers[0].ID)
+
+		reason := domain.PublisherVerificationReason("reason")
+
+		err = s.UpdatePublisherVerification(ctx, tx, publisherID, domain.PublisherVerificationStatusRejected, &reason)
+		require.NoError(t, err)
+
+		publisher, err = s.GetPublisherByID(ctx, tx, publisherID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.PublisherVerificationStatusRejected, publisher.VerificationStatus)
+		assert.Equal(t, &reason, publisher.VerificationReason)
+
+		publishers, err = s.ListPublishersByVerificationStatus(ctx, tx, domain.PublisherVerificationStatusPending)
+		require.NoError(t, err)
+		assert.Empty(t, publishers)
+
+		err = s.UpdatePublisherVerification(ctx, tx, uuid.New(), domain.PublisherVerificationStatusVerified, nil)
+		require.ErrorIs(t, err, domain.ErrPublisherNotFound)
+	})
+}
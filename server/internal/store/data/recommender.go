@@ -0,0 +1,160 @@
+package data
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+// ListRecommenderInteractions executes a query to return the interactions of the users with the games, based on their
+// libraries and invoices.
+func (s *store) ListRecommenderInteractions(ctx context.Context, tx pgx.Tx) ([]domain.RecommenderInteraction, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT ul.user_id, ul.game_id, EXISTS (
+				SELECT 1
+				FROM invoices i
+				INNER JOIN invoices_games ig
+					ON ig.invoice_id = i.id
+				WHERE i.user_id = ul.user_id AND ig.game_id = ul.game_id
+			), ul.created_at
+		FROM users_libraries ul
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", descriptionFailedQuery, err)
+	}
+	defer rows.Close()
+
+	var interactions []domain.RecommenderInteraction
+
+	for rows.Next() {
+		var interaction domain.RecommenderInteraction
+
+		err := rows.Scan(
+			&interaction.UserID,
+			&interaction.GameID,
+			&interaction.Purchased,
+			&interaction.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", descriptionFailedScanRows, err)
+		}
+
+		interactions = append(interactions, interaction)
+	}
+
+	return interactions, nil
+}
+
+// ReplaceRecommenderItems executes a query to replace all the recommender items with the specified ones.
+func (s *store) ReplaceRecommenderItems(ctx context.Context, tx pgx.Tx, items []domain.RecommenderItem) error {
+	_, err := tx.Exec(ctx, `
+		DELETE FROM recommender_items
+	`)
+	if err != nil {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, err)
+	}
+
+	_, err = tx.CopyFrom(ctx,
+		pgx.Identifier{"recommender_items"},
+		[]string{"game_id", "similar_game_id", "score"},
+		pgx.CopyFromSlice(len(items), func(i int) ([]any, error) {
+			return []any{items[i].GameID, items[i].SimilarGameID, items[i].Score}, nil
+		}),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, err)
+	}
+
+	return nil
+}
+
+// ListGamesRecommendedByItems executes a query to return the games recommended to the user with the specified
+// identifier, scored by the similarity of the recommender items with the games of the user library.
+func (s *store) ListGamesRecommendedByItems(ctx context.Context, tx pgx.Tx, userID uuid.UUID, filter domain.GamesRecommendedPaginatedFilter) (domain.PaginatedResponse[domain.Game], error) {
+	sqlWith := `
+		WITH scores AS (
+			SELECT ri.similar_game_id AS game_id, sum(ri.score) AS score
+			FROM users_libraries ul
+			INNER JOIN recommender_items ri
+				ON ri.game_id = ul.game_id
+			WHERE ul.user_id = $1 AND NOT EXISTS (
+				SELECT 1
+				FROM users_libraries o
+				WHERE o.user_id = $1 AND o.game_id = ri.similar_game_id
+			)
+			GROUP BY ri.similar_game_id
+		)
+	`
+
+	row := tx.QueryRow(ctx, sqlWith+`
+		SELECT count(s.game_id)
+		FROM scores s
+		INNER JOIN games g
+			ON g.id = s.game_id
+		WHERE g.status = 'published'
+	`,
+		userID,
+	)
+
+	var total int
+
+	err := row.Scan(&total)
+	if err != nil {
+		return domain.PaginatedResponse[domain.Game]{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, err)
+	}
+
+	rows, err := tx.Query(ctx, sqlWith+`
+		SELECT g.id, g.title, g.price, g.status, g.review_notes, g.release_date, g.description, g.age_rating, g.features, g.languages, g.requirements, g.created_at, g.modified_at,
+			p.id, p.email, p.name, p.address, p.country, p.vatin, p.verification_status, p.verification_reason, p.created_at, p.modified_at,
+			pm.id, pm.checksum, pm.media_type, pm.url, pm.created_at,
+			gpm.id, gpm.checksum, gpm.media_type, gpm.url, gpm.created_at,
+			gdm.id, gdm.checksum, gdm.media_type, gdm.url, gdm.created_at
+		FROM scores s
+		INNER JOIN games g
+			ON g.id = s.game_id
+		INNER JOIN publishers p
+			ON p.id = g.publisher_id
+		LEFT JOIN multimedia pm
+			ON pm.id = p.picture_multimedia_id
+		INNER JOIN multimedia gpm
+			ON gpm.id = g.preview_multimedia_id
+		LEFT JOIN multimedia gdm
+			ON gdm.id = g.download_multimedia_id
+		WHERE g.status = 'published'
+		ORDER BY s.score DESC, g.release_date DESC, g.created_at DESC
+	`+listSQLLimitOffset(filter.Limit, filter.Offset),
+		userID,
+	)
+	if err != nil {
+		return domain.PaginatedResponse[domain.Game]{}, fmt.Errorf("%s: %w", descriptionFailedQuery, err)
+	}
+	defer rows.Close()
+
+	games, err := getGamesFromRows(rows)
+	if err != nil {
+		return domain.PaginatedResponse[domain.Game]{}, fmt.Errorf("%s: %w", descriptionFailedScanRows, err)
+	}
+
+	for i, game := range games {
+		game.Multimedia, err = s.GetGameMultimedia(ctx, tx, game.ID)
+		if err != nil {
+			return domain.PaginatedResponse[domain.Game]{}, err
+		}
+
+		game.Tags, err = s.GetGameTags(ctx, tx, game.ID)
+		if err != nil {
+			return domain.PaginatedResponse[domain.Game]{}, err
+		}
+
+		games[i] = game
+	}
+
+	return domain.PaginatedResponse[domain.Game]{
+		Total:   total,
+		Results: games,
+	}, nil
+}
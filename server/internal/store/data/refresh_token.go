@@ -0,0 +1,79 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+// CreateRefreshToken executes a query to create a refresh token with the specified data.
+func (s *store) CreateRefreshToken(ctx context.Context, tx pgx.Tx, editableRefreshToken domain.EditableRefreshToken) (uuid.UUID, error) {
+	var id uuid.UUID
+
+	err := tx.QueryRow(ctx, `
+		INSERT INTO refresh_tokens (session_id, hash, expires_at)
+		VALUES ($1, $2, $3)
+		RETURNING id
+	`,
+		editableRefreshToken.SessionID,
+		editableRefreshToken.Hash,
+		editableRefreshToken.ExpiresAt,
+	).Scan(&id)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, err)
+	}
+
+	return id, nil
+}
+
+// GetRefreshTokenByHash executes a query to return the refresh token with the specified hash.
+// The refresh token is locked until the end of the transaction, so that it can only be rotated once.
+func (s *store) GetRefreshTokenByHash(ctx context.Context, tx pgx.Tx, hash []byte) (domain.RefreshToken, error) {
+	var refreshToken domain.RefreshToken
+
+	err := tx.QueryRow(ctx, `
+		SELECT id, session_id, hash, expires_at, rotated_at, created_at
+		FROM refresh_tokens
+		WHERE hash = $1
+		FOR UPDATE
+	`, hash).Scan(
+		&refreshToken.ID,
+		&refreshToken.SessionID,
+		&refreshToken.Hash,
+		&refreshToken.ExpiresAt,
+		&refreshToken.RotatedAt,
+		&refreshToken.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.RefreshToken{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, domain.ErrRefreshTokenNotFound)
+		}
+
+		return domain.RefreshToken{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, err)
+	}
+
+	return refreshToken, nil
+}
+
+// RotateRefreshToken executes a query to mark the refresh token with the specified identifier as rotated.
+func (s *store) RotateRefreshToken(ctx context.Context, tx pgx.Tx, id uuid.UUID) error {
+	commandTag, err := tx.Exec(ctx, `
+		UPDATE refresh_tokens
+		SET rotated_at = CURRENT_TIMESTAMP
+		WHERE id = $1
+	`, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, domain.ErrRefreshTokenNotFound)
+	}
+
+	return nil
+}
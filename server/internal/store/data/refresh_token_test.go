@@ -0,0 +1,57 @@
+//go:build integration
+
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+func TestStore_RefreshToken(t *testing.T) {
+	t.Run("should successfully create and rotate refresh tokens", func(t *testing.T) {
+		ctx := context.Background()
+
+		tx := newTx(t, ctx)
+
+		expiresAt := time.Now().UTC().Add(time.Hour).Truncate(time.Microsecond)
+
+		sessionID, err := s.CreateSession(ctx, tx, domain.EditableSession{
+			SubjectID:   uuid.New(),
+			SubjectRole: "user",
+			ExpiresAt:   expiresAt,
+		})
+		require.NoError(t, err)
+
+		editableRefreshToken := domain.EditableRefreshToken{
+			SessionID: sessionID,
+			Hash:      []byte("hash 1"),
+			ExpiresAt: expiresAt,
+		}
+
+		id1, err := s.CreateRefreshToken(ctx, tx, editableRefreshToken)
+		require.NoError(t, err)
+
+		refreshToken, err := s.GetRefreshTokenByHash(ctx, tx, editableRefreshToken.Hash)
+		require.NoError(t, err)
+		assert.Equal(t, id1, refreshToken.ID)
+		assert.Equal(t, editableRefreshToken, refreshToken.EditableRefreshToken)
+		assert.Nil(t, refreshToken.RotatedAt)
+
+		err = s.RotateRefreshToken(ctx, tx, id1)
+		require.NoError(t, err)
+
+		refreshToken, err = s.GetRefreshTokenByHash(ctx, tx, editableRefreshToken.Hash)
+		require.NoError(t, err)
+		assert.NotNil(t, refreshToken.RotatedAt)
+
+		_, err = s.GetRefreshTokenByHash(ctx, tx, []byte("hash 2"))
+		require.ErrorIs(t, err, domain.ErrRefreshTokenNotFound)
+	})
+}
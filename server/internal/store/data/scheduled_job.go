@@ -0,0 +1,200 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+const (
+	constraintScheduledJobsGameIDFkey = "scheduled_jobs_game_id_fkey"
+)
+
+// CreateScheduledJob executes a query to create a scheduled job of the game with the specified identifier. The run
+// time of the editable scheduled job must be set.
+func (s *store) CreateScheduledJob(ctx context.Context, tx pgx.Tx, gameID uuid.UUID, editableScheduledJob domain.EditableScheduledJob) (uuid.UUID, error) {
+	row := tx.QueryRow(ctx, `
+		INSERT INTO scheduled_jobs (game_id, action, run_at)
+		VALUES ($1, $2, $3)
+		RETURNING id
+	`,
+		gameID,
+		editableScheduledJob.Action,
+		editableScheduledJob.RunAt,
+	)
+
+	var id uuid.UUID
+
+	err := row.Scan(&id)
+	if err != nil {
+		switch constraintNameFromError(err) {
+		case constraintScheduledJobsGameIDFkey:
+			return uuid.UUID{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, domain.ErrGameNotFound)
+		}
+
+		return uuid.UUID{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, err)
+	}
+
+	return id, nil
+}
+
+// GetScheduledJobByID executes a query to return the scheduled job with the specified identifier.
+func (s *store) GetScheduledJobByID(ctx context.Context, tx pgx.Tx, id uuid.UUID) (domain.ScheduledJob, error) {
+	row := tx.QueryRow(ctx, `
+		SELECT id, game_id, action, run_at, status, error, executed_at, created_at, modified_at
+		FROM scheduled_jobs
+		WHERE id = $1
+	`,
+		id,
+	)
+
+	scheduledJob, err := getScheduledJobFromRow(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ScheduledJob{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, domain.ErrScheduledJobNotFound)
+		}
+
+		return domain.ScheduledJob{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, err)
+	}
+
+	return scheduledJob, nil
+}
+
+// ListScheduledJobs executes a query to return the scheduled jobs of the game with the specified identifier, latest
+// run time first.
+func (s *store) ListScheduledJobs(ctx context.Context, tx pgx.Tx, gameID uuid.UUID) ([]domain.ScheduledJob, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT id, game_id, action, run_at, status, error, executed_at, created_at, modified_at
+		FROM scheduled_jobs
+		WHERE game_id = $1
+		ORDER BY run_at DESC, created_at DESC
+	`,
+		gameID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", descriptionFailedQuery, err)
+	}
+	defer rows.Close()
+
+	scheduledJobs, err := getScheduledJobsFromRows(rows)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", descriptionFailedScanRows, err)
+	}
+
+	return scheduledJobs, nil
+}
+
+// ListDueScheduledJobs executes a query to return up to the specified amount of pending scheduled jobs whose run time
+// is not after the specified time, oldest run time first.
+func (s *store) ListDueScheduledJobs(ctx context.Context, tx pgx.Tx, now time.Time, limit int) ([]domain.ScheduledJob, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT id, game_id, action, run_at, status, error, executed_at, created_at, modified_at
+		FROM scheduled_jobs
+		WHERE status = 'pending' AND run_at <= $1
+		ORDER BY run_at ASC, created_at ASC
+		LIMIT $2
+	`,
+		now,
+		limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", descriptionFailedQuery, err)
+	}
+	defer rows.Close()
+
+	scheduledJobs, err := getScheduledJobsFromRows(rows)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", descriptionFailedScanRows, err)
+	}
+
+	return scheduledJobs, nil
+}
+
+// CompleteScheduledJob executes a query to set the final status and error of the pending scheduled job with the
+// specified identifier, and mark it as executed.
+func (s *store) CompleteScheduledJob(ctx context.Context, tx pgx.Tx, id uuid.UUID, status domain.ScheduledJobStatus, jobError *domain.ScheduledJobError) error {
+	commandTag, err := tx.Exec(ctx, `
+		UPDATE scheduled_jobs
+		SET status = $2, error = $3, executed_at = now()
+		WHERE id = $1 AND status = 'pending'
+	`,
+		id,
+		status,
+		jobError,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, domain.ErrScheduledJobNotFound)
+	}
+
+	return nil
+}
+
+// CancelScheduledJob executes a query to cancel the pending scheduled job with the specified identifier of the game
+// with the specified identifier.
+func (s *store) CancelScheduledJob(ctx context.Context, tx pgx.Tx, gameID, id uuid.UUID) error {
+	commandTag, err := tx.Exec(ctx, `
+		UPDATE scheduled_jobs
+		SET status = 'cancelled'
+		WHERE id = $1 AND game_id = $2 AND status = 'pending'
+	`,
+		id,
+		gameID,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, domain.ErrScheduledJobNotFound)
+	}
+
+	return nil
+}
+
+// getScheduledJobFromRow returns the scheduled job by scanning the given row.
+func getScheduledJobFromRow(row pgx.Row) (domain.ScheduledJob, error) {
+	var scheduledJob domain.ScheduledJob
+
+	err := row.Scan(
+		&scheduledJob.ID,
+		&scheduledJob.GameID,
+		&scheduledJob.Action,
+		&scheduledJob.RunAt,
+		&scheduledJob.Status,
+		&scheduledJob.Error,
+		&scheduledJob.ExecutedAt,
+		&scheduledJob.CreatedAt,
+		&scheduledJob.ModifiedAt,
+	)
+	if err != nil {
+		return domain.ScheduledJob{}, err
+	}
+
+	return scheduledJob, nil
+}
+
+// getScheduledJobsFromRows returns the scheduled jobs by scanning the given rows.
+func getScheduledJobsFromRows(rows pgx.Rows) ([]domain.ScheduledJob, error) {
+	var scheduledJobs []domain.ScheduledJob
+
+	for rows.Next() {
+		scheduledJob, err := getScheduledJobFromRow(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		scheduledJobs = append(scheduledJobs, scheduledJob)
+	}
+
+	return scheduledJobs, nil
+}
@@ -0,0 +1,119 @@
+//go:build integration
+
+package data
+
+import (
+	"context"
+	"hash/crc32"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+func TestStore_ScheduledJobs(t *testing.T) {
+	t.Run("should successfully create, run and cancel scheduled jobs", func(t *testing.T) {
+		ctx := context.Background()
+
+		tx := newTx(t, ctx)
+
+		acquired, err := s.AcquirePeriodicJobLock(ctx, tx, domain.PeriodicJobScheduledJobs)
+		require.NoError(t, err)
+		assert.True(t, acquired)
+
+		multimediaID, err := s.CreateMultimedia(ctx, tx, domain.MultimediaObject{
+			Checksum:  crc32.ChecksumIEEE([]byte("test")),
+			MediaType: "png",
+			URL:       "url",
+		})
+		require.NoError(t, err)
+
+		publisherID, err := s.CreatePublisher(ctx, tx, domain.EditablePublisherWithPassword{
+			EditablePublisher: domain.EditablePublisher{
+				Email:   "publisher@email.com",
+				Name:    "publisher name",
+				Address: "address",
+				Country: domain.Country{Region: language.MustParseRegion("pt")},
+				Vatin:   "123456789",
+			},
+			Password: "password",
+		})
+		require.NoError(t, err)
+
+		gameID, err := s.CreateGame(ctx, tx, publisherID, domain.EditableGame{
+			Title:       "title",
+			Price:       10,
+			Description: "description",
+			AgeRating:   "18",
+			Features:    "features",
+			Languages: domain.GameLanguages{
+				{Tag: language.English},
+			},
+			Requirements: domain.GameRequirements{
+				Minimum:     "minimum requirements",
+				Recommended: "recommended requirements",
+			},
+			PreviewMultimediaID: multimediaID,
+		})
+		require.NoError(t, err)
+
+		now := time.Now().UTC()
+		pastRunAt := now.Add(-time.Hour)
+		futureRunAt := now.Add(time.Hour)
+
+		dueID, err := s.CreateScheduledJob(ctx, tx, gameID, domain.EditableScheduledJob{
+			Action: domain.ScheduledJobActionPublishGame,
+			RunAt:  &pastRunAt,
+		})
+		require.NoError(t, err)
+
+		futureID, err := s.CreateScheduledJob(ctx, tx, gameID, domain.EditableScheduledJob{
+			Action: domain.ScheduledJobActionDelistGame,
+			RunAt:  &futureRunAt,
+		})
+		require.NoError(t, err)
+
+		_, err = s.CreateScheduledJob(ctx, tx, uuid.New(), domain.EditableScheduledJob{
+			Action: domain.ScheduledJobActionPublishGame,
+			RunAt:  &futureRunAt,
+		})
+		require.ErrorIs(t, err, domain.ErrGameNotFound)
+
+		dueScheduledJobs, err := s.ListDueScheduledJobs(ctx, tx, now, 10)
+		require.NoError(t, err)
+		require.Len(t, dueScheduledJobs, 1)
+		assert.Equal(t, dueID, dueScheduledJobs[0].ID)
+		assert.Equal(t, domain.ScheduledJobStatusPending, dueScheduledJobs[0].Status)
+
+		jobError := domain.ScheduledJobError("error")
+
+		err = s.CompleteScheduledJob(ctx, tx, dueID, domain.ScheduledJobStatusFailed, &jobError)
+		require.NoError(t, err)
+
+		err = s.CompleteScheduledJob(ctx, tx, dueID, domain.ScheduledJobStatusSucceeded, nil)
+		require.ErrorIs(t, err, domain.ErrScheduledJobNotFound)
+
+		scheduledJob, err := s.GetScheduledJobByID(ctx, tx, dueID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.ScheduledJobStatusFailed, scheduledJob.Status)
+		assert.Equal(t, &jobError, scheduledJob.Error)
+		assert.NotNil(t, scheduledJob.ExecutedAt)
+
+		err = s.CancelScheduledJob(ctx, tx, gameID, futureID)
+		require.NoError(t, err)
+
+		err = s.CancelScheduledJob(ctx, tx, gameID, futureID)
+		require.ErrorIs(t, err, domain.ErrScheduledJobNotFound)
+
+		scheduledJobs, err := s.ListScheduledJobs(ctx, tx, gameID)
+		require.NoError(t, err)
+		require.Len(t, scheduledJobs, 2)
+		assert.Equal(t, futureID, scheduledJobs[0].ID)
+		assert.Equal(t, domain.ScheduledJobStatusCancelled, scheduledJobs[0].Status)
+	})
+}
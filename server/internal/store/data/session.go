@@ -0,0 +1,172 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+// CreateSession executes a query to create a session with the specified data.
+func (s *store) CreateSession(ctx context.Context, tx pgx.Tx, editableSession domain.EditableSession) (uuid.UUID, error) {
+	var id uuid.UUID
+
+	err := tx.QueryRow(ctx, `
+		INSERT INTO sessions (subject_id, subject_role, user_agent, ip_address, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id
+	`,
+		editableSession.SubjectID,
+		editableSession.SubjectRole,
+		editableSession.Client.UserAgent,
+		editableSession.Client.IPAddress,
+		editableSession.ExpiresAt,
+	).Scan(&id)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, err)
+	}
+
+	return id, nil
+}
+
+// GetSessionByID executes a query to return the session with the specified identifier.
+func (s *store) GetSessionByID(ctx context.Context, tx pgx.Tx, id uuid.UUID) (domain.Session, error) {
+	row := tx.QueryRow(ctx, `
+		SELECT id, subject_id, subject_role, user_agent, ip_address, last_seen_at, expires_at, revoked_at, created_at
+		FROM sessions
+		WHERE id = $1
+	`, id)
+
+	session, err := getSessionFromRow(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Session{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, domain.ErrSessionNotFound)
+		}
+
+		return domain.Session{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, err)
+	}
+
+	return session, nil
+}
+
+// ListSessions executes a query to return the active sessions of the subject with the specified identifier, ordered
+// by the most recently seen.
+func (s *store) ListSessions(ctx context.Context, tx pgx.Tx, subjectID uuid.UUID) ([]domain.Session, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT id, subject_id, subject_role, user_agent, ip_address, last_seen_at, expires_at, revoked_at, created_at
+		FROM sessions
+		WHERE subject_id = $1 AND revoked_at IS NULL AND expires_at > $2
+		ORDER BY last_seen_at DESC
+	`, subjectID, time.Now().UTC())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", descriptionFailedQuery, err)
+	}
+	defer rows.Close()
+
+	sessions := make([]domain.Session, 0)
+
+	for rows.Next() {
+		session, err := getSessionFromRow(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", descriptionFailedScanRows, err)
+		}
+
+		sessions = append(sessions, session)
+	}
+
+	return sessions, nil
+}
+
+// TouchSession executes a query to update the last seen time, the client and the expiration time of the session with
+// the specified identifier.
+func (s *store) TouchSession(ctx context.Context, tx pgx.Tx, id uuid.UUID, client domain.SessionClient, expiresAt time.Time) error {
+	commandTag, err := tx.Exec(ctx, `
+		UPDATE sessions
+		SET last_seen_at = CURRENT_TIMESTAMP, user_agent = $2, ip_address = $3, expires_at = $4
+		WHERE id = $1
+	`, id, client.UserAgent, client.IPAddress, expiresAt)
+	if err != nil {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, domain.ErrSessionNotFound)
+	}
+
+	return nil
+}
+
+// RevokeSession executes a query to revoke the session with the specified identifier of the specified subject.
+// Revoking an already revoked session keeps its original revocation time.
+func (s *store) RevokeSession(ctx context.Context, tx pgx.Tx, subjectID, id uuid.UUID) error {
+	commandTag, err := tx.Exec(ctx, `
+		UPDATE sessions
+		SET revoked_at = coalesce(revoked_at, CURRENT_TIMESTAMP)
+		WHERE subject_id = $1 AND id = $2
+	`, subjectID, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, domain.ErrSessionNotFound)
+	}
+
+	return nil
+}
+
+// RevokeSessions executes a query to revoke all the sessions of the subject with the specified identifier.
+func (s *store) RevokeSessions(ctx context.Context, tx pgx.Tx, subjectID uuid.UUID) error {
+	_, err := tx.Exec(ctx, `
+		UPDATE sessions
+		SET revoked_at = CURRENT_TIMESTAMP
+		WHERE subject_id = $1 AND revoked_at IS NULL
+	`, subjectID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, err)
+	}
+
+	return nil
+}
+
+// RevokeOtherSessions executes a query to revoke all the sessions of the subject with the specified identifier, except
+// the session with the specified identifier.
+func (s *store) RevokeOtherSessions(ctx context.Context, tx pgx.Tx, subjectID, sessionID uuid.UUID) error {
+	_, err := tx.Exec(ctx, `
+		UPDATE sessions
+		SET revoked_at = CURRENT_TIMESTAMP
+		WHERE subject_id = $1 AND id <> $2 AND revoked_at IS NULL
+	`, subjectID, sessionID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, err)
+	}
+
+	return nil
+}
+
+// getSessionFromRow returns the session by scanning the given row.
+func getSessionFromRow(row pgx.Row) (domain.Session, error) {
+	var session domain.Session
+
+	err := row.Scan(
+		&session.ID,
+		&session.SubjectID,
+		&session.SubjectRole,
+		&session.Client.UserAgent,
+		&session.Client.IPAddress,
+		&session.LastSeenAt,
+		&session.ExpiresAt,
+		&session.RevokedAt,
+		&session.CreatedAt,
+	)
+	if err != nil {
+		return domain.Session{}, err
+	}
+
+	return session, nil
+}
@@ -0,0 +1,96 @@
+//go:build integration
+
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+func TestStore_Session(t *testing.T) {
+	t.Run("should successfully list, touch and revoke sessions", func(t *testing.T) {
+		ctx := context.Background()
+
+		tx := newTx(t, ctx)
+
+		editableSession := domain.EditableSession{
+			SubjectID:   uuid.New(),
+			SubjectRole: "user",
+			Client: domain.SessionClient{
+				UserAgent: "user agent 1",
+				IPAddress: "127.0.0.1",
+			},
+			ExpiresAt: time.Now().UTC().Add(time.Hour).Truncate(time.Microsecond),
+		}
+
+		id1, err := s.CreateSession(ctx, tx, editableSession)
+		require.NoError(t, err)
+
+		editableSession.Client.UserAgent = "user agent 2"
+
+		id2, err := s.CreateSession(ctx, tx, editableSession)
+		require.NoError(t, err)
+
+		session, err := s.GetSessionByID(ctx, tx, id1)
+		require.NoError(t, err)
+		assert.Equal(t, "user agent 1", session.Client.UserAgent)
+		assert.Nil(t, session.RevokedAt)
+		assert.True(t, session.Active(time.Now().UTC()))
+
+		sessions, err := s.ListSessions(ctx, tx, editableSession.SubjectID)
+		require.NoError(t, err)
+		assert.Len(t, sessions, 2)
+
+		client := domain.SessionClient{
+			UserAgent: "user agent 3",
+			IPAddress: "::1",
+		}
+
+		err = s.TouchSession(ctx, tx, id1, client, editableSession.ExpiresAt.Add(time.Hour))
+		require.NoError(t, err)
+
+		session, err = s.GetSessionByID(ctx, tx, id1)
+		require.NoError(t, err)
+		assert.Equal(t, client, session.Client)
+
+		err = s.RevokeSession(ctx, tx, editableSession.SubjectID, id1)
+		require.NoError(t, err)
+
+		sessions, err = s.ListSessions(ctx, tx, editableSession.SubjectID)
+		require.NoError(t, err)
+		require.Len(t, sessions, 1)
+		assert.Equal(t, id2, sessions[0].ID)
+
+		// Sessions of other subjects can not be revoked.
+		err = s.RevokeSession(ctx, tx, uuid.New(), id2)
+		require.ErrorIs(t, err, domain.ErrSessionNotFound)
+
+		id3, err := s.CreateSession(ctx, tx, editableSession)
+		require.NoError(t, err)
+
+		err = s.RevokeOtherSessions(ctx, tx, editableSession.SubjectID, id3)
+		require.NoError(t, err)
+
+		sessions, err = s.ListSessions(ctx, tx, editableSession.SubjectID)
+		require.NoError(t, err)
+		require.Len(t, sessions, 1)
+		assert.Equal(t, id3, sessions[0].ID)
+
+		err = s.RevokeSessions(ctx, tx, editableSession.SubjectID)
+		require.NoError(t, err)
+
+		sessions, err = s.ListSessions(ctx, tx, editableSession.SubjectID)
+		require.NoError(t, err)
+		assert.Empty(t, sessions)
+
+		_, err = s.GetSessionByID(ctx, tx, uuid.New())
+		require.ErrorIs(t, err, domain.ErrSessionNotFound)
+	})
+}
@@ -0,0 +1,153 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+// ListSignInThrottles executes a query to return the sign in throttles with the specified keys. Keys without a sign
+// in throttle are not returned.
+func (s *store) ListSignInThrottles(ctx context.Context, tx pgx.Tx, keys []string) ([]domain.SignInThrottle, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT key, failed_attempts, last_failed_at, locked_until
+		FROM sign_in_throttles
+		WHERE key = ANY($1)
+		ORDER BY key
+	`, keys)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", descriptionFailedQuery, err)
+	}
+	defer rows.Close()
+
+	signInThrottles, err := getSignInThrottlesFromRows(rows)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", descriptionFailedScanRows, err)
+	}
+
+	return signInThrottles, nil
+}
+
+// ListSignInThrottlesForUpdate executes a query to return the sign in throttles with the specified keys, locked until
+// the end of the specified transaction. Keys without a sign in throttle are inserted without failed attempts first, so
+// that they are locked as well.
+func (s *store) ListSignInThrottlesForUpdate(ctx context.Context, tx pgx.Tx, keys []string) ([]domain.SignInThrottle, error) {
+	// Every transaction locks the keys in the same order, so that they do not deadlock.
+	keys = slices.Sorted(slices.Values(keys))
+
+	_, err := tx.Exec(ctx, `
+		INSERT INTO sign_in_throttles (key, failed_attempts, last_failed_at)
+		SELECT key, 0, 'epoch'::timestamp
+		FROM unnest($1::varchar[]) AS key
+		ORDER BY key
+		ON CONFLICT (key) DO NOTHING
+	`, keys)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", descriptionFailedExec, err)
+	}
+
+	rows, err := tx.Query(ctx, `
+		SELECT key, failed_attempts, last_failed_at, locked_until
+		FROM sign_in_throttles
+		WHERE key = ANY($1)
+		ORDER BY key
+		FOR UPDATE
+	`, keys)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", descriptionFailedQuery, err)
+	}
+	defer rows.Close()
+
+	signInThrottles, err := getSignInThrottlesFromRows(rows)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", descriptionFailedScanRows, err)
+	}
+
+	return signInThrottles, nil
+}
+
+// FailSignInThrottle executes a query to record a failed sign in attempt at the specified time for the sign in
+// throttle with the specified key, and returns the updated sign in throttle. Failed attempts which happened before the
+// reset time are forgotten.
+func (s *store) FailSignInThrottle(ctx context.Context, tx pgx.Tx, key string, failedAt, resetAt time.Time) (domain.SignInThrottle, error) {
+	var signInThrottle domain.SignInThrottle
+
+	err := tx.QueryRow(ctx, `
+		INSERT INTO sign_in_throttles (key, failed_attempts, last_failed_at)
+		VALUES ($1, 1, $2)
+		ON CONFLICT (key) DO UPDATE
+		SET failed_attempts = CASE
+				WHEN sign_in_throttles.last_failed_at < $3 THEN 1
+				ELSE sign_in_throttles.failed_attempts + 1
+			END,
+			last_failed_at = excluded.last_failed_at
+		RETURNING key, failed_attempts, last_failed_at, locked_until
+	`, key, failedAt, resetAt).Scan(
+		&signInThrottle.Key,
+		&signInThrottle.FailedAttempts,
+		&signInThrottle.LastFailedAt,
+		&signInThrottle.LockedUntil,
+	)
+	if err != nil {
+		return domain.SignInThrottle{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, err)
+	}
+
+	return signInThrottle, nil
+}
+
+// LockSignInThrottle executes a query to lock out the sign in throttle with the specified key until the specified
+// time. Its failed attempts are cleared, so that the backoff starts over once the lockout ends.
+func (s *store) LockSignInThrottle(ctx context.Context, tx pgx.Tx, key string, lockedUntil time.Time) error {
+	_, err := tx.Exec(ctx, `
+		UPDATE sign_in_throttles
+		SET failed_attempts = 0, locked_until = $2
+		WHERE key = $1
+	`, key, lockedUntil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, err)
+	}
+
+	return nil
+}
+
+// DeleteSignInThrottle executes a query to delete the sign in throttle with the specified key, which clears its failed
+// attempts.
+func (s *store) DeleteSignInThrottle(ctx context.Context, tx pgx.Tx, key string) error {
+	_, err := tx.Exec(ctx, `
+		DELETE FROM sign_in_throttles
+		WHERE key = $1
+	`, key)
+	if err != nil {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, err)
+	}
+
+	return nil
+}
+
+// getSignInThrottlesFromRows returns the sign in throttles by scanning the given rows.
+func getSignInThrottlesFromRows(rows pgx.Rows) ([]domain.SignInThrottle, error) {
+	signInThrottles := make([]domain.SignInThrottle, 0)
+
+	for rows.Next() {
+		var signInThrottle domain.SignInThrottle
+
+		err := rows.Scan(
+			&signInThrottle.Key,
+			&signInThrottle.FailedAttempts,
+			&signInThrottle.LastFailedAt,
+			&signInThrottle.LockedUntil,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		signInThrottles = append(signInThrottles, signInThrottle)
+	}
+
+	return signInThrottles, nil
+}
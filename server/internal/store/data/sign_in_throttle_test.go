@@ -0,0 +1,159 @@
+//go:build integration
+
+package data
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+func TestStore_SignInThrottle(t *testing.T) {
+	t.Run("should successfully record, lock and delete sign in throttles", func(t *testing.T) {
+		ctx := context.Background()
+
+		tx := newTx(t, ctx)
+
+		var (
+			key = "user:account:username"
+			now = time.Now().UTC().Truncate(time.Microsecond)
+		)
+
+		signInThrottles, err := s.ListSignInThrottles(ctx, tx, []string{key})
+		require.NoError(t, err)
+		assert.Empty(t, signInThrottles)
+
+		signInThrottle, err := s.FailSignInThrottle(ctx, tx, key, now, now.Add(-domain.SignInThrottleResetTime))
+		require.NoError(t, err)
+		assert.Equal(t, 1, signInThrottle.FailedAttempts)
+
+		signInThrottle, err = s.FailSignInThrottle(ctx, tx, key, now, now.Add(-domain.SignInThrottleResetTime))
+		require.NoError(t, err)
+		assert.Equal(t, 2, signInThrottle.FailedAttempts)
+
+		// Failed attempts before the reset time are forgotten.
+		later := now.Add(2 * domain.SignInThrottleResetTime)
+
+		signInThrottle, err = s.FailSignInThrottle(ctx, tx, key, later, later.Add(-domain.SignInThrottleResetTime))
+		require.NoError(t, err)
+		assert.Equal(t, 1, signInThrottle.FailedAttempts)
+		assert.True(t, later.Equal(signInThrottle.LastFailedAt))
+
+		lockedUntil := later.Add(domain.SignInThrottleLockoutTime)
+
+		err = s.LockSignInThrottle(ctx, tx, key, lockedUntil)
+		require.NoError(t, err)
+
+		signInThrottles, err = s.ListSignInThrottles(ctx, tx, []string{key, "user:ip:127.0.0.1"})
+		require.NoError(t, err)
+		require.Len(t, signInThrottles, 1)
+		assert.Equal(t, key, signInThrottles[0].Key)
+		assert.Equal(t, 0, signInThrottles[0].FailedAttempts)
+		require.NotNil(t, signInThrottles[0].LockedUntil)
+		assert.True(t, lockedUntil.Equal(*signInThrottles[0].LockedUntil))
+
+		err = s.DeleteSignInThrottle(ctx, tx, key)
+		require.NoError(t, err)
+
+		signInThrottles, err = s.ListSignInThrottles(ctx, tx, []string{key})
+		require.NoError(t, err)
+		assert.Empty(t, signInThrottles)
+	})
+
+	t.Run("should successfully serialize concurrent failed attempts on locked sign in throttles", func(t *testing.T) {
+		ctx := context.Background()
+
+		t.Cleanup(func() {
+			cleanup(t, ctx)
+		})
+
+		const attempts = 10
+
+		var (
+			keys = []string{"user:account:username", "user:ip:127.0.0.1"}
+			now  = time.Now().UTC().Truncate(time.Microsecond)
+
+			wg       sync.WaitGroup
+			observed = make(chan int, attempts)
+		)
+
+		for i := range attempts {
+			attemptKeys := slices.Clone(keys)
+
+			// Half of the attempts list the keys in the reverse order, which must not deadlock.
+			if i%2 == 1 {
+				slices.Reverse(attemptKeys)
+			}
+
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				tx, err := s.NewTx(ctx, pgx.ReadCommitted, pgx.ReadWrite)
+				if !assert.NoError(t, err) {
+					return
+				}
+				defer func() {
+					// Rolling back after the commit does nothing.
+					_ = tx.Rollback(ctx)
+				}()
+
+				signInThrottles, err := s.ListSignInThrottlesForUpdate(ctx, tx, attemptKeys)
+				if !assert.NoError(t, err) || !assert.Len(t, signInThrottles, len(keys)) {
+					return
+				}
+
+				observed <- signInThrottles[0].FailedAttempts
+
+				// Widen the window between checking and recording the attempt, which concurrent attempts must wait for.
+				time.Sleep(10 * time.Millisecond)
+
+				for _, key := range attemptKeys {
+					_, err = s.FailSignInThrottle(ctx, tx, key, now, now.Add(-domain.SignInThrottleResetTime))
+					if !assert.NoError(t, err) {
+						return
+					}
+				}
+
+				assert.NoError(t, tx.Commit(ctx))
+			}()
+		}
+
+		wg.Wait()
+		close(observed)
+
+		// Each attempt observed the failures recorded by every attempt before it.
+		var observedFailedAttempts []int
+		for failedAttempts := range observed {
+			observedFailedAttempts = append(observedFailedAttempts, failedAttempts)
+		}
+
+		slices.Sort(observedFailedAttempts)
+
+		expectedFailedAttempts := make([]int, attempts)
+		for i := range expectedFailedAttempts {
+			expectedFailedAttempts[i] = i
+		}
+
+		assert.Equal(t, expectedFailedAttempts, observedFailedAttempts)
+
+		tx := newTx(t, ctx)
+
+		signInThrottles, err := s.ListSignInThrottles(ctx, tx, keys)
+		require.NoError(t, err)
+		require.Len(t, signInThrottles, len(keys))
+
+		for _, signInThrottle := range signInThrottles {
+			assert.Equal(t, attempts, signInThrottle.FailedAttempts)
+		}
+	})
+}
@@ -62,6 +62,33 @@ func (s *store) ListTags(ctx context.Context, tx pgx.Tx, filter domain.TagsPagin
 	}, nil
 }
 
+// ListTagsByNames executes a query to return the tags with the specified names. Unknown names are ignored.
+func (s *store) ListTagsByNames(ctx context.Context, tx pgx.Tx, names []domain.Name) ([]domain.Tag, error) {
+	namesStr := make([]string, len(names))
+	for i, name := range names {
+		namesStr[i] = string(name)
+	}
+
+	rows, err := tx.Query(ctx, `
+		SELECT id, name, description, created_at, modified_at
+		FROM tags
+		WHERE name = ANY($1)
+	`,
+		namesStr,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", descriptionFailedQuery, err)
+	}
+	defer rows.Close()
+
+	tags, err := getTagsFromRows(rows)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", descriptionFailedScanRows, err)
+	}
+
+	return tags, nil
+}
+
 // getTagFromRow returns the tag by scanning the given row.
 func getTagFromRow(row pgx.Row) (domain.Tag, error) {
 	var tag domain.Tag
@@ -0,0 +1,141 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+// CreateTOTP executes a query to create a totp with the specified data. A totp which is not enabled yet is replaced,
+// so that the enrollment can be restarted.
+func (s *store) CreateTOTP(ctx context.Context, tx pgx.Tx, editableTOTP domain.EditableTOTP) error {
+	commandTag, err := tx.Exec(ctx, `
+		INSERT INTO totps (subject_id, secret)
+		VALUES ($1, $2)
+		ON CONFLICT (subject_id) DO UPDATE
+		SET secret = excluded.secret, last_used_step = NULL, created_at = CURRENT_TIMESTAMP
+		WHERE totps.enabled_at IS NULL
+	`,
+		editableTOTP.SubjectID,
+		editableTOTP.Secret,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, domain.ErrTOTPAlreadyEnabled)
+	}
+
+	return nil
+}
+
+// GetTOTPBySubjectID executes a query to return the totp of the subject with the specified identifier.
+func (s *store) GetTOTPBySubjectID(ctx context.Context, tx pgx.Tx, subjectID uuid.UUID) (domain.TOTP, error) {
+	var totp domain.TOTP
+
+	err := tx.QueryRow(ctx, `
+		SELECT subject_id, secret, last_used_step, enabled_at, created_at
+		FROM totps
+		WHERE subject_id = $1
+	`, subjectID).Scan(
+		&totp.SubjectID,
+		&totp.Secret,
+		&totp.LastUsedStep,
+		&totp.EnabledAt,
+		&totp.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.TOTP{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, domain.ErrTOTPNotFound)
+		}
+
+		return domain.TOTP{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, err)
+	}
+
+	return totp, nil
+}
+
+// EnableTOTP executes a query to enable the totp of the subject with the specified identifier, recording the time step
+// of the code used to enable it.
+func (s *store) EnableTOTP(ctx context.Context, tx pgx.Tx, subjectID uuid.UUID, step int64) error {
+	commandTag, err := tx.Exec(ctx, `
+		UPDATE totps
+		SET enabled_at = CURRENT_TIMESTAMP, last_used_step = $2
+		WHERE subject_id = $1 AND enabled_at IS NULL
+	`, subjectID, step)
+	if err != nil {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, domain.ErrTOTPAlreadyEnabled)
+	}
+
+	return nil
+}
+
+// UseTOTP executes a query to record the time step of a code used with the enabled totp of the subject with the
+// specified identifier. It fails when the time step is not after the last used one, so that a code is only used once.
+func (s *store) UseTOTP(ctx context.Context, tx pgx.Tx, subjectID uuid.UUID, step int64) error {
+	commandTag, err := tx.Exec(ctx, `
+		UPDATE totps
+		SET last_used_step = $2
+		WHERE subject_id = $1 AND enabled_at IS NOT NULL AND (last_used_step IS NULL OR last_used_step < $2)
+	`, subjectID, step)
+	if err != nil {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, domain.ErrTOTPCodeInvalid)
+	}
+
+	return nil
+}
+
+// CreateRecoveryCodes executes a query to replace the recovery codes of the subject with the specified identifier with
+// the specified hashes.
+func (s *store) CreateRecoveryCodes(ctx context.Context, tx pgx.Tx, subjectID uuid.UUID, hashes [][]byte) error {
+	_, err := tx.Exec(ctx, `
+		DELETE FROM recovery_codes
+		WHERE subject_id = $1
+	`, subjectID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO recovery_codes (subject_id, hash)
+		SELECT $1, UNNEST($2::bytea[])
+	`, subjectID, hashes)
+	if err != nil {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, err)
+	}
+
+	return nil
+}
+
+// UseRecoveryCode executes a query to mark the unused recovery code of the subject with the specified identifier and
+// hash as used.
+func (s *store) UseRecoveryCode(ctx context.Context, tx pgx.Tx, subjectID uuid.UUID, hash []byte) error {
+	commandTag, err := tx.Exec(ctx, `
+		UPDATE recovery_codes
+		SET used_at = CURRENT_TIMESTAMP
+		WHERE subject_id = $1 AND hash = $2 AND used_at IS NULL
+	`, subjectID, hash)
+	if err != nil {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, domain.ErrRecoveryCodeNotFound)
+	}
+
+	return nil
+}
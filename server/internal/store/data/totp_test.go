@@ -0,0 +1,96 @@
+//go:build integration
+
+package data
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+func TestStore_TOTP(t *testing.T) {
+	t.Run("should successfully enroll, enable and use totp", func(t *testing.T) {
+		ctx := context.Background()
+
+		tx := newTx(t, ctx)
+
+		editableTOTP := domain.EditableTOTP{
+			SubjectID: uuid.New(),
+			Secret:    []byte("secret 1"),
+		}
+
+		err := s.CreateTOTP(ctx, tx, editableTOTP)
+		require.NoError(t, err)
+
+		editableTOTP.Secret = []byte("secret 2")
+
+		err = s.CreateTOTP(ctx, tx, editableTOTP)
+		require.NoError(t, err)
+
+		totp, err := s.GetTOTPBySubjectID(ctx, tx, editableTOTP.SubjectID)
+		require.NoError(t, err)
+		assert.Equal(t, editableTOTP, totp.EditableTOTP)
+		assert.Nil(t, totp.EnabledAt)
+		assert.Nil(t, totp.LastUsedStep)
+
+		err = s.UseTOTP(ctx, tx, editableTOTP.SubjectID, 1)
+		require.ErrorIs(t, err, domain.ErrTOTPCodeInvalid)
+
+		err = s.EnableTOTP(ctx, tx, editableTOTP.SubjectID, 1)
+		require.NoError(t, err)
+
+		totp, err = s.GetTOTPBySubjectID(ctx, tx, editableTOTP.SubjectID)
+		require.NoError(t, err)
+		assert.NotNil(t, totp.EnabledAt)
+		assert.Equal(t, int64(1), *totp.LastUsedStep)
+
+		err = s.UseTOTP(ctx, tx, editableTOTP.SubjectID, 1)
+		require.ErrorIs(t, err, domain.ErrTOTPCodeInvalid)
+
+		err = s.UseTOTP(ctx, tx, editableTOTP.SubjectID, 2)
+		require.NoError(t, err)
+
+		err = s.CreateTOTP(ctx, tx, editableTOTP)
+		require.ErrorIs(t, err, domain.ErrTOTPAlreadyEnabled)
+
+		err = s.EnableTOTP(ctx, tx, editableTOTP.SubjectID, 3)
+		require.ErrorIs(t, err, domain.ErrTOTPAlreadyEnabled)
+
+		_, err = s.GetTOTPBySubjectID(ctx, tx, uuid.New())
+		require.ErrorIs(t, err, domain.ErrTOTPNotFound)
+	})
+
+	t.Run("should successfully create and use recovery codes", func(t *testing.T) {
+		ctx := context.Background()
+
+		tx := newTx(t, ctx)
+
+		subjectID := uuid.New()
+
+		err := s.CreateRecoveryCodes(ctx, tx, subjectID, [][]byte{[]byte("hash 1"), []byte("hash 2")})
+		require.NoError(t, err)
+
+		err = s.UseRecoveryCode(ctx, tx, subjectID, []byte("hash 1"))
+		require.NoError(t, err)
+
+		err = s.UseRecoveryCode(ctx, tx, subjectID, []byte("hash 1"))
+		require.ErrorIs(t, err, domain.ErrRecoveryCodeNotFound)
+
+		err = s.UseRecoveryCode(ctx, tx, uuid.New(), []byte("hash 2"))
+		require.ErrorIs(t, err, domain.ErrRecoveryCodeNotFound)
+
+		err = s.CreateRecoveryCodes(ctx, tx, subjectID, [][]byte{[]byte("hash 3")})
+		require.NoError(t, err)
+
+		err = s.UseRecoveryCode(ctx, tx, subjectID, []byte("hash 2"))
+		require.ErrorIs(t, err, domain.ErrRecoveryCodeNotFound)
+
+		err = s.UseRecoveryCode(ctx, tx, subjectID, []byte("hash 3"))
+		require.NoError(t, err)
+	})
+}
@@ -61,7 +61,7 @@ func (s *store) CreateUser(ctx context.Context, tx pgx.Tx, editableUser domain.E
 // GetUserByID executes a query to return the user with the specified identifier.
 func (s *store) GetUserByID(ctx context.Context, tx pgx.Tx, id uuid.UUID) (domain.User, error) {
 	row := tx.QueryRow(ctx, `
-		SELECT u.id, u.username, u.email, u.display_name, u.date_of_birth, u.address, u.country, u.vatin, u.balance, u.created_at, u.modified_at,
+		SELECT u.id, u.username, u.email, u.display_name, u.date_of_birth, u.address, u.country, u.vatin, u.balance, u.email_verified_at, u.created_at, u.modified_at,
 			m.id, m.checksum, m.media_type, m.url, m.created_at 
 		FROM users u
 		LEFT JOIN multimedia m
@@ -86,7 +86,7 @@ func (s *store) GetUserByID(ctx context.Context, tx pgx.Tx, id uuid.UUID) (domai
 // GetUserByEmail executes a query to return the user with the specified email.
 func (s *store) GetUserByEmail(ctx context.Context, tx pgx.Tx, email domain.Email) (domain.User, error) {
 	row := tx.QueryRow(ctx, `
-		SELECT u.id, u.username, u.email, u.display_name, u.date_of_birth, u.address, u.country, u.vatin, u.balance, u.created_at, u.modified_at,
+		SELECT u.id, u.username, u.email, u.display_name, u.date_of_birth, u.address, u.country, u.vatin, u.balance, u.email_verified_at, u.created_at, u.modified_at,
 			m.id, m.checksum, m.media_type, m.url, m.created_at
 		FROM users u
 		LEFT JOIN multimedia m
@@ -111,7 +111,7 @@ func (s *store) GetUserByEmail(ctx context.Context, tx pgx.Tx, email domain.Emai
 // GetUserSignIn executes a query to return the sign-in of the user with the specified username or email.
 func (s *store) GetUserSignIn(ctx context.Context, tx pgx.Tx, username domain.Username, email domain.Email) (domain.SignInUser, error) {
 	row := tx.QueryRow(ctx, `
-		SELECT username, email, password 
+		SELECT id, username, email, display_name, password 
 		FROM users 
 		WHERE username = $1 OR email = $2
 		LIMIT 1
@@ -123,8 +123,10 @@ func (s *store) GetUserSignIn(ctx context.Context, tx pgx.Tx, username domain.Us
 	var signIn domain.SignInUser
 
 	err := row.Scan(
+		&signIn.ID,
 		&signIn.Username,
 		&signIn.Email,
+		&signIn.DisplayName,
 		&signIn.Password,
 	)
 	if err != nil {
@@ -186,6 +188,87 @@ func (s *store) PatchUser(ctx context.Context, tx pgx.Tx, id uuid.UUID, editable
 	return nil
 }
 
+// GetUserPassword executes a query to return the hashed password of the user with the specified identifier.
+func (s *store) GetUserPassword(ctx context.Context, tx pgx.Tx, id uuid.UUID) (domain.Password, error) {
+	row := tx.QueryRow(ctx, `
+		SELECT password
+		FROM users
+		WHERE id = $1
+	`, id)
+
+	var password domain.Password
+
+	err := row.Scan(&password)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", fmt.Errorf("%s: %w", descriptionFailedScanRow, domain.ErrUserNotFound)
+		}
+
+		return "", fmt.Errorf("%s: %w", descriptionFailedScanRow, err)
+	}
+
+	return password, nil
+}
+
+// GetUserAdmin executes a query to return true if the user with the specified identifier is an administrator, false
+// otherwise.
+func (s *store) GetUserAdmin(ctx context.Context, tx pgx.Tx, id uuid.UUID) (bool, error) {
+	row := tx.QueryRow(ctx, `
+		SELECT admin
+		FROM users
+		WHERE id = $1
+	`, id)
+
+	var admin bool
+
+	err := row.Scan(&admin)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, fmt.Errorf("%s: %w", descriptionFailedScanRow, domain.ErrUserNotFound)
+		}
+
+		return false, fmt.Errorf("%s: %w", descriptionFailedScanRow, err)
+	}
+
+	return admin, nil
+}
+
+// UpdateUserPassword executes a query to update the password of the user with the specified identifier.
+func (s *store) UpdateUserPassword(ctx context.Context, tx pgx.Tx, id uuid.UUID, password domain.Password) error {
+	commandTag, err := tx.Exec(ctx, `
+		UPDATE users
+		SET password = $2
+		WHERE id = $1
+	`, id, password)
+	if err != nil {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, domain.ErrUserNotFound)
+	}
+
+	return nil
+}
+
+// VerifyUserEmail executes a query to mark the email of the user with the specified identifier as verified.
+func (s *store) VerifyUserEmail(ctx context.Context, tx pgx.Tx, id uuid.UUID) error {
+	commandTag, err := tx.Exec(ctx, `
+		UPDATE users
+		SET email_verified_at = CURRENT_TIMESTAMP
+		WHERE id = $1
+	`, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, domain.ErrUserNotFound)
+	}
+
+	return nil
+}
+
 // getUserFromRow returns the user by scanning the given row.
 func getUserFromRow(row pgx.Row) (domain.User, error) {
 	var (
@@ -208,6 +291,7 @@ func getUserFromRow(row pgx.Row) (domain.User, error) {
 		&user.Country,
 		&user.Vatin,
 		&user.Balance,
+		&user.EmailVerifiedAt,
 		&user.CreatedAt,
 		&user.ModifiedAt,
 
@@ -74,8 +74,8 @@ func (s *store) ListUserCart(ctx context.Context, tx pgx.Tx, userID uuid.UUID, f
 	}
 
 	rows, err := tx.Query(ctx, `
-		SELECT g.id, g.title, g.price, g.is_active, g.release_date, g.description, g.age_rating, g.features, g.languages, g.requirements, g.created_at, g.modified_at,
-			p.id, p.email, p.name, p.address, p.country, p.vatin, p.created_at, p.modified_at,
+		SELECT g.id, g.title, g.price, g.status, g.review_notes, g.release_date, g.description, g.age_rating, g.features, g.languages, g.requirements, g.created_at, g.modified_at,
+			p.id, p.email, p.name, p.address, p.country, p.vatin, p.verification_status, p.verification_reason, p.created_at, p.modified_at,
 			pm.id, pm.checksum, pm.media_type, pm.url, pm.created_at,
 			gpm.id, gpm.checksum, gpm.media_type, gpm.url, gpm.created_at,
 			gdm.id, gdm.checksum, gdm.media_type, gdm.url, gdm.created_at
@@ -46,7 +46,6 @@ func TestStore_PurchaseUserCart(t *testing.T) {
 		game1, err := s.CreateGame(ctx, tx, publisherID, domain.EditableGame{
 			Title:       "title 1",
 			Price:       10,
-			IsActive:    true,
 			ReleaseDate: &releaseDate,
 			Description: "description",
 			AgeRating:   "18",
@@ -64,10 +63,12 @@ func TestStore_PurchaseUserCart(t *testing.T) {
 		})
 		require.NoError(t, err)
 
+		err = s.UpdateGameStatus(ctx, tx, game1, domain.GameStatusPublished, nil)
+		require.NoError(t, err)
+
 		game2, err := s.CreateGame(ctx, tx, publisherID, domain.EditableGame{
 			Title:       "title 2",
 			Price:       20,
-			IsActive:    true,
 			ReleaseDate: &releaseDate,
 			Description: "description",
 			AgeRating:   "18",
@@ -85,6 +86,9 @@ func TestStore_PurchaseUserCart(t *testing.T) {
 		})
 		require.NoError(t, err)
 
+		err = s.UpdateGameStatus(ctx, tx, game2, domain.GameStatusPublished, nil)
+		require.NoError(t, err)
+
 		userID, err := s.CreateUser(ctx, tx, domain.EditableUserWithPassword{
 			EditableUser: domain.EditableUser{
 				Username:            "username",
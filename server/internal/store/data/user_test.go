@@ -223,3 +223,117 @@ func TestStore_CreateUser(t *testing.T) {
 		})
 	})
 }
+
+func TestStore_VerifyUserEmail(t *testing.T) {
+	t.Run("should successfully verify user email and reset it when the email changes", func(t *testing.T) {
+		ctx := context.Background()
+
+		tx := newTx(t, ctx)
+
+		editableUser := domain.EditableUserWithPassword{
+			EditableUser: domain.EditableUser{
+				Username:    "username",
+				Email:       "user@email.com",
+				DisplayName: "user name",
+				DateOfBirth: domain.UserDateOfBirth(time.Date(2000, 02, 01, 0, 0, 0, 0, time.UTC)),
+				Address:     "address",
+				Country:     domain.Country{Region: language.MustParseRegion("pt")},
+				Vatin:       "123456789",
+			},
+			Password: "password",
+		}
+
+		userID, err := s.CreateUser(ctx, tx, editableUser)
+		require.NoError(t, err)
+
+		user, err := s.GetUserByID(ctx, tx, userID)
+		require.NoError(t, err)
+		assert.Nil(t, user.EmailVerifiedAt)
+
+		err = s.VerifyUserEmail(ctx, tx, userID)
+		require.NoError(t, err)
+
+		user, err = s.GetUserByID(ctx, tx, userID)
+		require.NoError(t, err)
+		assert.NotNil(t, user.EmailVerifiedAt)
+
+		sameEmail := domain.Email("USER@email.com")
+
+		err = s.PatchUser(ctx, tx, userID, domain.EditableUserPatch{Email: &sameEmail})
+		require.NoError(t, err)
+
+		user, err = s.GetUserByID(ctx, tx, userID)
+		require.NoError(t, err)
+		assert.NotNil(t, user.EmailVerifiedAt)
+
+		newEmail := domain.Email("new.user@email.com")
+
+		err = s.PatchUser(ctx, tx, userID, domain.EditableUserPatch{Email: &newEmail})
+		require.NoError(t, err)
+
+		user, err = s.GetUserByID(ctx, tx, userID)
+		require.NoError(t, err)
+		assert.Nil(t, user.EmailVerifiedAt)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when user is not found", func(t *testing.T) {
+			ctx := context.Background()
+
+			tx := newTx(t, ctx)
+
+			err := s.VerifyUserEmail(ctx, tx, uuid.New())
+			require.ErrorIs(t, err, domain.ErrUserNotFound)
+		})
+	})
+}
+
+func TestStore_UpdateUserPassword(t *testing.T) {
+	t.Run("should successfully update user password", func(t *testing.T) {
+		ctx := context.Background()
+
+		tx := newTx(t, ctx)
+
+		userID, err := s.CreateUser(ctx, tx, domain.EditableUserWithPassword{
+			EditableUser: domain.EditableUser{
+				Username:    "username",
+				Email:       "user@email.com",
+				DisplayName: "user name",
+				DateOfBirth: domain.UserDateOfBirth(time.Date(2000, 02, 01, 0, 0, 0, 0, time.UTC)),
+				Address:     "address",
+				Country:     domain.Country{Region: language.MustParseRegion("pt")},
+				Vatin:       "123456789",
+			},
+			Password: "password",
+		})
+		require.NoError(t, err)
+
+		password := domain.Password("$argon2id$v=19$m=65536,t=3,p=4$c2FsdHNhbHRzYWx0c2FsdA$a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2U")
+
+		err = s.UpdateUserPassword(ctx, tx, userID, password)
+		require.NoError(t, err)
+
+		actualPassword, err := s.GetUserPassword(ctx, tx, userID)
+		require.NoError(t, err)
+		assert.Equal(t, password, actualPassword)
+
+		signIn, err := s.GetUserSignIn(ctx, tx, "username", "")
+		require.NoError(t, err)
+		assert.Equal(t, userID, signIn.ID)
+		assert.Equal(t, password, signIn.Password)
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		t.Run("when user is not found", func(t *testing.T) {
+			ctx := context.Background()
+
+			tx := newTx(t, ctx)
+
+			_, err := s.GetUserPassword(ctx, tx, uuid.New())
+			require.ErrorIs(t, err, domain.ErrUserNotFound)
+
+			err = s.UpdateUserPassword(ctx, tx, uuid.New(), "password")
+			require.ErrorIs(t, err, domain.ErrUserNotFound)
+		})
+	})
+}
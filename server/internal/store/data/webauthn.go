@@ -0,0 +1,172 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+const (
+	constraintWebAuthnCredentialsCredentialIDKey = "webauthn_credentials_credential_id_key"
+)
+
+// CreateWebAuthnCeremony executes a query to create a webauthn ceremony with the specified data.
+func (s *store) CreateWebAuthnCeremony(ctx context.Context, tx pgx.Tx, editableWebAuthnCeremony domain.EditableWebAuthnCeremony) (uuid.UUID, error) {
+	var id uuid.UUID
+
+	err := tx.QueryRow(ctx, `
+		INSERT INTO webauthn_ceremonies (subject_id, subject_role, session, expires_at)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id
+	`,
+		editableWebAuthnCeremony.SubjectID,
+		editableWebAuthnCeremony.SubjectRole,
+		editableWebAuthnCeremony.Session,
+		editableWebAuthnCeremony.ExpiresAt,
+	).Scan(&id)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, err)
+	}
+
+	return id, nil
+}
+
+// DeleteWebAuthnCeremony executes a query to delete the webauthn ceremony with the specified identifier and returns
+// it, so that a ceremony can only be finished once.
+func (s *store) DeleteWebAuthnCeremony(ctx context.Context, tx pgx.Tx, id uuid.UUID) (domain.WebAuthnCeremony, error) {
+	var webAuthnCeremony domain.WebAuthnCeremony
+
+	err := tx.QueryRow(ctx, `
+		DELETE FROM webauthn_ceremonies
+		WHERE id = $1
+		RETURNING id, subject_id, subject_role, session, expires_at, created_at
+	`, id).Scan(
+		&webAuthnCeremony.ID,
+		&webAuthnCeremony.SubjectID,
+		&webAuthnCeremony.SubjectRole,
+		&webAuthnCeremony.Session,
+		&webAuthnCeremony.ExpiresAt,
+		&webAuthnCeremony.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.WebAuthnCeremony{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, domain.ErrWebAuthnCeremonyNotFound)
+		}
+
+		return domain.WebAuthnCeremony{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, err)
+	}
+
+	return webAuthnCeremony, nil
+}
+
+// DeleteExpiredWebAuthnCeremonies executes a query to delete the webauthn ceremonies which expired before the
+// specified time, since abandoned ceremonies are never finished.
+func (s *store) DeleteExpiredWebAuthnCeremonies(ctx context.Context, tx pgx.Tx, expiredAt time.Time) error {
+	_, err := tx.Exec(ctx, `
+		DELETE FROM webauthn_ceremonies
+		WHERE expires_at < $1
+	`, expiredAt)
+	if err != nil {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, err)
+	}
+
+	return nil
+}
+
+// CreateWebAuthnCredential executes a query to create a webauthn credential with the specified data.
+func (s *store) CreateWebAuthnCredential(ctx context.Context, tx pgx.Tx, editableWebAuthnCredential domain.EditableWebAuthnCredential) (uuid.UUID, error) {
+	var id uuid.UUID
+
+	err := tx.QueryRow(ctx, `
+		INSERT INTO webauthn_credentials (subject_id, subject_role, credential_id, public_key, attestation_type, transports, aaguid, sign_count)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id
+	`,
+		editableWebAuthnCredential.SubjectID,
+		editableWebAuthnCredential.SubjectRole,
+		editableWebAuthnCredential.CredentialID,
+		editableWebAuthnCredential.PublicKey,
+		editableWebAuthnCredential.AttestationType,
+		editableWebAuthnCredential.Transports,
+		editableWebAuthnCredential.AAGUID,
+		editableWebAuthnCredential.SignCount,
+	).Scan(&id)
+	if err != nil {
+		switch constraintNameFromError(err) {
+		case constraintWebAuthnCredentialsCredentialIDKey:
+			return uuid.UUID{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, domain.ErrWebAuthnCredentialAlreadyExists)
+		default:
+			return uuid.UUID{}, fmt.Errorf("%s: %w", descriptionFailedScanRow, err)
+		}
+	}
+
+	return id, nil
+}
+
+// ListWebAuthnCredentials executes a query to return the webauthn credentials of the subject with the specified
+// identifier.
+func (s *store) ListWebAuthnCredentials(ctx context.Context, tx pgx.Tx, subjectID uuid.UUID) ([]domain.WebAuthnCredential, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT id, subject_id, subject_role, credential_id, public_key, attestation_type, transports, aaguid, sign_count, last_used_at, created_at
+		FROM webauthn_credentials
+		WHERE subject_id = $1
+		ORDER BY created_at
+	`, subjectID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", descriptionFailedQuery, err)
+	}
+	defer rows.Close()
+
+	webAuthnCredentials := make([]domain.WebAuthnCredential, 0)
+
+	for rows.Next() {
+		var webAuthnCredential domain.WebAuthnCredential
+
+		err := rows.Scan(
+			&webAuthnCredential.ID,
+			&webAuthnCredential.SubjectID,
+			&webAuthnCredential.SubjectRole,
+			&webAuthnCredential.CredentialID,
+			&webAuthnCredential.PublicKey,
+			&webAuthnCredential.AttestationType,
+			&webAuthnCredential.Transports,
+			&webAuthnCredential.AAGUID,
+			&webAuthnCredential.SignCount,
+			&webAuthnCredential.LastUsedAt,
+			&webAuthnCredential.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", descriptionFailedScanRows, err)
+		}
+
+		webAuthnCredentials = append(webAuthnCredentials, webAuthnCredential)
+	}
+
+	return webAuthnCredentials, nil
+}
+
+// UseWebAuthnCredential executes a query to record the use of the webauthn credential with the specified identifier,
+// along with the new sign count of its authenticator. It fails when the sign count did not increase, unless the
+// authenticator does not keep one, so that concurrent uses of a cloned credential are detected.
+func (s *store) UseWebAuthnCredential(ctx context.Context, tx pgx.Tx, id uuid.UUID, signCount int64) error {
+	commandTag, err := tx.Exec(ctx, `
+		UPDATE webauthn_credentials
+		SET sign_count = $2, last_used_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND (sign_count < $2 OR $2 = 0)
+	`, id, signCount)
+	if err != nil {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", descriptionFailedExec, domain.ErrWebAuthnCredentialInvalid)
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+//go:build integration
+
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+func TestStore_WebAuthn(t *testing.T) {
+	t.Run("should successfully create and delete webauthn ceremonies", func(t *testing.T) {
+		ctx := context.Background()
+
+		tx := newTx(t, ctx)
+
+		subjectID := uuid.New()
+
+		editableWebAuthnCeremony := domain.EditableWebAuthnCeremony{
+			SubjectID:   &subjectID,
+			SubjectRole: "user",
+			Session:     []byte(`{"challenge": "challenge"}`),
+			ExpiresAt:   time.Now().Add(domain.WebAuthnCeremonyExpirationTime).Truncate(time.Microsecond),
+		}
+
+		id, err := s.CreateWebAuthnCeremony(ctx, tx, editableWebAuthnCeremony)
+		require.NoError(t, err)
+
+		webAuthnCeremony, err := s.DeleteWebAuthnCeremony(ctx, tx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id, webAuthnCeremony.ID)
+		assert.Equal(t, editableWebAuthnCeremony.SubjectID, webAuthnCeremony.SubjectID)
+		assert.Equal(t, editableWebAuthnCeremony.SubjectRole, webAuthnCeremony.SubjectRole)
+		assert.JSONEq(t, string(editableWebAuthnCeremony.Session), string(webAuthnCeremony.Session))
+		assert.True(t, editableWebAuthnCeremony.ExpiresAt.Equal(webAuthnCeremony.ExpiresAt))
+
+		_, err = s.DeleteWebAuthnCeremony(ctx, tx, id)
+		require.ErrorIs(t, err, domain.ErrWebAuthnCeremonyNotFound)
+	})
+
+	t.Run("should successfully delete expired webauthn ceremonies", func(t *testing.T) {
+		ctx := context.Background()
+
+		tx := newTx(t, ctx)
+
+		now := time.Now()
+
+		expiredID, err := s.CreateWebAuthnCeremony(ctx, tx, domain.EditableWebAuthnCeremony{
+			SubjectRole: "user",
+			Session:     []byte(`{"challenge": "expired"}`),
+			ExpiresAt:   now.Add(-time.Minute),
+		})
+		require.NoError(t, err)
+
+		pendingID, err := s.CreateWebAuthnCeremony(ctx, tx, domain.EditableWebAuthnCeremony{
+			SubjectRole: "user",
+			Session:     []byte(`{"challenge": "pending"}`),
+			ExpiresAt:   now.Add(domain.WebAuthnCeremonyExpirationTime),
+		})
+		require.NoError(t, err)
+
+		err = s.DeleteExpiredWebAuthnCeremonies(ctx, tx, now)
+		require.NoError(t, err)
+
+		_, err = s.DeleteWebAuthnCeremony(ctx, tx, expiredID)
+		require.ErrorIs(t, err, domain.ErrWebAuthnCeremonyNotFound)
+
+		webAuthnCeremony, err := s.DeleteWebAuthnCeremony(ctx, tx, pendingID)
+		require.NoError(t, err)
+		assert.Equal(t, pendingID, webAuthnCeremony.ID)
+	})
+
+	t.Run("should successfully create, list and use webauthn credentials", func(t *testing.T) {
+		ctx := context.Background()
+
+		tx := newTx(t, ctx)
+
+		editableWebAuthnCredential := domain.EditableWebAuthnCredential{
+			SubjectID:       uuid.New(),
+			SubjectRole:     "publisher",
+			CredentialID:    []byte(uuid.NewString()),
+			PublicKey:       []byte("public key"),
+			AttestationType: "none",
+			Transports:      []string{"internal", "hybrid"},
+			AAGUID:          make([]byte, 16),
+			SignCount:       1,
+		}
+
+		id, err := s.CreateWebAuthnCredential(ctx, tx, editableWebAuthnCredential)
+		require.NoError(t, err)
+
+		_, err = s.CreateWebAuthnCredential(ctx, tx, editableWebAuthnCredential)
+		require.ErrorIs(t, err, domain.ErrWebAuthnCredentialAlreadyExists)
+
+		webAuthnCredentials, err := s.ListWebAuthnCredentials(ctx, tx, editableWebAuthnCredential.SubjectID)
+		require.NoError(t, err)
+		require.Len(t, webAuthnCredentials, 1)
+		assert.Equal(t, id, webAuthnCredentials[0].ID)
+		assert.Equal(t, editableWebAuthnCredential, webAuthnCredentials[0].EditableWebAuthnCredential)
+		assert.Nil(t, webAuthnCredentials[0].LastUsedAt)
+
+		err = s.UseWebAuthnCredential(ctx, tx, id, 1)
+		require.ErrorIs(t, err, domain.ErrWebAuthnCredentialInvalid)
+
+		err = s.UseWebAuthnCredential(ctx, tx, id, 2)
+		require.NoError(t, err)
+
+		webAuthnCredentials, err = s.ListWebAuthnCredentials(ctx, tx, editableWebAuthnCredential.SubjectID)
+		require.NoError(t, err)
+		require.Len(t, webAuthnCredentials, 1)
+		assert.Equal(t, int64(2), webAuthnCredentials[0].SignCount)
+		assert.NotNil(t, webAuthnCredentials[0].LastUsedAt)
+
+		err = s.UseWebAuthnCredential(ctx, tx, uuid.New(), 3)
+		require.ErrorIs(t, err, domain.ErrWebAuthnCredentialInvalid)
+	})
+}
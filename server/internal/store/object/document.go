@@ -0,0 +1,55 @@
+package object
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"cloud.google.com/go/storage"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+// CreateDocumentObject creates a document object with the specified name and media type in the private documents
+// bucket. Its objects are never publicly readable, so they are only served through the API.
+func (s *store) CreateDocumentObject(ctx context.Context, name, mediaType string, reader io.Reader) error {
+	object := s.client.Bucket(s.bucketDocuments).Object(name)
+	object = object.If(storage.Conditions{DoesNotExist: true})
+
+	writer := object.NewWriter(ctx)
+	writer.ContentType = mediaType
+
+	_, err := io.Copy(writer, reader)
+	if err != nil {
+		return fmt.Errorf("store: failed to copy to writer: %w", err)
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return fmt.Errorf("store: failed to close writer: %w", err)
+	}
+
+	return nil
+}
+
+// GetDocumentObject returns the content of the document object with the specified name.
+func (s *store) GetDocumentObject(ctx context.Context, name string) ([]byte, error) {
+	reader, err := s.client.Bucket(s.bucketDocuments).Object(name).NewReader(ctx)
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrObjectNotExist):
+			return nil, domain.ErrPublisherDocumentNotFound
+		default:
+			return nil, fmt.Errorf("store: failed to create reader: %w", err)
+		}
+	}
+	defer reader.Close()
+
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("store: failed to read object: %w", err)
+	}
+
+	return content, nil
+}
@@ -22,3 +22,11 @@ func (s *noop) CreateMultimediaObject(ctx context.Context, name string, reader i
 func (s *noop) GetMultimediaObject(ctx context.Context, name string) (domain.MultimediaObject, error) {
 	return domain.MultimediaObject{URL: name}, nil
 }
+
+func (s *noop) CreateDocumentObject(ctx context.Context, name, mediaType string, reader io.Reader) error {
+	return nil
+}
+
+func (s *noop) GetDocumentObject(ctx context.Context, name string) ([]byte, error) {
+	return nil, nil
+}
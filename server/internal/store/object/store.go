@@ -13,6 +13,7 @@ import (
 type store struct {
 	client           *storage.Client
 	bucketMultimedia string
+	bucketDocuments  string
 }
 
 // New returns a new object store.
@@ -25,6 +26,7 @@ func New(ctx context.Context, config config.CloudStorage) (*store, error) {
 	return &store{
 		client:           client,
 		bucketMultimedia: config.BucketMultimedia,
+		bucketDocuments:  config.BucketDocuments,
 	}, nil
 }
 
@@ -1,11 +1,185 @@
 package http
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/google/uuid"
+
+	"github.com/goofr-group/gaming-store/server/api"
+	"github.com/goofr-group/gaming-store/server/internal/authn"
 	"github.com/goofr-group/gaming-store/server/internal/authz"
 	"github.com/goofr-group/gaming-store/server/internal/domain"
 )
 
-// AuthzRoles defines the authorization roles for the API.
-var AuthzRoles authz.Roles = authz.Roles{
-	AuthzWildcards: []string{domain.FieldParamUserID, domain.FieldParamPublisherID},
+const (
+	extensionPermissions = "x-permissions"
+
+	descriptionFailedToLoadSwagger     = "http: failed to load swagger"
+	descriptionFailedToLoadPermissions = "http: failed to load operation permissions"
+)
+
+// Authorization permissions that the operations can require in the x-permissions extension.
+const (
+	permissionPublisherRead  = "publisher:read"
+	permissionPublisherWrite = "publisher:write"
+	permissionMembersWrite   = "members:write"
+	permissionGamesWrite     = "games:write"
+	permissionSalesRead      = "sales:read"
+)
+
+// knownPermissions defines every permission that the operations can require.
+var knownPermissions = []string{
+	permissionPublisherRead,
+	permissionPublisherWrite,
+	permissionMembersWrite,
+	permissionGamesWrite,
+	permissionSalesRead,
+}
+
+// publisherMemberPermissions defines the permissions granted to the members of a publisher, by member role.
+// The publisher account itself is granted every permission.
+var publisherMemberPermissions = map[string][]string{
+	string(domain.PublisherMemberRoleOwner): {
+		permissionPublisherRead,
+		permissionPublisherWrite,
+		permissionMembersWrite,
+		permissionGamesWrite,
+		permissionSalesRead,
+	},
+	string(domain.PublisherMemberRoleDeveloper): {
+		permissionPublisherRead,
+		permissionGamesWrite,
+	},
+	string(domain.PublisherMemberRoleFinance): {
+		permissionPublisherRead,
+		permissionSalesRead,
+	},
+	string(domain.PublisherMemberRoleViewer): {
+		permissionPublisherRead,
+	},
+}
+
+// OwnershipService defines the service interface to resolve the ownership of resources.
+type OwnershipService interface {
+	GamePublishedBy(ctx context.Context, publisherID, gameID uuid.UUID) (bool, error)
+}
+
+// NewAuthzPolicy returns the authorization policy for the API, with the operation permissions and optional operations
+// declared in its specification.
+func NewAuthzPolicy(ownershipService OwnershipService) (authz.Policy, error) {
+	swagger, err := api.GetSwagger()
+	if err != nil {
+		return authz.Policy{}, fmt.Errorf("%s: %w", descriptionFailedToLoadSwagger, err)
+	}
+
+	operationPermissions := make(map[string][]string)
+
+	var optionalOperations []string
+
+	for path, pathItem := range swagger.Paths.Map() {
+		for method, operation := range pathItem.Operations() {
+			// The route patterns are the ones registered by the generated handler.
+			pattern := method + " " + baseURLApi + path
+
+			if optionalSecurity(operation.Security) {
+				optionalOperations = append(optionalOperations, pattern)
+			}
+
+			extension, ok := operation.Extensions[extensionPermissions]
+			if !ok {
+				continue
+			}
+
+			permissions, err := permissionsFromExtension(extension)
+			if err != nil {
+				return authz.Policy{}, fmt.Errorf("%s: %s %s: %w", descriptionFailedToLoadPermissions, method, path, err)
+			}
+
+			operationPermissions[pattern] = permissions
+		}
+	}
+
+	return authz.Policy{
+		AuthzWildcards:             []string{domain.FieldParamUserID, domain.FieldParamPublisherID},
+		PublisherWildcard:          domain.FieldParamPublisherID,
+		OperationPermissions:       operationPermissions,
+		PublisherMemberPermissions: publisherMemberPermissions,
+		Ownerships: []authz.Ownership{
+			{
+				Wildcard:      domain.FieldParamGameID,
+				OwnerWildcard: domain.FieldParamPublisherID,
+				Resolver:      ownershipService.GamePublishedBy,
+			},
+		},
+		UnboundRoles:       []string{string(authn.SubjectRoleAdmin)},
+		OptionalOperations: optionalOperations,
+	}, nil
+}
+
+// subjectAdmin returns true if the subject authenticated in the context is an admin, false otherwise.
+func subjectAdmin(ctx context.Context) bool {
+	claims, ok := authz.ClaimsFromContext(ctx)
+	return ok && slices.Contains(claims.Roles, authn.SubjectRoleAdmin)
+}
+
+// publisherPrivileged returns true if the subject authenticated in the context is the specified publisher, one of its
+// members or an admin, which can see the fields of the publisher and its games that are hidden from the store, false
+// otherwise.
+func publisherPrivileged(ctx context.Context, publisherID uuid.UUID) bool {
+	claims, ok := authz.ClaimsFromContext(ctx)
+	if !ok {
+		return false
+	}
+
+	if slices.Contains(claims.Roles, authn.SubjectRoleAdmin) {
+		return true
+	}
+
+	if slices.Contains(claims.Roles, authn.SubjectRolePublisher) && claims.Subject == publisherID.String() {
+		return true
+	}
+
+	_, ok = claims.Publishers[publisherID.String()]
+
+	return ok
+}
+
+// optionalSecurity returns true if the specified security requirements contain an empty one, which makes the token
+// optional, false otherwise.
+func optionalSecurity(securityRequirements *openapi3.SecurityRequirements) bool {
+	if securityRequirements == nil {
+		return false
+	}
+
+	return slices.ContainsFunc(*securityRequirements, func(securityRequirement openapi3.SecurityRequirement) bool {
+		return len(securityRequirement) == 0
+	})
+}
+
+// permissionsFromExtension returns the permissions declared in the specified x-permissions extension value, or returns
+// an error if it is not a list of known permissions.
+func permissionsFromExtension(extension any) ([]string, error) {
+	rawExtension, err := json.Marshal(extension)
+	if err != nil {
+		return nil, err
+	}
+
+	var permissions []string
+
+	err = json.Unmarshal(rawExtension, &permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, permission := range permissions {
+		if !slices.Contains(knownPermissions, permission) {
+			return nil, fmt.Errorf("unknown permission %q", permission)
+		}
+	}
+
+	return permissions, nil
 }
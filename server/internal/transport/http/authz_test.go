@@ -0,0 +1,34 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+// ownershipService implements the ownership service interface without any ownership.
+type ownershipService struct{}
+
+func (ownershipService) GamePublishedBy(context.Context, uuid.UUID, uuid.UUID) (bool, error) {
+	return false, nil
+}
+
+func TestNewAuthzPolicy(t *testing.T) {
+	t.Run("should grant the sales only to the owners and finance members", func(t *testing.T) {
+		policy, err := NewAuthzPolicy(ownershipService{})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{permissionSalesRead}, policy.OperationPermissions[http.MethodGet+" "+baseURLApi+"/publishers/{publisherId}/sales"])
+
+		assert.Contains(t, policy.PublisherMemberPermissions[string(domain.PublisherMemberRoleOwner)], permissionSalesRead)
+		assert.Contains(t, policy.PublisherMemberPermissions[string(domain.PublisherMemberRoleFinance)], permissionSalesRead)
+		assert.NotContains(t, policy.PublisherMemberPermissions[string(domain.PublisherMemberRoleDeveloper)], permissionSalesRead)
+		assert.NotContains(t, policy.PublisherMemberPermissions[string(domain.PublisherMemberRoleViewer)], permissionSalesRead)
+	})
+}
@@ -0,0 +1,104 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/goofr-group/gaming-store/server/api"
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+const (
+	codeEmailVerificationTokenInvalid = "email_verification_token_invalid"
+
+	errEmailVerificationTokenInvalid = "invalid email verification token"
+)
+
+// ResendUserEmailVerification handles the http request to resend a user email verification.
+func (h *handler) ResendUserEmailVerification(w http.ResponseWriter, r *http.Request, userID api.UserIdPathParam) {
+	ctx := r.Context()
+
+	err := h.service.ResendUserEmailVerification(ctx, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUserNotFound):
+			notFound(w, codeUserNotFound, errUserNotFound)
+		case errors.Is(err, domain.ErrUserEmailAlreadyVerified):
+			conflict(w, codeUserEmailAlreadyVerified, errUserEmailAlreadyVerified)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusNoContent, nil)
+}
+
+// ConfirmUserEmailVerification handles the http request to confirm a user email verification.
+func (h *handler) ConfirmUserEmailVerification(w http.ResponseWriter, r *http.Request) {
+	h.confirmEmailVerification(w, r, h.service.VerifyUserEmail)
+}
+
+// ResendPublisherEmailVerification handles the http request to resend a publisher email verification.
+func (h *handler) ResendPublisherEmailVerification(w http.ResponseWriter, r *http.Request, publisherID api.PublisherIdPathParam) {
+	ctx := r.Context()
+
+	err := h.service.ResendPublisherEmailVerification(ctx, publisherID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPublisherNotFound):
+			notFound(w, codePublisherNotFound, errPublisherNotFound)
+		case errors.Is(err, domain.ErrPublisherEmailAlreadyVerified):
+			conflict(w, codePublisherEmailAlreadyVerified, errPublisherEmailAlreadyVerified)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusNoContent, nil)
+}
+
+// ConfirmPublisherEmailVerification handles the http request to confirm a publisher email verification.
+func (h *handler) ConfirmPublisherEmailVerification(w http.ResponseWriter, r *http.Request) {
+	h.confirmEmailVerification(w, r, h.service.VerifyPublisherEmail)
+}
+
+// confirmEmailVerification handles the http request to confirm an email verification with the specified service
+// method.
+func (h *handler) confirmEmailVerification(w http.ResponseWriter, r *http.Request, verifyEmail func(ctx context.Context, token string) error) {
+	ctx := r.Context()
+
+	requestBody, err := io.ReadAll(r.Body)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+
+	var emailVerificationConfirm api.EmailVerificationConfirm
+
+	err = json.Unmarshal(requestBody, &emailVerificationConfirm)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+
+	err = verifyEmail(ctx, emailVerificationConfirm.Token)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrEmailVerificationTokenInvalid):
+			badRequest(w, codeEmailVerificationTokenInvalid, errEmailVerificationTokenInvalid)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusNoContent, nil)
+}
@@ -11,24 +11,32 @@ import (
 const (
 	codeAuthorizationHeaderInvalid = "authorization_header_invalid"
 	codeJWTInvalid                 = "jwt_invalid"
+	codeSessionInvalid             = "session_invalid"
 	codeRolesInvalid               = "roles_invalid"
 	codeAuthorizationInvalid       = "authorization_invalid"
+	codeOwnershipInvalid           = "ownership_invalid"
 	codeParamInvalidFormat         = "param_invalid_format"
 	codeRequestBodyInvalid         = "request_body_invalid"
 	codeFieldValueInvalid          = "field_value_invalid"
 	codeFilterValueInvalid         = "filter_value_invalid"
 	codeCredentialsIncorrect       = "credentials_incorrect"
+	codePasswordCompromised        = "password_compromised"
+	codeRefreshTokenInvalid        = "refresh_token_invalid"
 	codeInternalServerError        = "internal_server_error"
 
 	errAuthorizationHeaderInvalid = "invalid authorization header"
 	errJWTInvalid                 = "invalid jwt"
+	errSessionInvalid             = "invalid session"
 	errRolesInvalid               = "invalid subject roles"
 	errAuthorizationInvalid       = "unauthorized subject"
+	errOwnershipInvalid           = "resource not owned by path owner"
 	errParamInvalidFormat         = "invalid parameter format"
 	errRequestBodyInvalid         = "invalid request body"
 	errFieldValueInvalid          = "invalid field value"
 	errFilterValueInvalid         = "invalid filter value"
 	errCredentialsIncorrect       = "incorrect credentials"
+	errPasswordCompromised        = "compromised password"
+	errRefreshTokenInvalid        = "invalid refresh token"
 )
 
 // Common fault descriptions.
@@ -67,6 +75,11 @@ func requestEntityTooLarge(w http.ResponseWriter, code, message string) {
 	_ = fault(w, http.StatusRequestEntityTooLarge, code, &message)
 }
 
+// tooManyRequests writes an error response and sets the header with the too many requests status code.
+func tooManyRequests(w http.ResponseWriter, code, message string) {
+	_ = fault(w, http.StatusTooManyRequests, code, &message)
+}
+
 // internalServerError sets the header with the internal server error status code.
 func internalServerError(w http.ResponseWriter) {
 	_ = fault(w, http.StatusInternalServerError, codeInternalServerError, nil)
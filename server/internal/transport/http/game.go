@@ -1,6 +1,7 @@
 package http
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -16,14 +17,16 @@ const (
 	codeGameNotFound                   = "game_not_found"
 	codeGamePreviewMultimediaNotFound  = "game_preview_multimedia_not_found"
 	codeGameDownloadMultimediaNotFound = "game_download_multimedia_not_found"
-	codeGameNotActive                  = "game_not_active"
+	codeGameNotPublished               = "game_not_published"
 	codeGameNotReleased                = "game_not_released"
+	codeGameStatusFilterForbidden      = "game_status_filter_forbidden"
 
 	errGameNotFound                   = "game not found"
 	errGamePreviewMultimediaNotFound  = "game preview multimedia not found"
 	errGameDownloadMultimediaNotFound = "game download multimedia not found"
-	errGameNotActive                  = "game not active"
+	errGameNotPublished               = "game not published"
 	errGameNotReleased                = "game not released"
+	errGameStatusFilterForbidden      = "only admins and the publisher of the games can filter by this status"
 )
 
 // ListGames handles the http request to list games.
@@ -32,7 +35,19 @@ func (h *handler) ListGames(w http.ResponseWriter, r *http.Request, params api.L
 
 	domainGamesFilter := listGamesParamsToDomain(params)
 
-	domainPaginatedGames, err := h.service.ListGames(ctx, domainGamesFilter)
+	// Only admins, and the publisher in the filter and its members, can list the games that are not published.
+	if !subjectAdmin(ctx) && (params.PublisherId == nil || !publisherPrivileged(ctx, *params.PublisherId)) {
+		switch {
+		case domainGamesFilter.Status == nil:
+			status := domain.GameStatusPublished
+			domainGamesFilter.Status = &status
+		case domainGamesFilter.Status.Valid() && *domainGamesFilter.Status != domain.GameStatusPublished:
+			forbidden(w, codeGameStatusFilterForbidden, errGameStatusFilterForbidden)
+			return
+		}
+	}
+
+	domainPaginatedGames, err := h.service.ListGames(ctx, domainGamesFilter, preferredLanguagesFromAcceptLanguage(params.AcceptLanguage))
 	if err != nil {
 		var domainFilterValueInvalidError *domain.FilterValueInvalidError
 
@@ -46,7 +61,8 @@ func (h *handler) ListGames(w http.ResponseWriter, r *http.Request, params api.L
 		return
 	}
 
-	gamesPaginated := gamesPaginatedFromDomain(domainPaginatedGames)
+	gamesPaginated := gamesPaginatedFromDomain(ctx, domainPaginatedGames.PaginatedResponse)
+	gamesPaginated.Facets = optionalGamesFacetsFromDomain(domainPaginatedGames.Facets)
 
 	responseBody, err := json.Marshal(gamesPaginated)
 	if err != nil {
@@ -56,6 +72,7 @@ func (h *handler) ListGames(w http.ResponseWriter, r *http.Request, params api.L
 		return
 	}
 
+	w.Header().Add("Vary", requestHeaderKeyAcceptLanguage)
 	writeResponseJSON(w, http.StatusOK, responseBody)
 }
 
@@ -79,7 +96,75 @@ func (h *handler) ListGamesRecommended(w http.ResponseWriter, r *http.Request, p
 		return
 	}
 
-	gamesPaginated := gamesPaginatedFromDomain(domainPaginatedGames)
+	gamesPaginated := gamesPaginatedFromDomain(ctx, domainPaginatedGames)
+
+	responseBody, err := json.Marshal(gamesPaginated)
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, descriptionFailedToMarshalResponseBody, logging.Error(err))
+		internalServerError(w)
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusOK, responseBody)
+}
+
+// ListGamesSimilar handles the http request to list similar games.
+func (h *handler) ListGamesSimilar(w http.ResponseWriter, r *http.Request, gameID api.GameIdPathParam, params api.ListGamesSimilarParams) {
+	ctx := r.Context()
+
+	domainGamesSimilarFilter := listGamesSimilarParamsToDomain(params)
+
+	domainPaginatedGames, err := h.service.ListGamesSimilar(ctx, gameID, domainGamesSimilarFilter)
+	if err != nil {
+		var domainFilterValueInvalidError *domain.FilterValueInvalidError
+
+		switch {
+		case errors.As(err, &domainFilterValueInvalidError):
+			badRequest(w, codeFilterValueInvalid, fmt.Sprintf("%s: %s", errFilterValueInvalid, domainFilterValueInvalidError.FilterName))
+		case errors.Is(err, domain.ErrGameNotFound):
+			notFound(w, codeGameNotFound, errGameNotFound)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	gamesPaginated := gamesPaginatedFromDomain(ctx, domainPaginatedGames)
+
+	responseBody, err := json.Marshal(gamesPaginated)
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, descriptionFailedToMarshalResponseBody, logging.Error(err))
+		internalServerError(w)
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusOK, responseBody)
+}
+
+// ListGamesChart handles the http request to list the games of a chart.
+func (h *handler) ListGamesChart(w http.ResponseWriter, r *http.Request, params api.ListGamesChartParams) {
+	ctx := r.Context()
+
+	domainGamesChartFilter := listGamesChartParamsToDomain(params)
+
+	domainPaginatedGames, err := h.service.ListGamesChart(ctx, domainGamesChartFilter)
+	if err != nil {
+		var domainFilterValueInvalidError *domain.FilterValueInvalidError
+
+		switch {
+		case errors.As(err, &domainFilterValueInvalidError):
+			badRequest(w, codeFilterValueInvalid, fmt.Sprintf("%s: %s", errFilterValueInvalid, domainFilterValueInvalidError.FilterName))
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	gamesPaginated := gamesPaginatedFromDomain(ctx, domainPaginatedGames)
 
 	responseBody, err := json.Marshal(gamesPaginated)
 	if err != nil {
@@ -124,7 +209,12 @@ func (h *handler) CreateGame(w http.ResponseWriter, r *http.Request, publisherID
 		return
 	}
 
-	domainGame, err := h.service.CreateGame(ctx, publisherID, domainEditableGame)
+	author, ok := gameRevisionAuthorFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	domainGame, err := h.service.CreateGame(ctx, publisherID, domainEditableGame, author)
 	if err != nil {
 		var domainFieldValueInvalidError *domain.FieldValueInvalidError
 
@@ -133,6 +223,10 @@ func (h *handler) CreateGame(w http.ResponseWriter, r *http.Request, publisherID
 			badRequest(w, codeFieldValueInvalid, fmt.Sprintf("%s: %s", errFieldValueInvalid, domainFieldValueInvalidError.FieldName))
 		case errors.Is(err, domain.ErrPublisherNotFound):
 			notFound(w, codePublisherNotFound, errPublisherNotFound)
+		case errors.Is(err, domain.ErrPublisherEmailNotVerified):
+			conflict(w, codePublisherEmailNotVerified, errPublisherEmailNotVerified)
+		case errors.Is(err, domain.ErrPublisherNotVerified):
+			conflict(w, codePublisherNotVerified, errPublisherNotVerified)
 		case errors.Is(err, domain.ErrGamePreviewMultimediaNotFound):
 			conflict(w, codeGamePreviewMultimediaNotFound, errGamePreviewMultimediaNotFound)
 		case errors.Is(err, domain.ErrGameDownloadMultimediaNotFound):
@@ -144,7 +238,7 @@ func (h *handler) CreateGame(w http.ResponseWriter, r *http.Request, publisherID
 		return
 	}
 
-	game := gameFromDomain(domainGame)
+	game := gameFromDomain(ctx, domainGame)
 
 	responseBody, err := json.Marshal(game)
 	if err != nil {
@@ -158,10 +252,10 @@ func (h *handler) CreateGame(w http.ResponseWriter, r *http.Request, publisherID
 }
 
 // GetGameByID handles the http request to get a game by ID.
-func (h *handler) GetGameByID(w http.ResponseWriter, r *http.Request, publisherID api.PublisherIdPathParam, gameID api.GameIdPathParam) {
+func (h *handler) GetGameByID(w http.ResponseWriter, r *http.Request, publisherID api.PublisherIdPathParam, gameID api.GameIdPathParam, params api.GetGameByIDParams) {
 	ctx := r.Context()
 
-	domainGame, err := h.service.GetGameByID(ctx, gameID)
+	domainGame, err := h.service.GetGameByID(ctx, gameID, preferredLanguagesFromAcceptLanguage(params.AcceptLanguage))
 	if err != nil {
 		switch {
 		case errors.Is(err, domain.ErrGameNotFound):
@@ -173,7 +267,13 @@ func (h *handler) GetGameByID(w http.ResponseWriter, r *http.Request, publisherI
 		return
 	}
 
-	game := gameFromDomain(domainGame)
+	// Only admins, and the publisher of the game and its members, can get a game that is not published.
+	if domainGame.Status != domain.GameStatusPublished && !publisherPrivileged(ctx, domainGame.Publisher.ID) {
+		notFound(w, codeGameNotFound, errGameNotFound)
+		return
+	}
+
+	game := gameFromDomain(ctx, domainGame)
 
 	responseBody, err := json.Marshal(game)
 	if err != nil {
@@ -183,6 +283,7 @@ func (h *handler) GetGameByID(w http.ResponseWriter, r *http.Request, publisherI
 		return
 	}
 
+	w.Header().Add("Vary", requestHeaderKeyAcceptLanguage)
 	writeResponseJSON(w, http.StatusOK, responseBody)
 }
 
@@ -218,7 +319,12 @@ func (h *handler) PatchGameByID(w http.ResponseWriter, r *http.Request, publishe
 		return
 	}
 
-	domainGame, err := h.service.PatchGame(ctx, gameID, domainEditableGame)
+	author, ok := gameRevisionAuthorFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	domainGame, err := h.service.PatchGame(ctx, gameID, domainEditableGame, author)
 	if err != nil {
 		var domainFieldValueInvalidError *domain.FieldValueInvalidError
 
@@ -227,6 +333,8 @@ func (h *handler) PatchGameByID(w http.ResponseWriter, r *http.Request, publishe
 			badRequest(w, codeFieldValueInvalid, fmt.Sprintf("%s: %s", errFieldValueInvalid, domainFieldValueInvalidError.FieldName))
 		case errors.Is(err, domain.ErrGameNotFound):
 			notFound(w, codeGameNotFound, errGameNotFound)
+		case errors.Is(err, domain.ErrGameNotEditable):
+			conflict(w, codeGameNotEditable, errGameNotEditable)
 		case errors.Is(err, domain.ErrGamePreviewMultimediaNotFound):
 			conflict(w, codeGamePreviewMultimediaNotFound, errGamePreviewMultimediaNotFound)
 		case errors.Is(err, domain.ErrGameDownloadMultimediaNotFound):
@@ -238,7 +346,7 @@ func (h *handler) PatchGameByID(w http.ResponseWriter, r *http.Request, publishe
 		return
 	}
 
-	game := gameFromDomain(domainGame)
+	game := gameFromDomain(ctx, domainGame)
 
 	responseBody, err := json.Marshal(game)
 	if err != nil {
@@ -257,23 +365,54 @@ func listGamesParamsToDomain(params api.ListGamesParams) domain.GamesPaginatedFi
 
 	if params.Sort != nil {
 		switch *params.Sort {
-		case api.Title:
+		case api.ListGamesParamsSortTitle:
 			domainSort = domain.GamePaginatedSortTitle
-		case api.Price:
+		case api.ListGamesParamsSortPrice:
 			domainSort = domain.GamePaginatedSortPrice
-		case api.ReleaseDate:
+		case api.ListGamesParamsSortReleaseDate:
 			domainSort = domain.GamePaginatedSortReleaseDate
-		case api.UserCount:
+		case api.ListGamesParamsSortUserCount:
 			domainSort = domain.GamePaginatedSortUserCount
+		case api.ListGamesParamsSortRelevance:
+			domainSort = domain.GamePaginatedSortRelevance
 		default:
 			domainSort = domain.GamePaginatedSort(*params.Sort)
 		}
 	}
 
+	order := (*api.OrderQueryParam)(params.Order)
+
+	// The best matches of a search rank first, unless another order is requested.
+	if order == nil && domainSort == domain.GamePaginatedSortRelevance && params.Title != nil {
+		orderDesc := api.OrderQueryParamDesc
+		order = &orderDesc
+	}
+
+	var domainFacets []domain.GameFacet
+
+	if params.Facets != nil {
+		domainFacets = make([]domain.GameFacet, len(*params.Facets))
+
+		for i, facet := range *params.Facets {
+			switch facet {
+			case api.Tags:
+				domainFacets[i] = domain.GameFacetTags
+			case api.Prices:
+				domainFacets[i] = domain.GameFacetPrices
+			case api.Languages:
+				domainFacets[i] = domain.GameFacetLanguages
+			case api.AgeRatings:
+				domainFacets[i] = domain.GameFacetAgeRatings
+			default:
+				domainFacets[i] = domain.GameFacet(facet)
+			}
+		}
+	}
+
 	return domain.GamesPaginatedFilter{
 		PaginatedRequest: paginatedRequestToDomain(
 			domainSort,
-			(*api.OrderQueryParam)(params.Order),
+			order,
 			params.Limit,
 			params.Offset,
 		),
@@ -281,10 +420,11 @@ func listGamesParamsToDomain(params api.ListGamesParams) domain.GamesPaginatedFi
 		Title:             (*domain.GameTitle)(params.Title),
 		PriceUnder:        (*domain.GamePrice)(params.PriceUnder),
 		PriceAbove:        (*domain.GamePrice)(params.PriceAbove),
-		IsActive:          params.IsActive,
+		Status:            (*domain.GameStatus)(params.Status),
 		ReleaseDateBefore: optionalDateToOptionalTime(params.ReleaseDateBefore),
 		ReleaseDateAfter:  optionalDateToOptionalTime(params.ReleaseDateAfter),
 		TagIDs:            params.TagIds,
+		Facets:            domainFacets,
 	}
 }
 
@@ -300,6 +440,59 @@ func listGamesRecommendedParamsToDomain(params api.ListGamesRecommendedParams) d
 	}
 }
 
+// listGamesSimilarParamsToDomain returns a domain similar games paginated filter based on the standardized list
+// similar games parameters.
+func listGamesSimilarParamsToDomain(params api.ListGamesSimilarParams) domain.GamesSimilarPaginatedFilter {
+	return domain.GamesSimilarPaginatedFilter{
+		PaginatedRequestBase: paginatedRequestBaseToDomain(
+			params.Limit,
+			params.Offset,
+		),
+		UserID: params.UserId,
+	}
+}
+
+// listGamesChartParamsToDomain returns a domain games chart paginated filter based on the standardized list games
+// chart parameters.
+func listGamesChartParamsToDomain(params api.ListGamesChartParams) domain.GamesChartPaginatedFilter {
+	var domainType domain.GameChartType
+
+	switch params.Type {
+	case api.TopSellers:
+		domainType = domain.GameChartTypeTopSellers
+	case api.Trending:
+		domainType = domain.GameChartTypeTrending
+	case api.NewAndTrending:
+		domainType = domain.GameChartTypeNewAndTrending
+	default:
+		domainType = domain.GameChartType(params.Type)
+	}
+
+	domainWindow := domain.GameChartWindow7d
+
+	if params.Window != nil {
+		switch *params.Window {
+		case api.N1d:
+			domainWindow = domain.GameChartWindow1d
+		case api.N7d:
+			domainWindow = domain.GameChartWindow7d
+		case api.N30d:
+			domainWindow = domain.GameChartWindow30d
+		default:
+			domainWindow = domain.GameChartWindow(*params.Window)
+		}
+	}
+
+	return domain.GamesChartPaginatedFilter{
+		PaginatedRequestBase: paginatedRequestBaseToDomain(
+			params.Limit,
+			params.Offset,
+		),
+		Type:   domainType,
+		Window: domainWindow,
+	}
+}
+
 // gamePostToDomain returns a domain editable game based on the standardized game post.
 func gamePostToDomain(gamePost api.GamePost) (domain.EditableGame, error) {
 	languages, err := languagesToDomain(gamePost.Languages)
@@ -310,7 +503,6 @@ func gamePostToDomain(gamePost api.GamePost) (domain.EditableGame, error) {
 	return domain.EditableGame{
 		Title:       domain.GameTitle(gamePost.Title),
 		Price:       domain.GamePrice(gamePost.Price),
-		IsActive:    gamePost.IsActive,
 		ReleaseDate: optionalDateToOptionalTime(gamePost.ReleaseDate),
 		Description: domain.GameDescription(gamePost.Description),
 		AgeRating:   domain.GameAgeRating(gamePost.AgeRating),
@@ -351,7 +543,6 @@ func gamePatchToDomain(gamePatch api.GamePatch) (domain.EditableGamePatch, error
 	return domain.EditableGamePatch{
 		Title:                (*domain.GameTitle)(gamePatch.Title),
 		Price:                (*domain.GamePrice)(gamePatch.Price),
-		IsActive:             gamePatch.IsActive,
 		ReleaseDate:          optionalDateToOptionalTime(gamePatch.ReleaseDate),
 		Description:          (*domain.GameDescription)(gamePatch.Description),
 		AgeRating:            (*domain.GameAgeRating)(gamePatch.AgeRating),
@@ -363,14 +554,21 @@ func gamePatchToDomain(gamePatch api.GamePatch) (domain.EditableGamePatch, error
 	}, nil
 }
 
-// gameFromDomain returns a standardized game based on the domain model.
-func gameFromDomain(game domain.Game) api.Game {
+// gameFromDomain returns a standardized game based on the domain model. The review notes are only returned to admins,
+// and to the publisher of the game and its members.
+func gameFromDomain(ctx context.Context, game domain.Game) api.Game {
+	var reviewNotes *string
+	if publisherPrivileged(ctx, game.Publisher.ID) {
+		reviewNotes = (*string)(game.ReviewNotes)
+	}
+
 	return api.Game{
 		Id:          game.ID,
-		Publisher:   publisherFromDomain(game.Publisher),
+		Publisher:   publisherFromDomain(ctx, game.Publisher),
 		Title:       string(game.Title),
 		Price:       float64(game.Price),
-		IsActive:    game.IsActive,
+		Status:      api.GameStatus(game.Status),
+		ReviewNotes: reviewNotes,
 		ReleaseDate: optionalDateFromOptionalTime(game.ReleaseDate),
 		Description: string(game.Description),
 		AgeRating:   string(game.AgeRating),
@@ -390,20 +588,84 @@ func gameFromDomain(game domain.Game) api.Game {
 }
 
 // gamesFromDomain returns standardized games based on the domain model.
-func gamesFromDomain(games []domain.Game) []api.Game {
+func gamesFromDomain(ctx context.Context, games []domain.Game) []api.Game {
 	g := make([]api.Game, len(games))
 
 	for i := 0; i < len(games); i++ {
-		g[i] = gameFromDomain(games[i])
+		g[i] = gameFromDomain(ctx, games[i])
 	}
 
 	return g
 }
 
 // gamesPaginatedFromDomain returns a standardized games paginated response based on the domain model.
-func gamesPaginatedFromDomain(paginatedResponse domain.PaginatedResponse[domain.Game]) api.GamesPaginated {
+func gamesPaginatedFromDomain(ctx context.Context, paginatedResponse domain.PaginatedResponse[domain.Game]) api.GamesPaginated {
 	return api.GamesPaginated{
 		Total: paginatedResponse.Total,
-		Games: gamesFromDomain(paginatedResponse.Results),
+		Games: gamesFromDomain(ctx, paginatedResponse.Results),
+	}
+}
+
+// optionalGamesFacetsFromDomain returns standardized games facets based on the domain model.
+func optionalGamesFacetsFromDomain(facets *domain.GamesFacets) *api.GamesFacets {
+	if facets == nil {
+		return nil
+	}
+
+	var gamesFacets api.GamesFacets
+
+	if facets.Tags != nil {
+		tags := make([]api.GameFacetTag, len(facets.Tags))
+
+		for i, facet := range facets.Tags {
+			tags[i] = api.GameFacetTag{
+				Tag:   tagFromDomain(facet.Tag),
+				Count: facet.Count,
+			}
+		}
+
+		gamesFacets.Tags = &tags
 	}
+
+	if facets.Prices != nil {
+		prices := make([]api.GameFacetPrice, len(facets.Prices))
+
+		for i, facet := range facets.Prices {
+			prices[i] = api.GameFacetPrice{
+				Min:   float64(facet.Min),
+				Max:   (*float64)(facet.Max),
+				Count: facet.Count,
+			}
+		}
+
+		gamesFacets.Prices = &prices
+	}
+
+	if facets.Languages != nil {
+		languages := make([]api.GameFacetLanguage, len(facets.Languages))
+
+		for i, facet := range facets.Languages {
+			languages[i] = api.GameFacetLanguage{
+				Language: facet.Language.String(),
+				Count:    facet.Count,
+			}
+		}
+
+		gamesFacets.Languages = &languages
+	}
+
+	if facets.AgeRatings != nil {
+		ageRatings := make([]api.GameFacetAgeRating, len(facets.AgeRatings))
+
+		for i, facet := range facets.AgeRatings {
+			ageRatings[i] = api.GameFacetAgeRating{
+				AgeRating: string(facet.AgeRating),
+				Count:     facet.Count,
+			}
+		}
+
+		gamesFacets.AgeRatings = &ageRatings
+	}
+
+	return &gamesFacets
 }
@@ -0,0 +1,500 @@
+package http
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	oapitypes "github.com/oapi-codegen/runtime/types"
+
+	"github.com/goofr-group/gaming-store/server/api"
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+	"github.com/goofr-group/gaming-store/server/internal/logging"
+)
+
+const (
+	codeGameCatalogTooLarge = "game_catalog_too_large"
+
+	errGameCatalogTooLarge = "game catalog too large"
+
+	descriptionFailedToWriteGameCatalogCSV = "http: failed to write game catalog csv"
+
+	mediaTypeJSON = "application/json"
+	mediaTypeCSV  = "text/csv"
+
+	// gameCatalogMaxSize defines the maximum size in bytes of an imported game catalog.
+	gameCatalogMaxSize = 10 << 20
+
+	// gameCatalogCSVListSeparator defines the separator of the values of the list columns of a game catalog csv.
+	gameCatalogCSVListSeparator = ";"
+)
+
+// gameCatalogCSVHeader defines the columns of a game catalog csv.
+var gameCatalogCSVHeader = []string{
+	"title",
+	"price",
+	"releaseDate",
+	"description",
+	"ageRating",
+	"features",
+	"languages",
+	"minimumRequirements",
+	"recommendedRequirements",
+	"previewMultimediaId",
+	"downloadMultimediaId",
+	"tags",
+	"multimedia",
+}
+
+// ImportGameCatalog handles the http request to import a game catalog.
+func (h *handler) ImportGameCatalog(w http.ResponseWriter, r *http.Request, publisherID api.PublisherIdPathParam, params api.ImportGameCatalogParams) {
+	ctx := r.Context()
+
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get(requestHeaderKeyContentType))
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+
+	requestBody, err := io.ReadAll(io.LimitReader(r.Body, gameCatalogMaxSize+1))
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+
+	if len(requestBody) > gameCatalogMaxSize {
+		requestEntityTooLarge(w, codeGameCatalogTooLarge, errGameCatalogTooLarge)
+		return
+	}
+
+	var (
+		domainGameCatalog   domain.GameCatalog
+		decodingEntryErrors []domain.GameCatalogEntryError
+	)
+
+	switch mediaType {
+	case mediaTypeJSON:
+		var gameCatalog api.GameCatalog
+
+		err = json.Unmarshal(requestBody, &gameCatalog)
+		if err != nil {
+			badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+			return
+		}
+
+		domainGameCatalog, decodingEntryErrors = gameCatalogToDomain(gameCatalog)
+	case mediaTypeCSV:
+		domainGameCatalog, decodingEntryErrors, err = gameCatalogFromCSV(requestBody)
+		if err != nil {
+			badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+			return
+		}
+	default:
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+
+	author, ok := gameRevisionAuthorFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	dryRun := params.DryRun != nil && *params.DryRun
+
+	// Entries that could not be decoded are only validated, as no game can be created from them.
+	domainReport, err := h.service.ImportGameCatalog(ctx, publisherID, domainGameCatalog, dryRun || len(decodingEntryErrors) != 0, author)
+	if err != nil {
+		var domainFieldValueInvalidError *domain.FieldValueInvalidError
+
+		switch {
+		case errors.As(err, &domainFieldValueInvalidError):
+			badRequest(w, codeFieldValueInvalid, fmt.Sprintf("%s: %s", errFieldValueInvalid, domainFieldValueInvalidError.FieldName))
+		case errors.Is(err, domain.ErrPublisherNotFound):
+			notFound(w, codePublisherNotFound, errPublisherNotFound)
+		case errors.Is(err, domain.ErrPublisherEmailNotVerified):
+			conflict(w, codePublisherEmailNotVerified, errPublisherEmailNotVerified)
+		case errors.Is(err, domain.ErrPublisherNotVerified):
+			conflict(w, codePublisherNotVerified, errPublisherNotVerified)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	domainReport.DryRun = dryRun
+	domainReport.Errors = mergeGameCatalogEntryErrors(decodingEntryErrors, domainReport.Errors)
+
+	report := gameCatalogImportReportFromDomain(ctx, domainReport)
+
+	responseBody, err := json.Marshal(report)
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, descriptionFailedToMarshalResponseBody, logging.Error(err))
+		internalServerError(w)
+
+		return
+	}
+
+	switch {
+	case len(domainReport.Errors) != 0:
+		writeResponseJSON(w, http.StatusUnprocessableEntity, responseBody)
+	case dryRun:
+		writeResponseJSON(w, http.StatusOK, responseBody)
+	default:
+		writeResponseJSON(w, http.StatusCreated, responseBody)
+	}
+}
+
+// ExportGameCatalog handles the http request to export a game catalog.
+func (h *handler) ExportGameCatalog(w http.ResponseWriter, r *http.Request, publisherID api.PublisherIdPathParam, params api.ExportGameCatalogParams) {
+	ctx := r.Context()
+
+	format := domain.GameCatalogFormatJSON
+	if params.Format != nil {
+		format = domain.GameCatalogFormat(*params.Format)
+	}
+
+	if !format.Valid() {
+		badRequest(w, codeFilterValueInvalid, fmt.Sprintf("%s: %s", errFilterValueInvalid, domain.FieldFilterFormat))
+		return
+	}
+
+	domainGameCatalog, err := h.service.ExportGameCatalog(ctx, publisherID)
+	if err != nil {
+		internalServerError(w)
+		return
+	}
+
+	if format == domain.GameCatalogFormatCSV {
+		responseBody, err := gameCatalogToCSV(domainGameCatalog)
+		if err != nil {
+			logging.Logger.ErrorContext(ctx, descriptionFailedToWriteGameCatalogCSV, logging.Error(err))
+			internalServerError(w)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", mediaTypeCSV+"; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(responseBody)
+
+		return
+	}
+
+	responseBody, err := json.Marshal(gameCatalogFromDomain(domainGameCatalog))
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, descriptionFailedToMarshalResponseBody, logging.Error(err))
+		internalServerError(w)
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusOK, responseBody)
+}
+
+// gameCatalogToDomain returns a domain game catalog based on the standardized game catalog, along with the fields of
+// its entries that could not be decoded.
+func gameCatalogToDomain(gameCatalog api.GameCatalog) (domain.GameCatalog, []domain.GameCatalogEntryError) {
+	var (
+		domainGameCatalog = make(domain.GameCatalog, len(gameCatalog))
+		entryErrors       []domain.GameCatalogEntryError
+	)
+
+	for i, entry := range gameCatalog {
+		languages, err := languagesToDomain(entry.Languages)
+		if err != nil {
+			entryErrors = append(entryErrors, gameCatalogEntryError(i+1, domain.FieldLanguages))
+		}
+
+		var tagNames []domain.Name
+		if entry.Tags != nil {
+			tagNames = make([]domain.Name, len(*entry.Tags))
+			for j, tagName := range *entry.Tags {
+				tagNames[j] = domain.Name(tagName)
+			}
+		}
+
+		var multimediaIDs []uuid.UUID
+		if entry.Multimedia != nil {
+			multimediaIDs = *entry.Multimedia
+		}
+
+		domainGameCatalog[i] = domain.GameCatalogEntry{
+			EditableGame: domain.EditableGame{
+				Title:       domain.GameTitle(entry.Title),
+				Price:       domain.GamePrice(entry.Price),
+				ReleaseDate: optionalDateToOptionalTime(entry.ReleaseDate),
+				Description: domain.GameDescription(entry.Description),
+				AgeRating:   domain.GameAgeRating(entry.AgeRating),
+				Features:    domain.GameFeatures(entry.Features),
+				Languages:   languages,
+				Requirements: domain.GameRequirements{
+					Minimum:     domain.GameRequirement(entry.Requirements.Minimum),
+					Recommended: domain.GameRequirement(entry.Requirements.Recommended),
+				},
+				PreviewMultimediaID:  entry.PreviewMultimediaId,
+				DownloadMultimediaID: entry.DownloadMultimediaId,
+			},
+			TagNames:      tagNames,
+			MultimediaIDs: multimediaIDs,
+		}
+	}
+
+	return domainGameCatalog, entryErrors
+}
+
+// gameCatalogFromDomain returns a standardized game catalog based on the domain model.
+func gameCatalogFromDomain(gameCatalog domain.GameCatalog) api.GameCatalog {
+	entries := make(api.GameCatalog, len(gameCatalog))
+
+	for i, entry := range gameCatalog {
+		tags := make([]api.Name, len(entry.TagNames))
+		for j, tagName := range entry.TagNames {
+			tags[j] = string(tagName)
+		}
+
+		multimedia := slices.Clone(entry.MultimediaIDs)
+		if multimedia == nil {
+			multimedia = []uuid.UUID{}
+		}
+
+		entries[i] = api.GameCatalogEntry{
+			Title:       string(entry.Title),
+			Price:       float64(entry.Price),
+			ReleaseDate: optionalDateFromOptionalTime(entry.ReleaseDate),
+			Description: string(entry.Description),
+			AgeRating:   string(entry.AgeRating),
+			Features:    string(entry.Features),
+			Languages:   entry.Languages.String(),
+			Requirements: api.GameRequirements{
+				Minimum:     string(entry.Requirements.Minimum),
+				Recommended: string(entry.Requirements.Recommended),
+			},
+			PreviewMultimediaId:  entry.PreviewMultimediaID,
+			DownloadMultimediaId: entry.DownloadMultimediaID,
+			Tags:                 &tags,
+			Multimedia:           &multimedia,
+		}
+	}
+
+	return entries
+}
+
+// gameCatalogFromCSV returns a domain game catalog based on the csv data, along with the fields of its rows that could
+// not be decoded. Returns an error if the csv is malformed or its header does not match the catalog columns.
+func gameCatalogFromCSV(data []byte) (domain.GameCatalog, []domain.GameCatalogEntryError, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = len(gameCatalogCSVHeader)
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(records) == 0 || !slices.Equal(records[0], gameCatalogCSVHeader) {
+		return nil, nil, errors.New("invalid game catalog csv header")
+	}
+
+	var (
+		gameCatalog = make(domain.GameCatalog, len(records)-1)
+		entryErrors []domain.GameCatalogEntryError
+	)
+
+	for i, record := range records[1:] {
+		row := i + 1
+
+		var entry domain.GameCatalogEntry
+
+		entry.Title = domain.GameTitle(record[0])
+		entry.Description = domain.GameDescription(record[3])
+		entry.AgeRating = domain.GameAgeRating(record[4])
+		entry.Features = domain.GameFeatures(record[5])
+		entry.Requirements = domain.GameRequirements{
+			Minimum:     domain.GameRequirement(record[7]),
+			Recommended: domain.GameRequirement(record[8]),
+		}
+
+		price, err := strconv.ParseFloat(record[1], 64)
+		if err != nil {
+			entryErrors = append(entryErrors, gameCatalogEntryError(row, domain.FieldPrice))
+		}
+		entry.Price = domain.GamePrice(price)
+
+		if len(record[2]) != 0 {
+			releaseDate, err := time.Parse(oapitypes.DateFormat, record[2])
+			if err != nil {
+				entryErrors = append(entryErrors, gameCatalogEntryError(row, domain.FieldReleaseDate))
+			} else {
+				entry.ReleaseDate = &releaseDate
+			}
+		}
+
+		entry.Languages, err = languagesToDomain(splitGameCatalogCSVList(record[6]))
+		if err != nil {
+			entryErrors = append(entryErrors, gameCatalogEntryError(row, domain.FieldLanguages))
+		}
+
+		entry.PreviewMultimediaID, err = uuid.Parse(record[9])
+		if err != nil {
+			entryErrors = append(entryErrors, gameCatalogEntryError(row, domain.FieldPreviewMultimediaID))
+		}
+
+		if len(record[10]) != 0 {
+			downloadMultimediaID, err := uuid.Parse(record[10])
+			if err != nil {
+				entryErrors = append(entryErrors, gameCatalogEntryError(row, domain.FieldDownloadMultimediaID))
+			} else {
+				entry.DownloadMultimediaID = &downloadMultimediaID
+			}
+		}
+
+		for _, tagName := range splitGameCatalogCSVList(record[11]) {
+			entry.TagNames = append(entry.TagNames, domain.Name(tagName))
+		}
+
+		for _, s := range splitGameCatalogCSVList(record[12]) {
+			multimediaID, err := uuid.Parse(s)
+			if err != nil {
+				entryErrors = append(entryErrors, gameCatalogEntryError(row, domain.FieldMultimedia))
+				break
+			}
+
+			entry.MultimediaIDs = append(entry.MultimediaIDs, multimediaID)
+		}
+
+		gameCatalog[i] = entry
+	}
+
+	return gameCatalog, entryErrors, nil
+}
+
+// gameCatalogToCSV returns the csv data of the domain game catalog, with the same columns accepted by the import.
+func gameCatalogToCSV(gameCatalog domain.GameCatalog) ([]byte, error) {
+	var buffer bytes.Buffer
+
+	writer := csv.NewWriter(&buffer)
+
+	err := writer.Write(gameCatalogCSVHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range gameCatalog {
+		var releaseDate string
+		if entry.ReleaseDate != nil {
+			releaseDate = entry.ReleaseDate.Format(oapitypes.DateFormat)
+		}
+
+		var downloadMultimediaID string
+		if entry.DownloadMultimediaID != nil {
+			downloadMultimediaID = entry.DownloadMultimediaID.String()
+		}
+
+		tagNames := make([]string, len(entry.TagNames))
+		for i, tagName := range entry.TagNames {
+			tagNames[i] = string(tagName)
+		}
+
+		multimediaIDs := make([]string, len(entry.MultimediaIDs))
+		for i, multimediaID := range entry.MultimediaIDs {
+			multimediaIDs[i] = multimediaID.String()
+		}
+
+		err = writer.Write([]string{
+			string(entry.Title),
+			strconv.FormatFloat(float64(entry.Price), 'f', -1, 64),
+			releaseDate,
+			string(entry.Description),
+			string(entry.AgeRating),
+			string(entry.Features),
+			strings.Join(entry.Languages.String(), gameCatalogCSVListSeparator),
+			string(entry.Requirements.Minimum),
+			string(entry.Requirements.Recommended),
+			entry.PreviewMultimediaID.String(),
+			downloadMultimediaID,
+			strings.Join(tagNames, gameCatalogCSVListSeparator),
+			strings.Join(multimediaIDs, gameCatalogCSVListSeparator),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	writer.Flush()
+
+	err = writer.Error()
+	if err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// splitGameCatalogCSVList returns the values of a list column of a game catalog csv.
+func splitGameCatalogCSVList(s string) []string {
+	if len(s) == 0 {
+		return nil
+	}
+
+	return strings.Split(s, gameCatalogCSVListSeparator)
+}
+
+// gameCatalogEntryError returns the domain error of the invalid field of the game catalog entry in the specified row.
+func gameCatalogEntryError(row int, fieldName string) domain.GameCatalogEntryError {
+	return domain.GameCatalogEntryError{
+		Row:                    row,
+		FieldValueInvalidError: domain.FieldValueInvalidError{FieldName: fieldName},
+	}
+}
+
+// mergeGameCatalogEntryErrors returns the errors of the entries that could not be decoded together with the errors
+// reported by the validation, without duplicates and ordered by row.
+func mergeGameCatalogEntryErrors(decodingEntryErrors, entryErrors []domain.GameCatalogEntryError) []domain.GameCatalogEntryError {
+	merged := slices.Clone(decodingEntryErrors)
+
+	for _, entryError := range entryErrors {
+		if !slices.Contains(merged, entryError) {
+			merged = append(merged, entryError)
+		}
+	}
+
+	slices.SortStableFunc(merged, func(a, b domain.GameCatalogEntryError) int {
+		return cmp.Compare(a.Row, b.Row)
+	})
+
+	return merged
+}
+
+// gameCatalogImportReportFromDomain returns a standardized game catalog import report based on the domain model.
+func gameCatalogImportReportFromDomain(ctx context.Context, report domain.GameCatalogImportReport) api.GameCatalogImportReport {
+	entryErrors := make([]api.GameCatalogEntryError, len(report.Errors))
+	for i, entryError := range report.Errors {
+		entryErrors[i] = api.GameCatalogEntryError{
+			Row:   entryError.Row,
+			Field: entryError.FieldName,
+		}
+	}
+
+	games := make([]api.Game, len(report.Games))
+	for i, game := range report.Games {
+		games[i] = gameFromDomain(ctx, game)
+	}
+
+	return api.GameCatalogImportReport{
+		DryRun: report.DryRun,
+		Errors: entryErrors,
+		Games:  games,
+	}
+}
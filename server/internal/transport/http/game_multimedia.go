@@ -40,13 +40,20 @@ func (h *handler) CreateGameMultimedia(w http.ResponseWriter, r *http.Request, p
 
 	domainEditableGameMultimedia := gameMultimediaPostToDomain(gameMultimediaPost)
 
-	err = h.service.CreateGameMultimedia(ctx, gameID, multimediaID, domainEditableGameMultimedia)
+	author, ok := gameRevisionAuthorFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	err = h.service.CreateGameMultimedia(ctx, gameID, multimediaID, domainEditableGameMultimedia, author)
 	if err != nil {
 		switch {
 		case errors.Is(err, domain.ErrGameNotFound):
 			notFound(w, codeGameNotFound, errGameNotFound)
 		case errors.Is(err, domain.ErrMultimediaNotFound):
 			notFound(w, codeMultimediaNotFound, errMultimediaNotFound)
+		case errors.Is(err, domain.ErrGameNotEditable):
+			conflict(w, codeGameNotEditable, errGameNotEditable)
 		case errors.Is(err, domain.ErrGameMultimediaAlreadyExists):
 			conflict(w, codeGameMultimediaAlreadyExists, errGameMultimediaAlreadyExists)
 		case errors.Is(err, domain.ErrGameMultimediaPositionAlreadyExists):
@@ -65,11 +72,20 @@ func (h *handler) CreateGameMultimedia(w http.ResponseWriter, r *http.Request, p
 func (h *handler) DeleteGameMultimedia(w http.ResponseWriter, r *http.Request, publisherID api.PublisherIdPathParam, gameID api.GameIdPathParam, multimediaID api.MultimediaIdPathParam) {
 	ctx := r.Context()
 
-	err := h.service.DeleteGameMultimedia(ctx, gameID, multimediaID)
+	author, ok := gameRevisionAuthorFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.service.DeleteGameMultimedia(ctx, gameID, multimediaID, author)
 	if err != nil {
 		switch {
+		case errors.Is(err, domain.ErrGameNotFound):
+			notFound(w, codeGameNotFound, errGameNotFound)
 		case errors.Is(err, domain.ErrGameMultimediaNotFound):
 			conflict(w, codeGameMultimediaNotFound, errGameMultimediaNotFound)
+		case errors.Is(err, domain.ErrGameNotEditable):
+			conflict(w, codeGameNotEditable, errGameNotEditable)
 		default:
 			internalServerError(w)
 		}
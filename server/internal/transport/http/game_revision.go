@@ -0,0 +1,260 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/goofr-group/gaming-store/server/api"
+	"github.com/goofr-group/gaming-store/server/internal/authn"
+	"github.com/goofr-group/gaming-store/server/internal/authz"
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+	"github.com/goofr-group/gaming-store/server/internal/logging"
+)
+
+const (
+	codeGameRevisionNotFound = "game_revision_not_found"
+
+	errGameRevisionNotFound = "game revision not found"
+
+	descriptionFailedToGetGameRevisionAuthor = "http: failed to get game revision author"
+)
+
+// gameRevisionMultimedia defines the standardized value of the multimedia field in a game revision change.
+type gameRevisionMultimedia struct {
+	MultimediaID uuid.UUID `json:"multimediaId"`
+	Position     int       `json:"position"`
+}
+
+// ListGameRevisions handles the http request to list the revisions of a game.
+func (h *handler) ListGameRevisions(w http.ResponseWriter, r *http.Request, publisherID api.PublisherIdPathParam, gameID api.GameIdPathParam, params api.ListGameRevisionsParams) {
+	ctx := r.Context()
+
+	domainGameRevisionsFilter := domain.GameRevisionsPaginatedFilter{
+		PaginatedRequestBase: paginatedRequestBaseToDomain(
+			params.Limit,
+			params.Offset,
+		),
+	}
+
+	domainPaginatedGameRevisions, err := h.service.ListGameRevisions(ctx, gameID, domainGameRevisionsFilter)
+	if err != nil {
+		var domainFilterValueInvalidError *domain.FilterValueInvalidError
+
+		switch {
+		case errors.As(err, &domainFilterValueInvalidError):
+			badRequest(w, codeFilterValueInvalid, fmt.Sprintf("%s: %s", errFilterValueInvalid, domainFilterValueInvalidError.FilterName))
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	gameRevisionsPaginated := api.GameRevisionsPaginated{
+		Total:     domainPaginatedGameRevisions.Total,
+		Revisions: gameRevisionsFromDomain(domainPaginatedGameRevisions.Results),
+	}
+
+	responseBody, err := json.Marshal(gameRevisionsPaginated)
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, descriptionFailedToMarshalResponseBody, logging.Error(err))
+		internalServerError(w)
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusOK, responseBody)
+}
+
+// GetGameRevisionDiff handles the http request to compare two revisions of a game.
+func (h *handler) GetGameRevisionDiff(w http.ResponseWriter, r *http.Request, publisherID api.PublisherIdPathParam, gameID api.GameIdPathParam, params api.GetGameRevisionDiffParams) {
+	ctx := r.Context()
+
+	domainGameRevisionDiff, err := h.service.GetGameRevisionDiff(ctx, gameID, params.From, params.To)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrGameRevisionNotFound):
+			notFound(w, codeGameRevisionNotFound, errGameRevisionNotFound)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	gameRevisionDiff := gameRevisionDiffFromDomain(domainGameRevisionDiff)
+
+	responseBody, err := json.Marshal(gameRevisionDiff)
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, descriptionFailedToMarshalResponseBody, logging.Error(err))
+		internalServerError(w)
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusOK, responseBody)
+}
+
+// RollbackGameRevision handles the http request to roll back a game to one of its revisions.
+func (h *handler) RollbackGameRevision(w http.ResponseWriter, r *http.Request, publisherID api.PublisherIdPathParam, gameID api.GameIdPathParam, revisionNumber api.RevisionNumberPathParam) {
+	ctx := r.Context()
+
+	author, ok := gameRevisionAuthorFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	domainGame, err := h.service.RollbackGameRevision(ctx, gameID, revisionNumber, author)
+	if err != nil {
+		var domainFieldValueInvalidError *domain.FieldValueInvalidError
+
+		switch {
+		case errors.As(err, &domainFieldValueInvalidError):
+			badRequest(w, codeFieldValueInvalid, fmt.Sprintf("%s: %s", errFieldValueInvalid, domainFieldValueInvalidError.FieldName))
+		case errors.Is(err, domain.ErrGameRevisionNotFound):
+			notFound(w, codeGameRevisionNotFound, errGameRevisionNotFound)
+		case errors.Is(err, domain.ErrGameNotFound):
+			notFound(w, codeGameNotFound, errGameNotFound)
+		case errors.Is(err, domain.ErrGameNotEditable):
+			conflict(w, codeGameNotEditable, errGameNotEditable)
+		case errors.Is(err, domain.ErrMultimediaNotFound):
+			conflict(w, codeMultimediaNotFound, errMultimediaNotFound)
+		case errors.Is(err, domain.ErrGamePreviewMultimediaNotFound):
+			conflict(w, codeGamePreviewMultimediaNotFound, errGamePreviewMultimediaNotFound)
+		case errors.Is(err, domain.ErrGameDownloadMultimediaNotFound):
+			conflict(w, codeGameDownloadMultimediaNotFound, errGameDownloadMultimediaNotFound)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	writeGameResponse(w, r, domainGame)
+}
+
+// gameRevisionAuthorFromRequest returns the author of the game revisions recorded by the request, based on the claims
+// of its authorized subject. It writes an internal server error response if the claims are missing or invalid.
+func gameRevisionAuthorFromRequest(w http.ResponseWriter, r *http.Request) (domain.GameRevisionAuthor, bool) {
+	claims, ok := authz.ClaimsFromContext(r.Context())
+	if !ok {
+		logging.Logger.ErrorContext(r.Context(), descriptionFailedToGetGameRevisionAuthor)
+		internalServerError(w)
+
+		return domain.GameRevisionAuthor{}, false
+	}
+
+	id, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		logging.Logger.ErrorContext(r.Context(), descriptionFailedToGetGameRevisionAuthor, logging.Error(err))
+		internalServerError(w)
+
+		return domain.GameRevisionAuthor{}, false
+	}
+
+	role := domain.GameRevisionAuthorRoleUser
+	if slices.Contains(claims.Roles, authn.SubjectRolePublisher) {
+		role = domain.GameRevisionAuthorRolePublisher
+	}
+
+	return domain.GameRevisionAuthor{
+		ID:   id,
+		Role: role,
+	}, true
+}
+
+// gameRevisionFromDomain returns a standardized game revision based on the domain model.
+func gameRevisionFromDomain(gameRevision domain.GameRevision) api.GameRevision {
+	changedFields := make([]api.GameRevisionField, len(gameRevision.ChangedFields))
+	for i, field := range gameRevision.ChangedFields {
+		changedFields[i] = api.GameRevisionField(field)
+	}
+
+	return api.GameRevision{
+		Number: gameRevision.Number,
+		Author: api.GameRevisionAuthor{
+			Id:   gameRevision.Author.ID,
+			Role: api.GameRevisionAuthorRole(gameRevision.Author.Role),
+		},
+		ChangedFields: changedFields,
+		CreatedAt:     gameRevision.CreatedAt,
+	}
+}
+
+// gameRevisionsFromDomain returns standardized game revisions based on the domain models.
+func gameRevisionsFromDomain(gameRevisions []domain.GameRevision) []api.GameRevision {
+	revisions := make([]api.GameRevision, len(gameRevisions))
+	for i, gameRevision := range gameRevisions {
+		revisions[i] = gameRevisionFromDomain(gameRevision)
+	}
+
+	return revisions
+}
+
+// gameRevisionDiffFromDomain returns a standardized game revision diff based on the domain model.
+func gameRevisionDiffFromDomain(gameRevisionDiff domain.GameRevisionDiff) api.GameRevisionDiff {
+	changes := make([]api.GameRevisionChange, len(gameRevisionDiff.Fields))
+	for i, field := range gameRevisionDiff.Fields {
+		from := gameRevisionFieldValueFromDomain(gameRevisionDiff.From.Snapshot, field)
+		to := gameRevisionFieldValueFromDomain(gameRevisionDiff.To.Snapshot, field)
+
+		changes[i] = api.GameRevisionChange{
+			Field: api.GameRevisionField(field),
+			From:  &from,
+			To:    &to,
+		}
+	}
+
+	return api.GameRevisionDiff{
+		From:    gameRevisionFromDomain(gameRevisionDiff.From),
+		To:      gameRevisionFromDomain(gameRevisionDiff.To),
+		Changes: changes,
+	}
+}
+
+// gameRevisionFieldValueFromDomain returns the standardized value of the specified field in the game revision
+// snapshot, with the same format as in a game.
+func gameRevisionFieldValueFromDomain(snapshot domain.GameRevisionSnapshot, field domain.GameRevisionField) any {
+	switch field {
+	case domain.GameRevisionFieldTitle:
+		return snapshot.Title
+	case domain.GameRevisionFieldPrice:
+		return snapshot.Price
+	case domain.GameRevisionFieldReleaseDate:
+		return optionalDateFromOptionalTime(snapshot.ReleaseDate)
+	case domain.GameRevisionFieldDescription:
+		return snapshot.Description
+	case domain.GameRevisionFieldAgeRating:
+		return snapshot.AgeRating
+	case domain.GameRevisionFieldFeatures:
+		return snapshot.Features
+	case domain.GameRevisionFieldLanguages:
+		return snapshot.Languages.String()
+	case domain.GameRevisionFieldRequirements:
+		return api.GameRequirements{
+			Minimum:     string(snapshot.Requirements.Minimum),
+			Recommended: string(snapshot.Requirements.Recommended),
+		}
+	case domain.GameRevisionFieldPreviewMultimediaID:
+		return snapshot.PreviewMultimediaID
+	case domain.GameRevisionFieldDownloadMultimediaID:
+		return snapshot.DownloadMultimediaID
+	case domain.GameRevisionFieldMultimedia:
+		multimedia := make([]gameRevisionMultimedia, len(snapshot.Multimedia))
+		for i, gameMultimedia := range snapshot.Multimedia {
+			multimedia[i] = gameRevisionMultimedia{
+				MultimediaID: gameMultimedia.MultimediaID,
+				Position:     int(gameMultimedia.Position),
+			}
+		}
+
+		return multimedia
+	default:
+		return nil
+	}
+}
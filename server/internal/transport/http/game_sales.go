@@ -0,0 +1,59 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/goofr-group/gaming-store/server/api"
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+	"github.com/goofr-group/gaming-store/server/internal/logging"
+)
+
+// ListPublisherSales handles the http request to list publisher sales.
+func (h *handler) ListPublisherSales(w http.ResponseWriter, r *http.Request, publisherID api.PublisherIdPathParam) {
+	ctx := r.Context()
+
+	gamesSales, err := h.service.ListPublisherGameSales(ctx, publisherID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPublisherNotFound):
+			notFound(w, codePublisherNotFound, errPublisherNotFound)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	responseBody, err := json.Marshal(gamesSalesFromDomain(gamesSales))
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, descriptionFailedToMarshalResponseBody, logging.Error(err))
+		internalServerError(w)
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusOK, responseBody)
+}
+
+// gameSalesFromDomain returns standardized game sales based on the domain model.
+func gameSalesFromDomain(gameSales domain.GameSales) api.GameSales {
+	return api.GameSales{
+		GameId:  gameSales.GameID,
+		Title:   api.GameTitle(gameSales.Title),
+		Units:   gameSales.Units,
+		Revenue: api.GamePrice(gameSales.Revenue),
+	}
+}
+
+// gamesSalesFromDomain returns standardized sales of games based on the domain model.
+func gamesSalesFromDomain(gamesSales []domain.GameSales) []api.GameSales {
+	s := make([]api.GameSales, len(gamesSales))
+
+	for i := 0; i < len(gamesSales); i++ {
+		s[i] = gameSalesFromDomain(gamesSales[i])
+	}
+
+	return s
+}
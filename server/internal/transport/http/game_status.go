@@ -0,0 +1,186 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/goofr-group/gaming-store/server/api"
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+	"github.com/goofr-group/gaming-store/server/internal/logging"
+)
+
+const (
+	codeGameStatusTransitionInvalid = "game_status_transition_invalid"
+	codeGameHasOwners               = "game_has_owners"
+	codeGameNotEditable             = "game_not_editable"
+
+	errGameStatusTransitionInvalid = "game status transition not allowed"
+	errGameHasOwners               = "game has owners, delist it instead"
+	errGameNotEditable             = "game can only be edited as a draft or delisted"
+)
+
+// SubmitGame handles the http request to submit a game for review.
+func (h *handler) SubmitGame(w http.ResponseWriter, r *http.Request, publisherID api.PublisherIdPathParam, gameID api.GameIdPathParam) {
+	ctx := r.Context()
+
+	domainGame, err := h.service.SubmitGame(ctx, gameID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrGameNotFound):
+			notFound(w, codeGameNotFound, errGameNotFound)
+		case errors.Is(err, domain.ErrPublisherEmailNotVerified):
+			conflict(w, codePublisherEmailNotVerified, errPublisherEmailNotVerified)
+		case errors.Is(err, domain.ErrPublisherNotVerified):
+			conflict(w, codePublisherNotVerified, errPublisherNotVerified)
+		case errors.Is(err, domain.ErrGameStatusTransitionInvalid):
+			conflict(w, codeGameStatusTransitionInvalid, errGameStatusTransitionInvalid)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	writeGameResponse(w, r, domainGame)
+}
+
+// ApproveGame handles the http request to approve a game.
+func (h *handler) ApproveGame(w http.ResponseWriter, r *http.Request, publisherID api.PublisherIdPathParam, gameID api.GameIdPathParam) {
+	ctx := r.Context()
+
+	gameReviewPost, ok := readGameReviewPost(w, r)
+	if !ok {
+		return
+	}
+
+	domainGame, err := h.service.ApproveGame(ctx, gameID, (*domain.GameReviewNotes)(gameReviewPost.Notes))
+	if err != nil {
+		writeGameReviewError(w, err)
+		return
+	}
+
+	writeGameResponse(w, r, domainGame)
+}
+
+// RejectGame handles the http request to reject a game.
+func (h *handler) RejectGame(w http.ResponseWriter, r *http.Request, publisherID api.PublisherIdPathParam, gameID api.GameIdPathParam) {
+	ctx := r.Context()
+
+	gameReviewPost, ok := readGameReviewPost(w, r)
+	if !ok {
+		return
+	}
+
+	var reviewNotes domain.GameReviewNotes
+	if gameReviewPost.Notes != nil {
+		reviewNotes = domain.GameReviewNotes(*gameReviewPost.Notes)
+	}
+
+	domainGame, err := h.service.RejectGame(ctx, gameID, reviewNotes)
+	if err != nil {
+		writeGameReviewError(w, err)
+		return
+	}
+
+	writeGameResponse(w, r, domainGame)
+}
+
+// PublishGame handles the http request to publish a game.
+func (h *handler) PublishGame(w http.ResponseWriter, r *http.Request, publisherID api.PublisherIdPathParam, gameID api.GameIdPathParam) {
+	ctx := r.Context()
+
+	domainGame, err := h.service.PublishGame(ctx, gameID)
+	if err != nil {
+		writeGameReviewError(w, err)
+		return
+	}
+
+	writeGameResponse(w, r, domainGame)
+}
+
+// DelistGame handles the http request to delist a game.
+func (h *handler) DelistGame(w http.ResponseWriter, r *http.Request, publisherID api.PublisherIdPathParam, gameID api.GameIdPathParam) {
+	ctx := r.Context()
+
+	domainGame, err := h.service.DelistGame(ctx, gameID)
+	if err != nil {
+		writeGameReviewError(w, err)
+		return
+	}
+
+	writeGameResponse(w, r, domainGame)
+}
+
+// DeleteGameByID handles the http request to delete a game.
+func (h *handler) DeleteGameByID(w http.ResponseWriter, r *http.Request, publisherID api.PublisherIdPathParam, gameID api.GameIdPathParam) {
+	ctx := r.Context()
+
+	err := h.service.DeleteGame(ctx, gameID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrGameNotFound):
+			notFound(w, codeGameNotFound, errGameNotFound)
+		case errors.Is(err, domain.ErrGameHasOwners):
+			conflict(w, codeGameHasOwners, errGameHasOwners)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusNoContent, nil)
+}
+
+// readGameReviewPost reads the game review request body, and writes a bad request response if it is invalid.
+func readGameReviewPost(w http.ResponseWriter, r *http.Request) (api.GameReviewPost, bool) {
+	var gameReviewPost api.GameReviewPost
+
+	requestBody, err := io.ReadAll(r.Body)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return api.GameReviewPost{}, false
+	}
+
+	err = json.Unmarshal(requestBody, &gameReviewPost)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return api.GameReviewPost{}, false
+	}
+
+	return gameReviewPost, true
+}
+
+// writeGameReviewError writes the error response of a game status transition.
+func writeGameReviewError(w http.ResponseWriter, err error) {
+	var domainFieldValueInvalidError *domain.FieldValueInvalidError
+
+	switch {
+	case errors.As(err, &domainFieldValueInvalidError):
+		badRequest(w, codeFieldValueInvalid, fmt.Sprintf("%s: %s", errFieldValueInvalid, domainFieldValueInvalidError.FieldName))
+	case errors.Is(err, domain.ErrGameNotFound):
+		notFound(w, codeGameNotFound, errGameNotFound)
+	case errors.Is(err, domain.ErrPublisherNotVerified):
+		conflict(w, codePublisherNotVerified, errPublisherNotVerified)
+	case errors.Is(err, domain.ErrGameStatusTransitionInvalid):
+		conflict(w, codeGameStatusTransitionInvalid, errGameStatusTransitionInvalid)
+	default:
+		internalServerError(w)
+	}
+}
+
+// writeGameResponse writes the specified game as the response.
+func writeGameResponse(w http.ResponseWriter, r *http.Request, domainGame domain.Game) {
+	responseBody, err := json.Marshal(gameFromDomain(r.Context(), domainGame))
+	if err != nil {
+		logging.Logger.ErrorContext(r.Context(), descriptionFailedToMarshalResponseBody, logging.Error(err))
+		internalServerError(w)
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusOK, responseBody)
+}
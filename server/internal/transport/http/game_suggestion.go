@@ -0,0 +1,96 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/goofr-group/gaming-store/server/api"
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+	"github.com/goofr-group/gaming-store/server/internal/logging"
+)
+
+// gameSuggestionsDefaultLimit defines the default amount of game suggestions to get.
+const gameSuggestionsDefaultLimit = 10
+
+// ListGameSuggestions handles the http request to list game suggestions.
+func (h *handler) ListGameSuggestions(w http.ResponseWriter, r *http.Request, params api.ListGameSuggestionsParams) {
+	ctx := r.Context()
+
+	domainGameSuggestionsFilter := listGameSuggestionsParamsToDomain(params)
+
+	domainGameSuggestions, err := h.service.ListGameSuggestions(ctx, domainGameSuggestionsFilter)
+	if err != nil {
+		var domainFilterValueInvalidError *domain.FilterValueInvalidError
+
+		switch {
+		case errors.As(err, &domainFilterValueInvalidError):
+			badRequest(w, codeFilterValueInvalid, fmt.Sprintf("%s: %s", errFilterValueInvalid, domainFilterValueInvalidError.FilterName))
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	gameSuggestions := gameSuggestionsFromDomain(domainGameSuggestions)
+
+	responseBody, err := json.Marshal(gameSuggestions)
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, descriptionFailedToMarshalResponseBody, logging.Error(err))
+		internalServerError(w)
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusOK, responseBody)
+}
+
+// listGameSuggestionsParamsToDomain returns a domain game suggestions filter based on the standardized list game
+// suggestions parameters.
+func listGameSuggestionsParamsToDomain(params api.ListGameSuggestionsParams) domain.GameSuggestionsFilter {
+	limit := gameSuggestionsDefaultLimit
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	return domain.GameSuggestionsFilter{
+		Query: domain.GameSuggestionQuery(params.Q),
+		Limit: domain.GameSuggestionLimit(limit),
+	}
+}
+
+// gameSuggestionFromDomain returns a standardized game suggestion based on the domain model.
+func gameSuggestionFromDomain(gameSuggestion domain.GameSuggestion) api.GameSuggestion {
+	var suggestionType api.GameSuggestionType
+
+	switch gameSuggestion.Type {
+	case domain.GameSuggestionTypeTitle:
+		suggestionType = api.GameSuggestionTypeTitle
+	case domain.GameSuggestionTypeTag:
+		suggestionType = api.GameSuggestionTypeTag
+	case domain.GameSuggestionTypePublisher:
+		suggestionType = api.GameSuggestionTypePublisher
+	default:
+		suggestionType = api.GameSuggestionType(gameSuggestion.Type)
+	}
+
+	return api.GameSuggestion{
+		Type:       suggestionType,
+		Id:         gameSuggestion.ID,
+		Text:       gameSuggestion.Text,
+		Popularity: gameSuggestion.Popularity,
+	}
+}
+
+// gameSuggestionsFromDomain returns standardized game suggestions based on the domain model.
+func gameSuggestionsFromDomain(gameSuggestions []domain.GameSuggestion) []api.GameSuggestion {
+	s := make([]api.GameSuggestion, len(gameSuggestions))
+
+	for i := 0; i < len(gameSuggestions); i++ {
+		s[i] = gameSuggestionFromDomain(gameSuggestions[i])
+	}
+
+	return s
+}
@@ -0,0 +1,272 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goofr-group/gaming-store/server/api"
+	"github.com/goofr-group/gaming-store/server/internal/authn"
+	"github.com/goofr-group/gaming-store/server/internal/authz"
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+// gamesService implements the service interface with the games only.
+type gamesService struct {
+	Service
+	game   domain.Game
+	filter *domain.GamesPaginatedFilter
+}
+
+func (s gamesService) ListGames(_ context.Context, filter domain.GamesPaginatedFilter, _ []domain.Language) (domain.GamesPaginatedResponse, error) {
+	*s.filter = filter
+
+	return domain.GamesPaginatedResponse{
+		PaginatedResponse: domain.PaginatedResponse[domain.Game]{
+			Total:   1,
+			Results: []domain.Game{s.game},
+		},
+	}, nil
+}
+
+func (s gamesService) GetGameByID(context.Context, uuid.UUID, []domain.Language) (domain.Game, error) {
+	return s.game, nil
+}
+
+func TestHandler_ListGames(t *testing.T) {
+	var (
+		publisherID           = uuid.New()
+		statusPublished       = api.Published
+		statusDraft           = api.Draft
+		domainStatusPublished = domain.GameStatusPublished
+	)
+
+	tests := []struct {
+		name           string
+		claims         *authn.Claims
+		params         api.ListGamesParams
+		expectedStatus int
+		expectedFilter *domain.GameStatus
+	}{
+		{
+			name:           "should list published games by default",
+			expectedStatus: http.StatusOK,
+			expectedFilter: &domainStatusPublished,
+		},
+		{
+			name:           "should list published games when filtered by them",
+			params:         api.ListGamesParams{Status: &statusPublished},
+			expectedStatus: http.StatusOK,
+			expectedFilter: &domainStatusPublished,
+		},
+		{
+			name:           "should fail when an anonymous caller filters by another status",
+			params:         api.ListGamesParams{Status: &statusDraft},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "should fail when a publisher filters another publisher by another status",
+			claims:         &authn.Claims{Roles: []authn.SubjectRole{authn.SubjectRolePublisher}},
+			params:         api.ListGamesParams{PublisherId: &publisherID, Status: &statusDraft},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name: "should list every status of its own games to the publisher",
+			claims: func() *authn.Claims {
+				claims := authn.Claims{Roles: []authn.SubjectRole{authn.SubjectRolePublisher}}
+				claims.Subject = publisherID.String()
+				return &claims
+			}(),
+			params:         api.ListGamesParams{PublisherId: &publisherID},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "should list every status to admins",
+			claims:         &authn.Claims{Roles: []authn.SubjectRole{authn.SubjectRoleUser, authn.SubjectRoleAdmin}},
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var filter domain.GamesPaginatedFilter
+
+			h := &handler{service: gamesService{game: domain.Game{Status: domain.GameStatusPublished}, filter: &filter}}
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodGet, "/api/games", nil)
+
+			if test.claims != nil {
+				r = r.WithContext(authz.ContextWithClaims(r.Context(), *test.claims))
+			}
+
+			h.ListGames(w, r, test.params)
+
+			assert.Equal(t, test.expectedStatus, w.Code)
+
+			if test.expectedStatus == http.StatusOK {
+				assert.Equal(t, test.expectedFilter, filter.Status)
+			}
+		})
+	}
+}
+
+func TestHandler_GetGameByID(t *testing.T) {
+	var (
+		publisherID = uuid.New()
+		reviewNotes = domain.GameReviewNotes("Missing age rating")
+
+		publisherClaims = func() *authn.Claims {
+			claims := authn.Claims{Roles: []authn.SubjectRole{authn.SubjectRolePublisher}}
+			claims.Subject = publisherID.String()
+			return &claims
+		}()
+		memberClaims = &authn.Claims{
+			Roles:      []authn.SubjectRole{authn.SubjectRoleUser},
+			Publishers: map[string]string{publisherID.String(): "viewer"},
+		}
+		userClaims  = &authn.Claims{Roles: []authn.SubjectRole{authn.SubjectRoleUser}}
+		adminClaims = &authn.Claims{Roles: []authn.SubjectRole{authn.SubjectRoleUser, authn.SubjectRoleAdmin}}
+	)
+
+	game := func(status domain.GameStatus) domain.Game {
+		return domain.Game{
+			ID:          uuid.New(),
+			Publisher:   domain.Publisher{ID: publisherID},
+			Status:      status,
+			ReviewNotes: &reviewNotes,
+		}
+	}
+
+	tests := []struct {
+		name                string
+		claims              *authn.Claims
+		game                domain.Game
+		expectedStatus      int
+		expectedReviewNotes bool
+	}{
+		{
+			name:           "should return a published game without its review notes to anonymous callers",
+			game:           game(domain.GameStatusPublished),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "should return a published game without its review notes to other users",
+			claims:         userClaims,
+			game:           game(domain.GameStatusPublished),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:                "should return a draft with its review notes to its publisher",
+			claims:              publisherClaims,
+			game:                game(domain.GameStatusDraft),
+			expectedStatus:      http.StatusOK,
+			expectedReviewNotes: true,
+		},
+		{
+			name:                "should return a delisted game with its review notes to a publisher member",
+			claims:              memberClaims,
+			game:                game(domain.GameStatusDelisted),
+			expectedStatus:      http.StatusOK,
+			expectedReviewNotes: true,
+		},
+		{
+			name:                "should return a game in review with its review notes to admins",
+			claims:              adminClaims,
+			game:                game(domain.GameStatusInReview),
+			expectedStatus:      http.StatusOK,
+			expectedReviewNotes: true,
+		},
+		{
+			name:           "should not find a draft for anonymous callers",
+			game:           game(domain.GameStatusDraft),
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "should not find a delisted game for other users",
+			claims:         userClaims,
+			game:           game(domain.GameStatusDelisted),
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := &handler{service: gamesService{game: test.game}}
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodGet, "/api/publishers/"+publisherID.String()+"/games/"+test.game.ID.String(), nil)
+
+			if test.claims != nil {
+				r = r.WithContext(authz.ContextWithClaims(r.Context(), *test.claims))
+			}
+
+			h.GetGameByID(w, r, publisherID, test.game.ID, api.GetGameByIDParams{})
+
+			assert.Equal(t, test.expectedStatus, w.Code)
+
+			if test.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var game api.Game
+
+			err := json.Unmarshal(w.Body.Bytes(), &game)
+			require.NoError(t, err)
+
+			if test.expectedReviewNotes {
+				assert.Equal(t, (*string)(&reviewNotes), game.ReviewNotes)
+			} else {
+				assert.Nil(t, game.ReviewNotes)
+			}
+		})
+	}
+}
+
+func TestListGamesParamsToDomain(t *testing.T) {
+	var (
+		title         = "racing"
+		sortRelevance = api.ListGamesParamsSortRelevance
+		sortTitle     = api.ListGamesParamsSortTitle
+		orderAsc      = api.OrderQueryParamAsc
+	)
+
+	tests := []struct {
+		name          string
+		params        api.ListGamesParams
+		expectedOrder domain.PaginationOrder
+	}{
+		{
+			name:          "should rank the best matches first by default when sorting a search by relevance",
+			params:        api.ListGamesParams{Title: &title, Sort: &sortRelevance},
+			expectedOrder: domain.PaginationOrderDesc,
+		},
+		{
+			name:          "should keep the requested order when sorting a search by relevance",
+			params:        api.ListGamesParams{Title: &title, Sort: &sortRelevance, Order: (*api.ListGamesParamsOrder)(&orderAsc)},
+			expectedOrder: domain.PaginationOrderAsc,
+		},
+		{
+			name:          "should sort ascending by default when sorting by relevance without a search",
+			params:        api.ListGamesParams{Sort: &sortRelevance},
+			expectedOrder: domain.PaginationOrderAsc,
+		},
+		{
+			name:          "should sort ascending by default when sorting a search by another field",
+			params:        api.ListGamesParams{Title: &title, Sort: &sortTitle},
+			expectedOrder: domain.PaginationOrderAsc,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expectedOrder, listGamesParamsToDomain(test.params).Order)
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"golang.org/x/text/language"
+
+	"github.com/goofr-group/gaming-store/server/api"
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+	"github.com/goofr-group/gaming-store/server/internal/logging"
+)
+
+const (
+	codeGameTranslationNotFound = "game_translation_not_found"
+
+	errGameTranslationNotFound = "game translation not found"
+
+	paramLanguage = "language"
+)
+
+// ListGameTranslations handles the http request to list the translations of a game.
+func (h *handler) ListGameTranslations(w http.ResponseWriter, r *http.Request, publisherID api.PublisherIdPathParam, gameID api.GameIdPathParam) {
+	ctx := r.Context()
+
+	domainGameTranslations, err := h.service.ListGameTranslations(ctx, gameID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrGameNotFound):
+			notFound(w, codeGameNotFound, errGameNotFound)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	gameTranslations := make([]api.GameTranslation, len(domainGameTranslations))
+	for i, domainGameTranslation := range domainGameTranslations {
+		gameTranslations[i] = gameTranslationFromDomain(domainGameTranslation)
+	}
+
+	responseBody, err := json.Marshal(gameTranslations)
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, descriptionFailedToMarshalResponseBody, logging.Error(err))
+		internalServerError(w)
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusOK, responseBody)
+}
+
+// PutGameTranslation handles the http request to create or replace a translation of a game.
+func (h *handler) PutGameTranslation(w http.ResponseWriter, r *http.Request, publisherID api.PublisherIdPathParam, gameID api.GameIdPathParam, lang api.LanguagePathParam) {
+	ctx := r.Context()
+
+	domainLanguage, err := languageToDomain(lang)
+	if err != nil {
+		badRequest(w, codeParamInvalidFormat, fmt.Sprintf("%s: %s", errParamInvalidFormat, paramLanguage))
+		return
+	}
+
+	requestBody, err := io.ReadAll(r.Body)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+
+	var gameTranslationPut api.GameTranslationPut
+
+	err = json.Unmarshal(requestBody, &gameTranslationPut)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+
+	domainEditableGameTranslation := domain.EditableGameTranslation{
+		Title:       domain.GameTitle(gameTranslationPut.Title),
+		Description: domain.GameDescription(gameTranslationPut.Description),
+		Features:    domain.GameFeatures(gameTranslationPut.Features),
+	}
+
+	domainGameTranslation, err := h.service.PutGameTranslation(ctx, gameID, domainLanguage, domainEditableGameTranslation)
+	if err != nil {
+		var domainFieldValueInvalidError *domain.FieldValueInvalidError
+
+		switch {
+		case errors.As(err, &domainFieldValueInvalidError):
+			badRequest(w, codeFieldValueInvalid, fmt.Sprintf("%s: %s", errFieldValueInvalid, domainFieldValueInvalidError.FieldName))
+		case errors.Is(err, domain.ErrGameNotFound):
+			notFound(w, codeGameNotFound, errGameNotFound)
+		case errors.Is(err, domain.ErrGameNotEditable):
+			conflict(w, codeGameNotEditable, errGameNotEditable)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	gameTranslation := gameTranslationFromDomain(domainGameTranslation)
+
+	responseBody, err := json.Marshal(gameTranslation)
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, descriptionFailedToMarshalResponseBody, logging.Error(err))
+		internalServerError(w)
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusOK, responseBody)
+}
+
+// DeleteGameTranslation handles the http request to delete a translation of a game.
+func (h *handler) DeleteGameTranslation(w http.ResponseWriter, r *http.Request, publisherID api.PublisherIdPathParam, gameID api.GameIdPathParam, lang api.LanguagePathParam) {
+	ctx := r.Context()
+
+	domainLanguage, err := languageToDomain(lang)
+	if err != nil {
+		badRequest(w, codeParamInvalidFormat, fmt.Sprintf("%s: %s", errParamInvalidFormat, paramLanguage))
+		return
+	}
+
+	err = h.service.DeleteGameTranslation(ctx, gameID, domainLanguage)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrGameNotFound):
+			notFound(w, codeGameNotFound, errGameNotFound)
+		case errors.Is(err, domain.ErrGameTranslationNotFound):
+			notFound(w, codeGameTranslationNotFound, errGameTranslationNotFound)
+		case errors.Is(err, domain.ErrGameNotEditable):
+			conflict(w, codeGameNotEditable, errGameNotEditable)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusNoContent, nil)
+}
+
+// gameTranslationFromDomain returns a standardized game translation based on the domain model.
+func gameTranslationFromDomain(gameTranslation domain.GameTranslation) api.GameTranslation {
+	return api.GameTranslation{
+		Language:    gameTranslation.Language.String(),
+		Title:       string(gameTranslation.Title),
+		Description: string(gameTranslation.Description),
+		Features:    string(gameTranslation.Features),
+		CreatedAt:   gameTranslation.CreatedAt,
+		ModifiedAt:  gameTranslation.ModifiedAt,
+	}
+}
+
+// preferredLanguagesFromAcceptLanguage returns the domain languages of the Accept-Language header, ordered by
+// preference. A malformed header is ignored, as if no language was preferred.
+func preferredLanguagesFromAcceptLanguage(acceptLanguage *string) []domain.Language {
+	if acceptLanguage == nil {
+		return nil
+	}
+
+	tags, _, err := language.ParseAcceptLanguage(*acceptLanguage)
+	if err != nil {
+		return nil
+	}
+
+	languages := make([]domain.Language, len(tags))
+	for i, tag := range tags {
+		languages[i] = domain.Language{Tag: tag}
+	}
+
+	return languages
+}
@@ -20,6 +20,7 @@ const (
 	baseURLWebApp = "/"
 	baseURLApi    = "/api"
 	baseURLDocs   = "/api/docs/"
+	urlJWKS       = "/.well-known/jwks.json"
 )
 
 // Directories to serve.
@@ -31,9 +32,11 @@ const (
 
 // Request header const.
 const (
-	requestHeaderKeyAccept       = "Accept"
-	requestHeaderKeyContentType  = "Content-Type"
-	requestHeaderValueAcceptHTML = "text/html"
+	requestHeaderKeyAccept         = "Accept"
+	requestHeaderKeyAcceptLanguage = "Accept-Language"
+	requestHeaderKeyContentType    = "Content-Type"
+	requestHeaderKeyForwardedFor   = "X-Forwarded-For"
+	requestHeaderValueAcceptHTML   = "text/html"
 )
 
 // AuthorizationService defines the authorization service interface.
@@ -46,7 +49,31 @@ type Service interface {
 	CreateUser(ctx context.Context, editableUser domain.EditableUserWithPassword) (domain.User, error)
 	GetUserByID(ctx context.Context, id uuid.UUID) (domain.User, error)
 	PatchUser(ctx context.Context, id uuid.UUID, editableUser domain.EditableUserPatch) (domain.User, error)
-	SignInUser(ctx context.Context, username domain.Username, email domain.Email, password domain.Password) (string, error)
+	ChangeUserPassword(ctx context.Context, id, sessionID uuid.UUID, currentPassword, newPassword domain.Password, client domain.SessionClient) error
+	SignInUser(ctx context.Context, username domain.Username, email domain.Email, password domain.Password, client domain.SessionClient) (domain.SignIn, error)
+	RefreshUserTokens(ctx context.Context, refreshToken string, client domain.SessionClient) (domain.Tokens, error)
+	SignOutUser(ctx context.Context, refreshToken string) error
+	RequestUserPasswordReset(ctx context.Context, email domain.Email) error
+	ResetUserPassword(ctx context.Context, token string, password domain.Password) error
+	ResendUserEmailVerification(ctx context.Context, userID uuid.UUID) error
+	VerifyUserEmail(ctx context.Context, token string) error
+	CreateUserTOTP(ctx context.Context, userID uuid.UUID) (domain.TOTPEnrollment, error)
+	EnableUserTOTP(ctx context.Context, userID uuid.UUID, code string) ([]string, error)
+	SignInUserTOTP(ctx context.Context, challengeToken, code string, client domain.SessionClient) (domain.Tokens, error)
+	BeginUserWebAuthnRegistration(ctx context.Context, userID uuid.UUID) (domain.WebAuthnOptions, error)
+	FinishUserWebAuthnRegistration(ctx context.Context, userID, ceremonyID uuid.UUID, response []byte) error
+	BeginUserWebAuthnSignIn(ctx context.Context, client domain.SessionClient) (domain.WebAuthnOptions, error)
+	FinishUserWebAuthnSignIn(ctx context.Context, ceremonyID uuid.UUID, response []byte, client domain.SessionClient) (domain.Tokens, error)
+	BeginUserOIDCSignIn(ctx context.Context, provider string) (domain.OIDCAuthorizationStart, error)
+	FinishUserOIDCSignIn(ctx context.Context, provider, state, binding, code string, client domain.SessionClient) (domain.OIDCSignIn, error)
+	SignUpUserOIDC(ctx context.Context, identityToken string, editableUser domain.EditableUser, client domain.SessionClient) (domain.Tokens, error)
+	LinkUserIdentity(ctx context.Context, userID uuid.UUID, identityToken string) error
+
+	ListJSONWebKeys(ctx context.Context) []domain.JSONWebKey
+
+	ListUserSessions(ctx context.Context, userID uuid.UUID) ([]domain.Session, error)
+	DeleteUserSession(ctx context.Context, userID, sessionID uuid.UUID) error
+	DeleteUserSessions(ctx context.Context, userID uuid.UUID) error
 
 	CreateUserCartGame(ctx context.Context, userID, gameID uuid.UUID) error
 	ListUserCart(ctx context.Context, userID uuid.UUID, filter domain.UserCartPaginatedFilter) (domain.PaginatedResponse[domain.Game], error)
@@ -58,19 +85,72 @@ type Service interface {
 	CreatePublisher(ctx context.Context, editablePublisher domain.EditablePublisherWithPassword) (domain.Publisher, error)
 	GetPublisherByID(ctx context.Context, id uuid.UUID) (domain.Publisher, error)
 	PatchPublisher(ctx context.Context, id uuid.UUID, editablePublisher domain.EditablePublisherPatch) (domain.Publisher, error)
-	SignInPublisher(ctx context.Context, email domain.Email, password domain.Password) (string, error)
+	ChangePublisherPassword(ctx context.Context, id, sessionID uuid.UUID, currentPassword, newPassword domain.Password, client domain.SessionClient) error
+	SignInPublisher(ctx context.Context, email domain.Email, password domain.Password, client domain.SessionClient) (domain.SignIn, error)
+	RefreshPublisherTokens(ctx context.Context, refreshToken string, client domain.SessionClient) (domain.Tokens, error)
+	SignOutPublisher(ctx context.Context, refreshToken string) error
+	RequestPublisherPasswordReset(ctx context.Context, email domain.Email) error
+	ResetPublisherPassword(ctx context.Context, token string, password domain.Password) error
+	ResendPublisherEmailVerification(ctx context.Context, publisherID uuid.UUID) error
+	VerifyPublisherEmail(ctx context.Context, token string) error
+	CreatePublisherTOTP(ctx context.Context, publisherID uuid.UUID) (domain.TOTPEnrollment, error)
+	EnablePublisherTOTP(ctx context.Context, publisherID uuid.UUID, code string) ([]string, error)
+	SignInPublisherTOTP(ctx context.Context, challengeToken, code string, client domain.SessionClient) (domain.Tokens, error)
+	BeginPublisherWebAuthnRegistration(ctx context.Context, publisherID uuid.UUID) (domain.WebAuthnOptions, error)
+	FinishPublisherWebAuthnRegistration(ctx context.Context, publisherID, ceremonyID uuid.UUID, response []byte) error
+	BeginPublisherWebAuthnSignIn(ctx context.Context, client domain.SessionClient) (domain.WebAuthnOptions, error)
+	FinishPublisherWebAuthnSignIn(ctx context.Context, ceremonyID uuid.UUID, response []byte, client domain.SessionClient) (domain.Tokens, error)
+
+	ListPublisherMembers(ctx context.Context, publisherID uuid.UUID) ([]domain.PublisherMember, error)
+	InvitePublisherMember(ctx context.Context, publisherID uuid.UUID, email domain.Email, role domain.PublisherMemberRole) error
+	AcceptPublisherInvitation(ctx context.Context, userID uuid.UUID, token string) (domain.PublisherMember, error)
+	UpdatePublisherMemberRole(ctx context.Context, publisherID, userID uuid.UUID, role domain.PublisherMemberRole) (domain.PublisherMember, error)
+	DeletePublisherMember(ctx context.Context, publisherID, userID uuid.UUID) error
+	ListUserPublisherMembers(ctx context.Context, userID uuid.UUID) ([]domain.PublisherMember, error)
 
-	CreateGame(ctx context.Context, publisherID uuid.UUID, editableGame domain.EditableGame) (domain.Game, error)
-	ListGames(ctx context.Context, filter domain.GamesPaginatedFilter) (domain.PaginatedResponse[domain.Game], error)
+	ListPublisherGameSales(ctx context.Context, publisherID uuid.UUID) ([]domain.GameSales, error)
+
+	UploadPublisherDocument(ctx context.Context, publisherID uuid.UUID, file []byte, mediaType string) (domain.PublisherDocument, error)
+	ListPublisherDocuments(ctx context.Context, publisherID uuid.UUID) ([]domain.PublisherDocument, error)
+	GetPublisherDocumentFile(ctx context.Context, publisherID, documentID uuid.UUID) (domain.PublisherDocument, []byte, error)
+	ListPendingPublishers(ctx context.Context) ([]domain.Publisher, error)
+	ReviewPublisher(ctx context.Context, publisherID uuid.UUID, status domain.PublisherVerificationStatus, reason *domain.PublisherVerificationReason) (domain.Publisher, error)
+
+	CreateGame(ctx context.Context, publisherID uuid.UUID, editableGame domain.EditableGame, author domain.GameRevisionAuthor) (domain.Game, error)
+	ListGames(ctx context.Context, filter domain.GamesPaginatedFilter, preferredLanguages []domain.Language) (domain.GamesPaginatedResponse, error)
 	ListGamesRecommended(ctx context.Context, filter domain.GamesRecommendedPaginatedFilter) (domain.PaginatedResponse[domain.Game], error)
-	GetGameByID(ctx context.Context, id uuid.UUID) (domain.Game, error)
-	PatchGame(ctx context.Context, id uuid.UUID, editableGame domain.EditableGamePatch) (domain.Game, error)
+	ListGameSuggestions(ctx context.Context, filter domain.GameSuggestionsFilter) ([]domain.GameSuggestion, error)
+	ListGamesSimilar(ctx context.Context, gameID uuid.UUID, filter domain.GamesSimilarPaginatedFilter) (domain.PaginatedResponse[domain.Game], error)
+	ListGamesChart(ctx context.Context, filter domain.GamesChartPaginatedFilter) (domain.PaginatedResponse[domain.Game], error)
+	GetGameByID(ctx context.Context, id uuid.UUID, preferredLanguages []domain.Language) (domain.Game, error)
+	PatchGame(ctx context.Context, id uuid.UUID, editableGame domain.EditableGamePatch, author domain.GameRevisionAuthor) (domain.Game, error)
+	SubmitGame(ctx context.Context, id uuid.UUID) (domain.Game, error)
+	ApproveGame(ctx context.Context, id uuid.UUID, reviewNotes *domain.GameReviewNotes) (domain.Game, error)
+	RejectGame(ctx context.Context, id uuid.UUID, reviewNotes domain.GameReviewNotes) (domain.Game, error)
+	PublishGame(ctx context.Context, id uuid.UUID) (domain.Game, error)
+	DelistGame(ctx context.Context, id uuid.UUID) (domain.Game, error)
+	DeleteGame(ctx context.Context, id uuid.UUID) error
+
+	ListGameRevisions(ctx context.Context, gameID uuid.UUID, filter domain.GameRevisionsPaginatedFilter) (domain.PaginatedResponse[domain.GameRevision], error)
+	GetGameRevisionDiff(ctx context.Context, gameID uuid.UUID, fromNumber, toNumber int) (domain.GameRevisionDiff, error)
+	RollbackGameRevision(ctx context.Context, gameID uuid.UUID, number int, author domain.GameRevisionAuthor) (domain.Game, error)
+
+	ImportGameCatalog(ctx context.Context, publisherID uuid.UUID, catalog domain.GameCatalog, dryRun bool, author domain.GameRevisionAuthor) (domain.GameCatalogImportReport, error)
+	ExportGameCatalog(ctx context.Context, publisherID uuid.UUID) (domain.GameCatalog, error)
+
+	ListGameTranslations(ctx context.Context, gameID uuid.UUID) ([]domain.GameTranslation, error)
+	PutGameTranslation(ctx context.Context, gameID uuid.UUID, lang domain.Language, editableGameTranslation domain.EditableGameTranslation) (domain.GameTranslation, error)
+	DeleteGameTranslation(ctx context.Context, gameID uuid.UUID, lang domain.Language) error
+
+	CreateScheduledJob(ctx context.Context, gameID uuid.UUID, editableScheduledJob domain.EditableScheduledJob) (domain.ScheduledJob, error)
+	ListScheduledJobs(ctx context.Context, gameID uuid.UUID) ([]domain.ScheduledJob, error)
+	CancelScheduledJob(ctx context.Context, gameID, id uuid.UUID) error
 
 	CreateGameTag(ctx context.Context, gameID, tagID uuid.UUID) error
 	DeleteGameTag(ctx context.Context, gameID, tagID uuid.UUID) error
 
-	CreateGameMultimedia(ctx context.Context, gameID, multimediaID uuid.UUID, editableGameMultimedia domain.EditableGameMultimedia) error
-	DeleteGameMultimedia(ctx context.Context, gameID, multimediaID uuid.UUID) error
+	CreateGameMultimedia(ctx context.Context, gameID, multimediaID uuid.UUID, editableGameMultimedia domain.EditableGameMultimedia, author domain.GameRevisionAuthor) error
+	DeleteGameMultimedia(ctx context.Context, gameID, multimediaID uuid.UUID, author domain.GameRevisionAuthor) error
 
 	ListTags(ctx context.Context, filter domain.TagsPaginatedFilter) (domain.PaginatedResponse[domain.Tag], error)
 
@@ -79,16 +159,20 @@ type Service interface {
 
 // handler defines the http handler structure.
 type handler struct {
-	authzService AuthorizationService
-	service      Service
-	handler      http.Handler
+	authzService   AuthorizationService
+	service        Service
+	trustedProxies int
+	handler        http.Handler
 }
 
 // New returns a new http handler.
-func New(authzService AuthorizationService, service Service) *handler {
+// The trusted proxies are the number of reverse proxies in front of the server that append the client address to the
+// X-Forwarded-For header.
+func New(authzService AuthorizationService, service Service, trustedProxies int) *handler {
 	h := &handler{
-		authzService: authzService,
-		service:      service,
+		authzService:   authzService,
+		service:        service,
+		trustedProxies: trustedProxies,
 	}
 
 	router := http.NewServeMux()
@@ -116,6 +200,8 @@ func New(authzService AuthorizationService, service Service) *handler {
 				unauthorized(w, codeAuthorizationHeaderInvalid, errAuthorizationHeaderInvalid)
 			case errors.Is(err, authz.ErrJWTInvalid):
 				unauthorized(w, codeJWTInvalid, errJWTInvalid)
+			case errors.Is(err, authz.ErrSessionInvalid):
+				unauthorized(w, codeSessionInvalid, errSessionInvalid)
 			default:
 				internalServerError(w)
 			}
@@ -126,10 +212,15 @@ func New(authzService AuthorizationService, service Service) *handler {
 				forbidden(w, codeRolesInvalid, errRolesInvalid)
 			case errors.Is(err, authz.ErrAuthorizationInvalid):
 				forbidden(w, codeAuthorizationInvalid, errAuthorizationInvalid)
+			case errors.Is(err, authz.ErrOwnershipInvalid):
+				forbidden(w, codeOwnershipInvalid, errOwnershipInvalid)
 			default:
 				internalServerError(w)
 			}
 		},
+		InternalServerErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			internalServerError(w)
+		},
 	})
 
 	h.handler = api.HandlerWithOptions(h, api.StdHTTPServerOptions{
@@ -152,6 +243,10 @@ func New(authzService AuthorizationService, service Service) *handler {
 	swaggerFS := http.FileServer(http.Dir(dirSwaggerUI))
 	router.Handle(baseURLDocs, http.StripPrefix(baseURLDocs, swaggerFS))
 
+	// Handle JSON Web Key Set. The specification serves it from the root of the server, where other services expect it,
+	// besides the API base path of the generated handler.
+	router.HandleFunc(http.MethodGet+" "+urlJWKS, h.GetJSONWebKeySet)
+
 	return h
 }
 
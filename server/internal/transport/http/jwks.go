@@ -0,0 +1,63 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/goofr-group/gaming-store/server/api"
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+	"github.com/goofr-group/gaming-store/server/internal/logging"
+)
+
+// GetJSONWebKeySet handles the http request to get the public keys used to verify the tokens issued by the server.
+func (h *handler) GetJSONWebKeySet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	jsonWebKeys := h.service.ListJSONWebKeys(ctx)
+
+	responseBody, err := json.Marshal(jsonWebKeySetFromDomain(jsonWebKeys))
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, descriptionFailedToMarshalResponseBody, logging.Error(err))
+		internalServerError(w)
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusOK, responseBody)
+}
+
+// jsonWebKeySetFromDomain returns a standardized JSON Web Key Set based on the domain model.
+func jsonWebKeySetFromDomain(jsonWebKeys []domain.JSONWebKey) api.JSONWebKeySet {
+	keys := make([]api.JSONWebKey, len(jsonWebKeys))
+	for i, key := range jsonWebKeys {
+		keys[i] = jsonWebKeyFromDomain(key)
+	}
+
+	return api.JSONWebKeySet{
+		Keys: keys,
+	}
+}
+
+// jsonWebKeyFromDomain returns a standardized JSON Web Key based on the domain model. The parameters of other key types
+// are omitted.
+func jsonWebKeyFromDomain(key domain.JSONWebKey) api.JSONWebKey {
+	return api.JSONWebKey{
+		Kty: key.KeyType,
+		Kid: key.KeyID,
+		Use: key.Use,
+		Alg: key.Algorithm,
+		N:   optionalStringFromString(key.N),
+		E:   optionalStringFromString(key.E),
+		Crv: optionalStringFromString(key.Curve),
+		X:   optionalStringFromString(key.X),
+	}
+}
+
+// optionalStringFromString returns a pointer to the string, or nil if it is empty.
+func optionalStringFromString(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
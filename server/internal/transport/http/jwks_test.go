@@ -0,0 +1,72 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goofr-group/gaming-store/server/api"
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+// jsonWebKeysService implements the service interface with the JSON Web Keys only.
+type jsonWebKeysService struct {
+	Service
+	jsonWebKeys []domain.JSONWebKey
+}
+
+func (s jsonWebKeysService) ListJSONWebKeys(context.Context) []domain.JSONWebKey {
+	return s.jsonWebKeys
+}
+
+func TestHandler_GetJSONWebKeySet(t *testing.T) {
+	var (
+		curve = "Ed25519"
+		x     = "11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"
+		n     = "0vx7agoebGcQSuuPiLJXZptN9nndrQmbXEps2aiAFbWhM78LhWx4cbbfAAtVT86zwu1RK7aPFFxuhDR1L6tSoc_BJECPebWKRXjBZCiFV4n3oknjhMstn64tZ_2W-5JsGY4Hc5n9yBXArwl93lqt7_RN5w6Cf0h4QyQ5v-65YGjQR0_FDW2QvzqY368QQMicAtaSqzs8KJZgnYb9c7d0zgdAZHzu6qMQvRL5hajrn1n91CbOpbISD08qNLyrdkt-bFTWhAI4vMQFh6WeZu0fM4lFd2NcRwr3XPksINHaQ-G_xBniIqbw0Ls1jF44-csFCur-kEgU8awapJzKnqDKgw"
+		e     = "AQAB"
+
+		jsonWebKeys = []domain.JSONWebKey{
+			{KeyType: "OKP", KeyID: "current", Use: "sig", Algorithm: "EdDSA", Curve: curve, X: x},
+			{KeyType: "RSA", KeyID: "previous", Use: "sig", Algorithm: "RS256", N: n, E: e},
+		}
+
+		expectedJSONWebKeySet = api.JSONWebKeySet{
+			Keys: []api.JSONWebKey{
+				{Kty: "OKP", Kid: "current", Use: "sig", Alg: "EdDSA", Crv: &curve, X: &x},
+				{Kty: "RSA", Kid: "previous", Use: "sig", Alg: "RS256", N: &n, E: &e},
+			},
+		}
+	)
+
+	h := &handler{service: jsonWebKeysService{jsonWebKeys: jsonWebKeys}}
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, urlJWKS, nil)
+
+	h.GetJSONWebKeySet(w, r)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/json; charset=utf-8", w.Header().Get("Content-Type"))
+
+	var jsonWebKeySet api.JSONWebKeySet
+
+	err := json.Unmarshal(w.Body.Bytes(), &jsonWebKeySet)
+	require.NoError(t, err)
+	assert.Equal(t, expectedJSONWebKeySet, jsonWebKeySet)
+
+	// Parameters of other key types are omitted.
+	var rawJSONWebKeySet struct {
+		Keys []map[string]any `json:"keys"`
+	}
+
+	err = json.Unmarshal(w.Body.Bytes(), &rawJSONWebKeySet)
+	require.NoError(t, err)
+	assert.NotContains(t, rawJSONWebKeySet.Keys[0], "n")
+	assert.NotContains(t, rawJSONWebKeySet.Keys[1], "crv")
+}
@@ -82,10 +82,11 @@ func languagesToDomain(s []string) ([]domain.Language, error) {
 	return languages, nil
 }
 
-// jwtFromJWTToken returns a standardized JWT based on the JWT token.
-func jwtFromJWTToken(token string) api.JWT {
+// jwtFromDomain returns a standardized JWT based on the domain tokens.
+func jwtFromDomain(tokens domain.Tokens) api.JWT {
 	return api.JWT{
-		Token: token,
+		Token:        tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
 	}
 }
 
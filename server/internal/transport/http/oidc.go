@@ -0,0 +1,294 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/goofr-group/gaming-store/server/api"
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+	"github.com/goofr-group/gaming-store/server/internal/logging"
+)
+
+const (
+	codeOIDCProviderNotFound      = "oidc_provider_not_found"
+	codeOIDCAuthorizationInvalid  = "oidc_authorization_invalid"
+	codeOIDCIdentityTokenInvalid  = "oidc_identity_token_invalid"
+	codeUserIdentityAlreadyExists = "user_identity_already_exists"
+
+	errOIDCProviderNotFound      = "oidc provider not found"
+	errOIDCAuthorizationInvalid  = "invalid oidc authorization"
+	errOIDCIdentityTokenInvalid  = "invalid oidc identity token"
+	errUserIdentityAlreadyExists = "user identity already exists"
+)
+
+const (
+	// oidcBindingCookieName defines the name of the cookie that binds an oidc authorization to the browser that
+	// started it.
+	oidcBindingCookieName = "oidc_binding"
+	// oidcBindingCookiePath restricts the cookie to the oidc sign in endpoints.
+	oidcBindingCookiePath = baseURLApi + "/users/oidc"
+)
+
+// BeginUserOIDCSignIn handles the http request to begin a user sign in with an external provider.
+func (h *handler) BeginUserOIDCSignIn(w http.ResponseWriter, r *http.Request, provider api.OIDCProviderPathParam) {
+	ctx := r.Context()
+
+	authorizationStart, err := h.service.BeginUserOIDCSignIn(ctx, provider)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrOIDCProviderNotFound):
+			notFound(w, codeOIDCProviderNotFound, errOIDCProviderNotFound)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	responseBody, err := json.Marshal(api.OIDCAuthorization{
+		AuthorizationUrl: authorizationStart.AuthorizationURL,
+	})
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, descriptionFailedToMarshalResponseBody, logging.Error(err))
+		internalServerError(w)
+
+		return
+	}
+
+	setOIDCBindingCookie(w, authorizationStart.Binding, int(domain.OIDCAuthorizationExpirationTime.Seconds()))
+
+	writeResponseJSON(w, http.StatusOK, responseBody)
+}
+
+// FinishUserOIDCSignIn handles the http request to finish a user sign in with an external provider.
+func (h *handler) FinishUserOIDCSignIn(w http.ResponseWriter, r *http.Request, provider api.OIDCProviderPathParam) {
+	ctx := r.Context()
+
+	requestBody, err := io.ReadAll(r.Body)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+
+	var oidcCallback api.OIDCCallback
+
+	err = json.Unmarshal(requestBody, &oidcCallback)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+
+	// The authorization can only be completed once, so the binding is cleared whatever the outcome.
+	setOIDCBindingCookie(w, "", -1)
+
+	bindingCookie, err := r.Cookie(oidcBindingCookieName)
+	if err != nil {
+		badRequest(w, codeOIDCAuthorizationInvalid, errOIDCAuthorizationInvalid)
+		return
+	}
+
+	result, err := h.service.FinishUserOIDCSignIn(ctx, provider, oidcCallback.State, bindingCookie.Value, oidcCallback.Code, h.sessionClientFromRequest(r))
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrOIDCAuthorizationInvalid),
+			errors.Is(err, domain.ErrOIDCProviderNotFound):
+			badRequest(w, codeOIDCAuthorizationInvalid, errOIDCAuthorizationInvalid)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	if result.ChallengeToken != "" {
+		writeTwoFactorChallenge(ctx, w, result.ChallengeToken)
+		return
+	}
+
+	statusCode := http.StatusOK
+
+	var response any = jwtFromDomain(result.Tokens)
+
+	// The identity is not linked to any user yet.
+	if result.IdentityToken != "" {
+		statusCode = http.StatusNotFound
+		response = oidcIdentityFromDomain(result.Identity, result.IdentityToken)
+	}
+
+	responseBody, err := json.Marshal(response)
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, descriptionFailedToMarshalResponseBody, logging.Error(err))
+		internalServerError(w)
+
+		return
+	}
+
+	writeResponseJSON(w, statusCode, responseBody)
+}
+
+// SignUpUserOIDC handles the http request to sign up a user with an external identity.
+func (h *handler) SignUpUserOIDC(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	requestBody, err := io.ReadAll(r.Body)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+
+	var userOIDCPost api.UserOIDCPost
+
+	err = json.Unmarshal(requestBody, &userOIDCPost)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+
+	domainEditableUser, err := userOIDCPostToDomain(userOIDCPost)
+	if err != nil {
+		var domainFieldValueInvalidError *domain.FieldValueInvalidError
+
+		switch {
+		case errors.As(err, &domainFieldValueInvalidError):
+			badRequest(w, codeFieldValueInvalid, fmt.Sprintf("%s: %s", errFieldValueInvalid, domainFieldValueInvalidError.FieldName))
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	tokens, err := h.service.SignUpUserOIDC(ctx, userOIDCPost.IdentityToken, domainEditableUser, h.sessionClientFromRequest(r))
+	if err != nil {
+		var domainFieldValueInvalidError *domain.FieldValueInvalidError
+
+		switch {
+		case errors.As(err, &domainFieldValueInvalidError):
+			badRequest(w, codeFieldValueInvalid, fmt.Sprintf("%s: %s", errFieldValueInvalid, domainFieldValueInvalidError.FieldName))
+		case errors.Is(err, domain.ErrOIDCIdentityTokenInvalid):
+			unauthorized(w, codeOIDCIdentityTokenInvalid, errOIDCIdentityTokenInvalid)
+		case errors.Is(err, domain.ErrUserUsernameAlreadyExists):
+			conflict(w, codeUserUsernameAlreadyExists, errUserUsernameAlreadyExists)
+		case errors.Is(err, domain.ErrUserEmailAlreadyExists):
+			conflict(w, codeUserEmailAlreadyExists, errUserEmailAlreadyExists)
+		case errors.Is(err, domain.ErrUserVatinAlreadyExists):
+			conflict(w, codeUserVatinAlreadyExists, errUserVatinAlreadyExists)
+		case errors.Is(err, domain.ErrUserIdentityAlreadyExists):
+			conflict(w, codeUserIdentityAlreadyExists, errUserIdentityAlreadyExists)
+		case errors.Is(err, domain.ErrMultimediaNotFound):
+			conflict(w, codeMultimediaNotFound, errMultimediaNotFound)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	responseBody, err := json.Marshal(jwtFromDomain(tokens))
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, descriptionFailedToMarshalResponseBody, logging.Error(err))
+		internalServerError(w)
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusCreated, responseBody)
+}
+
+// LinkUserIdentity handles the http request to link an external identity to a user.
+func (h *handler) LinkUserIdentity(w http.ResponseWriter, r *http.Request, userID api.UserIdPathParam) {
+	ctx := r.Context()
+
+	requestBody, err := io.ReadAll(r.Body)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+
+	var oidcIdentityToken api.OIDCIdentityToken
+
+	err = json.Unmarshal(requestBody, &oidcIdentityToken)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+
+	err = h.service.LinkUserIdentity(ctx, userID, oidcIdentityToken.IdentityToken)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrOIDCIdentityTokenInvalid):
+			badRequest(w, codeOIDCIdentityTokenInvalid, errOIDCIdentityTokenInvalid)
+		case errors.Is(err, domain.ErrUserNotFound):
+			notFound(w, codeUserNotFound, errUserNotFound)
+		case errors.Is(err, domain.ErrUserIdentityAlreadyExists):
+			conflict(w, codeUserIdentityAlreadyExists, errUserIdentityAlreadyExists)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusNoContent, nil)
+}
+
+// setOIDCBindingCookie sets the cookie that binds an oidc authorization to the browser, with the specified max age in
+// seconds. A negative max age deletes the cookie. It is sent back from the application after the provider redirects to
+// it, so a lax same site policy is enough.
+func setOIDCBindingCookie(w http.ResponseWriter, binding string, maxAge int) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcBindingCookieName,
+		Value:    binding,
+		Path:     oidcBindingCookiePath,
+		MaxAge:   maxAge,
+		Secure:   true,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// userOIDCPostToDomain returns a domain editable user based on the standardized user oidc post.
+func userOIDCPostToDomain(userOIDCPost api.UserOIDCPost) (domain.EditableUser, error) {
+	country, err := countryToDomain(userOIDCPost.Country)
+	if err != nil {
+		return domain.EditableUser{}, err
+	}
+
+	var email domain.Email
+	if userOIDCPost.Email != nil {
+		email = domain.Email(*userOIDCPost.Email)
+	}
+
+	return domain.EditableUser{
+		Username:            domain.Username(userOIDCPost.Username),
+		Email:               email,
+		DisplayName:         domain.Name(userOIDCPost.DisplayName),
+		DateOfBirth:         domain.UserDateOfBirth(userOIDCPost.DateOfBirth.Time),
+		Address:             domain.Address(userOIDCPost.Address),
+		Country:             country,
+		Vatin:               domain.Vatin(userOIDCPost.Vatin),
+		PictureMultimediaID: userOIDCPost.PictureMultimediaId,
+	}, nil
+}
+
+// oidcIdentityFromDomain returns a standardized oidc identity based on the domain model and its identity token.
+func oidcIdentityFromDomain(identity domain.OIDCIdentity, identityToken string) api.OIDCIdentity {
+	oidcIdentity := api.OIDCIdentity{
+		IdentityToken: identityToken,
+		Provider:      identity.Provider,
+	}
+
+	if len(identity.Email) != 0 {
+		email := string(identity.Email)
+		oidcIdentity.Email = &email
+	}
+
+	if len(identity.Name) != 0 {
+		oidcIdentity.Name = &identity.Name
+	}
+
+	return oidcIdentity
+}
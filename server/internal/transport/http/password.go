@@ -0,0 +1,91 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/goofr-group/gaming-store/server/api"
+	"github.com/goofr-group/gaming-store/server/internal/authz"
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+	"github.com/goofr-group/gaming-store/server/internal/logging"
+)
+
+const (
+	descriptionFailedToGetSession = "http: failed to get session"
+)
+
+// ChangeUserPassword handles the http request to change a user password.
+func (h *handler) ChangeUserPassword(w http.ResponseWriter, r *http.Request, userID api.UserIdPathParam) {
+	h.changePassword(w, r, userID, h.service.ChangeUserPassword)
+}
+
+// ChangePublisherPassword handles the http request to change a publisher password.
+func (h *handler) ChangePublisherPassword(w http.ResponseWriter, r *http.Request, publisherID api.PublisherIdPathParam) {
+	h.changePassword(w, r, publisherID, h.service.ChangePublisherPassword)
+}
+
+// changePassword handles the http request to change a password with the specified service method.
+func (h *handler) changePassword(w http.ResponseWriter, r *http.Request, id uuid.UUID, changePassword func(ctx context.Context, id, sessionID uuid.UUID, currentPassword, newPassword domain.Password, client domain.SessionClient) error) {
+	ctx := r.Context()
+
+	// The session of the request is kept, while every other session is revoked.
+	claims, ok := authz.ClaimsFromContext(ctx)
+	if !ok {
+		logging.Logger.ErrorContext(ctx, descriptionFailedToGetSession)
+		internalServerError(w)
+
+		return
+	}
+
+	sessionID, err := uuid.Parse(claims.SessionID)
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, descriptionFailedToGetSession, logging.Error(err))
+		internalServerError(w)
+
+		return
+	}
+
+	requestBody, err := io.ReadAll(r.Body)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+
+	var passwordChange api.PasswordChange
+
+	err = json.Unmarshal(requestBody, &passwordChange)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+
+	err = changePassword(ctx, id, sessionID, domain.Password(passwordChange.CurrentPassword), domain.Password(passwordChange.NewPassword), h.sessionClientFromRequest(r))
+	if err != nil {
+		var domainFieldValueInvalidError *domain.FieldValueInvalidError
+
+		switch {
+		case errors.As(err, &domainFieldValueInvalidError):
+			badRequest(w, codeFieldValueInvalid, fmt.Sprintf("%s: %s", errFieldValueInvalid, domainFieldValueInvalidError.FieldName))
+		case errors.Is(err, domain.ErrPasswordCompromised):
+			badRequest(w, codePasswordCompromised, errPasswordCompromised)
+		case errors.Is(err, domain.ErrCredentialsIncorrect):
+			badRequest(w, codeCredentialsIncorrect, errCredentialsIncorrect)
+		case errors.Is(err, domain.ErrUserNotFound):
+			notFound(w, codeUserNotFound, errUserNotFound)
+		case errors.Is(err, domain.ErrPublisherNotFound):
+			notFound(w, codePublisherNotFound, errPublisherNotFound)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusNoContent, nil)
+}
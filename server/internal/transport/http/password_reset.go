@@ -0,0 +1,113 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/goofr-group/gaming-store/server/api"
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+const (
+	codePasswordResetTokenInvalid = "password_reset_token_invalid"
+
+	errPasswordResetTokenInvalid = "invalid password reset token"
+)
+
+// RequestUserPasswordReset handles the http request to request a user password reset.
+func (h *handler) RequestUserPasswordReset(w http.ResponseWriter, r *http.Request) {
+	h.requestPasswordReset(w, r, h.service.RequestUserPasswordReset)
+}
+
+// ConfirmUserPasswordReset handles the http request to confirm a user password reset.
+func (h *handler) ConfirmUserPasswordReset(w http.ResponseWriter, r *http.Request) {
+	h.confirmPasswordReset(w, r, h.service.ResetUserPassword)
+}
+
+// RequestPublisherPasswordReset handles the http request to request a publisher password reset.
+func (h *handler) RequestPublisherPasswordReset(w http.ResponseWriter, r *http.Request) {
+	h.requestPasswordReset(w, r, h.service.RequestPublisherPasswordReset)
+}
+
+// ConfirmPublisherPasswordReset handles the http request to confirm a publisher password reset.
+func (h *handler) ConfirmPublisherPasswordReset(w http.ResponseWriter, r *http.Request) {
+	h.confirmPasswordReset(w, r, h.service.ResetPublisherPassword)
+}
+
+// requestPasswordReset handles the http request to request a password reset with the specified service method.
+func (h *handler) requestPasswordReset(w http.ResponseWriter, r *http.Request, requestPasswordReset func(ctx context.Context, email domain.Email) error) {
+	ctx := r.Context()
+
+	requestBody, err := io.ReadAll(r.Body)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+
+	var passwordResetRequest api.PasswordResetRequest
+
+	err = json.Unmarshal(requestBody, &passwordResetRequest)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+
+	err = requestPasswordReset(ctx, domain.Email(passwordResetRequest.Email))
+	if err != nil {
+		var domainFieldValueInvalidError *domain.FieldValueInvalidError
+
+		switch {
+		case errors.As(err, &domainFieldValueInvalidError):
+			badRequest(w, codeFieldValueInvalid, fmt.Sprintf("%s: %s", errFieldValueInvalid, domainFieldValueInvalidError.FieldName))
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusNoContent, nil)
+}
+
+// confirmPasswordReset handles the http request to confirm a password reset with the specified service method.
+func (h *handler) confirmPasswordReset(w http.ResponseWriter, r *http.Request, resetPassword func(ctx context.Context, token string, password domain.Password) error) {
+	ctx := r.Context()
+
+	requestBody, err := io.ReadAll(r.Body)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+
+	var passwordResetConfirm api.PasswordResetConfirm
+
+	err = json.Unmarshal(requestBody, &passwordResetConfirm)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+
+	err = resetPassword(ctx, passwordResetConfirm.Token, domain.Password(passwordResetConfirm.Password))
+	if err != nil {
+		var domainFieldValueInvalidError *domain.FieldValueInvalidError
+
+		switch {
+		case errors.As(err, &domainFieldValueInvalidError):
+			badRequest(w, codeFieldValueInvalid, fmt.Sprintf("%s: %s", errFieldValueInvalid, domainFieldValueInvalidError.FieldName))
+		case errors.Is(err, domain.ErrPasswordCompromised):
+			badRequest(w, codePasswordCompromised, errPasswordCompromised)
+		case errors.Is(err, domain.ErrPasswordResetTokenInvalid):
+			badRequest(w, codePasswordResetTokenInvalid, errPasswordResetTokenInvalid)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusNoContent, nil)
+}
@@ -1,6 +1,7 @@
 package http
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -13,13 +14,17 @@ import (
 )
 
 const (
-	codePublisherNotFound           = "publisher_not_found"
-	codePublisherEmailAlreadyExists = "publisher_email_already_exists"
-	codePublisherVatinAlreadyExists = "publisher_vatin_already_exists"
-
-	errPublisherNotFound           = "publisher not found"
-	errPublisherEmailAlreadyExists = "email already exists"
-	errPublisherVatinAlreadyExists = "vatin already exists"
+	codePublisherNotFound             = "publisher_not_found"
+	codePublisherEmailAlreadyExists   = "publisher_email_already_exists"
+	codePublisherVatinAlreadyExists   = "publisher_vatin_already_exists"
+	codePublisherEmailNotVerified     = "publisher_email_not_verified"
+	codePublisherEmailAlreadyVerified = "publisher_email_already_verified"
+
+	errPublisherNotFound             = "publisher not found"
+	errPublisherEmailAlreadyExists   = "email already exists"
+	errPublisherVatinAlreadyExists   = "vatin already exists"
+	errPublisherEmailNotVerified     = "publisher email not verified"
+	errPublisherEmailAlreadyVerified = "publisher email already verified"
 )
 
 // CreatePublisher handles the http request to create a publisher.
@@ -61,6 +66,8 @@ func (h *handler) CreatePublisher(w http.ResponseWriter, r *http.Request) {
 		switch {
 		case errors.As(err, &domainFieldValueInvalidError):
 			badRequest(w, codeFieldValueInvalid, fmt.Sprintf("%s: %s", errFieldValueInvalid, domainFieldValueInvalidError.FieldName))
+		case errors.Is(err, domain.ErrPasswordCompromised):
+			badRequest(w, codePasswordCompromised, errPasswordCompromised)
 		case errors.Is(err, domain.ErrPublisherEmailAlreadyExists):
 			conflict(w, codePublisherEmailAlreadyExists, errPublisherEmailAlreadyExists)
 		case errors.Is(err, domain.ErrPublisherVatinAlreadyExists):
@@ -74,7 +81,7 @@ func (h *handler) CreatePublisher(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	publisher := publisherFromDomain(domainPublisher)
+	publisher := publisherFromDomain(ctx, domainPublisher)
 
 	responseBody, err := json.Marshal(publisher)
 	if err != nil {
@@ -103,7 +110,7 @@ func (h *handler) GetPublisherByID(w http.ResponseWriter, r *http.Request, publi
 		return
 	}
 
-	publisher := publisherFromDomain(domainPublisher)
+	publisher := publisherFromDomain(ctx, domainPublisher)
 
 	responseBody, err := json.Marshal(publisher)
 	if err != nil {
@@ -170,7 +177,7 @@ func (h *handler) PatchPublisherByID(w http.ResponseWriter, r *http.Request, pub
 		return
 	}
 
-	publisher := publisherFromDomain(domainPublisher)
+	publisher := publisherFromDomain(ctx, domainPublisher)
 
 	responseBody, err := json.Marshal(publisher)
 	if err != nil {
@@ -201,7 +208,7 @@ func (h *handler) SignInPublisher(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	token, err := h.service.SignInPublisher(ctx, domain.Email(signIn.Email), domain.Password(signIn.Password))
+	result, err := h.service.SignInPublisher(ctx, domain.Email(signIn.Email), domain.Password(signIn.Password), h.sessionClientFromRequest(r))
 	if err != nil {
 		switch {
 		case errors.Is(err, domain.ErrCredentialsIncorrect):
@@ -213,7 +220,56 @@ func (h *handler) SignInPublisher(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	jwt := jwtFromJWTToken(token)
+	if result.ChallengeToken != "" {
+		writeTwoFactorChallenge(ctx, w, result.ChallengeToken)
+		return
+	}
+
+	jwt := jwtFromDomain(result.Tokens)
+
+	responseBody, err := json.Marshal(jwt)
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, descriptionFailedToMarshalResponseBody, logging.Error(err))
+		internalServerError(w)
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusOK, responseBody)
+}
+
+// RefreshPublisherTokens handles the http request to refresh publisher tokens.
+func (h *handler) RefreshPublisherTokens(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	requestBody, err := io.ReadAll(r.Body)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+
+	var refreshToken api.RefreshToken
+
+	err = json.Unmarshal(requestBody, &refreshToken)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+
+	tokens, err := h.service.RefreshPublisherTokens(ctx, refreshToken.RefreshToken, h.sessionClientFromRequest(r))
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrRefreshTokenInvalid),
+			errors.Is(err, domain.ErrRefreshTokenReused):
+			unauthorized(w, codeRefreshTokenInvalid, errRefreshTokenInvalid)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	jwt := jwtFromDomain(tokens)
 
 	responseBody, err := json.Marshal(jwt)
 	if err != nil {
@@ -226,6 +282,39 @@ func (h *handler) SignInPublisher(w http.ResponseWriter, r *http.Request) {
 	writeResponseJSON(w, http.StatusOK, responseBody)
 }
 
+// SignOutPublisher handles the http request to sign out a publisher.
+func (h *handler) SignOutPublisher(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	requestBody, err := io.ReadAll(r.Body)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+
+	var refreshToken api.RefreshToken
+
+	err = json.Unmarshal(requestBody, &refreshToken)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+
+	err = h.service.SignOutPublisher(ctx, refreshToken.RefreshToken)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrRefreshTokenInvalid):
+			unauthorized(w, codeRefreshTokenInvalid, errRefreshTokenInvalid)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusNoContent, nil)
+}
+
 // publisherPostToDomain returns a domain editable publisher with password based on the standardized publisher post.
 func publisherPostToDomain(publisherPost api.PublisherPost) (domain.EditablePublisherWithPassword, error) {
 	country, err := countryToDomain(publisherPost.Country)
@@ -269,17 +358,31 @@ func publisherPatchToDomain(publisherPatch api.PublisherPatch) (domain.EditableP
 	}, nil
 }
 
-// publisherFromDomain returns a standardized publisher based on the domain model.
-func publisherFromDomain(publisher domain.Publisher) api.Publisher {
+// publisherFromDomain returns a standardized publisher based on the domain model. The verification status and reason
+// are only returned to admins, and to the publisher and its members.
+func publisherFromDomain(ctx context.Context, publisher domain.Publisher) api.Publisher {
+	var (
+		verificationStatus *api.PublisherVerificationStatus
+		verificationReason *string
+	)
+
+	if publisherPrivileged(ctx, publisher.ID) {
+		verificationStatus = (*api.PublisherVerificationStatus)(&publisher.VerificationStatus)
+		verificationReason = (*string)(publisher.VerificationReason)
+	}
+
 	return api.Publisher{
-		Id:                publisher.ID,
-		Email:             string(publisher.Email),
-		Name:              string(publisher.Name),
-		Address:           string(publisher.Address),
-		Country:           publisher.Country.String(),
-		Vatin:             string(publisher.Vatin),
-		PictureMultimedia: optionalMultimediaFromDomain(publisher.PictureMultimedia),
-		CreatedAt:         publisher.CreatedAt,
-		ModifiedAt:        publisher.ModifiedAt,
+		Id:                 publisher.ID,
+		Email:              string(publisher.Email),
+		EmailVerifiedAt:    publisher.EmailVerifiedAt,
+		Name:               string(publisher.Name),
+		Address:            string(publisher.Address),
+		Country:            publisher.Country.String(),
+		Vatin:              string(publisher.Vatin),
+		PictureMultimedia:  optionalMultimediaFromDomain(publisher.PictureMultimedia),
+		VerificationStatus: verificationStatus,
+		VerificationReason: verificationReason,
+		CreatedAt:          publisher.CreatedAt,
+		ModifiedAt:         publisher.ModifiedAt,
 	}
 }
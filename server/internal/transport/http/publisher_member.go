@@ -0,0 +1,239 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/goofr-group/gaming-store/server/api"
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+	"github.com/goofr-group/gaming-store/server/internal/logging"
+)
+
+const (
+	codePublisherMemberNotFound      = "publisher_member_not_found"
+	codePublisherMemberAlreadyExists = "publisher_member_already_exists"
+	codePublisherInvitationInvalid   = "publisher_invitation_invalid"
+
+	errPublisherMemberNotFound      = "publisher member not found"
+	errPublisherMemberAlreadyExists = "publisher member already exists"
+	errPublisherInvitationInvalid   = "invalid publisher invitation"
+)
+
+// ListPublisherMembers handles the http request to list publisher members.
+func (h *handler) ListPublisherMembers(w http.ResponseWriter, r *http.Request, publisherID api.PublisherIdPathParam) {
+	ctx := r.Context()
+
+	publisherMembers, err := h.service.ListPublisherMembers(ctx, publisherID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPublisherNotFound):
+			notFound(w, codePublisherNotFound, errPublisherNotFound)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	responseBody, err := json.Marshal(publisherMembersFromDomain(publisherMembers))
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, descriptionFailedToMarshalResponseBody, logging.Error(err))
+		internalServerError(w)
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusOK, responseBody)
+}
+
+// PatchPublisherMember handles the http request to modify a publisher member.
+func (h *handler) PatchPublisherMember(w http.ResponseWriter, r *http.Request, publisherID api.PublisherIdPathParam, memberID api.MemberIdPathParam) {
+	ctx := r.Context()
+
+	requestBody, err := io.ReadAll(r.Body)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+
+	var publisherMemberPatch api.PublisherMemberPatch
+
+	err = json.Unmarshal(requestBody, &publisherMemberPatch)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+
+	publisherMember, err := h.service.UpdatePublisherMemberRole(ctx, publisherID, memberID, domain.PublisherMemberRole(publisherMemberPatch.Role))
+	if err != nil {
+		var domainFieldValueInvalidError *domain.FieldValueInvalidError
+
+		switch {
+		case errors.As(err, &domainFieldValueInvalidError):
+			badRequest(w, codeFieldValueInvalid, fmt.Sprintf("%s: %s", errFieldValueInvalid, domainFieldValueInvalidError.FieldName))
+		case errors.Is(err, domain.ErrPublisherMemberNotFound):
+			notFound(w, codePublisherMemberNotFound, errPublisherMemberNotFound)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	responseBody, err := json.Marshal(publisherMemberFromDomain(publisherMember))
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, descriptionFailedToMarshalResponseBody, logging.Error(err))
+		internalServerError(w)
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusOK, responseBody)
+}
+
+// DeletePublisherMember handles the http request to delete a publisher member.
+func (h *handler) DeletePublisherMember(w http.ResponseWriter, r *http.Request, publisherID api.PublisherIdPathParam, memberID api.MemberIdPathParam) {
+	ctx := r.Context()
+
+	err := h.service.DeletePublisherMember(ctx, publisherID, memberID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPublisherMemberNotFound):
+			notFound(w, codePublisherMemberNotFound, errPublisherMemberNotFound)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusNoContent, nil)
+}
+
+// CreatePublisherInvitation handles the http request to invite a publisher member.
+func (h *handler) CreatePublisherInvitation(w http.ResponseWriter, r *http.Request, publisherID api.PublisherIdPathParam) {
+	ctx := r.Context()
+
+	requestBody, err := io.ReadAll(r.Body)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+
+	var publisherInvitationPost api.PublisherInvitationPost
+
+	err = json.Unmarshal(requestBody, &publisherInvitationPost)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+
+	err = h.service.InvitePublisherMember(ctx, publisherID, domain.Email(publisherInvitationPost.Email), domain.PublisherMemberRole(publisherInvitationPost.Role))
+	if err != nil {
+		var domainFieldValueInvalidError *domain.FieldValueInvalidError
+
+		switch {
+		case errors.As(err, &domainFieldValueInvalidError):
+			badRequest(w, codeFieldValueInvalid, fmt.Sprintf("%s: %s", errFieldValueInvalid, domainFieldValueInvalidError.FieldName))
+		case errors.Is(err, domain.ErrPublisherNotFound):
+			notFound(w, codePublisherNotFound, errPublisherNotFound)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusNoContent, nil)
+}
+
+// AcceptUserPublisherInvitation handles the http request to accept a publisher invitation.
+func (h *handler) AcceptUserPublisherInvitation(w http.ResponseWriter, r *http.Request, userID api.UserIdPathParam) {
+	ctx := r.Context()
+
+	requestBody, err := io.ReadAll(r.Body)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+
+	var publisherInvitationAccept api.PublisherInvitationAccept
+
+	err = json.Unmarshal(requestBody, &publisherInvitationAccept)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+
+	publisherMember, err := h.service.AcceptPublisherInvitation(ctx, userID, publisherInvitationAccept.Token)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPublisherInvitationInvalid):
+			badRequest(w, codePublisherInvitationInvalid, errPublisherInvitationInvalid)
+		case errors.Is(err, domain.ErrUserNotFound):
+			notFound(w, codeUserNotFound, errUserNotFound)
+		case errors.Is(err, domain.ErrPublisherMemberAlreadyExists):
+			conflict(w, codePublisherMemberAlreadyExists, errPublisherMemberAlreadyExists)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	responseBody, err := json.Marshal(publisherMemberFromDomain(publisherMember))
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, descriptionFailedToMarshalResponseBody, logging.Error(err))
+		internalServerError(w)
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusOK, responseBody)
+}
+
+// ListUserPublisherMembers handles the http request to list user publisher memberships.
+func (h *handler) ListUserPublisherMembers(w http.ResponseWriter, r *http.Request, userID api.UserIdPathParam) {
+	ctx := r.Context()
+
+	publisherMembers, err := h.service.ListUserPublisherMembers(ctx, userID)
+	if err != nil {
+		internalServerError(w)
+		return
+	}
+
+	responseBody, err := json.Marshal(publisherMembersFromDomain(publisherMembers))
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, descriptionFailedToMarshalResponseBody, logging.Error(err))
+		internalServerError(w)
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusOK, responseBody)
+}
+
+// publisherMemberFromDomain returns a standardized publisher member based on the domain model.
+func publisherMemberFromDomain(publisherMember domain.PublisherMember) api.PublisherMember {
+	return api.PublisherMember{
+		PublisherId: publisherMember.PublisherID,
+		UserId:      publisherMember.UserID,
+		Role:        api.PublisherMemberRole(publisherMember.Role),
+		CreatedAt:   publisherMember.CreatedAt,
+		ModifiedAt:  publisherMember.ModifiedAt,
+	}
+}
+
+// publisherMembersFromDomain returns standardized publisher members based on the domain model.
+func publisherMembersFromDomain(publisherMembers []domain.PublisherMember) []api.PublisherMember {
+	m := make([]api.PublisherMember, len(publisherMembers))
+
+	for i := 0; i < len(publisherMembers); i++ {
+		m[i] = publisherMemberFromDomain(publisherMembers[i])
+	}
+
+	return m
+}
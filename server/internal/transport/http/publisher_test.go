@@ -0,0 +1,110 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goofr-group/gaming-store/server/internal/authn"
+	"github.com/goofr-group/gaming-store/server/internal/authz"
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+)
+
+// publishersService implements the service interface with the publishers only.
+type publishersService struct {
+	Service
+	publisher domain.Publisher
+}
+
+func (s publishersService) GetPublisherByID(context.Context, uuid.UUID) (domain.Publisher, error) {
+	return s.publisher, nil
+}
+
+func TestHandler_GetPublisherByID(t *testing.T) {
+	var (
+		verificationReason = domain.PublisherVerificationReason("Invalid business document")
+
+		publisher = domain.Publisher{
+			ID:                 uuid.New(),
+			VerificationStatus: domain.PublisherVerificationStatusRejected,
+			VerificationReason: &verificationReason,
+		}
+
+		publisherClaims = func() *authn.Claims {
+			claims := authn.Claims{Roles: []authn.SubjectRole{authn.SubjectRolePublisher}}
+			claims.Subject = publisher.ID.String()
+			return &claims
+		}()
+		otherPublisherClaims = func() *authn.Claims {
+			claims := authn.Claims{Roles: []authn.SubjectRole{authn.SubjectRolePublisher}}
+			claims.Subject = uuid.NewString()
+			return &claims
+		}()
+		userClaims  = &authn.Claims{Roles: []authn.SubjectRole{authn.SubjectRoleUser}}
+		adminClaims = &authn.Claims{Roles: []authn.SubjectRole{authn.SubjectRoleUser, authn.SubjectRoleAdmin}}
+	)
+
+	tests := []struct {
+		name                 string
+		claims               *authn.Claims
+		expectedVerification bool
+	}{
+		{
+			name: "should return the publisher without its verification to anonymous callers",
+		},
+		{
+			name:   "should return the publisher without its verification to users",
+			claims: userClaims,
+		},
+		{
+			name:   "should return the publisher without its verification to other publishers",
+			claims: otherPublisherClaims,
+		},
+		{
+			name:                 "should return the publisher with its verification to itself",
+			claims:               publisherClaims,
+			expectedVerification: true,
+		},
+		{
+			name:                 "should return the publisher with its verification to admins",
+			claims:               adminClaims,
+			expectedVerification: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := &handler{service: publishersService{publisher: publisher}}
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodGet, "/api/publishers/"+publisher.ID.String(), nil)
+
+			if test.claims != nil {
+				r = r.WithContext(authz.ContextWithClaims(r.Context(), *test.claims))
+			}
+
+			h.GetPublisherByID(w, r, publisher.ID)
+
+			require.Equal(t, http.StatusOK, w.Code)
+
+			var body map[string]any
+
+			err := json.Unmarshal(w.Body.Bytes(), &body)
+			require.NoError(t, err)
+
+			if test.expectedVerification {
+				assert.Equal(t, string(publisher.VerificationStatus), body["verificationStatus"])
+				assert.Equal(t, string(verificationReason), body["verificationReason"])
+			} else {
+				assert.NotContains(t, body, "verificationStatus")
+				assert.NotContains(t, body, "verificationReason")
+			}
+		})
+	}
+}
@@ -0,0 +1,225 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+
+	"github.com/goofr-group/gaming-store/server/api"
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+	"github.com/goofr-group/gaming-store/server/internal/logging"
+)
+
+const (
+	codePublisherNotVerified      = "publisher_not_verified"
+	codePublisherDocumentNotFound = "publisher_document_not_found"
+
+	errPublisherNotVerified      = "publisher not verified"
+	errPublisherDocumentNotFound = "publisher document not found"
+)
+
+// ListPublisherDocuments handles the http request to list publisher documents.
+func (h *handler) ListPublisherDocuments(w http.ResponseWriter, r *http.Request, publisherID api.PublisherIdPathParam) {
+	ctx := r.Context()
+
+	documents, err := h.service.ListPublisherDocuments(ctx, publisherID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPublisherNotFound):
+			notFound(w, codePublisherNotFound, errPublisherNotFound)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	responseBody, err := json.Marshal(publisherDocumentsFromDomain(documents))
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, descriptionFailedToMarshalResponseBody, logging.Error(err))
+		internalServerError(w)
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusOK, responseBody)
+}
+
+// UploadPublisherDocument handles the http request to upload a publisher document.
+func (h *handler) UploadPublisherDocument(w http.ResponseWriter, r *http.Request, publisherID api.PublisherIdPathParam) {
+	ctx := r.Context()
+
+	file, fileHeader, err := r.FormFile(domain.FieldKeyFile)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+	defer file.Close()
+
+	limitReader := io.LimitReader(file, domain.MultimediaFileMaxSize+1)
+
+	fileData, err := io.ReadAll(limitReader)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+
+	if len(fileData) > domain.MultimediaFileMaxSize {
+		requestEntityTooLarge(w, codeMultimediaFileTooLarge, errMultimediaFileTooLarge)
+		return
+	}
+
+	contentType := fileHeader.Header.Get(requestHeaderKeyContentType)
+
+	document, err := h.service.UploadPublisherDocument(ctx, publisherID, fileData, contentType)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPublisherNotFound):
+			notFound(w, codePublisherNotFound, errPublisherNotFound)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	responseBody, err := json.Marshal(publisherDocumentFromDomain(document))
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, descriptionFailedToMarshalResponseBody, logging.Error(err))
+		internalServerError(w)
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusCreated, responseBody)
+}
+
+// GetPublisherDocumentFile handles the http request to download a publisher document.
+func (h *handler) GetPublisherDocumentFile(w http.ResponseWriter, r *http.Request, publisherID api.PublisherIdPathParam, documentID api.DocumentIdPathParam) {
+	ctx := r.Context()
+
+	document, file, err := h.service.GetPublisherDocumentFile(ctx, publisherID, documentID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPublisherDocumentNotFound):
+			notFound(w, codePublisherDocumentNotFound, errPublisherDocumentNotFound)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	// The file is downloaded rather than rendered, since its media type was chosen by the publisher.
+	w.Header().Set("Content-Type", document.MediaType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": document.ID.String()}))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(file)
+}
+
+// ListPendingPublishers handles the http request to list pending publishers.
+func (h *handler) ListPendingPublishers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	publishers, err := h.service.ListPendingPublishers(ctx)
+	if err != nil {
+		internalServerError(w)
+		return
+	}
+
+	responseBody, err := json.Marshal(publishersFromDomain(ctx, publishers))
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, descriptionFailedToMarshalResponseBody, logging.Error(err))
+		internalServerError(w)
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusOK, responseBody)
+}
+
+// ReviewPublisher handles the http request to review a publisher.
+func (h *handler) ReviewPublisher(w http.ResponseWriter, r *http.Request, publisherID api.PublisherIdPathParam) {
+	ctx := r.Context()
+
+	requestBody, err := io.ReadAll(r.Body)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+
+	var publisherVerificationPost api.PublisherVerificationPost
+
+	err = json.Unmarshal(requestBody, &publisherVerificationPost)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+
+	publisher, err := h.service.ReviewPublisher(
+		ctx,
+		publisherID,
+		domain.PublisherVerificationStatus(publisherVerificationPost.Status),
+		(*domain.PublisherVerificationReason)(publisherVerificationPost.Reason),
+	)
+	if err != nil {
+		var domainFieldValueInvalidError *domain.FieldValueInvalidError
+
+		switch {
+		case errors.As(err, &domainFieldValueInvalidError):
+			badRequest(w, codeFieldValueInvalid, fmt.Sprintf("%s: %s", errFieldValueInvalid, domainFieldValueInvalidError.FieldName))
+		case errors.Is(err, domain.ErrPublisherNotFound):
+			notFound(w, codePublisherNotFound, errPublisherNotFound)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	responseBody, err := json.Marshal(publisherFromDomain(ctx, publisher))
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, descriptionFailedToMarshalResponseBody, logging.Error(err))
+		internalServerError(w)
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusOK, responseBody)
+}
+
+// publishersFromDomain returns a standardized list of publishers based on the domain model.
+func publishersFromDomain(ctx context.Context, publishers []domain.Publisher) []api.Publisher {
+	p := make([]api.Publisher, len(publishers))
+
+	for i := 0; i < len(publishers); i++ {
+		p[i] = publisherFromDomain(ctx, publishers[i])
+	}
+
+	return p
+}
+
+// publisherDocumentFromDomain returns a standardized publisher document based on the domain model.
+func publisherDocumentFromDomain(document domain.PublisherDocument) api.PublisherDocument {
+	return api.PublisherDocument{
+		Id:        document.ID,
+		MediaType: document.MediaType,
+		CreatedAt: document.CreatedAt,
+	}
+}
+
+// publisherDocumentsFromDomain returns a standardized list of publisher documents based on the domain model.
+func publisherDocumentsFromDomain(documents []domain.PublisherDocument) []api.PublisherDocument {
+	d := make([]api.PublisherDocument, len(documents))
+
+	for i := 0; i < len(documents); i++ {
+		d[i] = publisherDocumentFromDomain(documents[i])
+	}
+
+	return d
+}
@@ -0,0 +1,130 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/goofr-group/gaming-store/server/api"
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+	"github.com/goofr-group/gaming-store/server/internal/logging"
+)
+
+const (
+	codeScheduledJobNotFound = "scheduled_job_not_found"
+
+	errScheduledJobNotFound = "scheduled job not found"
+)
+
+// ListScheduledJobs handles the http request to list the scheduled jobs of a game.
+func (h *handler) ListScheduledJobs(w http.ResponseWriter, r *http.Request, publisherID api.PublisherIdPathParam, gameID api.GameIdPathParam) {
+	ctx := r.Context()
+
+	domainScheduledJobs, err := h.service.ListScheduledJobs(ctx, gameID)
+	if err != nil {
+		internalServerError(w)
+		return
+	}
+
+	scheduledJobs := make([]api.ScheduledJob, len(domainScheduledJobs))
+	for i, domainScheduledJob := range domainScheduledJobs {
+		scheduledJobs[i] = scheduledJobFromDomain(domainScheduledJob)
+	}
+
+	responseBody, err := json.Marshal(scheduledJobs)
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, descriptionFailedToMarshalResponseBody, logging.Error(err))
+		internalServerError(w)
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusOK, responseBody)
+}
+
+// CreateScheduledJob handles the http request to create a scheduled job of a game.
+func (h *handler) CreateScheduledJob(w http.ResponseWriter, r *http.Request, publisherID api.PublisherIdPathParam, gameID api.GameIdPathParam) {
+	ctx := r.Context()
+
+	requestBody, err := io.ReadAll(r.Body)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+
+	var scheduledJobPost api.ScheduledJobPost
+
+	err = json.Unmarshal(requestBody, &scheduledJobPost)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+
+	domainEditableScheduledJob := domain.EditableScheduledJob{
+		Action: domain.ScheduledJobAction(scheduledJobPost.Action),
+		RunAt:  scheduledJobPost.RunAt,
+	}
+
+	domainScheduledJob, err := h.service.CreateScheduledJob(ctx, gameID, domainEditableScheduledJob)
+	if err != nil {
+		var domainFieldValueInvalidError *domain.FieldValueInvalidError
+
+		switch {
+		case errors.As(err, &domainFieldValueInvalidError):
+			badRequest(w, codeFieldValueInvalid, fmt.Sprintf("%s: %s", errFieldValueInvalid, domainFieldValueInvalidError.FieldName))
+		case errors.Is(err, domain.ErrGameNotFound):
+			notFound(w, codeGameNotFound, errGameNotFound)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	scheduledJob := scheduledJobFromDomain(domainScheduledJob)
+
+	responseBody, err := json.Marshal(scheduledJob)
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, descriptionFailedToMarshalResponseBody, logging.Error(err))
+		internalServerError(w)
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusCreated, responseBody)
+}
+
+// CancelScheduledJob handles the http request to cancel a scheduled job of a game.
+func (h *handler) CancelScheduledJob(w http.ResponseWriter, r *http.Request, publisherID api.PublisherIdPathParam, gameID api.GameIdPathParam, jobID api.JobIdPathParam) {
+	ctx := r.Context()
+
+	err := h.service.CancelScheduledJob(ctx, gameID, jobID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrScheduledJobNotFound):
+			notFound(w, codeScheduledJobNotFound, errScheduledJobNotFound)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusNoContent, nil)
+}
+
+// scheduledJobFromDomain returns a standardized scheduled job based on the domain model.
+func scheduledJobFromDomain(scheduledJob domain.ScheduledJob) api.ScheduledJob {
+	return api.ScheduledJob{
+		Id:         scheduledJob.ID,
+		Action:     api.ScheduledJobAction(scheduledJob.Action),
+		RunAt:      scheduledJob.RunAt,
+		Status:     api.ScheduledJobStatus(scheduledJob.Status),
+		Error:      (*string)(scheduledJob.Error),
+		ExecutedAt: scheduledJob.ExecutedAt,
+		CreatedAt:  scheduledJob.CreatedAt,
+		ModifiedAt: scheduledJob.ModifiedAt,
+	}
+}
@@ -0,0 +1,136 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/goofr-group/gaming-store/server/api"
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+	"github.com/goofr-group/gaming-store/server/internal/logging"
+)
+
+const (
+	codeSessionNotFound = "session_not_found"
+
+	errSessionNotFound = "session not found"
+)
+
+// ListUserSessions handles the http request to list user sessions.
+func (h *handler) ListUserSessions(w http.ResponseWriter, r *http.Request, userID api.UserIdPathParam) {
+	ctx := r.Context()
+
+	sessions, err := h.service.ListUserSessions(ctx, userID)
+	if err != nil {
+		internalServerError(w)
+		return
+	}
+
+	responseBody, err := json.Marshal(sessionsFromDomain(sessions))
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, descriptionFailedToMarshalResponseBody, logging.Error(err))
+		internalServerError(w)
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusOK, responseBody)
+}
+
+// DeleteUserSession handles the http request to delete a user session.
+func (h *handler) DeleteUserSession(w http.ResponseWriter, r *http.Request, userID api.UserIdPathParam, sessionID api.SessionIdPathParam) {
+	ctx := r.Context()
+
+	err := h.service.DeleteUserSession(ctx, userID, sessionID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrSessionNotFound):
+			notFound(w, codeSessionNotFound, errSessionNotFound)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusNoContent, nil)
+}
+
+// DeleteUserSessions handles the http request to delete all user sessions.
+func (h *handler) DeleteUserSessions(w http.ResponseWriter, r *http.Request, userID api.UserIdPathParam) {
+	ctx := r.Context()
+
+	err := h.service.DeleteUserSessions(ctx, userID)
+	if err != nil {
+		internalServerError(w)
+		return
+	}
+
+	writeResponseJSON(w, http.StatusNoContent, nil)
+}
+
+// sessionClientFromRequest returns the domain session client of the specified request.
+func (h *handler) sessionClientFromRequest(r *http.Request) domain.SessionClient {
+	userAgent := []rune(r.UserAgent())
+	if len(userAgent) > domain.SessionUserAgentMaxLength {
+		userAgent = userAgent[:domain.SessionUserAgentMaxLength]
+	}
+
+	return domain.SessionClient{
+		UserAgent: string(userAgent),
+		IPAddress: clientIPAddress(r, h.trustedProxies),
+	}
+}
+
+// clientIPAddress returns the IP address of the client that sent the specified request.
+// Each trusted proxy appends the address it received the request from to the X-Forwarded-For header, so the client
+// address is the one appended by the outermost trusted proxy. The entries before it can be set by the client and are
+// not trusted. Without trusted proxies, or when the header has fewer entries than expected, the remote address is used.
+func clientIPAddress(r *http.Request, trustedProxies int) string {
+	if trustedProxies > 0 {
+		var forwardedFor []string
+		for _, header := range r.Header.Values(requestHeaderKeyForwardedFor) {
+			for _, address := range strings.Split(header, ",") {
+				forwardedFor = append(forwardedFor, strings.TrimSpace(address))
+			}
+		}
+
+		if len(forwardedFor) >= trustedProxies {
+			ip := net.ParseIP(forwardedFor[len(forwardedFor)-trustedProxies])
+			if ip != nil {
+				return ip.String()
+			}
+		}
+	}
+
+	ipAddress, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return ipAddress
+}
+
+// sessionFromDomain returns a standardized session based on the domain model.
+func sessionFromDomain(session domain.Session) api.Session {
+	return api.Session{
+		Id:         session.ID,
+		Device:     session.Client.UserAgent,
+		IpAddress:  session.Client.IPAddress,
+		LastSeenAt: session.LastSeenAt,
+		CreatedAt:  session.CreatedAt,
+	}
+}
+
+// sessionsFromDomain returns standardized sessions based on the domain model.
+func sessionsFromDomain(sessions []domain.Session) []api.Session {
+	s := make([]api.Session, len(sessions))
+
+	for i := 0; i < len(sessions); i++ {
+		s[i] = sessionFromDomain(sessions[i])
+	}
+
+	return s
+}
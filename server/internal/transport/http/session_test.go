@@ -0,0 +1,70 @@
+package http
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientIPAddress(t *testing.T) {
+	tests := []struct {
+		name           string
+		remoteAddr     string
+		forwardedFor   []string
+		trustedProxies int
+		expected       string
+	}{
+		{
+			name:       "should return the remote address without trusted proxies",
+			remoteAddr: "10.0.0.1:4321",
+			expected:   "10.0.0.1",
+		},
+		{
+			name:         "should ignore the forwarded header without trusted proxies",
+			remoteAddr:   "10.0.0.1:4321",
+			forwardedFor: []string{"203.0.113.7"},
+			expected:     "10.0.0.1",
+		},
+		{
+			name:           "should return the address appended by the trusted proxy",
+			remoteAddr:     "10.0.0.1:4321",
+			forwardedFor:   []string{"198.51.100.1, 203.0.113.7"},
+			trustedProxies: 1,
+			expected:       "203.0.113.7",
+		},
+		{
+			name:           "should return the address appended by the outermost trusted proxy",
+			remoteAddr:     "10.0.0.1:4321",
+			forwardedFor:   []string{"198.51.100.1", "203.0.113.7, 10.0.0.2"},
+			trustedProxies: 2,
+			expected:       "203.0.113.7",
+		},
+		{
+			name:           "should return the remote address when the forwarded header is missing",
+			remoteAddr:     "10.0.0.1:4321",
+			trustedProxies: 1,
+			expected:       "10.0.0.1",
+		},
+		{
+			name:           "should return the remote address when the forwarded address is invalid",
+			remoteAddr:     "10.0.0.1:4321",
+			forwardedFor:   []string{"unknown"},
+			trustedProxies: 1,
+			expected:       "10.0.0.1",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/", nil)
+			r.RemoteAddr = test.remoteAddr
+
+			for _, forwardedFor := range test.forwardedFor {
+				r.Header.Add(requestHeaderKeyForwardedFor, forwardedFor)
+			}
+
+			assert.Equal(t, test.expected, clientIPAddress(r, test.trustedProxies))
+		})
+	}
+}
@@ -0,0 +1,212 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/goofr-group/gaming-store/server/api"
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+	"github.com/goofr-group/gaming-store/server/internal/logging"
+)
+
+const (
+	codeTOTPNotFound              = "totp_not_found"
+	codeTOTPAlreadyEnabled        = "totp_already_enabled"
+	codeTOTPCodeInvalid           = "totp_code_invalid"
+	codeTwoFactorChallengeInvalid = "two_factor_challenge_invalid"
+
+	errTOTPNotFound              = "totp not found"
+	errTOTPAlreadyEnabled        = "totp already enabled"
+	errTOTPCodeInvalid           = "invalid totp code"
+	errTwoFactorChallengeInvalid = "invalid two-factor challenge token"
+)
+
+// CreateUserTOTP handles the http request to enroll a user in totp.
+func (h *handler) CreateUserTOTP(w http.ResponseWriter, r *http.Request, userID api.UserIdPathParam) {
+	ctx := r.Context()
+
+	enrollment, err := h.service.CreateUserTOTP(ctx, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUserNotFound):
+			notFound(w, codeUserNotFound, errUserNotFound)
+		case errors.Is(err, domain.ErrTOTPAlreadyEnabled):
+			conflict(w, codeTOTPAlreadyEnabled, errTOTPAlreadyEnabled)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	writeTOTPEnrollment(ctx, w, enrollment)
+}
+
+// EnableUserTOTP handles the http request to enable the two-factor authentication of a user.
+func (h *handler) EnableUserTOTP(w http.ResponseWriter, r *http.Request, userID api.UserIdPathParam) {
+	h.enableTOTP(w, r, userID, h.service.EnableUserTOTP)
+}
+
+// SignInUserTOTP handles the http request to complete a user two-factor sign in.
+func (h *handler) SignInUserTOTP(w http.ResponseWriter, r *http.Request) {
+	h.signInTOTP(w, r, h.service.SignInUserTOTP)
+}
+
+// CreatePublisherTOTP handles the http request to enroll a publisher in totp.
+func (h *handler) CreatePublisherTOTP(w http.ResponseWriter, r *http.Request, publisherID api.PublisherIdPathParam) {
+	ctx := r.Context()
+
+	enrollment, err := h.service.CreatePublisherTOTP(ctx, publisherID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPublisherNotFound):
+			notFound(w, codePublisherNotFound, errPublisherNotFound)
+		case errors.Is(err, domain.ErrTOTPAlreadyEnabled):
+			conflict(w, codeTOTPAlreadyEnabled, errTOTPAlreadyEnabled)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	writeTOTPEnrollment(ctx, w, enrollment)
+}
+
+// EnablePublisherTOTP handles the http request to enable the two-factor authentication of a publisher.
+func (h *handler) EnablePublisherTOTP(w http.ResponseWriter, r *http.Request, publisherID api.PublisherIdPathParam) {
+	h.enableTOTP(w, r, publisherID, h.service.EnablePublisherTOTP)
+}
+
+// SignInPublisherTOTP handles the http request to complete a publisher two-factor sign in.
+func (h *handler) SignInPublisherTOTP(w http.ResponseWriter, r *http.Request) {
+	h.signInTOTP(w, r, h.service.SignInPublisherTOTP)
+}
+
+// enableTOTP handles the http request to enable the two-factor authentication of a subject with the specified service
+// method.
+func (h *handler) enableTOTP(w http.ResponseWriter, r *http.Request, subjectID uuid.UUID, enableTOTP func(ctx context.Context, subjectID uuid.UUID, code string) ([]string, error)) {
+	ctx := r.Context()
+
+	requestBody, err := io.ReadAll(r.Body)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+
+	var totpCode api.TOTPCode
+
+	err = json.Unmarshal(requestBody, &totpCode)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+
+	recoveryCodes, err := enableTOTP(ctx, subjectID, totpCode.Code)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrTOTPCodeInvalid):
+			badRequest(w, codeTOTPCodeInvalid, errTOTPCodeInvalid)
+		case errors.Is(err, domain.ErrTOTPNotFound):
+			notFound(w, codeTOTPNotFound, errTOTPNotFound)
+		case errors.Is(err, domain.ErrTOTPAlreadyEnabled):
+			conflict(w, codeTOTPAlreadyEnabled, errTOTPAlreadyEnabled)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	responseBody, err := json.Marshal(api.RecoveryCodes{
+		RecoveryCodes: recoveryCodes,
+	})
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, descriptionFailedToMarshalResponseBody, logging.Error(err))
+		internalServerError(w)
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusOK, responseBody)
+}
+
+// signInTOTP handles the http request to complete a two-factor sign in with the specified service method.
+func (h *handler) signInTOTP(w http.ResponseWriter, r *http.Request, signInTOTP func(ctx context.Context, challengeToken, code string, client domain.SessionClient) (domain.Tokens, error)) {
+	ctx := r.Context()
+
+	requestBody, err := io.ReadAll(r.Body)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+
+	var twoFactorSignIn api.TwoFactorSignIn
+
+	err = json.Unmarshal(requestBody, &twoFactorSignIn)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+
+	tokens, err := signInTOTP(ctx, twoFactorSignIn.ChallengeToken, twoFactorSignIn.Code, h.sessionClientFromRequest(r))
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrTwoFactorChallengeInvalid):
+			unauthorized(w, codeTwoFactorChallengeInvalid, errTwoFactorChallengeInvalid)
+		case errors.Is(err, domain.ErrTOTPCodeInvalid):
+			unauthorized(w, codeTOTPCodeInvalid, errTOTPCodeInvalid)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	responseBody, err := json.Marshal(jwtFromDomain(tokens))
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, descriptionFailedToMarshalResponseBody, logging.Error(err))
+		internalServerError(w)
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusOK, responseBody)
+}
+
+// writeTOTPEnrollment writes the totp enrollment to the response.
+func writeTOTPEnrollment(ctx context.Context, w http.ResponseWriter, enrollment domain.TOTPEnrollment) {
+	responseBody, err := json.Marshal(api.TOTPEnrollment{
+		Secret: enrollment.Secret,
+		Uri:    enrollment.URI,
+	})
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, descriptionFailedToMarshalResponseBody, logging.Error(err))
+		internalServerError(w)
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusOK, responseBody)
+}
+
+// writeTwoFactorChallenge writes the two-factor challenge token to the response, meaning that the sign in must be
+// completed with a second factor.
+func writeTwoFactorChallenge(ctx context.Context, w http.ResponseWriter, challengeToken string) {
+	responseBody, err := json.Marshal(api.TwoFactorChallenge{
+		ChallengeToken: challengeToken,
+	})
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, descriptionFailedToMarshalResponseBody, logging.Error(err))
+		internalServerError(w)
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusAccepted, responseBody)
+}
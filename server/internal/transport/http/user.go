@@ -19,6 +19,8 @@ const (
 	codeUserVatinAlreadyExists    = "user_vatin_already_exists"
 	codeUserNotOldEnough          = "user_not_old_enough"
 	codeUserBalanceInsufficient   = "user_balance_insufficient"
+	codeUserEmailNotVerified      = "user_email_not_verified"
+	codeUserEmailAlreadyVerified  = "user_email_already_verified"
 
 	errUserNotFound              = "user not found"
 	errUserUsernameAlreadyExists = "username already exists"
@@ -26,6 +28,8 @@ const (
 	errUserVatinAlreadyExists    = "vatin already exists"
 	errUserNotOldEnough          = "user not old enough"
 	errUserBalanceInsufficient   = "user balance insufficient"
+	errUserEmailNotVerified      = "user email not verified"
+	errUserEmailAlreadyVerified  = "user email already verified"
 )
 
 // CreateUser handles the http request to create a user.
@@ -67,6 +71,8 @@ func (h *handler) CreateUser(w http.ResponseWriter, r *http.Request) {
 		switch {
 		case errors.As(err, &domainFieldValueInvalidError):
 			badRequest(w, codeFieldValueInvalid, fmt.Sprintf("%s: %s", errFieldValueInvalid, domainFieldValueInvalidError.FieldName))
+		case errors.Is(err, domain.ErrPasswordCompromised):
+			badRequest(w, codePasswordCompromised, errPasswordCompromised)
 		case errors.Is(err, domain.ErrUserUsernameAlreadyExists):
 			conflict(w, codeUserUsernameAlreadyExists, errUserUsernameAlreadyExists)
 		case errors.Is(err, domain.ErrUserEmailAlreadyExists):
@@ -221,7 +227,7 @@ func (h *handler) SignInUser(w http.ResponseWriter, r *http.Request) {
 		email = domain.Email(*signIn.Email)
 	}
 
-	token, err := h.service.SignInUser(ctx, username, email, domain.Password(signIn.Password))
+	result, err := h.service.SignInUser(ctx, username, email, domain.Password(signIn.Password), h.sessionClientFromRequest(r))
 	if err != nil {
 		switch {
 		case errors.Is(err, domain.ErrCredentialsIncorrect):
@@ -233,7 +239,12 @@ func (h *handler) SignInUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	jwt := jwtFromJWTToken(token)
+	if result.ChallengeToken != "" {
+		writeTwoFactorChallenge(ctx, w, result.ChallengeToken)
+		return
+	}
+
+	jwt := jwtFromDomain(result.Tokens)
 
 	responseBody, err := json.Marshal(jwt)
 	if err != nil {
@@ -246,6 +257,83 @@ func (h *handler) SignInUser(w http.ResponseWriter, r *http.Request) {
 	writeResponseJSON(w, http.StatusOK, responseBody)
 }
 
+// RefreshUserTokens handles the http request to refresh user tokens.
+func (h *handler) RefreshUserTokens(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	requestBody, err := io.ReadAll(r.Body)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+
+	var refreshToken api.RefreshToken
+
+	err = json.Unmarshal(requestBody, &refreshToken)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+
+	tokens, err := h.service.RefreshUserTokens(ctx, refreshToken.RefreshToken, h.sessionClientFromRequest(r))
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrRefreshTokenInvalid),
+			errors.Is(err, domain.ErrRefreshTokenReused):
+			unauthorized(w, codeRefreshTokenInvalid, errRefreshTokenInvalid)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	jwt := jwtFromDomain(tokens)
+
+	responseBody, err := json.Marshal(jwt)
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, descriptionFailedToMarshalResponseBody, logging.Error(err))
+		internalServerError(w)
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusOK, responseBody)
+}
+
+// SignOutUser handles the http request to sign out a user.
+func (h *handler) SignOutUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	requestBody, err := io.ReadAll(r.Body)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+
+	var refreshToken api.RefreshToken
+
+	err = json.Unmarshal(requestBody, &refreshToken)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return
+	}
+
+	err = h.service.SignOutUser(ctx, refreshToken.RefreshToken)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrRefreshTokenInvalid):
+			unauthorized(w, codeRefreshTokenInvalid, errRefreshTokenInvalid)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusNoContent, nil)
+}
+
 // userPostToDomain returns a domain editable user with password based on the standardized user post.
 func userPostToDomain(userPost api.UserPost) (domain.EditableUserWithPassword, error) {
 	country, err := countryToDomain(userPost.Country)
@@ -300,6 +388,7 @@ func userFromDomain(user domain.User) api.User {
 		Id:                user.ID,
 		Username:          string(user.Username),
 		Email:             string(user.Email),
+		EmailVerifiedAt:   user.EmailVerifiedAt,
 		DisplayName:       string(user.DisplayName),
 		DateOfBirth:       dateFromTime(user.DateOfBirth.Time()),
 		Address:           string(user.Address),
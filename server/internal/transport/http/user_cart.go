@@ -41,7 +41,7 @@ func (h *handler) ListUserCartGames(w http.ResponseWriter, r *http.Request, user
 		return
 	}
 
-	gamesPaginated := gamesPaginatedFromDomain(domainPaginatedGames)
+	gamesPaginated := gamesPaginatedFromDomain(ctx, domainPaginatedGames)
 
 	responseBody, err := json.Marshal(gamesPaginated)
 	if err != nil {
@@ -67,8 +67,8 @@ func (h *handler) CreateUserCartGame(w http.ResponseWriter, r *http.Request, use
 			notFound(w, codeGameNotFound, errGameNotFound)
 		case errors.Is(err, domain.ErrUserCartGameAlreadyExists):
 			conflict(w, codeUserCartGameAlreadyExists, errUserCartGameAlreadyExists)
-		case errors.Is(err, domain.ErrGameNotActive):
-			conflict(w, codeGameNotActive, errGameNotActive)
+		case errors.Is(err, domain.ErrGameNotPublished):
+			conflict(w, codeGameNotPublished, errGameNotPublished)
 		case errors.Is(err, domain.ErrGameNotReleased):
 			conflict(w, codeGameNotReleased, errGameNotReleased)
 		case errors.Is(err, domain.ErrUserNotOldEnough):
@@ -113,8 +113,12 @@ func (h *handler) PurchaseUserCart(w http.ResponseWriter, r *http.Request, userI
 		switch {
 		case errors.Is(err, domain.ErrUserNotFound):
 			notFound(w, codeUserNotFound, errUserNotFound)
+		case errors.Is(err, domain.ErrUserEmailNotVerified):
+			conflict(w, codeUserEmailNotVerified, errUserEmailNotVerified)
 		case errors.Is(err, domain.ErrUserCartEmpty):
 			conflict(w, codeUserCartEmpty, errUserCartEmpty)
+		case errors.Is(err, domain.ErrGameNotPublished):
+			conflict(w, codeGameNotPublished, errGameNotPublished)
 		case errors.Is(err, domain.ErrUserBalanceInsufficient):
 			conflict(w, codeUserBalanceInsufficient, errUserBalanceInsufficient)
 		default:
@@ -37,7 +37,7 @@ func (h *handler) ListUserGames(w http.ResponseWriter, r *http.Request, userID a
 		return
 	}
 
-	gamesPaginated := gamesPaginatedFromDomain(domainPaginatedGames)
+	gamesPaginated := gamesPaginatedFromDomain(ctx, domainPaginatedGames)
 
 	responseBody, err := json.Marshal(gamesPaginated)
 	if err != nil {
@@ -0,0 +1,232 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/goofr-group/gaming-store/server/api"
+	"github.com/goofr-group/gaming-store/server/internal/domain"
+	"github.com/goofr-group/gaming-store/server/internal/logging"
+)
+
+const (
+	codeWebAuthnCeremonyInvalid         = "webauthn_ceremony_invalid"
+	codeWebAuthnCeremonyThrottled       = "webauthn_ceremony_throttled"
+	codeWebAuthnCredentialInvalid       = "webauthn_credential_invalid"
+	codeWebAuthnCredentialAlreadyExists = "webauthn_credential_already_exists"
+
+	errWebAuthnCeremonyInvalid         = "invalid webauthn ceremony"
+	errWebAuthnCeremonyThrottled       = "too many webauthn ceremonies"
+	errWebAuthnCredentialInvalid       = "invalid webauthn credential"
+	errWebAuthnCredentialAlreadyExists = "webauthn credential already exists"
+)
+
+// BeginUserWebAuthnRegistration handles the http request to begin a user passkey registration.
+func (h *handler) BeginUserWebAuthnRegistration(w http.ResponseWriter, r *http.Request, userID api.UserIdPathParam) {
+	ctx := r.Context()
+
+	options, err := h.service.BeginUserWebAuthnRegistration(ctx, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUserNotFound):
+			notFound(w, codeUserNotFound, errUserNotFound)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	writeWebAuthnOptions(ctx, w, options)
+}
+
+// FinishUserWebAuthnRegistration handles the http request to finish a user passkey registration.
+func (h *handler) FinishUserWebAuthnRegistration(w http.ResponseWriter, r *http.Request, userID api.UserIdPathParam) {
+	webAuthnFinish, ok := readWebAuthnFinish(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.service.FinishUserWebAuthnRegistration(r.Context(), userID, webAuthnFinish.CeremonyId, webAuthnFinish.Credential)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUserNotFound):
+			notFound(w, codeUserNotFound, errUserNotFound)
+		default:
+			writeWebAuthnRegistrationError(w, err)
+		}
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusNoContent, nil)
+}
+
+// BeginUserWebAuthnSignIn handles the http request to begin a user passkey sign in.
+func (h *handler) BeginUserWebAuthnSignIn(w http.ResponseWriter, r *http.Request) {
+	h.beginWebAuthnSignIn(w, r, h.service.BeginUserWebAuthnSignIn)
+}
+
+// FinishUserWebAuthnSignIn handles the http request to finish a user passkey sign in.
+func (h *handler) FinishUserWebAuthnSignIn(w http.ResponseWriter, r *http.Request) {
+	h.finishWebAuthnSignIn(w, r, h.service.FinishUserWebAuthnSignIn)
+}
+
+// BeginPublisherWebAuthnRegistration handles the http request to begin a publisher passkey registration.
+func (h *handler) BeginPublisherWebAuthnRegistration(w http.ResponseWriter, r *http.Request, publisherID api.PublisherIdPathParam) {
+	ctx := r.Context()
+
+	options, err := h.service.BeginPublisherWebAuthnRegistration(ctx, publisherID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPublisherNotFound):
+			notFound(w, codePublisherNotFound, errPublisherNotFound)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	writeWebAuthnOptions(ctx, w, options)
+}
+
+// FinishPublisherWebAuthnRegistration handles the http request to finish a publisher passkey registration.
+func (h *handler) FinishPublisherWebAuthnRegistration(w http.ResponseWriter, r *http.Request, publisherID api.PublisherIdPathParam) {
+	webAuthnFinish, ok := readWebAuthnFinish(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.service.FinishPublisherWebAuthnRegistration(r.Context(), publisherID, webAuthnFinish.CeremonyId, webAuthnFinish.Credential)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPublisherNotFound):
+			notFound(w, codePublisherNotFound, errPublisherNotFound)
+		default:
+			writeWebAuthnRegistrationError(w, err)
+		}
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusNoContent, nil)
+}
+
+// BeginPublisherWebAuthnSignIn handles the http request to begin a publisher passkey sign in.
+func (h *handler) BeginPublisherWebAuthnSignIn(w http.ResponseWriter, r *http.Request) {
+	h.beginWebAuthnSignIn(w, r, h.service.BeginPublisherWebAuthnSignIn)
+}
+
+// FinishPublisherWebAuthnSignIn handles the http request to finish a publisher passkey sign in.
+func (h *handler) FinishPublisherWebAuthnSignIn(w http.ResponseWriter, r *http.Request) {
+	h.finishWebAuthnSignIn(w, r, h.service.FinishPublisherWebAuthnSignIn)
+}
+
+// beginWebAuthnSignIn handles the http request to begin a passkey sign in with the specified service method.
+func (h *handler) beginWebAuthnSignIn(w http.ResponseWriter, r *http.Request, beginWebAuthnSignIn func(ctx context.Context, client domain.SessionClient) (domain.WebAuthnOptions, error)) {
+	ctx := r.Context()
+
+	options, err := beginWebAuthnSignIn(ctx, h.sessionClientFromRequest(r))
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrWebAuthnCeremonyThrottled):
+			tooManyRequests(w, codeWebAuthnCeremonyThrottled, errWebAuthnCeremonyThrottled)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	writeWebAuthnOptions(ctx, w, options)
+}
+
+// finishWebAuthnSignIn handles the http request to finish a passkey sign in with the specified service method.
+func (h *handler) finishWebAuthnSignIn(w http.ResponseWriter, r *http.Request, finishWebAuthnSignIn func(ctx context.Context, ceremonyID uuid.UUID, response []byte, client domain.SessionClient) (domain.Tokens, error)) {
+	ctx := r.Context()
+
+	webAuthnFinish, ok := readWebAuthnFinish(w, r)
+	if !ok {
+		return
+	}
+
+	tokens, err := finishWebAuthnSignIn(ctx, webAuthnFinish.CeremonyId, webAuthnFinish.Credential, h.sessionClientFromRequest(r))
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrWebAuthnCeremonyInvalid):
+			badRequest(w, codeWebAuthnCeremonyInvalid, errWebAuthnCeremonyInvalid)
+		case errors.Is(err, domain.ErrWebAuthnCredentialInvalid):
+			unauthorized(w, codeWebAuthnCredentialInvalid, errWebAuthnCredentialInvalid)
+		default:
+			internalServerError(w)
+		}
+
+		return
+	}
+
+	responseBody, err := json.Marshal(jwtFromDomain(tokens))
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, descriptionFailedToMarshalResponseBody, logging.Error(err))
+		internalServerError(w)
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusOK, responseBody)
+}
+
+// readWebAuthnFinish reads the webauthn ceremony response from the request body. A bad request is written to the
+// response when the body is invalid.
+func readWebAuthnFinish(w http.ResponseWriter, r *http.Request) (api.WebAuthnFinish, bool) {
+	requestBody, err := io.ReadAll(r.Body)
+	if err != nil {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return api.WebAuthnFinish{}, false
+	}
+
+	var webAuthnFinish api.WebAuthnFinish
+
+	err = json.Unmarshal(requestBody, &webAuthnFinish)
+	if err != nil || len(webAuthnFinish.Credential) == 0 {
+		badRequest(w, codeRequestBodyInvalid, errRequestBodyInvalid)
+		return api.WebAuthnFinish{}, false
+	}
+
+	return webAuthnFinish, true
+}
+
+// writeWebAuthnRegistrationError writes the response of a failed passkey registration.
+func writeWebAuthnRegistrationError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrWebAuthnCeremonyInvalid):
+		badRequest(w, codeWebAuthnCeremonyInvalid, errWebAuthnCeremonyInvalid)
+	case errors.Is(err, domain.ErrWebAuthnCredentialInvalid):
+		badRequest(w, codeWebAuthnCredentialInvalid, errWebAuthnCredentialInvalid)
+	case errors.Is(err, domain.ErrWebAuthnCredentialAlreadyExists):
+		conflict(w, codeWebAuthnCredentialAlreadyExists, errWebAuthnCredentialAlreadyExists)
+	default:
+		internalServerError(w)
+	}
+}
+
+// writeWebAuthnOptions writes the options of a started webauthn ceremony to the response.
+func writeWebAuthnOptions(ctx context.Context, w http.ResponseWriter, options domain.WebAuthnOptions) {
+	responseBody, err := json.Marshal(api.WebAuthnOptions{
+		CeremonyId: options.CeremonyID,
+		Options:    options.Options,
+	})
+	if err != nil {
+		logging.Logger.ErrorContext(ctx, descriptionFailedToMarshalResponseBody, logging.Error(err))
+		internalServerError(w)
+
+		return
+	}
+
+	writeResponseJSON(w, http.StatusOK, responseBody)
+}
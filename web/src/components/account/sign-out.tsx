@@ -1,14 +1,21 @@
 import { LogOut } from "lucide-react";
 
 import { Button } from "@/components/ui/button";
-import { clearToken } from "@/lib/auth";
+import { signOutUser } from "@/lib/api";
+import { clearTokens, getRefreshToken } from "@/lib/auth";
 
 export function SignOut() {
   /**
-   * Signs out a user and reloads the current page.
+   * Signs out a user, revoking their session, and reloads the current page.
    */
-  function handleClick() {
-    clearToken();
+  async function handleClick() {
+    try {
+      await signOutUser(getRefreshToken());
+    } catch {
+      // The session expires on its own when it can not be revoked.
+    }
+
+    clearTokens();
     window.location.reload();
   }
 
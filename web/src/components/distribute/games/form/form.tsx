@@ -56,6 +56,7 @@ import { usePublisher } from "@/hooks/use-publisher";
 import { useTags } from "@/hooks/use-tags";
 import { useToast } from "@/hooks/use-toast";
 import {
+  changeGameStatus,
   createGame,
   createGameMultimedia,
   createGameTag,
@@ -190,7 +191,7 @@ const formSchema = z
           message: "Price should have a maximum of 2 decimal cases",
         }),
     ),
-    isActive: z.boolean(),
+    submitForReview: z.boolean(),
   })
   .superRefine((data, ctx) => {
     if (data.releaseDate && !data.downloadMultimedia) {
@@ -242,7 +243,7 @@ export function GameForm(props: GameProps) {
             multimedia: [],
             ageRating: undefined,
             price: undefined,
-            isActive: false,
+            submitForReview: false,
           },
   });
   const { toast } = useToast();
@@ -273,7 +274,6 @@ export function GameForm(props: GameProps) {
         const newGame: NewGame = {
           title: data.title,
           price: removeTax(data.price),
-          isActive: data.isActive,
           description: data.description,
           ageRating: data.ageRating,
           features: data.features,
@@ -321,13 +321,16 @@ export function GameForm(props: GameProps) {
           ),
         );
 
+        if (data.submitForReview) {
+          await changeGameStatus(publisherId, createdGame.id, "submit");
+        }
+
         return;
       }
 
       const editableGame: EditableGame = {
         title: data.title,
         price: removeTax(data.price),
-        isActive: data.isActive,
         description: data.description,
         ageRating: data.ageRating,
         features: data.features,
@@ -416,6 +419,10 @@ export function GameForm(props: GameProps) {
           createGameTag(publisherId, updatedGame.id, tag.id),
         ),
       );
+
+      if (data.submitForReview) {
+        await changeGameStatus(publisherId, updatedGame.id, "submit");
+      }
     },
     onSuccess() {
       toast({
@@ -436,6 +443,24 @@ export function GameForm(props: GameProps) {
       }
 
       if (error instanceof Conflict) {
+        switch (error.code) {
+          case "publisher_not_verified":
+          case "publisher_email_not_verified":
+            toast({
+              variant: "destructive",
+              title: "The game was saved, but could not be submitted for review",
+              description:
+                "Only verified publishers can submit games for review.",
+            });
+            return;
+          case "game_not_editable":
+            toast({
+              variant: "destructive",
+              title: "Only drafts and delisted games can be edited",
+            });
+            return;
+        }
+
         toast({
           variant: "destructive",
           title: "Each screenshot must be unique",
@@ -726,10 +751,10 @@ export function GameForm(props: GameProps) {
 
           <FormField
             control={form.control}
-            name="isActive"
+            name="submitForReview"
             render={({ field }) => (
               <FormItem>
-                <FormLabel>Active</FormLabel>
+                <FormLabel>Submit for Review</FormLabel>
                 <div className="flex items-center h-10">
                   <FormControl>
                     <Switch
@@ -739,7 +764,8 @@ export function GameForm(props: GameProps) {
                   </FormControl>
                 </div>
                 <FormDescription>
-                  Indicates whether the game is displayed in the store
+                  Sends the game to be reviewed once saved, so that it can be
+                  published in the store after it is approved
                 </FormDescription>
               </FormItem>
             )}
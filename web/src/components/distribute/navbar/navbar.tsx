@@ -23,7 +23,8 @@ import {
 } from "@/components/ui/dropdown-menu";
 import { Sheet, SheetContent, SheetTrigger } from "@/components/ui/sheet";
 import { usePublisher } from "@/hooks/use-publisher";
-import { clearToken } from "@/lib/auth";
+import { signOutPublisher } from "@/lib/api";
+import { clearTokens, getRefreshToken } from "@/lib/auth";
 import { getInitials } from "@/lib/utils";
 
 import { NavLink } from "./nav-link";
@@ -32,10 +33,17 @@ function PublisherAccount() {
   const query = usePublisher();
 
   /**
-   * Signs out a publisher and reloads the current page.
+   * Signs out a publisher, revoking their session, and reloads the current
+   * page.
    */
-  function handleSignOut() {
-    clearToken();
+  async function handleSignOut() {
+    try {
+      await signOutPublisher(getRefreshToken());
+    } catch {
+      // The session expires on its own when it can not be revoked.
+    }
+
+    clearTokens();
     window.location.reload();
   }
 
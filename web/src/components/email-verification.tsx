@@ -0,0 +1,66 @@
+import { useEffect } from "react";
+
+import { useMutation } from "@tanstack/react-query";
+import { Link } from "@tanstack/react-router";
+import { LoaderCircle } from "lucide-react";
+
+import { Button } from "@/components/ui/button";
+import {
+  Card,
+  CardDescription,
+  CardFooter,
+  CardHeader,
+  CardTitle,
+} from "@/components/ui/card";
+
+/**
+ * Email verification page. It verifies the email with the token of the link
+ * sent by email as soon as it is opened.
+ */
+export function EmailVerification(props: {
+  token?: string;
+  continuePath: "/account" | "/distribute/account";
+  confirmEmailVerification: (token: string) => Promise<void>;
+}) {
+  const { token, confirmEmailVerification } = props;
+  const mutation = useMutation({
+    async mutationFn(token: string) {
+      await confirmEmailVerification(token);
+    },
+  });
+  const { mutate } = mutation;
+
+  useEffect(() => {
+    if (token) {
+      mutate(token);
+    }
+  }, [token, mutate]);
+
+  let description = "Verifying your email...";
+  if (!token || mutation.isError) {
+    description =
+      "This link is invalid or expired. Please request a new one from your account.";
+  } else if (mutation.isSuccess) {
+    description = "Your email is verified.";
+  }
+
+  return (
+    <Card className="w-full max-w-md bg-background/80 backdrop-blur-sm border-none shadow-2xl">
+      <CardHeader className="space-y-1 flex flex-col items-center">
+        <CardTitle className="text-3xl font-bold tracking-tight">
+          Email Verification
+        </CardTitle>
+        <CardDescription className="text-center">{description}</CardDescription>
+      </CardHeader>
+      <CardFooter className="flex items-center flex-col space-y-4">
+        {mutation.isPending ? (
+          <LoaderCircle className="animate-spin" />
+        ) : (
+          <Button asChild variant="link">
+            <Link to={props.continuePath}>Go to your account</Link>
+          </Button>
+        )}
+      </CardFooter>
+    </Card>
+  );
+}
@@ -0,0 +1,289 @@
+import { useState } from "react";
+import { useForm } from "react-hook-form";
+
+import { zodResolver } from "@hookform/resolvers/zod";
+import { useMutation } from "@tanstack/react-query";
+import { Link, useNavigate } from "@tanstack/react-router";
+import * as z from "zod";
+
+import { Button } from "@/components/ui/button";
+import {
+  Card,
+  CardContent,
+  CardDescription,
+  CardFooter,
+  CardHeader,
+  CardTitle,
+} from "@/components/ui/card";
+import {
+  Form,
+  FormControl,
+  FormField,
+  FormItem,
+  FormLabel,
+  FormMessage,
+} from "@/components/ui/form";
+import { Input } from "@/components/ui/input";
+import { useToast } from "@/hooks/use-toast";
+import { TOAST_MESSAGES } from "@/lib/constants";
+import { BadRequest } from "@/lib/errors";
+import { passwordRefinement } from "@/lib/zod";
+
+const requestFormSchema = z.object({
+  email: z.string().email({
+    message: "Please enter a valid email address",
+  }),
+});
+
+type RequestSchemaType = z.infer<typeof requestFormSchema>;
+
+const confirmFormSchema = z
+  .object({
+    password: z
+      .string()
+      .min(14, {
+        message: "Password must be at least 14 characters long",
+      })
+      .max(72, {
+        message: "Password must be shorter than 72 characters",
+      })
+      .superRefine(passwordRefinement),
+    confirm: z.string().min(1, {
+      message: "Passwords don't match",
+    }),
+  })
+  .refine((data) => data.password === data.confirm, {
+    message: "Passwords don't match",
+    path: ["confirm"],
+  });
+
+type ConfirmSchemaType = z.infer<typeof confirmFormSchema>;
+
+/**
+ * Password reset page. Without a token, it requests a password reset link by
+ * email. With the token of a link, it sets a new password.
+ */
+export function PasswordReset(props: {
+  token?: string;
+  signInPath: "/signin" | "/distribute/signin";
+  requestPasswordReset: (email: string) => Promise<void>;
+  confirmPasswordReset: (token: string, password: string) => Promise<void>;
+}) {
+  return (
+    <Card className="w-full max-w-md bg-background/80 backdrop-blur-sm border-none shadow-2xl">
+      {props.token ? (
+        <ConfirmForm
+          token={props.token}
+          signInPath={props.signInPath}
+          confirmPasswordReset={props.confirmPasswordReset}
+        />
+      ) : (
+        <RequestForm
+          signInPath={props.signInPath}
+          requestPasswordReset={props.requestPasswordReset}
+        />
+      )}
+    </Card>
+  );
+}
+
+function RequestForm(props: {
+  signInPath: "/signin" | "/distribute/signin";
+  requestPasswordReset: (email: string) => Promise<void>;
+}) {
+  const [requested, setRequested] = useState(false);
+  const form = useForm<RequestSchemaType>({
+    resolver: zodResolver(requestFormSchema),
+    defaultValues: {
+      email: "",
+    },
+  });
+  const { toast } = useToast();
+  const mutation = useMutation({
+    async mutationFn(data: RequestSchemaType) {
+      await props.requestPasswordReset(data.email);
+    },
+    onSuccess() {
+      setRequested(true);
+    },
+    onError(error) {
+      if (error instanceof BadRequest) {
+        form.setError("email", {
+          message: "Please enter a valid email address",
+        });
+        return;
+      }
+
+      toast(TOAST_MESSAGES.unexpectedError);
+    },
+  });
+
+  /**
+   * Handles form submission.
+   * @param data Form data.
+   */
+  function onSubmit(data: RequestSchemaType) {
+    mutation.mutate(data);
+  }
+
+  return (
+    <Form {...form}>
+      <form onSubmit={form.handleSubmit(onSubmit)}>
+        <CardHeader className="space-y-1 flex flex-col items-center">
+          <CardTitle className="text-3xl font-bold tracking-tight">
+            Reset Password
+          </CardTitle>
+          <CardDescription className="text-center">
+            {requested
+              ? "If an account exists for this email, a link to reset its password is on its way."
+              : "Enter the email of your account to receive a link to reset its password."}
+          </CardDescription>
+        </CardHeader>
+        {!requested && (
+          <CardContent className="space-y-4">
+            <FormField
+              control={form.control}
+              name="email"
+              render={({ field }) => (
+                <FormItem>
+                  <FormLabel>Email</FormLabel>
+                  <FormControl>
+                    <Input placeholder="Enter your email" {...field} />
+                  </FormControl>
+                  <FormMessage />
+                </FormItem>
+              )}
+            />
+          </CardContent>
+        )}
+        <CardFooter className="flex items-center flex-col space-y-4">
+          {!requested && (
+            <Button
+              className="w-full text-primary-foreground font-semibold"
+              type="submit"
+              disabled={mutation.isPending}
+            >
+              Send Link
+            </Button>
+          )}
+          <Button asChild variant="link">
+            <Link to={props.signInPath}>Back to sign in</Link>
+          </Button>
+        </CardFooter>
+      </form>
+    </Form>
+  );
+}
+
+function ConfirmForm(props: {
+  token: string;
+  signInPath: "/signin" | "/distribute/signin";
+  confirmPasswordReset: (token: string, password: string) => Promise<void>;
+}) {
+  const form = useForm<ConfirmSchemaType>({
+    resolver: zodResolver(confirmFormSchema),
+    defaultValues: {
+      password: "",
+      confirm: "",
+    },
+  });
+  const { toast } = useToast();
+  const navigate = useNavigate();
+  const mutation = useMutation({
+    async mutationFn(data: ConfirmSchemaType) {
+      await props.confirmPasswordReset(props.token, data.password);
+    },
+    async onSuccess() {
+      toast({
+        title: "Password reset successfully",
+      });
+      await navigate({ to: props.signInPath });
+    },
+    onError(error) {
+      if (error instanceof BadRequest) {
+        switch (error.code) {
+          case "password_compromised":
+            form.setError("password", {
+              message:
+                "This password appeared in a data breach, please choose another one",
+            });
+            return;
+          case "password_reset_token_invalid":
+            toast({
+              variant: "destructive",
+              title: "This link is invalid or expired",
+              description: "Please request a new password reset link.",
+            });
+            return;
+        }
+      }
+
+      toast(TOAST_MESSAGES.unexpectedError);
+    },
+  });
+
+  /**
+   * Handles form submission.
+   * @param data Form data.
+   */
+  function onSubmit(data: ConfirmSchemaType) {
+    mutation.mutate(data);
+  }
+
+  return (
+    <Form {...form}>
+      <form onSubmit={form.handleSubmit(onSubmit)}>
+        <CardHeader className="space-y-1 flex flex-col items-center">
+          <CardTitle className="text-3xl font-bold tracking-tight">
+            Reset Password
+          </CardTitle>
+          <CardDescription className="text-center">
+            Choose a new password. You will be signed out everywhere.
+          </CardDescription>
+        </CardHeader>
+        <CardContent className="space-y-4">
+          <FormField
+            control={form.control}
+            name="password"
+            render={({ field }) => (
+              <FormItem>
+                <FormLabel>New Password</FormLabel>
+                <FormControl>
+                  <Input
+                    placeholder="Enter your new password"
+                    type="password"
+                    {...field}
+                  />
+                </FormControl>
+                <FormMessage />
+              </FormItem>
+            )}
+          />
+
+          <FormField
+            control={form.control}
+            name="confirm"
+            render={({ field }) => (
+              <FormItem>
+                <FormLabel>Confirm Password</FormLabel>
+                <FormControl>
+                  <Input type="password" {...field} />
+                </FormControl>
+                <FormMessage />
+              </FormItem>
+            )}
+          />
+        </CardContent>
+        <CardFooter className="flex items-center flex-col space-y-4">
+          <Button
+            className="w-full text-primary-foreground font-semibold"
+            type="submit"
+            disabled={mutation.isPending}
+          >
+            Reset Password
+          </Button>
+        </CardFooter>
+      </form>
+    </Form>
+  );
+}
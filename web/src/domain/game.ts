@@ -2,6 +2,21 @@ import { Multimedia } from "./multimedia";
 import { Publisher } from "./publisher";
 import { Tag } from "./tag";
 
+/**
+ * Represents the status of a game in its lifecycle.
+ */
+export type GameStatus =
+  | "draft"
+  | "in_review"
+  | "approved"
+  | "published"
+  | "delisted";
+
+/**
+ * Represents a status transition that a publisher can request for its game.
+ */
+export type GameStatusTransition = "submit" | "publish" | "delist";
+
 /**
  * Represents a game that is not announced.
  */
@@ -10,7 +25,8 @@ interface ToBeAnnouncedGame {
   publisher: Publisher;
   title: string;
   price: number;
-  isActive: boolean;
+  status: GameStatus;
+  reviewNotes?: string;
   description: string;
   ageRating: string;
   features: string;
@@ -60,7 +76,7 @@ export interface GamesFilters {
   title?: string;
   priceUnder?: number;
   priceAbove?: number;
-  isActive?: boolean;
+  status?: GameStatus;
   releaseDateBefore?: string;
   releaseDateAfter?: string;
   tagIds?: string[];
@@ -81,7 +97,6 @@ export interface RecommendedGamesFilters {
 export interface NewGame {
   title: string;
   price: number;
-  isActive: boolean;
   releaseDate?: string;
   description: string;
   ageRating: string;
@@ -101,7 +116,6 @@ export interface NewGame {
 export interface EditableGame {
   title?: string;
   price?: number;
-  isActive?: boolean;
   releaseDate?: string;
   description?: string;
   ageRating?: string;
@@ -3,6 +3,7 @@
  */
 export interface Jwt {
   token: string;
+  refreshToken: string;
 }
 
 /**
@@ -3,6 +3,7 @@ import {
   EditableGame,
   Game,
   GamesFilters,
+  GameStatusTransition,
   NewGame,
   PaginatedGames,
   RecommendedGamesFilters,
@@ -26,7 +27,13 @@ import {
   UserGameLibraryFilters,
 } from "@/domain/user";
 
-import { getToken } from "./auth";
+import {
+  clearTokens,
+  decodeTokenPayload,
+  getRefreshToken,
+  getToken,
+  storeTokens,
+} from "./auth";
 import {
   BadRequest,
   Conflict,
@@ -42,6 +49,121 @@ import {
  */
 const DEFAULT_TIMEOUT = 1000 * 60;
 
+/**
+ * Represents the tokens refresh in progress, shared by the requests that fail
+ * while it runs, so that the refresh token is only exchanged once.
+ */
+let refreshingTokens: Promise<string> | undefined;
+
+/**
+ * Exchanges the refresh token of the signed in subject for new tokens, which
+ * are stored in place of the previous ones.
+ * @returns New access token.
+ * @throws {TokenMissing} When the tokens are not found.
+ * @throws {Unauthorized} Refresh token is invalid.
+ * @throws {InternalServerError} Server internal error.
+ */
+async function requestRefreshedTokens() {
+  const refreshToken = getRefreshToken();
+  const { roles } = decodeTokenPayload(getToken());
+  const subject = roles.includes("publisher") ? "publishers" : "users";
+
+  const response = await fetch(`/api/${subject}/refresh`, {
+    signal: AbortSignal.timeout(DEFAULT_TIMEOUT),
+    method: "POST",
+    headers: {
+      "Content-Type": "application/json",
+    },
+    body: JSON.stringify({ refreshToken }),
+  });
+
+  if (response.status >= 400) {
+    const error = (await response.json()) as ApiError;
+    switch (response.status) {
+      case 401:
+        // The session was revoked or the refresh token expired.
+        clearTokens();
+        throw new Unauthorized(error.code, error.message);
+      default:
+        throw new InternalServerError();
+    }
+  }
+
+  const jwt = (await response.json()) as Jwt;
+  storeTokens(jwt);
+
+  return jwt.token;
+}
+
+/**
+ * Refreshes the tokens of the signed in subject, joining the refresh in
+ * progress, if any.
+ * @returns New access token.
+ */
+function refreshTokens() {
+  refreshingTokens ??= requestRefreshedTokens().finally(() => {
+    refreshingTokens = undefined;
+  });
+
+  return refreshingTokens;
+}
+
+/**
+ * Fetches a resource with the access token of the signed in subject. When the
+ * access token is rejected, the tokens are refreshed and the request is sent
+ * once more.
+ * @param input Resource URL.
+ * @param init Request options.
+ * @returns Response.
+ * @throws {TokenMissing} When the access token is not found.
+ */
+async function authorizedFetch(input: string, init: RequestInit) {
+  const token = getToken();
+  const headers = new Headers(init.headers);
+  headers.set("Authorization", `Bearer ${token}`);
+
+  const response = await fetch(input, { ...init, headers });
+  if (response.status !== 401) {
+    return response;
+  }
+
+  let newToken: string;
+  try {
+    // Another request may have refreshed the tokens in the meantime.
+    const currentToken = getToken();
+    newToken = currentToken !== token ? currentToken : await refreshTokens();
+  } catch {
+    return response;
+  }
+
+  headers.set("Authorization", `Bearer ${newToken}`);
+
+  return fetch(input, { ...init, headers });
+}
+
+/**
+ * Fetches a resource with the access token of the signed in subject, if any,
+ * for the operations that tailor their response to it.
+ * @param input Resource URL.
+ * @param init Request options.
+ * @returns Response.
+ */
+async function optionallyAuthorizedFetch(input: string, init: RequestInit) {
+  try {
+    getToken();
+  } catch {
+    return fetch(input, init);
+  }
+
+  const response = await authorizedFetch(input, init);
+  if (response.status !== 401) {
+    return response;
+  }
+
+  // The session of the subject ended, so the resource is fetched anonymously.
+  return fetch(input, init);
+}
+
 /**
  * Creates a new user.
  * @param newUser User to be created.
@@ -106,6 +228,118 @@ export async function signInUser(credentials: UserCredentials) {
   return jwt;
 }
 
+/**
+ * Signs out a user by revoking the session of their refresh token.
+ * @param refreshToken Refresh token.
+ * @throws {Unauthorized} Refresh token is invalid.
+ * @throws {InternalServerError} Server internal error.
+ */
+export async function signOutUser(refreshToken: string) {
+  const response = await fetch("/api/users/signout", {
+    signal: AbortSignal.timeout(DEFAULT_TIMEOUT),
+    method: "POST",
+    headers: {
+      "Content-Type": "application/json",
+    },
+    body: JSON.stringify({ refreshToken }),
+  });
+
+  if (response.status >= 400) {
+    const error = (await response.json()) as ApiError;
+    switch (response.status) {
+      case 401:
+        throw new Unauthorized(error.code, error.message);
+      default:
+        throw new InternalServerError();
+    }
+  }
+}
+
+/**
+ * Requests a user password reset link by email.
+ * @param email User email.
+ * @throws {BadRequest} Invalid email.
+ * @throws {InternalServerError} Server internal error.
+ */
+export async function requestUserPasswordReset(email: string) {
+  const response = await fetch("/api/users/password-reset", {
+    signal: AbortSignal.timeout(DEFAULT_TIMEOUT),
+    method: "POST",
+    headers: {
+      "Content-Type": "application/json",
+    },
+    body: JSON.stringify({ email }),
+  });
+
+  if (response.status >= 400) {
+    const error = (await response.json()) as ApiError;
+    switch (response.status) {
+      case 400:
+        throw new BadRequest(error.code, error.message);
+      default:
+        throw new InternalServerError();
+    }
+  }
+}
+
+/**
+ * Sets a new user password with the token of a password reset link.
+ * @param token Password reset token.
+ * @param password New password.
+ * @throws {BadRequest} Invalid or compromised password, or invalid token.
+ * @throws {InternalServerError} Server internal error.
+ */
+export async function confirmUserPasswordReset(
+  token: string,
+  password: string,
+) {
+  const response = await fetch("/api/users/password-reset/confirm", {
+    signal: AbortSignal.timeout(DEFAULT_TIMEOUT),
+    method: "POST",
+    headers: {
+      "Content-Type": "application/json",
+    },
+    body: JSON.stringify({ token, password }),
+  });
+
+  if (response.status >= 400) {
+    const error = (await response.json()) as ApiError;
+    switch (response.status) {
+      case 400:
+        throw new BadRequest(error.code, error.message);
+      default:
+        throw new InternalServerError();
+    }
+  }
+}
+
+/**
+ * Verifies a user email with the token of an email verification link.
+ * @param token Email verification token.
+ * @throws {BadRequest} Invalid token.
+ * @throws {InternalServerError} Server internal error.
+ */
+export async function confirmUserEmailVerification(token: string) {
+  const response = await fetch("/api/users/email-verification/confirm", {
+    signal: AbortSignal.timeout(DEFAULT_TIMEOUT),
+    method: "POST",
+    headers: {
+      "Content-Type": "application/json",
+    },
+    body: JSON.stringify({ token }),
+  });
+
+  if (response.status >= 400) {
+    const error = (await response.json()) as ApiError;
+    switch (response.status) {
+      case 400:
+        throw new BadRequest(error.code, error.message);
+      default:
+        throw new InternalServerError();
+    }
+  }
+}
+
 /**
  * Retrieves a user given a user ID.
  * @param id User ID.
@@ -116,13 +350,8 @@ export async function signInUser(credentials: UserCredentials) {
  * @throws {InternalServerError} Server internal error.
  */
 export async function getUser(id: string) {
-  const token = getToken();
-
-  const response = await fetch(`/api/users/${id}`, {
+  const response = await authorizedFetch(`/api/users/${id}`, {
     signal: AbortSignal.timeout(DEFAULT_TIMEOUT),
-    headers: {
-      Authorization: `Bearer ${token}`,
-    },
   });
 
   if (response.status >= 400) {
@@ -157,8 +386,6 @@ export async function getUserGameLibrary(
   userId: string,
   filters?: UserGameLibraryFilters,
 ) {
-  const token = getToken();
-
   const searchParams = new URLSearchParams();
   if (filters?.limit) {
     searchParams.set("limit", String(filters.limit));
@@ -173,12 +400,12 @@ export async function getUserGameLibrary(
     searchParams.set("order", filters.order);
   }
 
-  const response = await fetch(`/api/users/${userId}/games?${searchParams}`, {
-    signal: AbortSignal.timeout(DEFAULT_TIMEOUT),
-    headers: {
-      Authorization: `Bearer ${token}`,
+  const response = await authorizedFetch(
+    `/api/users/${userId}/games?${searchParams}`,
+    {
+      signal: AbortSignal.timeout(DEFAULT_TIMEOUT),
     },
-  });
+  );
 
   if (response.status >= 400) {
     const error = (await response.json()) as ApiError;
@@ -209,13 +436,10 @@ export async function getUserGameLibrary(
  * @throws {InternalServerError} Server internal error.
  */
 export async function updateUser(id: string, details: EditableUser) {
-  const token = getToken();
-
-  const response = await fetch(`/api/users/${id}`, {
+  const response = await authorizedFetch(`/api/users/${id}`, {
     signal: AbortSignal.timeout(DEFAULT_TIMEOUT),
     method: "PATCH",
     headers: {
-      Authorization: `Bearer ${token}`,
       "Content-Type": "application/json",
     },
     body: JSON.stringify(details),
@@ -306,6 +530,118 @@ export async function signInPublisher(credentials: PublisherCredentials) {
   return jwt;
 }
 
+/**
+ * Signs out a publisher by revoking the session of their refresh token.
+ * @param refreshToken Refresh token.
+ * @throws {Unauthorized} Refresh token is invalid.
+ * @throws {InternalServerError} Server internal error.
+ */
+export async function signOutPublisher(refreshToken: string) {
+  const response = await fetch("/api/publishers/signout", {
+    signal: AbortSignal.timeout(DEFAULT_TIMEOUT),
+    method: "POST",
+    headers: {
+      "Content-Type": "application/json",
+    },
+    body: JSON.stringify({ refreshToken }),
+  });
+
+  if (response.status >= 400) {
+    const error = (await response.json()) as ApiError;
+    switch (response.status) {
+      case 401:
+        throw new Unauthorized(error.code, error.message);
+      default:
+        throw new InternalServerError();
+    }
+  }
+}
+
+/**
+ * Requests a publisher password reset link by email.
+ * @param email Publisher email.
+ * @throws {BadRequest} Invalid email.
+ * @throws {InternalServerError} Server internal error.
+ */
+export async function requestPublisherPasswordReset(email: string) {
+  const response = await fetch("/api/publishers/password-reset", {
+    signal: AbortSignal.timeout(DEFAULT_TIMEOUT),
+    method: "POST",
+    headers: {
+      "Content-Type": "application/json",
+    },
+    body: JSON.stringify({ email }),
+  });
+
+  if (response.status >= 400) {
+    const error = (await response.json()) as ApiError;
+    switch (response.status) {
+      case 400:
+        throw new BadRequest(error.code, error.message);
+      default:
+        throw new InternalServerError();
+    }
+  }
+}
+
+/**
+ * Sets a new publisher password with the token of a password reset link.
+ * @param token Password reset token.
+ * @param password New password.
+ * @throws {BadRequest} Invalid or compromised password, or invalid token.
+ * @throws {InternalServerError} Server internal error.
+ */
+export async function confirmPublisherPasswordReset(
+  token: string,
+  password: string,
+) {
+  const response = await fetch("/api/publishers/password-reset/confirm", {
+    signal: AbortSignal.timeout(DEFAULT_TIMEOUT),
+    method: "POST",
+    headers: {
+      "Content-Type": "application/json",
+    },
+    body: JSON.stringify({ token, password }),
+  });
+
+  if (response.status >= 400) {
+    const error = (await response.json()) as ApiError;
+    switch (response.status) {
+      case 400:
+        throw new BadRequest(error.code, error.message);
+      default:
+        throw new InternalServerError();
+    }
+  }
+}
+
+/**
+ * Verifies a publisher email with the token of an email verification link.
+ * @param token Email verification token.
+ * @throws {BadRequest} Invalid token.
+ * @throws {InternalServerError} Server internal error.
+ */
+export async function confirmPublisherEmailVerification(token: string) {
+  const response = await fetch("/api/publishers/email-verification/confirm", {
+    signal: AbortSignal.timeout(DEFAULT_TIMEOUT),
+    method: "POST",
+    headers: {
+      "Content-Type": "application/json",
+    },
+    body: JSON.stringify({ token }),
+  });
+
+  if (response.status >= 400) {
+    const error = (await response.json()) as ApiError;
+    switch (response.status) {
+      case 400:
+        throw new BadRequest(error.code, error.message);
+      default:
+        throw new InternalServerError();
+    }
+  }
+}
+
 /**
  * Retrieves a publisher given a publisher ID.
  * @param id Publisher ID.
@@ -314,13 +650,8 @@ export async function signInPublisher(credentials: PublisherCredentials) {
  * @throws {InternalServerError} Server internal error.
  */
 export async function getPublisher(id: string) {
-  const token = getToken();
-
-  const response = await fetch(`/api/publishers/${id}`, {
+  const response = await authorizedFetch(`/api/publishers/${id}`, {
     signal: AbortSignal.timeout(DEFAULT_TIMEOUT),
-    headers: {
-      Authorization: `Bearer ${token}`,
-    },
   });
 
   if (response.status >= 400) {
@@ -350,13 +681,10 @@ export async function getPublisher(id: string) {
  * @throws {InternalServerError} Server internal error.
  */
 export async function updatePublisher(id: string, details: EditablePublisher) {
-  const token = getToken();
-
-  const response = await fetch(`/api/publishers/${id}`, {
+  const response = await authorizedFetch(`/api/publishers/${id}`, {
     signal: AbortSignal.timeout(DEFAULT_TIMEOUT),
     method: "PATCH",
     headers: {
-      Authorization: `Bearer ${token}`,
       "Content-Type": "application/json",
     },
     body: JSON.stringify(details),
@@ -393,17 +721,12 @@ export async function updatePublisher(id: string, details: EditablePublisher) {
  * @throws {InternalServerError} Server internal error.
  */
 export async function uploadMultimedia(file: File) {
-  const token = getToken();
-
   const formData = new FormData();
   formData.set("file", file);
 
-  const response = await fetch("/api/multimedia", {
+  const response = await authorizedFetch("/api/multimedia", {
     signal: AbortSignal.timeout(DEFAULT_TIMEOUT),
     method: "PUT",
-    headers: {
-      Authorization: `Bearer ${token}`,
-    },
     body: formData,
   });
 
@@ -427,7 +750,8 @@ export async function uploadMultimedia(file: File) {
 }
 
 /**
- * Retrieves the games.
+ * Retrieves the games. Only published games are retrieved, unless the
+ * signed in subject is an admin or the publisher in the filters.
  * @param filters Filters.
  * @returns Paginated games.
  * @throws {InternalServerError} Server internal error.
@@ -458,8 +782,8 @@ export async function getGames(filters: GamesFilters) {
   if (filters.priceAbove !== undefined) {
     searchParams.set("priceAbove", String(filters.priceAbove));
   }
-  if (filters.isActive) {
-    searchParams.set("isActive", String(filters.isActive));
+  if (filters.status) {
+    searchParams.set("status", filters.status);
   }
   if (filters.releaseDateBefore) {
     searchParams.set("releaseDateBefore", filters.releaseDateBefore);
@@ -473,9 +797,12 @@ export async function getGames(filters: GamesFilters) {
     );
   }
 
-  const response = await fetch(`/api/games?${searchParams}`, {
-    signal: AbortSignal.timeout(DEFAULT_TIMEOUT),
-  });
+  const response = await optionallyAuthorizedFetch(
+    `/api/games?${searchParams}`,
+    {
+      signal: AbortSignal.timeout(DEFAULT_TIMEOUT),
+    },
+  );
 
   if (response.status >= 400) {
     throw new InternalServerError();
@@ -518,7 +845,8 @@ export async function getRecommendedGames(filters: RecommendedGamesFilters) {
 }
 
 /**
- * Retrieves a game from a publisher.
+ * Retrieves a game from a publisher. Games that are not published are only
+ * retrieved by admins and by their publisher.
  * @param publisherId Publisher ID.
  * @returns Game of a publisher.
  * @throws {BadRequest} Invalid parameters.
@@ -526,7 +854,7 @@ export async function getRecommendedGames(filters: RecommendedGamesFilters) {
  * @throws {InternalServerError} Server internal error.
  */
 export async function getPublisherGame(publisherId: string, gameId: string) {
-  const response = await fetch(
+  const response = await optionallyAuthorizedFetch(
     `/api/publishers/${publisherId}/games/${gameId}`,
     {
       signal: AbortSignal.timeout(DEFAULT_TIMEOUT),
@@ -562,17 +890,17 @@ export async function getPublisherGame(publisherId: string, gameId: string) {
  * @throws {InternalServerError} Server internal error.
  */
 export async function createGame(publisherId: string, newGame: NewGame) {
-  const token = getToken();
-
-  const response = await fetch(`/api/publishers/${publisherId}/games`, {
-    signal: AbortSignal.timeout(DEFAULT_TIMEOUT),
-    method: "POST",
-    headers: {
-      Authorization: `Bearer ${token}`,
-      "Content-Type": "application/json",
+  const response = await authorizedFetch(
+    `/api/publishers/${publisherId}/games`,
+    {
+      signal: AbortSignal.timeout(DEFAULT_TIMEOUT),
+      method: "POST",
+      headers: {
+        "Content-Type": "application/json",
+      },
+      body: JSON.stringify(newGame),
     },
-    body: JSON.stringify(newGame),
-  });
+  );
 
   if (response.status >= 400) {
     const error = (await response.json()) as ApiError;
@@ -612,15 +940,12 @@ export async function updateGame(
   gameId: string,
   details: EditableGame,
 ) {
-  const token = getToken();
-
-  const response = await fetch(
+  const response = await authorizedFetch(
     `/api/publishers/${publisherId}/games/${gameId}`,
     {
       signal: AbortSignal.timeout(DEFAULT_TIMEOUT),
       method: "PATCH",
       headers: {
-        Authorization: `Bearer ${token}`,
         "Content-Type": "application/json",
       },
       body: JSON.stringify(details),
@@ -648,6 +973,52 @@ export async function updateGame(
   return game;
 }
 
+/**
+ * Moves a game from a publisher to another status of its lifecycle.
+ * @param publisherId Publisher ID.
+ * @param gameId Game ID.
+ * @param transition Status transition.
+ * @returns Updated game.
+ * @throws {Unauthorized} Access token is invalid.
+ * @throws {Forbidden} Forbidden access.
+ * @throws {NotFound} Game not found.
+ * @throws {Conflict} Transition not allowed from the status of the game. Or publisher not verified.
+ * @throws {InternalServerError} Server internal error.
+ */
+export async function changeGameStatus(
+  publisherId: string,
+  gameId: string,
+  transition: GameStatusTransition,
+) {
+  const response = await authorizedFetch(
+    `/api/publishers/${publisherId}/games/${gameId}/${transition}`,
+    {
+      signal: AbortSignal.timeout(DEFAULT_TIMEOUT),
+      method: "POST",
+    },
+  );
+
+  if (response.status >= 400) {
+    const error = (await response.json()) as ApiError;
+    switch (response.status) {
+      case 401:
+        throw new Unauthorized(error.code, error.message);
+      case 403:
+        throw new Forbidden(error.code, error.message);
+      case 404:
+        throw new NotFound(error.code, error.message);
+      case 409:
+        throw new Conflict(error.code, error.message);
+      default:
+        throw new InternalServerError();
+    }
+  }
+
+  const game = (await response.json()) as Game;
+
+  return game;
+}
+
 /**
  * Creates a game multimedia association.
  * @param publisherId Publisher ID.
@@ -666,15 +1037,12 @@ export async function createGameMultimedia(
   multimediaId: string,
   data: CreateGameMultimedia,
 ) {
-  const token = getToken();
-
-  const response = await fetch(
+  const response = await authorizedFetch(
     `/api/publishers/${publisherId}/games/${gameId}/multimedia/${multimediaId}`,
     {
       signal: AbortSignal.timeout(DEFAULT_TIMEOUT),
       method: "POST",
       headers: {
-        Authorization: `Bearer ${token}`,
         "Content-Type": "application/json",
       },
       body: JSON.stringify(data),
@@ -713,16 +1081,11 @@ export async function deleteGameMultimedia(
   gameId: string,
   multimediaId: string,
 ) {
-  const token = getToken();
-
-  const response = await fetch(
+  const response = await authorizedFetch(
     `/api/publishers/${publisherId}/games/${gameId}/multimedia/${multimediaId}`,
     {
       signal: AbortSignal.timeout(DEFAULT_TIMEOUT),
       method: "DELETE",
-      headers: {
-        Authorization: `Bearer ${token}`,
-      },
     },
   );
 
@@ -757,16 +1120,11 @@ export async function createGameTag(
   gameId: string,
   tagId: string,
 ) {
-  const token = getToken();
-
-  const response = await fetch(
+  const response = await authorizedFetch(
     `/api/publishers/${publisherId}/games/${gameId}/tags/${tagId}`,
     {
       signal: AbortSignal.timeout(DEFAULT_TIMEOUT),
       method: "POST",
-      headers: {
-        Authorization: `Bearer ${token}`,
-      },
     },
   );
 
@@ -802,16 +1160,11 @@ export async function deleteGameTag(
   gameId: string,
   tagId: string,
 ) {
-  const token = getToken();
-
-  const response = await fetch(
+  const response = await authorizedFetch(
     `/api/publishers/${publisherId}/games/${gameId}/tags/${tagId}`,
     {
       signal: AbortSignal.timeout(DEFAULT_TIMEOUT),
       method: "DELETE",
-      headers: {
-        Authorization: `Bearer ${token}`,
-      },
     },
   );
 
@@ -878,8 +1231,6 @@ export async function getUserCartGames(
   userId: string,
   filters?: UserCartGamesFilters,
 ) {
-  const token = getToken();
-
   const searchParams = new URLSearchParams();
   if (filters?.limit) {
     searchParams.set("limit", String(filters.limit));
@@ -894,13 +1245,10 @@ export async function getUserCartGames(
     searchParams.set("order", filters.order);
   }
 
-  const response = await fetch(
+  const response = await authorizedFetch(
     `/api/users/${userId}/cart/games?${searchParams}`,
     {
       signal: AbortSignal.timeout(DEFAULT_TIMEOUT),
-      headers: {
-        Authorization: `Bearer ${token}`,
-      },
     },
   );
 
@@ -935,15 +1283,13 @@ export async function getUserCartGames(
  * @throws {InternalServerError} Server internal error.
  */
 export async function createUserCartGame(userId: string, gameId: string) {
-  const token = getToken();
-
-  const response = await fetch(`/api/users/${userId}/cart/games/${gameId}`, {
-    signal: AbortSignal.timeout(DEFAULT_TIMEOUT),
-    method: "POST",
-    headers: {
-      Authorization: `Bearer ${token}`,
+  const response = await authorizedFetch(
+    `/api/users/${userId}/cart/games/${gameId}`,
+    {
+      signal: AbortSignal.timeout(DEFAULT_TIMEOUT),
+      method: "POST",
     },
-  });
+  );
 
   if (response.status >= 400) {
     const error = (await response.json()) as ApiError;
@@ -972,15 +1318,13 @@ export async function createUserCartGame(userId: string, gameId: string) {
  * @throws {InternalServerError} Server internal error.
  */
 export async function deleteUserCartGame(userId: string, gameId: string) {
-  const token = getToken();
-
-  const response = await fetch(`/api/users/${userId}/cart/games/${gameId}`, {
-    method: "DELETE",
-    signal: AbortSignal.timeout(DEFAULT_TIMEOUT),
-    headers: {
-      Authorization: `Bearer ${token}`,
+  const response = await authorizedFetch(
+    `/api/users/${userId}/cart/games/${gameId}`,
+    {
+      method: "DELETE",
+      signal: AbortSignal.timeout(DEFAULT_TIMEOUT),
     },
-  });
+  );
 
   if (response.status >= 400) {
     const error = (await response.json()) as ApiError;
@@ -1007,14 +1351,9 @@ export async function deleteUserCartGame(userId: string, gameId: string) {
  * @throws {InternalServerError} Server internal error.
  */
 export async function purchaseUserCart(userId: string) {
-  const token = getToken();
-
-  const response = await fetch(`/api/users/${userId}/cart/purchase`, {
+  const response = await authorizedFetch(`/api/users/${userId}/cart/purchase`, {
     method: "POST",
     signal: AbortSignal.timeout(DEFAULT_TIMEOUT),
-    headers: {
-      Authorization: `Bearer ${token}`,
-    },
   });
 
   if (response.status >= 400) {
@@ -1,4 +1,4 @@
-import { TokenPayload } from "@/domain/jwt";
+import { Jwt, TokenPayload } from "@/domain/jwt";
 
 import { TokenMissing, TokenPayloadMissing } from "./errors";
 
@@ -8,22 +8,60 @@ import { TokenMissing, TokenPayloadMissing } from "./errors";
 const TOKEN_COOKIE_NAME = "token";
 
 /**
- * Retrieves subject token.
- * @returns Token.
- * @throws {TokenMissing} When the token is not found.
+ * Cookie name for the refresh token.
  */
-export function getToken(): string {
+const REFRESH_TOKEN_COOKIE_NAME = "refreshToken";
+
+/**
+ * Refresh token expiration time in milliseconds, as issued by the server.
+ */
+const REFRESH_TOKEN_EXPIRATION_TIME = 1000 * 60 * 60 * 24 * 30;
+
+/**
+ * Retrieves a cookie value.
+ * @param name Cookie name.
+ * @returns Cookie value, if found.
+ */
+function getCookie(name: string): string | undefined {
   // Get individual name=value cookies.
   const cookies = document.cookie.split(";");
 
   for (const cookie of cookies) {
     const [cookieName, cookieValue] = cookie.split("=");
-    if (TOKEN_COOKIE_NAME == cookieName.trim()) {
+    if (name == cookieName.trim()) {
       return decodeURIComponent(cookieValue);
     }
   }
 
-  throw new TokenMissing();
+  return undefined;
+}
+
+/**
+ * Retrieves subject token.
+ * @returns Token.
+ * @throws {TokenMissing} When the token is not found.
+ */
+export function getToken(): string {
+  const token = getCookie(TOKEN_COOKIE_NAME);
+  if (!token) {
+    throw new TokenMissing();
+  }
+
+  return token;
+}
+
+/**
+ * Retrieves subject refresh token.
+ * @returns Refresh token.
+ * @throws {TokenMissing} When the refresh token is not found.
+ */
+export function getRefreshToken(): string {
+  const refreshToken = getCookie(REFRESH_TOKEN_COOKIE_NAME);
+  if (!refreshToken) {
+    throw new TokenMissing();
+  }
+
+  return refreshToken;
 }
 
 /**
@@ -46,20 +84,23 @@ export function decodeTokenPayload(token: string): TokenPayload {
 }
 
 /**
- * Stores token in cookies.
- * @param token JWT token.
- * @param expirationTime JWT expiration time.
+ * Stores the tokens in cookies. The access token is kept as long as the
+ * refresh token, so that it can be refreshed once it expires.
+ * @param jwt JWT and refresh token.
  */
-export function storeToken(token: string, expirationTime: number) {
-  const expireTimeInMs = expirationTime * 1000;
-  const expireDate = new Date(expireTimeInMs).toUTCString();
+export function storeTokens(jwt: Jwt) {
+  const expireDate = new Date(
+    Date.now() + REFRESH_TOKEN_EXPIRATION_TIME,
+  ).toUTCString();
 
-  document.cookie = `token=${token}; Path=/; Expires=${expireDate}; SameSite=Strict; Secure`;
+  document.cookie = `${TOKEN_COOKIE_NAME}=${jwt.token}; Path=/; Expires=${expireDate}; SameSite=Strict; Secure`;
+  document.cookie = `${REFRESH_TOKEN_COOKIE_NAME}=${encodeURIComponent(jwt.refreshToken)}; Path=/; Expires=${expireDate}; SameSite=Strict; Secure`;
 }
 
 /**
- * Clears token in cookies.
+ * Clears the tokens in cookies.
  */
-export function clearToken() {
-  document.cookie = `token=; Path=/; Expires=Thu, 01 Jan 1970 00:00:01 GMT;`;
+export function clearTokens() {
+  document.cookie = `${TOKEN_COOKIE_NAME}=; Path=/; Expires=Thu, 01 Jan 1970 00:00:01 GMT;`;
+  document.cookie = `${REFRESH_TOKEN_COOKIE_NAME}=; Path=/; Expires=Thu, 01 Jan 1970 00:00:01 GMT;`;
 }
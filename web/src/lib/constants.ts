@@ -1,3 +1,5 @@
+import { GameStatus } from "@/domain/game";
+
 /**
  * Map where the key is the country code and the value is the country information.
  * ISO 3166 Alpha-2 Format.
@@ -305,6 +307,22 @@ export const TOAST_MESSAGES = {
  */
 export const TO_BE_ANNOUNCED = "To be announced";
 
+/**
+ * Labels displayed for each game status.
+ */
+export const GAME_STATUS_LABELS: Record<GameStatus, string> = {
+  draft: "Draft",
+  in_review: "In Review",
+  approved: "Approved",
+  published: "Published",
+  delisted: "Delisted",
+};
+
+/**
+ * Game statuses in which the store page of a game can be edited.
+ */
+export const EDITABLE_GAME_STATUSES: GameStatus[] = ["draft", "delisted"];
+
 /**
  * Minimum age at which the user can be registered on the platform.
  */
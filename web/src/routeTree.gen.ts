@@ -17,6 +17,8 @@ import { Route as LayoutImport } from "./routes/_layout";
 import { Route as LayoutIndexImport } from "./routes/_layout/index";
 import { Route as DistributeSigninImport } from "./routes/distribute/signin";
 import { Route as DistributeRegisterImport } from "./routes/distribute/register";
+import { Route as DistributePasswordResetImport } from "./routes/distribute/password-reset";
+import { Route as DistributeEmailVerificationImport } from "./routes/distribute/email-verification";
 import { Route as DistributeLayoutImport } from "./routes/distribute/_layout";
 import { Route as LayoutUnderConstructionImport } from "./routes/_layout/under-construction";
 import { Route as LayoutTermsServiceImport } from "./routes/_layout/terms-service";
@@ -24,6 +26,8 @@ import { Route as LayoutSigninImport } from "./routes/_layout/signin";
 import { Route as LayoutRegisterImport } from "./routes/_layout/register";
 import { Route as LayoutPrivacyPolicyImport } from "./routes/_layout/privacy-policy";
 import { Route as LayoutPaymentImport } from "./routes/_layout/payment";
+import { Route as LayoutPasswordResetImport } from "./routes/_layout/password-reset";
+import { Route as LayoutEmailVerificationImport } from "./routes/_layout/email-verification";
 import { Route as LayoutCookiePolicyImport } from "./routes/_layout/cookie-policy";
 import { Route as LayoutCartImport } from "./routes/_layout/cart";
 import { Route as LayoutBrowseImport } from "./routes/_layout/browse";
@@ -68,6 +72,17 @@ const DistributeRegisterRoute = DistributeRegisterImport.update({
   getParentRoute: () => DistributeRoute,
 } as any);
 
+const DistributePasswordResetRoute = DistributePasswordResetImport.update({
+  path: "/password-reset",
+  getParentRoute: () => DistributeRoute,
+} as any);
+
+const DistributeEmailVerificationRoute =
+  DistributeEmailVerificationImport.update({
+    path: "/email-verification",
+    getParentRoute: () => DistributeRoute,
+  } as any);
+
 const DistributeLayoutRoute = DistributeLayoutImport.update({
   id: "/_layout",
   getParentRoute: () => DistributeRoute,
@@ -103,6 +118,16 @@ const LayoutPaymentRoute = LayoutPaymentImport.update({
   getParentRoute: () => LayoutRoute,
 } as any);
 
+const LayoutPasswordResetRoute = LayoutPasswordResetImport.update({
+  path: "/password-reset",
+  getParentRoute: () => LayoutRoute,
+} as any);
+
+const LayoutEmailVerificationRoute = LayoutEmailVerificationImport.update({
+  path: "/email-verification",
+  getParentRoute: () => LayoutRoute,
+} as any);
+
 const LayoutCookiePolicyRoute = LayoutCookiePolicyImport.update({
   path: "/cookie-policy",
   getParentRoute: () => LayoutRoute,
@@ -208,6 +233,20 @@ declare module "@tanstack/react-router" {
       preLoaderRoute: typeof LayoutCookiePolicyImport;
       parentRoute: typeof LayoutImport;
     };
+    "/_layout/email-verification": {
+      id: "/_layout/email-verification";
+      path: "/email-verification";
+      fullPath: "/email-verification";
+      preLoaderRoute: typeof LayoutEmailVerificationImport;
+      parentRoute: typeof LayoutImport;
+    };
+    "/_layout/password-reset": {
+      id: "/_layout/password-reset";
+      path: "/password-reset";
+      fullPath: "/password-reset";
+      preLoaderRoute: typeof LayoutPasswordResetImport;
+      parentRoute: typeof LayoutImport;
+    };
     "/_layout/payment": {
       id: "/_layout/payment";
       path: "/payment";
@@ -264,6 +303,20 @@ declare module "@tanstack/react-router" {
       preLoaderRoute: typeof DistributeLayoutImport;
       parentRoute: typeof DistributeRoute;
     };
+    "/distribute/email-verification": {
+      id: "/distribute/email-verification";
+      path: "/email-verification";
+      fullPath: "/distribute/email-verification";
+      preLoaderRoute: typeof DistributeEmailVerificationImport;
+      parentRoute: typeof DistributeImport;
+    };
+    "/distribute/password-reset": {
+      id: "/distribute/password-reset";
+      path: "/password-reset";
+      fullPath: "/distribute/password-reset";
+      preLoaderRoute: typeof DistributePasswordResetImport;
+      parentRoute: typeof DistributeImport;
+    };
     "/distribute/register": {
       id: "/distribute/register";
       path: "/register";
@@ -351,6 +404,8 @@ interface LayoutRouteChildren {
   LayoutBrowseRoute: typeof LayoutBrowseRoute;
   LayoutCartRoute: typeof LayoutCartRoute;
   LayoutCookiePolicyRoute: typeof LayoutCookiePolicyRoute;
+  LayoutEmailVerificationRoute: typeof LayoutEmailVerificationRoute;
+  LayoutPasswordResetRoute: typeof LayoutPasswordResetRoute;
   LayoutPaymentRoute: typeof LayoutPaymentRoute;
   LayoutPrivacyPolicyRoute: typeof LayoutPrivacyPolicyRoute;
   LayoutRegisterRoute: typeof LayoutRegisterRoute;
@@ -366,6 +421,8 @@ const LayoutRouteChildren: LayoutRouteChildren = {
   LayoutBrowseRoute: LayoutBrowseRoute,
   LayoutCartRoute: LayoutCartRoute,
   LayoutCookiePolicyRoute: LayoutCookiePolicyRoute,
+  LayoutEmailVerificationRoute: LayoutEmailVerificationRoute,
+  LayoutPasswordResetRoute: LayoutPasswordResetRoute,
   LayoutPaymentRoute: LayoutPaymentRoute,
   LayoutPrivacyPolicyRoute: LayoutPrivacyPolicyRoute,
   LayoutRegisterRoute: LayoutRegisterRoute,
@@ -406,12 +463,16 @@ const DistributeLayoutRouteWithChildren =
 
 interface DistributeRouteChildren {
   DistributeLayoutRoute: typeof DistributeLayoutRouteWithChildren;
+  DistributeEmailVerificationRoute: typeof DistributeEmailVerificationRoute;
+  DistributePasswordResetRoute: typeof DistributePasswordResetRoute;
   DistributeRegisterRoute: typeof DistributeRegisterRoute;
   DistributeSigninRoute: typeof DistributeSigninRoute;
 }
 
 const DistributeRouteChildren: DistributeRouteChildren = {
   DistributeLayoutRoute: DistributeLayoutRouteWithChildren,
+  DistributeEmailVerificationRoute: DistributeEmailVerificationRoute,
+  DistributePasswordResetRoute: DistributePasswordResetRoute,
   DistributeRegisterRoute: DistributeRegisterRoute,
   DistributeSigninRoute: DistributeSigninRoute,
 };
@@ -426,6 +487,8 @@ export interface FileRoutesByFullPath {
   "/browse": typeof LayoutBrowseRoute;
   "/cart": typeof LayoutCartRoute;
   "/cookie-policy": typeof LayoutCookiePolicyRoute;
+  "/email-verification": typeof LayoutEmailVerificationRoute;
+  "/password-reset": typeof LayoutPasswordResetRoute;
   "/payment": typeof LayoutPaymentRoute;
   "/privacy-policy": typeof LayoutPrivacyPolicyRoute;
   "/register": typeof LayoutRegisterRoute;
@@ -433,6 +496,8 @@ export interface FileRoutesByFullPath {
   "/terms-service": typeof LayoutTermsServiceRoute;
   "/under-construction": typeof LayoutUnderConstructionRoute;
   "/distribute": typeof DistributeLayoutRouteWithChildren;
+  "/distribute/email-verification": typeof DistributeEmailVerificationRoute;
+  "/distribute/password-reset": typeof DistributePasswordResetRoute;
   "/distribute/register": typeof DistributeRegisterRoute;
   "/distribute/signin": typeof DistributeSigninRoute;
   "/": typeof LayoutIndexRoute;
@@ -451,6 +516,8 @@ export interface FileRoutesByTo {
   "/browse": typeof LayoutBrowseRoute;
   "/cart": typeof LayoutCartRoute;
   "/cookie-policy": typeof LayoutCookiePolicyRoute;
+  "/email-verification": typeof LayoutEmailVerificationRoute;
+  "/password-reset": typeof LayoutPasswordResetRoute;
   "/payment": typeof LayoutPaymentRoute;
   "/privacy-policy": typeof LayoutPrivacyPolicyRoute;
   "/register": typeof LayoutRegisterRoute;
@@ -458,6 +525,8 @@ export interface FileRoutesByTo {
   "/terms-service": typeof LayoutTermsServiceRoute;
   "/under-construction": typeof LayoutUnderConstructionRoute;
   "/distribute": typeof DistributeLayoutIndexRoute;
+  "/distribute/email-verification": typeof DistributeEmailVerificationRoute;
+  "/distribute/password-reset": typeof DistributePasswordResetRoute;
   "/distribute/register": typeof DistributeRegisterRoute;
   "/distribute/signin": typeof DistributeSigninRoute;
   "/": typeof LayoutIndexRoute;
@@ -477,6 +546,8 @@ export interface FileRoutesById {
   "/_layout/browse": typeof LayoutBrowseRoute;
   "/_layout/cart": typeof LayoutCartRoute;
   "/_layout/cookie-policy": typeof LayoutCookiePolicyRoute;
+  "/_layout/email-verification": typeof LayoutEmailVerificationRoute;
+  "/_layout/password-reset": typeof LayoutPasswordResetRoute;
   "/_layout/payment": typeof LayoutPaymentRoute;
   "/_layout/privacy-policy": typeof LayoutPrivacyPolicyRoute;
   "/_layout/register": typeof LayoutRegisterRoute;
@@ -485,6 +556,8 @@ export interface FileRoutesById {
   "/_layout/under-construction": typeof LayoutUnderConstructionRoute;
   "/distribute": typeof DistributeRouteWithChildren;
   "/distribute/_layout": typeof DistributeLayoutRouteWithChildren;
+  "/distribute/email-verification": typeof DistributeEmailVerificationRoute;
+  "/distribute/password-reset": typeof DistributePasswordResetRoute;
   "/distribute/register": typeof DistributeRegisterRoute;
   "/distribute/signin": typeof DistributeSigninRoute;
   "/_layout/": typeof LayoutIndexRoute;
@@ -506,6 +579,8 @@ export interface FileRouteTypes {
     | "/browse"
     | "/cart"
     | "/cookie-policy"
+    | "/email-verification"
+    | "/password-reset"
     | "/payment"
     | "/privacy-policy"
     | "/register"
@@ -513,6 +588,8 @@ export interface FileRouteTypes {
     | "/terms-service"
     | "/under-construction"
     | "/distribute"
+    | "/distribute/email-verification"
+    | "/distribute/password-reset"
     | "/distribute/register"
     | "/distribute/signin"
     | "/"
@@ -530,6 +607,8 @@ export interface FileRouteTypes {
     | "/browse"
     | "/cart"
     | "/cookie-policy"
+    | "/email-verification"
+    | "/password-reset"
     | "/payment"
     | "/privacy-policy"
     | "/register"
@@ -537,6 +616,8 @@ export interface FileRouteTypes {
     | "/terms-service"
     | "/under-construction"
     | "/distribute"
+    | "/distribute/email-verification"
+    | "/distribute/password-reset"
     | "/distribute/register"
     | "/distribute/signin"
     | "/"
@@ -554,6 +635,8 @@ export interface FileRouteTypes {
     | "/_layout/browse"
     | "/_layout/cart"
     | "/_layout/cookie-policy"
+    | "/_layout/email-verification"
+    | "/_layout/password-reset"
     | "/_layout/payment"
     | "/_layout/privacy-policy"
     | "/_layout/register"
@@ -562,6 +645,8 @@ export interface FileRouteTypes {
     | "/_layout/under-construction"
     | "/distribute"
     | "/distribute/_layout"
+    | "/distribute/email-verification"
+    | "/distribute/password-reset"
     | "/distribute/register"
     | "/distribute/signin"
     | "/_layout/"
@@ -609,6 +694,8 @@ export const routeTree = rootRoute
         "/_layout/browse",
         "/_layout/cart",
         "/_layout/cookie-policy",
+        "/_layout/email-verification",
+        "/_layout/password-reset",
         "/_layout/payment",
         "/_layout/privacy-policy",
         "/_layout/register",
@@ -635,6 +722,14 @@ export const routeTree = rootRoute
       "filePath": "_layout/cookie-policy.tsx",
       "parent": "/_layout"
     },
+    "/_layout/email-verification": {
+      "filePath": "_layout/email-verification.tsx",
+      "parent": "/_layout"
+    },
+    "/_layout/password-reset": {
+      "filePath": "_layout/password-reset.tsx",
+      "parent": "/_layout"
+    },
     "/_layout/payment": {
       "filePath": "_layout/payment.tsx",
       "parent": "/_layout"
@@ -663,6 +758,8 @@ export const routeTree = rootRoute
       "filePath": "distribute",
       "children": [
         "/distribute/_layout",
+        "/distribute/email-verification",
+        "/distribute/password-reset",
         "/distribute/register",
         "/distribute/signin"
       ]
@@ -680,6 +777,14 @@ export const routeTree = rootRoute
         "/distribute/_layout/games/$gameId/edit"
       ]
     },
+    "/distribute/email-verification": {
+      "filePath": "distribute/email-verification.tsx",
+      "parent": "/distribute"
+    },
+    "/distribute/password-reset": {
+      "filePath": "distribute/password-reset.tsx",
+      "parent": "/distribute"
+    },
     "/distribute/register": {
       "filePath": "distribute/register.tsx",
       "parent": "/distribute"
@@ -96,7 +96,7 @@ function gamesQueryOptions(search: BrowseSearchSchemaType) {
   const offset = PAGE_SIZE * (search.page - 1);
 
   let filters: GamesFilters | RecommendedGamesFilters = {
-    isActive: true,
+    status: "published",
     limit,
     offset,
   };
@@ -0,0 +1,28 @@
+import { createFileRoute } from "@tanstack/react-router";
+import * as z from "zod";
+
+import { EmailVerification } from "@/components/email-verification";
+import { confirmUserEmailVerification } from "@/lib/api";
+
+const emailVerificationSearchSchema = z.object({
+  token: z.string().optional().catch(undefined),
+});
+
+export const Route = createFileRoute("/_layout/email-verification")({
+  component: Component,
+  validateSearch: emailVerificationSearchSchema,
+});
+
+function Component() {
+  const search = Route.useSearch();
+
+  return (
+    <div className="min-h-[80vh] flex items-center justify-center">
+      <EmailVerification
+        token={search.token}
+        continuePath="/account"
+        confirmEmailVerification={confirmUserEmailVerification}
+      />
+    </div>
+  );
+}
@@ -42,14 +42,14 @@ function homeGamesQueryOptions() {
           }),
           getGames({
             limit: 6,
-            isActive: true,
+            status: "published",
             sort: "releaseDate",
             order: "asc",
             releaseDateAfter: format(new Date(), "yyyy-MM-dd"),
           }),
           getGames({
             limit: 6,
-            isActive: true,
+            status: "published",
             sort: "userCount",
             order: "desc",
             releaseDateBefore: format(new Date(), "yyyy-MM-dd"),
@@ -0,0 +1,29 @@
+import { createFileRoute } from "@tanstack/react-router";
+import * as z from "zod";
+
+import { PasswordReset } from "@/components/password-reset";
+import { confirmUserPasswordReset, requestUserPasswordReset } from "@/lib/api";
+
+const passwordResetSearchSchema = z.object({
+  token: z.string().optional().catch(undefined),
+});
+
+export const Route = createFileRoute("/_layout/password-reset")({
+  component: Component,
+  validateSearch: passwordResetSearchSchema,
+});
+
+function Component() {
+  const search = Route.useSearch();
+
+  return (
+    <div className="min-h-[80vh] flex items-center justify-center">
+      <PasswordReset
+        token={search.token}
+        signInPath="/signin"
+        requestPasswordReset={requestUserPasswordReset}
+        confirmPasswordReset={confirmUserPasswordReset}
+      />
+    </div>
+  );
+}
@@ -62,7 +62,7 @@ function gameQueryOptions(gameId: string, publisherId: string) {
 
       const relatedGames = (
         await getGames({
-          isActive: true,
+          status: "published",
           limit: RELATED_GAMES_LIMIT,
           tagIds:
             game.tags.length > 0
@@ -398,7 +398,7 @@ function AddToCart(props: {
 
   const today = useMemo(() => new Date(), []);
 
-  if (!props.game.isActive) {
+  if (props.game.status !== "published") {
     return (
       <Tooltip>
         <TooltipTrigger>
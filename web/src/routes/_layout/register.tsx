@@ -39,7 +39,7 @@ import {
 } from "@/components/ui/select";
 import { useToast } from "@/hooks/use-toast";
 import { createUser, signInUser } from "@/lib/api";
-import { decodeTokenPayload, storeToken } from "@/lib/auth";
+import { storeTokens } from "@/lib/auth";
 import { COUNTRIES, MINIMUM_USER_AGE, TOAST_MESSAGES } from "@/lib/constants";
 import { Conflict } from "@/lib/errors";
 import { userNavbarQueryKey } from "@/lib/query-keys";
@@ -108,8 +108,7 @@ function Component() {
         email: data.email,
         password: data.password,
       });
-      const payload = decodeTokenPayload(jwt.token);
-      storeToken(jwt.token, payload.exp);
+      storeTokens(jwt);
     },
     async onSuccess() {
       await queryClient.invalidateQueries({ queryKey: userNavbarQueryKey });
@@ -24,7 +24,7 @@ import {
 import { Input } from "@/components/ui/input";
 import { useToast } from "@/hooks/use-toast";
 import { signInUser } from "@/lib/api";
-import { decodeTokenPayload, storeToken } from "@/lib/auth";
+import { storeTokens } from "@/lib/auth";
 import { TOAST_MESSAGES } from "@/lib/constants";
 import { Unauthorized } from "@/lib/errors";
 import { userNavbarQueryKey } from "@/lib/query-keys";
@@ -62,9 +62,8 @@ function Component() {
         username: data.emailOrUsername,
         password: data.password,
       });
-      const payload = decodeTokenPayload(jwt.token);
 
-      storeToken(jwt.token, payload.exp);
+      storeTokens(jwt);
     },
     async onSuccess() {
       await queryClient.invalidateQueries({ queryKey: userNavbarQueryKey });
@@ -153,6 +152,9 @@ function Component() {
               <Button asChild variant="link">
                 <Link to="/register">Create account</Link>
               </Button>
+              <Button asChild variant="link">
+                <Link to="/password-reset">Forgot password?</Link>
+              </Button>
             </CardFooter>
           </form>
         </Form>
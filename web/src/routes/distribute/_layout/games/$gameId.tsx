@@ -1,4 +1,9 @@
-import { queryOptions, useSuspenseQuery } from "@tanstack/react-query";
+import {
+  queryOptions,
+  useMutation,
+  useQueryClient,
+  useSuspenseQuery,
+} from "@tanstack/react-query";
 import { createFileRoute, Link } from "@tanstack/react-router";
 import { format } from "date-fns";
 import { Download, Edit } from "lucide-react";
@@ -13,10 +18,20 @@ import {
   TooltipContent,
   TooltipTrigger,
 } from "@/components/ui/tooltip";
-import { getPublisherGame } from "@/lib/api";
+import { Game, GameStatus, GameStatusTransition } from "@/domain/game";
+import { useToast } from "@/hooks/use-toast";
+import { changeGameStatus, getPublisherGame } from "@/lib/api";
 import { decodeTokenPayload, getToken } from "@/lib/auth";
-import { MISSING_VALUE_SYMBOL, TAX, TO_BE_ANNOUNCED } from "@/lib/constants";
-import { BadRequest, NotFound } from "@/lib/errors";
+import {
+  EDITABLE_GAME_STATUSES,
+  GAME_STATUS_LABELS,
+  MISSING_VALUE_SYMBOL,
+  TAX,
+  TO_BE_ANNOUNCED,
+  TOAST_MESSAGES,
+} from "@/lib/constants";
+import { BadRequest, Conflict, NotFound } from "@/lib/errors";
+import { withAuthErrors } from "@/lib/middleware";
 import { distributeGameQueryKey } from "@/lib/query-keys";
 import { applyTax, formatCurrency, getLanguageName } from "@/lib/utils";
 
@@ -78,9 +93,9 @@ function Component() {
           <CardTitle>{game.title}</CardTitle>
           <Badge
             className="w-fit"
-            variant={game.isActive ? "default" : "destructive"}
+            variant={game.status === "published" ? "default" : "secondary"}
           >
-            {game.isActive ? "Active" : "Inactive"}
+            {GAME_STATUS_LABELS[game.status]}
           </Badge>
         </div>
         <div className="flex-1 flex gap-2 first:*:ml-auto">
@@ -104,15 +119,40 @@ function Component() {
               </TooltipContent>
             </Tooltip>
           )}
-          <Button asChild>
-            <Link params={params} to="/distribute/games/$gameId/edit">
-              <Edit />
-              Edit
-            </Link>
-          </Button>
+          <GameStatusAction game={game} />
+          {EDITABLE_GAME_STATUSES.includes(game.status) ? (
+            <Button asChild>
+              <Link params={params} to="/distribute/games/$gameId/edit">
+                <Edit />
+                Edit
+              </Link>
+            </Button>
+          ) : (
+            <Tooltip>
+              <TooltipTrigger className="size-fit">
+                <Button asChild disabled>
+                  <span>
+                    <Edit />
+                    Edit
+                  </span>
+                </Button>
+              </TooltipTrigger>
+              <TooltipContent>
+                Only drafts and delisted games can be edited
+              </TooltipContent>
+            </Tooltip>
+          )}
         </div>
       </CardHeader>
       <CardContent className="flex-1 space-y-6">
+        {game.reviewNotes && (
+          <div>
+            <h3 className="font-semibold mb-1">Review Notes</h3>
+            <p className="whitespace-pre-wrap bg-muted p-4 rounded-md text-sm">
+              {game.reviewNotes}
+            </p>
+          </div>
+        )}
         <div className="grid grid-cols-1 md:grid-cols-2 gap-4">
           <div className="grid grid-cols-2 w-fit gap-2">
             <div>
@@ -233,3 +273,69 @@ function Component() {
     </Card>
   );
 }
+
+/**
+ * Status transition available to the publisher for a game in each status.
+ */
+const GAME_STATUS_ACTIONS: Partial<
+  Record<GameStatus, { transition: GameStatusTransition; label: string }>
+> = {
+  draft: { transition: "submit", label: "Submit for Review" },
+  delisted: { transition: "submit", label: "Submit for Review" },
+  approved: { transition: "publish", label: "Publish" },
+  published: { transition: "delist", label: "Delist" },
+};
+
+/**
+ * Moves the game to the next status available to the publisher, if any.
+ */
+function GameStatusAction(props: { game: Game }) {
+  const queryClient = useQueryClient();
+  const { toast } = useToast();
+  const action = GAME_STATUS_ACTIONS[props.game.status];
+  const mutation = useMutation({
+    async mutationFn(transition: GameStatusTransition) {
+      await changeGameStatus(
+        props.game.publisher.id,
+        props.game.id,
+        transition,
+      );
+    },
+    async onSuccess() {
+      await queryClient.invalidateQueries({
+        queryKey: distributeGameQueryKey(
+          props.game.id,
+          props.game.publisher.id,
+        ),
+      });
+    },
+    onError: withAuthErrors((error) => {
+      if (error instanceof Conflict) {
+        toast({
+          variant: "destructive",
+          title:
+            error.code === "game_status_transition_invalid"
+              ? "The game status changed, please reload the page"
+              : "Only verified publishers can submit and publish games",
+        });
+        return;
+      }
+
+      toast(TOAST_MESSAGES.unexpectedError);
+    }),
+  });
+
+  if (!action) {
+    return null;
+  }
+
+  return (
+    <Button
+      disabled={mutation.isPending}
+      variant="secondary"
+      onClick={() => mutation.mutate(action.transition)}
+    >
+      {action.label}
+    </Button>
+  );
+}
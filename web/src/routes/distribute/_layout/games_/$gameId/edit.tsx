@@ -87,7 +87,7 @@ function Component() {
               "releaseDate" in game ? new Date(game.releaseDate) : undefined,
             title: game.title,
             requirements: game.requirements,
-            isActive: game.isActive,
+            submitForReview: false,
             downloadMultimedia: game.downloadMultimedia,
             previewMultimedia: game.previewMultimedia,
             multimedia: game.multimedia,
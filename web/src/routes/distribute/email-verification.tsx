@@ -0,0 +1,33 @@
+import { createFileRoute } from "@tanstack/react-router";
+import * as z from "zod";
+
+import { DistributeNavbar } from "@/components/distribute/navbar/navbar";
+import { EmailVerification } from "@/components/email-verification";
+import { confirmPublisherEmailVerification } from "@/lib/api";
+
+const emailVerificationSearchSchema = z.object({
+  token: z.string().optional().catch(undefined),
+});
+
+export const Route = createFileRoute("/distribute/email-verification")({
+  component: Component,
+  validateSearch: emailVerificationSearchSchema,
+});
+
+function Component() {
+  const search = Route.useSearch();
+
+  return (
+    <div className="flex flex-col min-h-screen bg-gradient-to-br from-primary to-secondary">
+      <DistributeNavbar variant="simple" />
+
+      <main className="flex-1 flex items-center justify-center p-4">
+        <EmailVerification
+          token={search.token}
+          continuePath="/distribute/account"
+          confirmEmailVerification={confirmPublisherEmailVerification}
+        />
+      </main>
+    </div>
+  );
+}
@@ -0,0 +1,37 @@
+import { createFileRoute } from "@tanstack/react-router";
+import * as z from "zod";
+
+import { DistributeNavbar } from "@/components/distribute/navbar/navbar";
+import { PasswordReset } from "@/components/password-reset";
+import {
+  confirmPublisherPasswordReset,
+  requestPublisherPasswordReset,
+} from "@/lib/api";
+
+const passwordResetSearchSchema = z.object({
+  token: z.string().optional().catch(undefined),
+});
+
+export const Route = createFileRoute("/distribute/password-reset")({
+  component: Component,
+  validateSearch: passwordResetSearchSchema,
+});
+
+function Component() {
+  const search = Route.useSearch();
+
+  return (
+    <div className="flex flex-col min-h-screen bg-gradient-to-br from-primary to-secondary">
+      <DistributeNavbar variant="simple" />
+
+      <main className="flex-1 flex items-center justify-center p-4">
+        <PasswordReset
+          token={search.token}
+          signInPath="/distribute/signin"
+          requestPasswordReset={requestPublisherPasswordReset}
+          confirmPasswordReset={confirmPublisherPasswordReset}
+        />
+      </main>
+    </div>
+  );
+}
@@ -32,7 +32,7 @@ import {
 } from "@/components/ui/select";
 import { useToast } from "@/hooks/use-toast";
 import { createPublisher, signInPublisher } from "@/lib/api";
-import { decodeTokenPayload, storeToken } from "@/lib/auth";
+import { storeTokens } from "@/lib/auth";
 import { COUNTRIES, TOAST_MESSAGES } from "@/lib/constants";
 import { Conflict } from "@/lib/errors";
 import { passwordRefinement, publisherAccountDetails } from "@/lib/zod";
@@ -94,8 +94,7 @@ function Component() {
         email: data.email,
         password: data.password,
       });
-      const payload = decodeTokenPayload(jwt.token);
-      storeToken(jwt.token, payload.exp);
+      storeTokens(jwt);
     },
     async onSuccess() {
       await navigate({ to: "/distribute/games" });
@@ -25,7 +25,7 @@ import {
 import { Input } from "@/components/ui/input";
 import { useToast } from "@/hooks/use-toast";
 import { signInPublisher } from "@/lib/api";
-import { decodeTokenPayload, storeToken } from "@/lib/auth";
+import { storeTokens } from "@/lib/auth";
 import { TOAST_MESSAGES } from "@/lib/constants";
 import { Unauthorized } from "@/lib/errors";
 
@@ -60,9 +60,8 @@ function Component() {
         email: data.email,
         password: data.password,
       });
-      const payload = decodeTokenPayload(jwt.token);
 
-      storeToken(jwt.token, payload.exp);
+      storeTokens(jwt);
     },
     async onSuccess() {
       await navigate({ to: "/distribute/games" });
@@ -150,6 +149,9 @@ function Component() {
                 <Button asChild variant="link">
                   <Link to="/distribute/register">Create account</Link>
                 </Button>
+                <Button asChild variant="link">
+                  <Link to="/distribute/password-reset">Forgot password?</Link>
+                </Button>
               </CardFooter>
             </form>
           </Form>